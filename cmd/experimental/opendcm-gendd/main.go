@@ -31,12 +31,12 @@ func check(err error) {
 
 func usage() {
 	fmt.Printf("OpenDCM version %s\n", od.OpenDCMVersion)
-	fmt.Printf("usage: %s dictFromNEMA.xml out_file\n", baseFile)
+	fmt.Printf("usage: %s dictFromNEMA.xml dictionary_dir\n", baseFile)
+	fmt.Println("writes dictionary.go, tag/tag.go and uid/uid.go to dictionary_dir")
 	os.Exit(1)
 }
 
 func main() {
-	od.GetConfig()
 	if len(os.Args) == 2 && (os.Args[1] == "--help" || os.Args[1] == "-h") {
 		usage()
 	}
@@ -88,8 +88,6 @@ func main() {
 	od.Infof("found %d unique identifiers (UIDs)", len(UIDs))
 
 	// build golang string
-	outF, err := os.OpenFile(os.Args[2], os.O_CREATE|os.O_WRONLY, 0666)
-	check(err)
 	outCode := `// Code generated using util:gendatadict. DO NOT EDIT.
 package dictionary
 
@@ -144,9 +142,26 @@ var UIDDictionary = map[string]*UIDEntry{
 	outCode += `}
 		`
 	// write to disk
-	_, err = outF.WriteString(outCode)
-	check(err)
+	outDir := os.Args[2]
+	check(ioutil.WriteFile(filepath.Join(outDir, "dictionary.go"), []byte(outCode), 0666))
 	od.Info(`saved dictionary file to disk`)
+
+	allElements := append(append(fileMetaElements, dirStructElements...), dataElements...)
+	tagCode, err := generateTagPackage(allElements)
+	check(err)
+	check(writePackage(filepath.Join(outDir, "tag"), "tag.go", tagCode))
+	uidCode, err := generateUIDPackage(UIDs)
+	check(err)
+	check(writePackage(filepath.Join(outDir, "uid"), "uid.go", uidCode))
+	od.Info(`saved tag and uid packages to disk`)
+}
+
+// writePackage writes `code` to the file `name` within directory `dir`, creating `dir` if necessary.
+func writePackage(dir string, name string, code []byte) error {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, name), code, 0666)
 }
 
 var stringRE, tagRE, uidStartRE, acceptibleVM *regexp.Regexp
@@ -200,7 +215,7 @@ func parseDataElements(data string) (elements []dictionary.DictEntry) {
 				elements[index].VR = token[:2]
 			default:
 				elements[index].VR = "UN"
-				od.Warnf(`using "UN" as VR instead of "%s" for tag "%s"`, token, elements[index].Tag)
+				od.Warnf(`using "UN" as VR instead of "%s" for tag "%08X"`, token, elements[index].Tag)
 			}
 		case 5:
 			orIndex := strings.Index(token, " or")
@@ -208,7 +223,7 @@ func parseDataElements(data string) (elements []dictionary.DictEntry) {
 				token = token[:orIndex]
 			}
 			if !acceptibleVM.Match([]byte(token)) {
-				od.Warnf(`using "n" as VM instead of "%s" for tag "%s"`, token, elements[index].Tag)
+				od.Warnf(`using "n" as VM instead of "%s" for tag "%08X"`, token, elements[index].Tag)
 				token = "n"
 			}
 			elements[index].VM = token
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/b71729/opendcm/dictionary"
+)
+
+/*
+===============================================================================
+    Packages: tag and uid
+    ---
+    Generates the `tag` package, providing a constant for the tag of each
+    data element named by its keyword, and the `uid` package, providing a
+    constant for each unique identifier.
+===============================================================================
+*/
+
+const generatedHeader = "// Code generated using util:gendatadict. DO NOT EDIT.\n\n"
+
+// generateTagPackage returns the source of the `tag` package, with a constant
+// for each element of `elements` which has a keyword.
+func generateTagPackage(elements []dictionary.DictEntry) ([]byte, error) {
+	sorted := append([]dictionary.DictEntry{}, elements...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Tag < sorted[j].Tag })
+
+	src := strings.Builder{}
+	src.WriteString(generatedHeader)
+	src.WriteString("// Package tag provides a constant for the tag of each data element of the\n")
+	src.WriteString("// data dictionary, named by its keyword. For example: `tag.SeriesInstanceUID`\n")
+	src.WriteString("package tag\n\nconst (\n")
+	seen := make(map[string]bool, len(sorted))
+	for _, entry := range sorted {
+		// some entries of the dictionary have no usable keyword
+		if !isIdentifier(entry.Name) || seen[entry.Name] {
+			continue
+		}
+		seen[entry.Name] = true
+		comment := fmt.Sprintf("(%04X,%04X) %s", uint16(entry.Tag>>16), uint16(entry.Tag), entry.VR)
+		if entry.Retired {
+			comment += ", retired"
+		}
+		fmt.Fprintf(&src, "\t// %s %s\n\t%s uint32 = 0x%08X\n", entry.Name, comment, entry.Name, entry.Tag)
+	}
+	src.WriteString(")\n")
+	return format.Source([]byte(src.String()))
+}
+
+// generateUIDPackage returns the source of the `uid` package, with a constant
+// for each of `uids`, named according to its human-readable name.
+func generateUIDPackage(uids []dictionary.UIDEntry) ([]byte, error) {
+	sorted := append([]dictionary.UIDEntry{}, uids...)
+	sort.Slice(sorted, func(i, j int) bool { return lessUID(sorted[i].UID, sorted[j].UID) })
+
+	src := strings.Builder{}
+	src.WriteString(generatedHeader)
+	src.WriteString("// Package uid provides a constant for each unique identifier of the data\n")
+	src.WriteString("// dictionary, such as SOP classes and transfer syntaxes. For example: `uid.ExplicitVRLittleEndian`\n")
+	src.WriteString("package uid\n\nconst (\n")
+	// current uids are named before retired uids, such that a retired uid
+	// sharing its name with a current uid is suffixed with "Retired"
+	names := make(map[string]string, len(sorted))
+	seen := make(map[string]bool, len(sorted))
+	for _, retired := range []bool{false, true} {
+		for _, entry := range sorted {
+			name := uidIdentifier(entry.NameHuman)
+			// some entries of the dictionary have no name
+			if name == "" || strings.Contains(entry.NameHuman, "(Retired)") != retired {
+				continue
+			}
+			if seen[name] && retired {
+				name += "Retired"
+			}
+			if seen[name] {
+				// disambiguate by the final component of the uid
+				name += "_" + entry.UID[strings.LastIndex(entry.UID, ".")+1:]
+			}
+			seen[name] = true
+			names[entry.UID] = name
+		}
+	}
+	for _, entry := range sorted {
+		if name, found := names[entry.UID]; found {
+			fmt.Fprintf(&src, "\t// %s is the %s \"%s\"\n\t%s = \"%s\"\n", name, entry.Type, entry.NameHuman, name, entry.UID)
+		}
+	}
+	src.WriteString(")\n")
+	return format.Source([]byte(src.String()))
+}
+
+// uidIdentifier returns an exported identifier for the human-readable name of
+// a uid, omitting any description following a colon and any "(Retired)" marker.
+// For example, "Implicit VR Little Endian: Default Transfer Syntax for DICOM"
+// becomes "ImplicitVRLittleEndian".
+func uidIdentifier(name string) string {
+	if i := strings.Index(name, ":"); i != -1 {
+		name = name[:i]
+	}
+	name = strings.Replace(name, "(Retired)", "", -1)
+	name = strings.Replace(name, "&", " And ", -1)
+	identifier := strings.Builder{}
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	}) {
+		identifier.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	if identifier.Len() > 0 && !unicode.IsLetter(rune(identifier.String()[0])) {
+		return "UID" + identifier.String()
+	}
+	return identifier.String()
+}
+
+// isIdentifier returns whether `name` is usable as an exported identifier.
+func isIdentifier(name string) bool {
+	if name == "" || !unicode.IsUpper(rune(name[0])) {
+		return false
+	}
+	for _, r := range name {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// lessUID returns whether uid `a` sorts before `b`, comparing each component numerically.
+func lessUID(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if as[i] == bs[i] {
+			continue
+		}
+		an, aErr := strconv.ParseUint(as[i], 10, 64)
+		bn, bErr := strconv.ParseUint(bs[i], 10, 64)
+		if aErr != nil || bErr != nil {
+			return as[i] < bs[i]
+		}
+		return an < bn
+	}
+	return len(as) < len(bs)
+}
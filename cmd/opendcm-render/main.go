@@ -0,0 +1,187 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	od "github.com/b71729/opendcm"
+)
+
+/*
+===============================================================================
+    Util: Render DICOM Frames
+===============================================================================
+*/
+
+// This decodes frames of the input dicom, applies the display pipeline (with
+//   window/level taken from the file or from flags), and writes each frame to
+//   the output directory as PNG or 16-bit TIFF.
+
+var baseFile = filepath.Base(os.Args[0])
+
+var (
+	format    = flag.String("format", "png", `output format: "png" or "tiff"`)
+	frames    = flag.String("frames", "", `frames to render, such as "0,2-4" (default all frames)`)
+	outDir    = flag.String("out", ".", "output directory")
+	window    = flag.Int("window", 0, "index of the window or VOI LUT of the file to apply")
+	center    = flag.Float64("wc", 0, "window center, overriding the file (requires -ww)")
+	width     = flag.Float64("ww", 0, "window width, overriding the file (requires -wc)")
+	function  = flag.String("function", "LINEAR", `VOI LUT function of -wc and -ww: "LINEAR", "LINEAR_EXACT" or "SIGMOID"`)
+	noWindow  = flag.Bool("auto", false, "ignore the windows of the file, and span the values of each frame")
+	setWindow = false
+)
+
+var functions = map[string]bool{"LINEAR": true, "LINEAR_EXACT": true, "SIGMOID": true}
+
+func check(err error) {
+	if err != nil {
+		od.FatalfDepth(3, "error: %v", err)
+	}
+}
+
+func usage() {
+	fmt.Printf("OpenDCM version %s\n", od.OpenDCMVersion)
+	fmt.Printf("usage: %s [options] file\n", baseFile)
+	flag.PrintDefaults()
+	os.Exit(1)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		usage()
+	}
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if set["wc"] != set["ww"] {
+		od.Fatalf("-wc and -ww must be provided together")
+	}
+	setWindow = set["wc"]
+	*function = strings.ToUpper(*function)
+	if !functions[*function] {
+		od.Fatalf(`function "%s" is not supported. please provide "LINEAR", "LINEAR_EXACT" or "SIGMOID"`, *function)
+	}
+	*format = strings.ToLower(*format)
+	if *format != "png" && *format != "tiff" {
+		od.Fatalf(`format "%s" is not supported. please provide "png" or "tiff"`, *format)
+	}
+	stat, err := os.Stat(*outDir)
+	check(err)
+	if !stat.IsDir() {
+		od.Fatalf(`"%s" is not a directory. please provide a directory`, *outDir)
+	}
+
+	path := flag.Arg(0)
+	dcm, err := od.FromFile(path)
+	check(err)
+	numFrames := dcm.GetPixelData().NumFrames()
+	if numFrames == 0 {
+		od.Fatalf(`"%s" does not contain pixel data`, path)
+	}
+	indices, err := parseFrames(*frames, numFrames)
+	check(err)
+
+	render, err := newRenderer(&dcm)
+	check(err)
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for _, i := range indices {
+		img, err := render(i)
+		check(err)
+		outPath := filepath.Join(*outDir, fmt.Sprintf("%s-%d.%s", name, i, *format))
+		check(writeImage(outPath, img))
+		od.Infof(`wrote frame %d to "%s"`, i, outPath)
+	}
+}
+
+// newRenderer returns a function which renders the frame at an index of `dcm`.
+// Monochrome frames are rendered through the display pipeline, and colour
+// frames are decoded as they are.
+func newRenderer(dcm *od.Dicom) (func(index int) (image.Image, error), error) {
+	params, err := dcm.GetImageParameters()
+	if err != nil {
+		return nil, err
+	}
+	if params.PhotometricInterpretation != "MONOCHROME1" && params.PhotometricInterpretation != "MONOCHROME2" {
+		return dcm.GetImage, nil
+	}
+	p, err := dcm.GetDisplayPipeline()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case setWindow:
+		p.VOI = &od.VOILUT{WindowCenter: *center, WindowWidth: *width, Function: *function}
+	case *noWindow:
+		p.VOI = nil
+	case *window < 0 || (len(p.VOIs) > 0 && *window >= len(p.VOIs)):
+		return nil, fmt.Errorf("window %d does not exist; file has %d windows", *window, len(p.VOIs))
+	case len(p.VOIs) > 0:
+		p.VOI = &p.VOIs[*window]
+	}
+	return func(index int) (image.Image, error) {
+		frame, err := dcm.GetNativeFrame(index, &p.Params)
+		if err != nil {
+			return nil, err
+		}
+		if *format == "tiff" {
+			return p.Render16(frame)
+		}
+		return p.Render(frame)
+	}, nil
+}
+
+// parseFrames returns the indices of the frames described by `s`, a comma
+// separated list of indices and ranges. An empty `s` describes all frames.
+func parseFrames(s string, numFrames int) ([]int, error) {
+	indices := []int{}
+	if s == "" {
+		for i := 0; i < numFrames; i++ {
+			indices = append(indices, i)
+		}
+		return indices, nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf(`frames "%s" are invalid`, s)
+		}
+		last := first
+		if len(bounds) == 2 {
+			if last, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, fmt.Errorf(`frames "%s" are invalid`, s)
+			}
+		}
+		if first < 0 || last >= numFrames || first > last {
+			return nil, fmt.Errorf(`frames "%s" do not exist; file has %d frames`, part, numFrames)
+		}
+		for i := first; i <= last; i++ {
+			indices = append(indices, i)
+		}
+	}
+	return indices, nil
+}
+
+// writeImage writes `img` to `path`, encoded according to the output format.
+func writeImage(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if *format == "tiff" {
+		err = encodeTIFF(f, img)
+	} else {
+		err = png.Encode(f, img)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"io"
+)
+
+/*
+===============================================================================
+    TIFF
+    ---
+    Writes uncompressed, 16-bit baseline TIFF images: grayscale images as a
+    single sample per pixel, and every other image as RGB.
+===============================================================================
+*/
+
+// tiffEntry is an entry of an image file directory, with a SHORT or LONG value.
+type tiffEntry struct {
+	tag    uint16
+	typ    uint16
+	values []uint32
+}
+
+const (
+	tiffShort = 3
+	tiffLong  = 4
+	// tiffHeaderLength is the length of the header which precedes the image file directory
+	tiffHeaderLength = 8
+)
+
+// encodeTIFF writes `img` to `w` as a 16-bit TIFF image.
+func encodeTIFF(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	gray, isGray := img.(*image.Gray16)
+	if !isGray {
+		if _, isGray = img.(*image.Gray); isGray {
+			gray = image.NewGray16(bounds)
+			for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+				for x := bounds.Min.X; x < bounds.Max.X; x++ {
+					gray.Set(x, y, img.At(x, y))
+				}
+			}
+		}
+	}
+
+	// samples are written little endian, as declared by the header
+	pixels := bytes.Buffer{}
+	samplesPerPixel := 3
+	if isGray {
+		samplesPerPixel = 1
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				binary.Write(&pixels, binary.LittleEndian, gray.Gray16At(x, y).Y)
+			}
+		}
+	} else {
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				c := color.RGBA64Model.Convert(img.At(x, y)).(color.RGBA64)
+				binary.Write(&pixels, binary.LittleEndian, [3]uint16{c.R, c.G, c.B})
+			}
+		}
+	}
+
+	photometric := uint32(2) // RGB
+	if isGray {
+		photometric = 1 // BlackIsZero
+	}
+	bitsPerSample := make([]uint32, samplesPerPixel)
+	for i := range bitsPerSample {
+		bitsPerSample[i] = 16
+	}
+	entries := []tiffEntry{
+		{256, tiffLong, []uint32{uint32(bounds.Dx())}},      // ImageWidth
+		{257, tiffLong, []uint32{uint32(bounds.Dy())}},      // ImageLength
+		{258, tiffShort, bitsPerSample},                     // BitsPerSample
+		{259, tiffShort, []uint32{1}},                       // Compression: none
+		{262, tiffShort, []uint32{photometric}},             // PhotometricInterpretation
+		{273, tiffLong, []uint32{0}},                        // StripOffsets: set below
+		{277, tiffShort, []uint32{uint32(samplesPerPixel)}}, // SamplesPerPixel
+		{278, tiffLong, []uint32{uint32(bounds.Dy())}},      // RowsPerStrip
+		{279, tiffLong, []uint32{uint32(pixels.Len())}},     // StripByteCounts
+		{284, tiffShort, []uint32{1}},                       // PlanarConfiguration: interleaved
+	}
+
+	// values which do not fit within an entry follow the image file directory,
+	// and are followed by the single strip of pixels
+	ifdLength := 2 + len(entries)*12 + 4
+	overflow := bytes.Buffer{}
+	for _, entry := range entries {
+		if entry.length() > 4 {
+			overflow.Write(entry.bytes())
+		}
+	}
+	entries[5].values[0] = uint32(tiffHeaderLength + ifdLength + overflow.Len())
+
+	out := bytes.Buffer{}
+	out.WriteString("II*\x00")
+	binary.Write(&out, binary.LittleEndian, uint32(tiffHeaderLength))
+	binary.Write(&out, binary.LittleEndian, uint16(len(entries)))
+	overflowOffset := uint32(tiffHeaderLength + ifdLength)
+	for _, entry := range entries {
+		binary.Write(&out, binary.LittleEndian, [2]uint16{entry.tag, entry.typ})
+		binary.Write(&out, binary.LittleEndian, uint32(len(entry.values)))
+		if entry.length() > 4 {
+			binary.Write(&out, binary.LittleEndian, overflowOffset)
+			overflowOffset += uint32(entry.length())
+			continue
+		}
+		value := make([]byte, 4)
+		copy(value, entry.bytes())
+		out.Write(value)
+	}
+	// offset of the next image file directory: there is none
+	binary.Write(&out, binary.LittleEndian, uint32(0))
+	out.Write(overflow.Bytes())
+	out.Write(pixels.Bytes())
+	_, err := out.WriteTo(w)
+	return err
+}
+
+// length returns the number of bytes occupied by the entry's values.
+func (entry *tiffEntry) length() int {
+	if entry.typ == tiffShort {
+		return len(entry.values) * 2
+	}
+	return len(entry.values) * 4
+}
+
+// bytes returns the entry's values, encoded little endian.
+func (entry *tiffEntry) bytes() []byte {
+	b := bytes.Buffer{}
+	for _, v := range entry.values {
+		if entry.typ == tiffShort {
+			binary.Write(&b, binary.LittleEndian, uint16(v))
+		} else {
+			binary.Write(&b, binary.LittleEndian, v)
+		}
+	}
+	return b.Bytes()
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -16,6 +17,11 @@ import (
 
 var baseFile = filepath.Base(os.Args[0])
 
+var (
+	maxWidth    = flag.Int("width", 64, "maximum width of each value, beyond which it is truncated (0 for no limit)")
+	hidePrivate = flag.Bool("no-private", false, "hide private elements")
+)
+
 func check(err error) {
 	if err != nil {
 		od.FatalfDepth(3, "error: %v", err)
@@ -24,33 +30,30 @@ func check(err error) {
 
 func usage() {
 	fmt.Printf("OpenDCM version %s\n", od.OpenDCMVersion)
-	fmt.Printf("usage: %s file_or_dir\n", baseFile)
+	fmt.Printf("usage: %s [options] file_or_dir\n", baseFile)
+	flag.PrintDefaults()
 	os.Exit(1)
 }
 
 func main() {
-	if len(os.Args) == 2 && (os.Args[1] == "--help" || os.Args[1] == "-h") {
-		usage()
-	}
-	if len(os.Args) != 2 {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
 		usage()
 	}
-	stat, err := os.Stat(os.Args[1])
+	path := flag.Arg(0)
+	stat, err := os.Stat(path)
 	check(err)
 	if isDir := stat.IsDir(); !isDir {
-		dcm, err := od.FromFile(os.Args[1])
+		dcm, err := od.FromFile(path)
 		check(err)
-		for _, element := range dcm.DataSet {
-			fmt.Printf("%08x = %v\n", element.GetTag(), element.GetName())
-		}
+		// print elements in ascending order of tag, with their values
+		check(dcm.Dump(os.Stdout, od.DumpOptions{MaxValueWidth: *maxWidth, HidePrivate: *hidePrivate}))
 		pd := dcm.GetPixelData()
 		fmt.Printf("NUM PIXEL FRAMES: %d\n", pd.NumFrames())
+		// frames are rendered to images by opendcm-render
 		for i := 0; i < pd.NumFrames(); i++ {
 			fmt.Printf("Frame: (len %d)\n", len(pd.GetFrame(i)))
-			f, err := os.Create(fmt.Sprintf("frame-%d.jpg", i))
-			check(err)
-			f.Write(pd.GetFrame(i)[:len(pd.GetFrame(i))-1])
-			f.Close()
 		}
 		tsuid := ""
 		found, err := dcm.GetElementValue(0x00020010, &tsuid)
@@ -62,7 +65,7 @@ func main() {
 	} else {
 		errorCount := 0
 		successCount := 0
-		err := od.ConcurrentlyWalkDir(os.Args[1], func(path string) {
+		err := od.ConcurrentlyWalkDir(path, func(path string) {
 			_, err := od.FromFile(path)
 			check(err)
 			basePath := filepath.Base(path)
@@ -0,0 +1,310 @@
+package opendcm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/*
+===============================================================================
+	Codec
+	---
+	Provides a registry of codecs, which compress and decompress frames of
+	pixel data with an encapsulated transfer syntax, and transcoding of a
+	dicom's pixel data between transfer syntaxes.
+===============================================================================
+*/
+
+// Codec compresses and decompresses frames of pixel data with a transfer syntax.
+type Codec interface {
+	// Decode decompresses `frame` into native pixel data, updating `params`
+	// to describe the decompressed frame.
+	Decode(frame []byte, params *ImageParameters) ([]byte, error)
+	// Encode compresses `frame`, native pixel data described by `params`,
+	// updating `params` to describe the frame once it is decompressed.
+	Encode(frame []byte, params *ImageParameters) ([]byte, error)
+}
+
+// ErrEncodingNotSupported is returned by codecs which can only decompress frames.
+var ErrEncodingNotSupported = errors.New("codec does not support encoding")
+
+// ErrDecodingNotSupported is returned by codecs which can only compress frames.
+var ErrDecodingNotSupported = errors.New("codec does not support decoding")
+
+// CodecFuncs adapts a pair of functions to the `Codec` interface. Either
+// function may be nil, in which case the codec does not support it.
+type CodecFuncs struct {
+	DecodeFunc func(frame []byte, params *ImageParameters) ([]byte, error)
+	EncodeFunc func(frame []byte, params *ImageParameters) ([]byte, error)
+}
+
+// Decode calls DecodeFunc, or returns `ErrDecodingNotSupported` if it is nil.
+func (c CodecFuncs) Decode(frame []byte, params *ImageParameters) ([]byte, error) {
+	if c.DecodeFunc == nil {
+		return nil, ErrDecodingNotSupported
+	}
+	return c.DecodeFunc(frame, params)
+}
+
+// Encode calls EncodeFunc, or returns `ErrEncodingNotSupported` if it is nil.
+func (c CodecFuncs) Encode(frame []byte, params *ImageParameters) ([]byte, error) {
+	if c.EncodeFunc == nil {
+		return nil, ErrEncodingNotSupported
+	}
+	return c.EncodeFunc(frame, params)
+}
+
+var (
+	codecsMutex sync.RWMutex
+	// codecs maps transfer syntax UIDs onto the codec registered for them
+	codecs = map[string]Codec{
+		RLELossless:        CodecFuncs{DecodeFunc: DecodeRLE, EncodeFunc: EncodeRLE},
+		JPEGBaseline:       CodecFuncs{DecodeFunc: DecodeJPEG},
+		JPEGExtended:       CodecFuncs{DecodeFunc: DecodeJPEG},
+		JPEGLossless:       CodecFuncs{DecodeFunc: DecodeJPEG},
+		JPEGLosslessSV1:    CodecFuncs{DecodeFunc: DecodeJPEG},
+		JPEGLSLossless:     CodecFuncs{DecodeFunc: DecodeJPEGLS},
+		JPEGLSNearLossless: CodecFuncs{DecodeFunc: DecodeJPEGLS},
+		JPEG2000Lossless:   CodecFuncs{DecodeFunc: DecodeJPEG2000},
+		JPEG2000:           CodecFuncs{DecodeFunc: DecodeJPEG2000},
+	}
+)
+
+// RegisterCodec registers `codec` for the encapsulated transfer syntax
+// `transferSyntaxUID`, replacing any codec already registered for it.
+//
+// The transfer syntax must be present in `TransferSyntaxMap`.
+func RegisterCodec(transferSyntaxUID string, codec Codec) error {
+	ts, err := LookupTransferSyntax(transferSyntaxUID)
+	if err != nil {
+		return err
+	}
+	if !ts.Encapsulated {
+		return fmt.Errorf("RegisterCodec: %s is not an encapsulated transfer syntax", ts.Name)
+	}
+	codecsMutex.Lock()
+	defer codecsMutex.Unlock()
+	codecs[transferSyntaxUID] = codec
+	return nil
+}
+
+// LookupCodec returns the codec registered for `transferSyntaxUID`.
+func LookupCodec(transferSyntaxUID string) (Codec, error) {
+	codecsMutex.RLock()
+	defer codecsMutex.RUnlock()
+	if codec, found := codecs[transferSyntaxUID]; found {
+		return codec, nil
+	}
+	name := transferSyntaxUID
+	if ts, err := LookupTransferSyntax(transferSyntaxUID); err == nil {
+		name = ts.Name
+	}
+	return nil, fmt.Errorf("no codec is registered for %s", name)
+}
+
+// lossyCompressionMethods holds the values of LossyImageCompressionMethod
+// (0028,2114) for lossy transfer syntaxes.
+var lossyCompressionMethods = map[string]string{
+	JPEGBaseline:              "ISO_10918_1",
+	JPEGExtended:              "ISO_10918_1",
+	JPEGLSNearLossless:        "ISO_14495_1",
+	JPEG2000:                  "ISO_15444_1",
+	"1.2.840.10008.1.2.4.93":  "ISO_15444_1",
+	"1.2.840.10008.1.2.4.100": "ISO_13818_2",
+	"1.2.840.10008.1.2.4.101": "ISO_13818_2",
+	"1.2.840.10008.1.2.4.102": "ISO_14496_10",
+	"1.2.840.10008.1.2.4.103": "ISO_14496_10",
+	"1.2.840.10008.1.2.4.104": "ISO_14496_10",
+	"1.2.840.10008.1.2.4.105": "ISO_14496_10",
+	"1.2.840.10008.1.2.4.106": "ISO_14496_10",
+	"1.2.840.10008.1.2.4.107": "ISO_23008_2",
+	"1.2.840.10008.1.2.4.108": "ISO_23008_2",
+}
+
+// Transcode converts the pixel data of `dcm` to the transfer syntax
+// `transferSyntaxUID`, decompressing and compressing each frame with the
+// registered codecs as necessary.
+//
+// (7FE0,0010) PixelData and (0002,0010) TransferSyntaxUID are replaced, and the
+// Image Pixel module is updated to describe the converted frames. If the target
+// transfer syntax is lossy, LossyImageCompression (0028,2110) is set to "01",
+// and LossyImageCompressionRatio (0028,2112) and LossyImageCompressionMethod
+// (0028,2114) gain a value describing the compression.
+func Transcode(dcm *Dicom, transferSyntaxUID string) error {
+	target, err := LookupTransferSyntax(transferSyntaxUID)
+	if err != nil {
+		return fmt.Errorf("Transcode: %v", err)
+	}
+	source := dcm.GetTransferSyntax()
+	if source == nil {
+		source = TransferSyntaxMap[ImplicitVRLittleEndian]
+	}
+	if source.UID == target.UID {
+		return nil
+	}
+	pd := NewElement()
+	hasPixelData := dcm.GetElement(pixelDataTag, &pd)
+	if hasPixelData && pd.deferred != nil {
+		return errors.New("Transcode: PixelData has not been loaded. See: `Dicom.LoadPixelData`")
+	}
+	var sourceCodec, targetCodec Codec
+	if source.Encapsulated {
+		if sourceCodec, err = LookupCodec(source.UID); err != nil {
+			return fmt.Errorf("Transcode: %v", err)
+		}
+	}
+	if target.Encapsulated {
+		if targetCodec, err = LookupCodec(target.UID); err != nil {
+			return fmt.Errorf("Transcode: %v", err)
+		}
+	}
+
+	params := ImageParameters{}
+	frames := make([][]byte, dcm.pixelData.NumFrames())
+	nativeLength, encodedLength := 0, 0
+	if len(frames) > 0 {
+		if params, err = dcm.GetImageParameters(); err != nil {
+			return fmt.Errorf("Transcode: %v", err)
+		}
+	}
+	original := params
+	for i := range frames {
+		frameParams := original
+		frame := dcm.pixelData.GetFrame(i)
+		if sourceCodec != nil {
+			if frame, err = sourceCodec.Decode(frame, &frameParams); err != nil {
+				return fmt.Errorf("Transcode: decoding frame %d with %s: %v", i, source.Name, err)
+			}
+		}
+		nativeLength += len(frame)
+		if targetCodec != nil {
+			if frame, err = targetCodec.Encode(frame, &frameParams); err != nil {
+				return fmt.Errorf("Transcode: encoding frame %d with %s: %v", i, target.Name, err)
+			}
+		} else if frameParams.LittleEndian != target.LittleEndian {
+			frame = swapBytes(frame, int(frameParams.BitsAllocated/8))
+			frameParams.LittleEndian = target.LittleEndian
+		}
+		encodedLength += len(frame)
+		frames[i] = frame
+		params = frameParams
+	}
+
+	switch {
+	case !hasPixelData:
+		dcm.setTransferSyntax(target)
+	case target.Encapsulated:
+		if err = dcm.setEncapsulatedPixelData(target.UID, frames); err != nil {
+			return fmt.Errorf("Transcode: %v", err)
+		}
+	default:
+		dcm.setNativePixelData(target, frames, &params)
+	}
+	if len(frames) > 0 {
+		dcm.setImageParameters(&params)
+	}
+	if method, lossy := lossyCompressionMethods[target.UID]; lossy && len(frames) > 0 {
+		dcm.setLossyImageCompression(method, float64(nativeLength)/float64(encodedLength))
+	}
+	return nil
+}
+
+// setTransferSyntax sets the transfer syntax, and (0002,0010) TransferSyntaxUID.
+func (dcm *Dicom) setTransferSyntax(ts *TransferSyntax) {
+	dcm.transferSyntax = ts
+	e := NewElementWithTag(0x00020010)
+	e.data = []byte(ts.UID)
+	dcm.addElement(e)
+}
+
+// nativePixelDataVR returns the VR of native PixelData of `bitsAllocated` bits
+// per sample: OB if each sample is within a byte, else OW.
+func nativePixelDataVR(bitsAllocated uint16) string {
+	if bitsAllocated <= 8 {
+		return "OB"
+	}
+	return "OW"
+}
+
+// setNativePixelData replaces PixelData with the native frames `frames`, as
+// described by `params`, and sets the transfer syntax to `ts`.
+func (dcm *Dicom) setNativePixelData(ts *TransferSyntax, frames [][]byte, params *ImageParameters) {
+	dcm.setTransferSyntax(ts)
+
+	pd := NewElementWithTag(pixelDataTag)
+	pd.setVR(nativePixelDataVR(params.BitsAllocated))
+	pd.isLittleEndian = ts.LittleEndian
+	frameBits := params.frameBits()
+	if frameBits%8 == 0 {
+		for _, frame := range frames {
+			pd.data = append(pd.data, frame...)
+		}
+	} else {
+		// bit-packed frames do not necessarily end on a byte boundary
+		pd.data = make([]byte, (frameBits*len(frames)+7)/8)
+		for i, frame := range frames {
+			for bit := 0; bit < frameBits; bit++ {
+				if frame[bit/8]&(1<<uint(bit%8)) != 0 {
+					offset := i*frameBits + bit
+					pd.data[offset/8] |= 1 << uint(offset%8)
+				}
+			}
+		}
+	}
+	pd.datalen = uint32(len(pd.data))
+	dcm.addElement(pd)
+	dcm.pixelData = newPixelData()
+	dcm.pixelData.frames = frames
+}
+
+// setImageParameters updates the attributes of the Image Pixel module which
+// describe the encoding of each frame.
+func (dcm *Dicom) setImageParameters(params *ImageParameters) {
+	for tag, v := range map[uint32]uint16{
+		0x00280002: params.SamplesPerPixel,
+		0x00280100: params.BitsAllocated,
+		0x00280101: params.BitsStored,
+		0x00280102: params.HighBit,
+		0x00280103: params.PixelRepresentation,
+	} {
+		e := NewElementWithTag(tag)
+		e.data = []byte{byte(v), byte(v >> 8)}
+		dcm.addElement(e)
+	}
+	// PlanarConfiguration is only present for images of more than one sample
+	if params.SamplesPerPixel > 1 {
+		e := NewElementWithTag(0x00280006)
+		e.data = []byte{byte(params.PlanarConfiguration), byte(params.PlanarConfiguration >> 8)}
+		dcm.addElement(e)
+	}
+	if params.PhotometricInterpretation != "" {
+		photometric := NewElementWithTag(0x00280004)
+		photometric.data = []byte(params.PhotometricInterpretation)
+		dcm.addElement(photometric)
+	}
+}
+
+// setLossyImageCompression records that the pixel data has undergone lossy
+// compression with `method`, at a compression ratio of `ratio`.
+func (dcm *Dicom) setLossyImageCompression(method string, ratio float64) {
+	lossy := NewElementWithTag(0x00282110)
+	lossy.data = []byte("01")
+	dcm.addElement(lossy)
+	for tag, value := range map[uint32]string{
+		0x00282112: strconv.FormatFloat(ratio, 'f', 2, 64),
+		0x00282114: method,
+	} {
+		existing := ""
+		dcm.GetElementValue(tag, &existing)
+		values := []string{}
+		if existing = strings.TrimSpace(existing); existing != "" {
+			values = strings.Split(existing, "\\")
+		}
+		e := NewElementWithTag(tag)
+		e.data = []byte(strings.Join(append(values, value), "\\"))
+		dcm.addElement(e)
+	}
+}
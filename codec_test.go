@@ -0,0 +1,211 @@
+package opendcm
+
+import (
+	"bytes"
+	"image"
+	"math/rand"
+	"testing"
+
+	"github.com/b71729/opendcm/dictionary"
+	"github.com/stretchr/testify/assert"
+)
+
+// invertCodec is a lossy codec for the tests, which inverts each byte.
+var invertCodec = CodecFuncs{
+	DecodeFunc: func(frame []byte, params *ImageParameters) ([]byte, error) {
+		return invertBytes(frame), nil
+	},
+	EncodeFunc: func(frame []byte, params *ImageParameters) ([]byte, error) {
+		return invertBytes(frame), nil
+	},
+}
+
+func invertBytes(src []byte) []byte {
+	dst := make([]byte, len(src))
+	for i, b := range src {
+		dst[i] = ^b
+	}
+	return dst
+}
+
+// getImages returns every frame of `dcm`, decoded into an `image.Image`.
+func getImages(t *testing.T, dcm *Dicom) []image.Image {
+	images := []image.Image{}
+	for i := 0; i < dcm.GetPixelData().NumFrames(); i++ {
+		img, err := dcm.GetImage(i)
+		assert.NoError(t, err)
+		images = append(images, img)
+	}
+	return images
+}
+
+func TestLookupCodec(t *testing.T) {
+	// ensures that codecs are registered for the supported transfer syntaxes,
+	// and that unsupported operations are reported.
+	t.Parallel()
+	for _, uid := range []string{RLELossless, JPEGBaseline, JPEGExtended, JPEGLossless, JPEGLosslessSV1, JPEGLSLossless, JPEGLSNearLossless, JPEG2000Lossless, JPEG2000} {
+		codec, err := LookupCodec(uid)
+		assert.NoError(t, err)
+		assert.NotNil(t, codec)
+	}
+	codec, err := LookupCodec(JPEG2000)
+	assert.NoError(t, err)
+	_, err = codec.Encode([]byte{0}, &ImageParameters{})
+	assert.Equal(t, ErrEncodingNotSupported, err)
+	_, err = CodecFuncs{}.Decode([]byte{0}, &ImageParameters{})
+	assert.Equal(t, ErrDecodingNotSupported, err)
+
+	_, err = LookupCodec(ExplicitVRLittleEndian)
+	assert.EqualError(t, err, "no codec is registered for Explicit VR Little Endian")
+	_, err = LookupCodec("1.2.3")
+	assert.EqualError(t, err, "no codec is registered for 1.2.3")
+}
+
+func TestRegisterCodec(t *testing.T) {
+	// ensures that registered codecs decode frames, and that codecs can only be
+	// registered for recognised, encapsulated transfer syntaxes.
+	t.Parallel()
+	assert.Error(t, RegisterCodec("1.2.3", invertCodec))
+	assert.Error(t, RegisterCodec(ExplicitVRBigEndian, invertCodec))
+	assert.NoError(t, RegisterCodec("1.2.840.10008.1.2.4.92", invertCodec))
+
+	params := ImageParameters{
+		Rows: 2, Columns: 3, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7,
+	}
+	dcm := newCompressedDicom(t, "1.2.840.10008.1.2.4.92", params, invertBytes(sequentialBytes(6)))
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, &image.Gray{Pix: sequentialBytes(6), Stride: 3, Rect: image.Rect(0, 0, 3, 2)}, img)
+}
+
+func TestTranscode(t *testing.T) {
+	// ensures that pixel data is converted between native and encapsulated
+	// transfer syntaxes, and that the converted dicom is written and read.
+	t.Parallel()
+	dcm := newNativeDicom(2, 3, 1, 16, "2", sequentialBytes(24))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("MONOCHROME2 ")
+	dcm.addElement(photometric)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	expected := getImages(t, &dcm)
+
+	for _, uid := range []string{RLELossless, ExplicitVRBigEndian, RLELossless, ImplicitVRLittleEndian, ExplicitVRBigEndian, ExplicitVRLittleEndian} {
+		assert.NoError(t, Transcode(&dcm, uid))
+		assert.Equal(t, uid, dcm.GetTransferSyntax().UID)
+		tsuid := ""
+		_, err := dcm.GetElementValue(0x00020010, &tsuid)
+		assert.NoError(t, err)
+		assert.Equal(t, uid, tsuid)
+		assert.Equal(t, expected, getImages(t, &dcm), uid)
+
+		buf := bytes.Buffer{}
+		_, err = dcm.WriteTo(&buf)
+		assert.NoError(t, err)
+		decoded, err := FromReader(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, uid, decoded.GetTransferSyntax().UID)
+		assert.Equal(t, expected, getImages(t, &decoded), uid)
+	}
+	assert.False(t, dcm.HasElement(0x00282110))
+	// the VR of 16 bit native PixelData is OW, leaving the dictionary unchanged
+	pd := dcm.DataSet[pixelDataTag]
+	assert.Equal(t, "OW", pd.GetVR())
+	assert.Equal(t, "OB", dictionary.DicomDictionary[pixelDataTag].VR)
+
+	// transcoding to the same transfer syntax does nothing
+	assert.NoError(t, Transcode(&dcm, ExplicitVRLittleEndian))
+
+	// unsupported and unrecognised transfer syntaxes
+	err := Transcode(&dcm, JPEGBaseline)
+	assert.EqualError(t, err, "Transcode: encoding frame 0 with JPEG Baseline (Process 1): codec does not support encoding")
+	assert.Equal(t, ExplicitVRLittleEndian, dcm.GetTransferSyntax().UID)
+	assert.Error(t, Transcode(&dcm, "1.2.840.10008.1.2.4.100"))
+	assert.Error(t, Transcode(&dcm, "1.2.3"))
+}
+
+func TestTranscodeCompressed(t *testing.T) {
+	// ensures that compressed frames are decompressed, and the Image Pixel module
+	// updated to describe them.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	planes := [][]int32{newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8)}
+	params := ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_RCT", PlanarConfiguration: 1,
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	frame := encodeJPEG2000(planes, 16, 8, j2kEncodeOptions{precision: 8, levels: 1, mct: true})
+	dcm := newCompressedDicom(t, JPEG2000Lossless, params, frame, frame)
+	expected := getImages(t, &dcm)
+
+	assert.NoError(t, Transcode(&dcm, ExplicitVRLittleEndian))
+	assert.Equal(t, 2, dcm.GetPixelData().NumFrames())
+	transcoded, err := dcm.GetImageParameters()
+	assert.NoError(t, err)
+	assert.Equal(t, "RGB", transcoded.PhotometricInterpretation)
+	assert.Equal(t, uint16(0), transcoded.PlanarConfiguration)
+	assert.Equal(t, expected, getImages(t, &dcm))
+	pd := NewElement()
+	assert.True(t, dcm.GetElement(pixelDataTag, &pd))
+	assert.Equal(t, 2*16*8*3, len(pd.data))
+}
+
+func TestTranscodeBitPacked(t *testing.T) {
+	// ensures that bit-packed frames, which do not end on a byte boundary, are
+	// rejoined into PixelData.
+	t.Parallel()
+	data := []byte{0x5A, 0xC3, 0x3C}
+	dcm := newNativeDicom(3, 3, 1, 1, "2", data)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	assert.NoError(t, Transcode(&dcm, ExplicitVRBigEndian))
+	pd := NewElement()
+	assert.True(t, dcm.GetElement(pixelDataTag, &pd))
+	assert.Equal(t, []byte{0x5A, 0xC3, 0x00}, pd.data)
+	assert.Equal(t, [][]byte{{0x5A, 0x01}, {0x61, 0x00}}, dcm.GetPixelData().frames)
+}
+
+func TestTranscodeLossy(t *testing.T) {
+	// ensures that the Lossy Image Compression attributes record each lossy
+	// compression.
+	t.Parallel()
+	assert.NoError(t, RegisterCodec("1.2.840.10008.1.2.4.93", invertCodec))
+	dcm := newNativeDicom(2, 3, 1, 8, "1", sequentialBytes(6))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("MONOCHROME2 ")
+	dcm.addElement(photometric)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	expected := getImages(t, &dcm)
+
+	for i, methods := range []string{"ISO_15444_1", "ISO_15444_1\\ISO_15444_1"} {
+		assert.NoError(t, Transcode(&dcm, "1.2.840.10008.1.2.4.93"))
+		assert.Equal(t, [][]byte{invertBytes(sequentialBytes(6))}, dcm.GetPixelData().frames)
+		assert.Equal(t, expected, getImages(t, &dcm))
+		for tag, value := range map[uint32]string{
+			0x00282110: "01",
+			0x00282112: []string{"1.00", "1.00\\1.00"}[i],
+			0x00282114: methods,
+		} {
+			actual := ""
+			_, err := dcm.GetElementValue(tag, &actual)
+			assert.NoError(t, err)
+			assert.Equal(t, value, actual)
+		}
+		assert.NoError(t, Transcode(&dcm, ExplicitVRLittleEndian))
+		assert.Equal(t, expected, getImages(t, &dcm))
+	}
+}
+
+func TestTranscodeWithoutPixelData(t *testing.T) {
+	// ensures that dicoms without pixel data only change transfer syntax, and
+	// that deferred pixel data must first be loaded.
+	t.Parallel()
+	dcm := newDicom()
+	assert.NoError(t, Transcode(&dcm, RLELossless))
+	assert.Equal(t, RLELossless, dcm.GetTransferSyntax().UID)
+	assert.False(t, dcm.HasElement(pixelDataTag))
+
+	pd := NewElementWithTag(pixelDataTag)
+	pd.deferred = &deferredValue{length: 2}
+	dcm.addElement(pd)
+	assert.Error(t, Transcode(&dcm, ExplicitVRLittleEndian))
+}
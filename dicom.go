@@ -2,6 +2,7 @@ package opendcm
 
 import (
 	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -9,6 +10,11 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/b71729/bin"
 	"github.com/b71729/opendcm/dictionary"
@@ -47,8 +53,9 @@ var (
 	// RecognisedVRs lists all VRs that are recognised by OpenDCM.
 	// See ``6.2 Value Representation (VR)`` for more information
 	RecognisedVRs = []string{
-		"AE", "AS", "AT", "CS", "DA", "DS", "DT", "FL", "FD", "IS", "LO", "LT", "OB", "OD",
-		"OF", "OW", "PN", "SH", "SL", "SQ", "SS", "ST", "TM", "UI", "UL", "UN", "US", "UT",
+		"AE", "AS", "AT", "CS", "DA", "DS", "DT", "FD", "FL", "IS", "LO", "LT", "OB", "OD", "OF",
+		"OL", "OV", "OW", "PN", "SH", "SL", "SQ", "SS", "ST", "SV", "TM", "UC", "UI", "UL", "UN",
+		"UR", "US", "UT", "UV",
 	}
 
 	// CharacterSetMap provides a mapping between character set name, and character set characteristics.
@@ -98,10 +105,34 @@ var (
 type Dicom struct {
 	preamble [128]byte
 	DataSet
-	pixelData PixelData
+	pixelData      PixelData
+	transferSyntax *TransferSyntax
+	closer         io.Closer
 	tmpBuffers
 }
 
+// ParseOptions specifies how much of a dicom should be decoded, and which
+// element values should instead be deferred: recorded by their offset and
+// length, and read on demand from the underlying `io.ReaderAt`.
+//
+// The zero value decodes every element eagerly.
+type ParseOptions struct {
+	// StopAtTag, if non-zero, stops parsing before the first top-level element
+	// with a tag greater than or equal to StopAtTag.
+	StopAtTag uint32
+	// SkipPixelData defers the value of (7FE0,0010) PixelData. See: `Dicom.LoadPixelData`
+	SkipPixelData bool
+	// MaxEagerElementSize, if non-zero, defers the value of binary elements which
+	// are longer than MaxEagerElementSize bytes. Textual elements and sequences
+	// are always read eagerly.
+	MaxEagerElementSize uint32
+}
+
+// defersValues returns whether any element values may be deferred.
+func (opts ParseOptions) defersValues() bool {
+	return opts.SkipPixelData || opts.MaxEagerElementSize > 0
+}
+
 // NewDicom returns a fresh Dicom suitable for parsing
 // dicom data.
 func newDicom() Dicom {
@@ -115,6 +146,40 @@ func (dcm *Dicom) GetPixelData() *PixelData {
 	return &dcm.pixelData
 }
 
+// GetTransferSyntax returns the transfer syntax used to encode the data set.
+// If the meta group did not specify a transfer syntax, this will be the
+// transfer syntax determined from the data set itself.
+func (dcm *Dicom) GetTransferSyntax() *TransferSyntax {
+	return dcm.transferSyntax
+}
+
+// LoadPixelData reads the value of a deferred PixelData element from the
+// underlying source, and decodes its frames. See: `ParseOptions.SkipPixelData`
+func (dcm *Dicom) LoadPixelData() error {
+	e := NewElement()
+	if !dcm.GetElement(pixelDataTag, &e) {
+		return errors.New("LoadPixelData: dicom does not contain PixelData")
+	}
+	if dcm.err = e.LoadValue(); dcm.err != nil {
+		return dcm.err
+	}
+	dcm.addElement(e)
+	dcm.pixelData = newPixelData()
+	dcm.onPixelData(e)
+	return nil
+}
+
+// Close releases the source retained by `FromFileWithOptions` in order to read
+// deferred values. Deferred values can not be loaded once the dicom is closed.
+func (dcm *Dicom) Close() error {
+	if dcm.closer == nil {
+		return nil
+	}
+	dcm.err = dcm.closer.Close()
+	dcm.closer = nil
+	return dcm.err
+}
+
 // GetPreamble returns the "preamble" component
 func (dcm *Dicom) GetPreamble() [128]byte {
 	return dcm.preamble
@@ -151,161 +216,207 @@ func (dcm *Dicom) attemptReadPreamble(br *bin.Reader) (bool, error) {
 	return true, nil
 }
 
-// onPixelData is called when a PixelData element is detected in the dicom.
-func (dcm *Dicom) onPixelData(pdElement Element) {
-	if pdElement.HasItems() {
-		Warn("Has fragmented data.")
-		// decode offset table
-		offsetTableRaw := pdElement.items[0].fragment
-		offsetTable := make([]int, 0)
-		for i := 0; i < len(offsetTableRaw)-4; i += 4 {
-			offsetTable = append(offsetTable, int(binary.LittleEndian.Uint32(offsetTableRaw[i:(i+4)])))
-		}
-		// we must concatenate all items other than the offsettable into one slice
+// FromReader decodes a dicom file from `source`, returning an error
+// if something went wrong during the process.
+// This takes ownership of `source`; do not use it after passing through.
+func FromReader(source io.Reader) (Dicom, error) {
+	return FromReaderWithOptions(source, ParseOptions{})
+}
 
-		concatenated := make([]byte, 0)
-		for i := 1; i < len(pdElement.items); i++ {
-			concatenated = append(concatenated, pdElement.items[i].fragment...)
-		}
+// FromReaderWithOptions decodes a dicom file from `source` according to `opts`.
+//
+// Deferred values can only be read on demand if `source` implements `io.ReaderAt`;
+// if `source` also implements `io.Seeker`, its current offset is taken into account.
+// See: FromReader for more information
+func FromReaderWithOptions(source io.Reader, opts ParseOptions) (Dicom, error) {
+	dcm := newDicom()
+	var elr ElementReader
+	if elr, dcm.err = dcm.newElementReader(source, opts); dcm.err != nil {
+		return dcm, dcm.err
+	}
 
-		// process frames
-		for i := 0; i < len(offsetTable); i++ {
-			var frame []byte
-			if i == len(offsetTable)-1 {
-				frame = concatenated[offsetTable[i]:]
-			} else {
-				frame = concatenated[offsetTable[i]:offsetTable[i+1]]
+	// read elements
+	inMeta := true
+	for {
+		if dcm._bool, dcm.err = dcm.beforeElement(&elr, &inMeta, opts.StopAtTag); dcm.err != nil {
+			return dcm, dcm.err
+		}
+		if !dcm._bool {
+			break
+		}
+		e := NewElement()
+		if dcm.err = elr.ReadElement(&e); dcm.err != nil {
+			if dcm.err == io.EOF {
+				break
 			}
-			dcm.pixelData.frames = append(dcm.pixelData.frames, frame)
+			return dcm, dcm.err
 		}
-		for i, frame := range dcm.pixelData.frames {
-			Errorf("frame #%d: %d bytes", i, len(frame))
+		//Debugf("Adding element: %s [%s] @ %d", e.dictEntry, e.GetVR(), elr.GetPosition())
+		if e.GetTag() == 0x00020010 {
+			// lookup transfer syntax, which determines encoding of the non-meta section
+			if dcm.transferSyntax, dcm.err = LookupTransferSyntax(string(e.data)); dcm.err != nil {
+				return dcm, dcm.err
+			}
 		}
-	} else {
-		Warn("No fragmented data.")
-		dcm.pixelData.frames = append(dcm.pixelData.frames, pdElement.data)
+		// elements are added in the order in which they are read. See: InsertionOrder
+		dcm.addElement(e)
+	}
+
+	// we must re-encode the parsed elements from their native characterset into UTF-8:
+	// lookup character set according to the pre-defined table
+	cs := dcm.GetCharacterSet()
+	Debugf("CS: %v", cs.Name)
+	decoder := cs.Encoding.NewDecoder()
+	// for each element in dataset:
+	for _, e := range dcm.DataSet {
+		decodeText(&e, decoder)
+		dcm.addElement(e)
+	}
+
+	// PixelData is decoded once the elements describing it have been added.
+	// if deferred, it is instead decoded by `LoadPixelData`
+	if pd, found := dcm.DataSet[pixelDataTag]; found && !pd.IsDeferred() {
+		dcm.onPixelData(pd)
 	}
+	return dcm, nil
 }
 
-// FromReader decodes a dicom file from `source`, returning an error
-// if something went wrong during the process.
-// This takes ownership of `source`; do not use it after passing through.
-func FromReader(source io.Reader) (Dicom, error) {
-	dcm := newDicom()
+// decodeText decodes the value of `e`, if it is of a VR subject to the character
+// set ("SH", "LO", "ST", "PN", "LT", "UC", "UT"), and those of the elements of its
+// items, into UTF-8 in-place.
+func decodeText(e *Element, decoder *encoding.Decoder) {
+	switch e.GetVR() {
+	case "SH", "LO", "ST", "PN", "LT", "UC", "UT":
+		e.data, _ = decoder.Bytes(e.data) // this will not result in an error as replacement runes are enforced
+	}
+	for _, item := range e.items {
+		for _, nested := range item.dataset {
+			decodeText(&nested, decoder)
+			item.dataset.addElement(nested)
+		}
+	}
+}
+
+// newElementReader parses the preamble from `source`, returning an ElementReader
+// positioned at the start of the meta group.
+func (dcm *Dicom) newElementReader(source io.Reader, opts ParseOptions) (ElementReader, error) {
+	var sourceAt io.ReaderAt
+	sourceOffset := int64(0)
+	if opts.defersValues() {
+		if ra, ok := source.(io.ReaderAt); ok {
+			sourceAt = ra
+			if seeker, ok := source.(io.Seeker); ok {
+				if sourceOffset, dcm.err = seeker.Seek(0, io.SeekCurrent); dcm.err != nil {
+					return ElementReader{}, dcm.err
+				}
+			}
+		}
+	}
 	binaryReader := bin.NewReader(source, binary.LittleEndian)
 
 	// attempt to parse preamble
 	dcm._bool, dcm.err = dcm.attemptReadPreamble(&binaryReader)
 	if dcm.err != nil {
-		return dcm, dcm.err
+		return ElementReader{}, dcm.err
 	}
 	if !dcm._bool {
 		Debug("file is missing preamble/magic (bytes 0-132)")
 	}
 
 	elr := NewElementReader(binaryReader)
+	elr.opts = opts
+	elr.source = sourceAt
+	elr.sourceOffset = sourceOffset
 	// meta elements are always explicit vr, little endian
 	elr.SetImplicitVR(false)
 	elr.SetLittleEndian(true)
+	return elr, nil
+}
 
-	// read elements
-	inMeta := true
-	// initialise array of elements
-	elements := make([]Element, 0)
-	for {
-		e := NewElement()
-		if inMeta {
-			// if in meta section, we should read the first two
-			// bytes (first component of tag) to determine whether
-			// we have reached boundary of meta section
-			if dcm.err = elr.br.Peek(dcm._1kb[:2]); dcm.err != nil {
-				if dcm.err == io.EOF {
-					break
-				}
-				return dcm, dcm.err
+// beforeElement is called before reading each top-level element from `elr`.
+// Upon reaching the boundary of the meta group, `elr` is set up to decode the
+// remainder of the data set according to the transfer syntax.
+//
+// Returns whether the next element should be read; false if the end of the
+// stream, or `stopAtTag`, has been reached.
+func (dcm *Dicom) beforeElement(elr *ElementReader, inMeta *bool, stopAtTag uint32) (bool, error) {
+	if *inMeta {
+		// if in meta section, we should read the first two
+		// bytes (first component of tag) to determine whether
+		// we have reached boundary of meta section
+		if dcm.err = elr.br.Peek(dcm._1kb[:2]); dcm.err != nil {
+			if dcm.err == io.EOF {
+				return false, nil
 			}
-			// if the first component is not (0002), we have reached end
-			// of meta section
-			if binary.LittleEndian.Uint16(dcm._1kb[:2]) != 0x0002 {
-				inMeta = false
-				// determine binary encoding of non-meta section
+			return false, dcm.err
+		}
+		// if the first component is not (0002), we have reached end
+		// of meta section
+		if binary.LittleEndian.Uint16(dcm._1kb[:2]) != 0x0002 {
+			*inMeta = false
+			// set binary encoding of non-meta section according to the
+			// transfer syntax, if one was specified in the meta section
+			if dcm.transferSyntax != nil {
+				elr.SetImplicitVR(dcm.transferSyntax.ImplicitVR)
+				elr.SetLittleEndian(dcm.transferSyntax.LittleEndian)
+				// the remainder of a deflated stream must be inflated before it can be read
+				if dcm.transferSyntax.Deflated {
+					elr.inflate()
+				}
+			} else {
+				// otherwise, determine binary encoding of non-meta section
 				// we do this by peeking six bytes from the reader
 				// and passing through to `determineEncoding`
+				Debug("meta section is missing transfer syntax; determining encoding from data set")
 				if dcm.err = elr.br.Peek(dcm._1kb[:6]); dcm.err != nil {
 					if dcm.err == io.EOF {
-						break
+						return false, nil
 					}
-					return dcm, dcm.err
+					return false, dcm.err
 				}
 				elr.determineEncoding(dcm._1kb[:6])
+				dcm.transferSyntax = transferSyntaxForEncoding(elr.IsImplicitVR(), elr.IsLittleEndian())
 			}
 		}
-		if dcm.err = elr.ReadElement(&e); dcm.err != nil {
+	}
+	if stopAtTag != 0 {
+		// stop before reading the element if it is at, or beyond stopAtTag
+		if dcm.err = elr.br.Peek(dcm._1kb[:4]); dcm.err != nil {
 			if dcm.err == io.EOF {
-				break
+				return false, nil
 			}
-			return dcm, dcm.err
-		}
-		//Debugf("Adding element: %s [%s] @ %d", e.dictEntry, e.GetVR(), elr.br.GetPosition())
-		switch e.GetTag() {
-		case 0x00080005:
-			dcm.addElement(e)
-		default:
-			elements = append(elements, e)
-		}
-	}
-
-	// we must re-encode the parsed elements from their native characterset into UTF-8:
-	// lookup character set according to the pre-defined table
-	cs := dcm.GetCharacterSet()
-	Debugf("CS: %v", cs.Name)
-	decoder := cs.Encoding.NewDecoder()
-	// for each element in dataset:
-	for _, e := range elements {
-		// 	is it of ("SH", "LO", "ST", "PN", "LT", "UT")?
-		switch e.GetVR() {
-		case "SH", "LO", "ST", "PN", "LT", "UT":
-			// if so, decode data in-place
-			e.data, _ = decoder.Bytes(e.data) // this will not result in an error as replacement runes are enforced
+			return false, dcm.err
 		}
-
-		// look for PixelData
-		if e.GetTag() == pixelDataTag {
-			dcm.onPixelData(e)
-			continue
+		elr.tagFromBytes(dcm._1kb[:4], &dcm.ui32)
+		if dcm.ui32 >= stopAtTag {
+			return false, nil
 		}
-		dcm.addElement(e)
 	}
-
-	return dcm, nil
+	return true, nil
 }
 
 // FromFile decodes a dicom file from the given file path
 // See: FromReader for more information
 func FromFile(path string) (Dicom, error) {
+	return FromFileWithOptions(path, ParseOptions{})
+}
+
+// FromFileWithOptions decodes a dicom file from the given file path according to `opts`.
+// If `opts` may defer values, the file is kept open in order to read them on demand,
+// and should be released using `Dicom.Close`.
+// See: FromReaderWithOptions for more information
+func FromFileWithOptions(path string, opts ParseOptions) (Dicom, error) {
 	var f *os.File
 	dcm := newDicom()
 	if f, dcm.err = os.Open(path); dcm.err != nil {
 		return dcm, dcm.err
 	}
-	defer f.Close()
-	return FromReader(f)
-}
-
-type PixelData struct {
-	frames [][]byte
-}
-
-func newPixelData() PixelData {
-	return PixelData{frames: make([][]byte, 0)}
-}
-
-func (pd *PixelData) GetFrame(index int) []byte {
-	return pd.frames[index]
-}
-
-func (pd *PixelData) NumFrames() int {
-	return len(pd.frames)
+	if dcm, dcm.err = FromReaderWithOptions(f, opts); dcm.err != nil || !opts.defersValues() {
+		f.Close()
+		return dcm, dcm.err
+	}
+	dcm.closer = f
+	return dcm, nil
 }
 
 /*
@@ -350,19 +461,85 @@ func (ds *DataSet) GetElement(tag uint32, dst *Element) bool {
 // GetElementValue writes the element's value indexed by `tag` into `dst`
 // its return value (bool) indicates whether the DataSet contains said `tag`.
 // its return value (error) indicates whether there are any other problems.
+// A deferred value is read once, and retained by the element within the DataSet.
 func (ds *DataSet) GetElementValue(tag uint32, dst interface{}) (bool, error) {
 	if e, found := (*ds)[tag]; found {
+		if e.IsDeferred() {
+			if err := e.LoadValue(); err != nil {
+				return true, err
+			}
+			ds.addElement(e)
+		}
 		return true, e.GetValue(dst)
 	}
 
 	return false, nil
 }
 
+// GetByKeyword writes the value of the element with keyword `keyword`, such as
+// "StudyInstanceUID", into `dst`.
+// See: GetElementValue and LookupKeyword
+func (ds *DataSet) GetByKeyword(keyword string, dst interface{}) (bool, error) {
+	tag, found := LookupKeyword(keyword)
+	if !found {
+		return false, fmt.Errorf(`GetByKeyword: "%s" is not a keyword of the dictionary`, keyword)
+	}
+	return ds.GetElementValue(tag, dst)
+}
+
+// Put adds Element `e` to the data set, replacing any element of the same tag.
+// A replacement retains the position of the element it replaces. See: InsertionOrder
+//
+// Elements should be added with Put, rather than by writing to the map
+// directly, for their position to be recorded.
+func (ds *DataSet) Put(e Element) {
+	ds.addElement(e)
+}
+
 // addElement adds Element `e` to the data set.
 func (ds *DataSet) addElement(e Element) {
+	if existing, found := (*ds)[e.GetTag()]; found {
+		e.inserted = existing.inserted
+	} else {
+		// positions begin at 1, and are kept contiguous by `Delete`, so the next
+		// follows the number of elements
+		e.inserted = uint64(len(*ds)) + 1
+	}
 	(*ds)[e.GetTag()] = e
 }
 
+// Delete removes the element indexed by `tag`, if it exists. The positions of
+// the elements put after it are renumbered, in time proportional to the number
+// of elements.
+func (ds *DataSet) Delete(tag uint32) {
+	deleted, found := (*ds)[tag]
+	if !found {
+		return
+	}
+	delete(*ds, tag)
+	if deleted.inserted == 0 {
+		// the element was not put, and so has no position: those put are numbered
+		// afresh, such that the next position, which follows the number of
+		// elements, remains free
+		for i, t := range ds.InsertionOrder() {
+			e := (*ds)[t]
+			if e.inserted == 0 {
+				break
+			}
+			e.inserted = uint64(i) + 1
+			(*ds)[t] = e
+		}
+		return
+	}
+	// elements put after the deleted element move up a position
+	for t, e := range *ds {
+		if e.inserted > deleted.inserted {
+			e.inserted--
+			(*ds)[t] = e
+		}
+	}
+}
+
 // HasElement returns whether the element indexed by `tag` exists.
 func (ds *DataSet) HasElement(tag uint32) bool {
 	return ds.GetElement(tag, &Element{})
@@ -373,6 +550,45 @@ func (ds *DataSet) Len() int {
 	return len((*ds))
 }
 
+// Tags returns the tags of the elements, in ascending order. This is the order
+// in which the standard requires elements to be encoded.
+func (ds *DataSet) Tags() []uint32 {
+	tags := make([]uint32, 0, len(*ds))
+	for tag := range *ds {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+	return tags
+}
+
+// InsertionOrder returns the tags of the elements, in the order in which they
+// were put into the data set. For a parsed data set, this is the order in which
+// they appear in the source.
+//
+// The order is only defined for elements added with `Put`. Elements written to
+// the map directly follow them, in ascending order of tag.
+func (ds *DataSet) InsertionOrder() []uint32 {
+	tags := ds.Tags()
+	sort.SliceStable(tags, func(i, j int) bool {
+		a, b := (*ds)[tags[i]].inserted, (*ds)[tags[j]].inserted
+		if a == 0 || b == 0 {
+			// elements without a position follow those with one
+			return b == 0 && a != 0
+		}
+		return a < b
+	})
+	return tags
+}
+
+// Range calls `fn` for each element, in ascending order of tag, until `fn` returns false.
+func (ds *DataSet) Range(fn func(e Element) bool) {
+	for _, tag := range ds.Tags() {
+		if !fn((*ds)[tag]) {
+			return
+		}
+	}
+}
+
 // GetCharacterSet returns either the character set as defined in (0008,0005),
 // or ISO_IR 100 (default character set)
 func (ds *DataSet) GetCharacterSet() (cs *CharacterSet) {
@@ -415,6 +631,35 @@ func NewItem() Item {
 	}
 }
 
+// GetDataSet returns the data set embedded within the item.
+// Items of encapsulated pixel data hold a fragment in place of a data set.
+func (i *Item) GetDataSet() *DataSet {
+	return &i.dataset
+}
+
+// GetFragment returns the raw bytes of the item, as is the case for
+// items of encapsulated pixel data. Returns nil for items holding a data set.
+func (i *Item) GetFragment() []byte {
+	return i.fragment
+}
+
+// GetElement writes the element indexed by `tag` within the item into `dst`
+// its return value indicates whether the item contains said `tag`.
+func (i *Item) GetElement(tag uint32, dst *Element) bool {
+	return i.dataset.GetElement(tag, dst)
+}
+
+// GetElementValue writes the value of the element indexed by `tag` within the item into `dst`.
+// See: DataSet.GetElementValue
+func (i *Item) GetElementValue(tag uint32, dst interface{}) (bool, error) {
+	return i.dataset.GetElementValue(tag, dst)
+}
+
+// HasElement returns whether the item contains the element indexed by `tag`.
+func (i *Item) HasElement(tag uint32) bool {
+	return i.dataset.HasElement(tag)
+}
+
 /*
 ===============================================================================
 	Element
@@ -433,6 +678,22 @@ type Element struct {
 	isLittleEndian bool
 	datalen        uint32
 	items          []Item
+	offset         int64
+	deferred       *deferredValue
+	// inserted is the position of the element within its data set, in order of
+	// insertion, beginning at 1. It is 0 if the element was not added with `Put`
+	inserted uint64
+}
+
+// deferredValue describes where the value of a deferred element can be read from.
+type deferredValue struct {
+	// source is nil if the value can not be read on demand
+	source io.ReaderAt
+	// offset is the absolute offset of the value within source
+	offset int64
+	length int64
+	// encapsulated values are a series of fragments, and are of undefined length
+	encapsulated bool
 }
 
 // NewElement returns a fresh Element
@@ -460,6 +721,14 @@ func NewElementWithTag(t uint32) Element {
 	return e
 }
 
+// setVR sets the Element's "VR" component. The dictionary entry is copied, as
+// it is shared with every other element of the same tag.
+func (e *Element) setVR(vr string) {
+	entry := *e.dictEntry
+	entry.VR = vr
+	e.dictEntry = &entry
+}
+
 // splitCharacterStringVM splits `buffer` using "\" as delimiter.
 func splitCharacterStringVM(buffer []byte) [][]byte {
 	return bytes.Split(buffer, []byte(`\`))
@@ -505,17 +774,66 @@ func (e *Element) GetItems() []Item {
 	return e.items
 }
 
-// Len returns the data literal bytelength
+// Len returns the data literal bytelength.
+// For deferred elements, this is the number of bytes occupied in the source.
 func (e *Element) Len() int {
+	if e.deferred != nil {
+		return int(e.deferred.length)
+	}
 	return int(e.datalen)
 }
 
+// byteOrder returns the byte order of the element's binary value.
+func (e *Element) byteOrder() binary.ByteOrder {
+	if e.isLittleEndian {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+// GetOffset returns the offset of the element's value within the data source.
+// See: `ElementReader.GetPosition`
+func (e *Element) GetOffset() int64 {
+	return e.offset
+}
+
+// IsDeferred returns whether the element's value has not yet been read.
+// See: `LoadValue`
+func (e *Element) IsDeferred() bool {
+	return e.deferred != nil
+}
+
+// LoadValue reads the value of a deferred element from the underlying source.
+// It is a no-op for elements that have already been read.
+//
+// The value is retained by `e` only: an element retrieved from a DataSet is a
+// copy, and so `GetValue` of such a copy reads the source again on each call.
+// See: `DataSet.GetElementValue`, which retains the value within the DataSet.
+func (e *Element) LoadValue() error {
+	if e.deferred == nil {
+		return nil
+	}
+	if e.deferred.source == nil {
+		return fmt.Errorf("LoadValue: value of %s was deferred, but the source does not support random access", e.dictEntry)
+	}
+	buf := make([]byte, e.deferred.length)
+	if n, err := e.deferred.source.ReadAt(buf, e.deferred.offset); n < len(buf) {
+		return err
+	}
+	if e.deferred.encapsulated {
+		// parse fragments from the buffer
+		elr := NewElementReader(bin.NewReaderBytes(buf, e.byteOrder()))
+		if err := elr.readElementDataUndefLength(e); err != nil {
+			return err
+		}
+	} else {
+		e.data = buf
+	}
+	e.deferred = nil
+	return nil
+}
+
 func (e *Element) supportsType(typ interface{}) bool {
-	/*
-			TODO:
-			"OD", "OF", "OW",
-		    "SQ",
-	*/
 	// in the case that the VR is unknown, take the less disruptive choice: respond with true
 	// in practice, we don't know whether it supports, but we need a way of allowing the value to be retrieved.
 	if e.GetVR() == "UN" {
@@ -524,16 +842,48 @@ func (e *Element) supportsType(typ interface{}) bool {
 	switch typ.(type) {
 	case string, *string, []string, *[]string:
 		switch e.GetVR() {
-		case "SH", "LO", "ST", "PN", "LT", "UT",
+		case "SH", "LO", "ST", "PN", "LT", "UC", "UT", "UR",
 			"IS", "DS", "TM", "DA", "DT", "UI", "CS", "AS", "AE": // These shouldnt be parsed using charset btw
 			return true
 		}
 	case float32, *float32, []float32, *[]float32:
-		if e.GetVR() == "FL" {
+		if e.GetVR() == "FL" || e.GetVR() == "OF" {
 			return true
 		}
 	case float64, *float64, []float64, *[]float64:
-		if e.GetVR() == "FD" {
+		switch e.GetVR() {
+		case "FD", "OD", "DS":
+			return true
+		}
+	case int64, *int64, []int64, *[]int64:
+		if e.GetVR() == "SV" || e.GetVR() == "IS" {
+			return true
+		}
+	case uint64, *uint64, []uint64, *[]uint64:
+		if e.GetVR() == "UV" || e.GetVR() == "OV" {
+			return true
+		}
+	case DateTime, *DateTime, []DateTime, *[]DateTime, time.Time, *time.Time, DateTimeRange, *DateTimeRange:
+		switch e.GetVR() {
+		case "DA", "TM", "DT":
+			return true
+		}
+	case PersonName, *PersonName, []PersonName, *[]PersonName:
+		if e.GetVR() == "PN" {
+			return true
+		}
+	case Age, *Age:
+		if e.GetVR() == "AS" {
+			return true
+		}
+	case int, []int:
+		// integers of any size may be expressed by VRs of integers, subject to range
+		switch e.GetVR() {
+		case "SS", "US", "SL", "UL", "SV", "UV", "IS":
+			return true
+		}
+	case DataSet, []DataSet, Item, []Item:
+		if e.GetVR() == "SQ" {
 			return true
 		}
 	case int16, *int16, []int16, *[]int16:
@@ -545,11 +895,12 @@ func (e *Element) supportsType(typ interface{}) bool {
 			return true
 		}
 	case uint16, *uint16, []uint16, *[]uint16:
-		if e.GetVR() == "US" {
+		if e.GetVR() == "US" || e.GetVR() == "OW" {
 			return true
 		}
 	case uint32, *uint32, []uint32, *[]uint32:
-		if e.GetVR() == "UL" || e.GetVR() == "AT" {
+		switch e.GetVR() {
+		case "UL", "OL", "AT":
 			return true
 		}
 	case []byte, *[]byte:
@@ -559,13 +910,44 @@ func (e *Element) supportsType(typ interface{}) bool {
 	return false
 }
 
+// uint32Value decodes a 32 bit value from `v`. Attribute tags (AT) are pairs of
+// 16 bit group and element numbers, and are decoded as a tag.
+func (e *Element) uint32Value(order binary.ByteOrder, v []byte) uint32 {
+	if e.GetVR() == "AT" {
+		return uint32(order.Uint16(v))<<16 | uint32(order.Uint16(v[2:]))
+	}
+	return order.Uint32(v)
+}
+
 // GetValue writes the element's "value" component to "dst".
 // "dst" should be writable (pointer type)
 func (e *Element) GetValue(dst interface{}) error {
+	// deferred values are read on demand
+	if err := e.LoadValue(); err != nil {
+		return err
+	}
 	// check whether the VR supports expression as target type
 	if !e.supportsType(dst) {
 		return fmt.Errorf("GetValue(%s): value of %s cannot be expressed as a %s", reflect.TypeOf(dst), e.dictEntry, reflect.TypeOf(dst))
 	}
+	// textual values of numbers, dates, times, names and ages are parsed
+	switch dst.(type) {
+	case *DateTime, *[]DateTime, *time.Time, *DateTimeRange, *PersonName, *[]PersonName, *Age:
+		return e.getTypedValue(dst)
+	case *float64, *[]float64, *int64, *[]int64:
+		if e.GetVR() == "DS" || e.GetVR() == "IS" {
+			return e.getTypedValue(dst)
+		}
+	}
+	// binary values are decoded according to the byte order of their source.
+	// scalar destinations receive the first value, which must be present.
+	order := e.byteOrder()
+	hasValue := func(size int) error {
+		if len(e.data) < size {
+			return fmt.Errorf("GetValue(%s): value of %s is too short", reflect.TypeOf(dst), e.dictEntry)
+		}
+		return nil
+	}
 	switch typedDst := dst.(type) {
 	case *string:
 		// if VR is textual just return UTF8 string (when a dicom is parsed, using `FromReader`, all text elements
@@ -580,52 +962,76 @@ func (e *Element) GetValue(dst interface{}) error {
 		*typedDst = e.data
 	case *[]float32:
 		for _, v := range splitBinaryVM(e.data, 4) {
-			if e.isLittleEndian {
-				*typedDst = append(*typedDst, math.Float32frombits(binary.LittleEndian.Uint32(v)))
-			} else {
-				*typedDst = append(*typedDst, math.Float32frombits(binary.BigEndian.Uint32(v)))
-			}
+			*typedDst = append(*typedDst, math.Float32frombits(order.Uint32(v)))
 		}
 	case *float32:
-		*typedDst = math.Float32frombits(binary.LittleEndian.Uint32(e.data[:4]))
+		if err := hasValue(4); err != nil {
+			return err
+		}
+		*typedDst = math.Float32frombits(order.Uint32(e.data))
 	case *[]float64:
 		for _, v := range splitBinaryVM(e.data, 8) {
-			if e.isLittleEndian {
-				*typedDst = append(*typedDst, math.Float64frombits(binary.LittleEndian.Uint64(v)))
-			} else {
-				*typedDst = append(*typedDst, math.Float64frombits(binary.BigEndian.Uint64(v)))
-			}
+			*typedDst = append(*typedDst, math.Float64frombits(order.Uint64(v)))
 		}
 	case *float64:
-		*typedDst = math.Float64frombits(binary.LittleEndian.Uint64(e.data[:8]))
+		if err := hasValue(8); err != nil {
+			return err
+		}
+		*typedDst = math.Float64frombits(order.Uint64(e.data))
 	case *[]int16:
 		for _, v := range splitBinaryVM(e.data, 2) {
-			if e.isLittleEndian {
-				*typedDst = append(*typedDst, int16(binary.LittleEndian.Uint16(v)))
-			} else {
-				*typedDst = append(*typedDst, int16(binary.BigEndian.Uint16(v)))
-			}
+			*typedDst = append(*typedDst, int16(order.Uint16(v)))
 		}
 	case *int16:
-		if e.isLittleEndian {
-			*typedDst = int16(binary.LittleEndian.Uint16(e.data))
-		} else {
-			*typedDst = int16(binary.BigEndian.Uint16(e.data))
+		if err := hasValue(2); err != nil {
+			return err
 		}
+		*typedDst = int16(order.Uint16(e.data))
 	case *[]int32:
 		for _, v := range splitBinaryVM(e.data, 4) {
-			if e.isLittleEndian {
-				*typedDst = append(*typedDst, int32(binary.LittleEndian.Uint32(v)))
-			} else {
-				*typedDst = append(*typedDst, int32(binary.BigEndian.Uint32(v)))
-			}
+			*typedDst = append(*typedDst, int32(order.Uint32(v)))
 		}
 	case *int32:
-		if e.isLittleEndian {
-			*typedDst = int32(binary.LittleEndian.Uint32(e.data))
-		} else {
-			*typedDst = int32(binary.BigEndian.Uint32(e.data))
+		if err := hasValue(4); err != nil {
+			return err
+		}
+		*typedDst = int32(order.Uint32(e.data))
+	case *[]int64:
+		for _, v := range splitBinaryVM(e.data, 8) {
+			*typedDst = append(*typedDst, int64(order.Uint64(v)))
+		}
+	case *int64:
+		if err := hasValue(8); err != nil {
+			return err
+		}
+		*typedDst = int64(order.Uint64(e.data))
+	case *[]uint16:
+		for _, v := range splitBinaryVM(e.data, 2) {
+			*typedDst = append(*typedDst, order.Uint16(v))
 		}
+	case *uint16:
+		if err := hasValue(2); err != nil {
+			return err
+		}
+		*typedDst = order.Uint16(e.data)
+	case *[]uint32:
+		for _, v := range splitBinaryVM(e.data, 4) {
+			*typedDst = append(*typedDst, e.uint32Value(order, v))
+		}
+	case *uint32:
+		if err := hasValue(4); err != nil {
+			return err
+		}
+		*typedDst = e.uint32Value(order, e.data)
+	case *[]uint64:
+		for _, v := range splitBinaryVM(e.data, 8) {
+			*typedDst = append(*typedDst, order.Uint64(v))
+		}
+	case *uint64:
+		if err := hasValue(8); err != nil {
+			return err
+		}
+		*typedDst = order.Uint64(e.data)
 	// if not writable type (pointer), return error
 	case bool, string,
 		int, int8, int16, int32, int64,
@@ -649,9 +1055,19 @@ func (e *Element) GetValue(dst interface{}) error {
 // ElementReader extends `bin.Reader` to export methods to assist in
 // decoding DICOM Elements, i.e. "ReadElement".
 type ElementReader struct {
-	br       bin.Reader
-	implicit bool
-	charSet  *CharacterSet
+	br        bin.Reader
+	implicit  bool
+	charSet   *CharacterSet
+	posOffset int64
+	// opts specifies which element values should be deferred
+	opts ParseOptions
+	// source, if set, allows deferred values to be read on demand.
+	// sourceOffset is the offset of source corresponding to position zero.
+	source       io.ReaderAt
+	sourceOffset int64
+	// sourceVR is the VR of the element being read, as it appears in the source.
+	// It may differ from the VR of the dictionary.
+	sourceVR string
 	tmpBuffers
 }
 
@@ -692,6 +1108,98 @@ func lookupTag(t uint32) (entry *dictionary.DictEntry, found bool) {
 	return
 }
 
+var (
+	keywordsOnce sync.Once
+	keywords     map[string]uint32
+)
+
+// LookupKeyword returns the tag of the dictionary entry with keyword (Name) `keyword`,
+// such as 0x00100010 for "PatientName". The reverse index of keywords is built from
+// `dictionary.DicomDictionary` upon first use.
+func LookupKeyword(keyword string) (uint32, bool) {
+	keywordsOnce.Do(func() {
+		keywords = make(map[string]uint32, len(dictionary.DicomDictionary))
+		for tag, entry := range dictionary.DicomDictionary {
+			// some entries of the dictionary have no usable keyword
+			if entry.Name == "" || entry.Name[0] < 'A' || entry.Name[0] > 'Z' {
+				continue
+			}
+			keywords[entry.Name] = tag
+		}
+	})
+	tag, found := keywords[keyword]
+	return tag, found
+}
+
+// ParseTag parses `s` as either a tag, written in hexadecimal as "(gggg,eeee)",
+// "gggg,eeee" or "ggggeeee", or as the keyword of a dictionary entry such as "PatientName".
+func ParseTag(s string) (uint32, error) {
+	s = strings.TrimSpace(s)
+	hex := strings.TrimSuffix(strings.TrimPrefix(s, "("), ")")
+	if parts := strings.Split(hex, ","); len(parts) == 2 {
+		if len(parts[0]) != 4 || len(parts[1]) != 4 {
+			return 0, fmt.Errorf(`tag "%s" is invalid`, s)
+		}
+		hex = parts[0] + parts[1]
+	}
+	if len(hex) == 8 {
+		if tag, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return uint32(tag), nil
+		}
+	}
+	if tag, found := LookupKeyword(s); found {
+		return tag, nil
+	}
+	return 0, fmt.Errorf(`"%s" is neither a tag nor a keyword`, s)
+}
+
+// GetPosition returns the current offset of the reader within the data source.
+//
+// If the data source has been inflated (see: `inflate`), the offset is given in
+// terms of the inflated stream, continuing from where the compressed data began.
+// As such, offsets remain the same as they would be in an uncompressed equivalent.
+func (elr *ElementReader) GetPosition() int64 {
+	return elr.posOffset + elr.br.GetPosition()
+}
+
+// inflate replaces the data source of this ElementReader with one which
+// decompresses (raw deflate, as per RFC 1951) the remainder of the source.
+// This is used by "Deflated Explicit VR Little Endian", where the data set
+// following the meta group is compressed.
+func (elr *ElementReader) inflate() {
+	// positions no longer correspond to offsets of the source, and so
+	// deferred values can not be read on demand
+	elr.source = nil
+	elr.posOffset = elr.GetPosition()
+	elr.br = bin.NewReader(flate.NewReader(&byteReader{br: elr.br}), elr.br.GetByteOrder())
+}
+
+// byteReader adapts a `bin.Reader` to `io.Reader` and `io.ByteReader`, such
+// that it can be used as the source of a decompressor.
+type byteReader struct {
+	br  bin.Reader
+	buf [1]byte
+}
+
+// ReadByte reads a single byte from the underlying `bin.Reader`.
+func (r *byteReader) ReadByte() (byte, error) {
+	if err := r.br.ReadBytes(r.buf[:]); err != nil {
+		return 0, err
+	}
+	return r.buf[0], nil
+}
+
+// Read reads up to `len(p)` bytes from the underlying `bin.Reader`.
+func (r *byteReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		if p[n], err = r.ReadByte(); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
 // IsLittleEndian returns whether this ElementReader is set to parse
 // data according to Little Endian byte ordering.
 func (elr *ElementReader) IsLittleEndian() bool {
@@ -734,10 +1242,16 @@ func (elr *ElementReader) readElementVR(dst *Element) error {
 	if elr.err = elr.br.ReadBytes(elr._1kb[:2]); elr.err != nil {
 		return elr.err
 	}
-	// only overwrite the existing dictionary entry's VR if we have UN
-	// and source has something else (has added value)
-	if (dst.GetVR() == "UN" || dst.GetVR() == "") && string(elr._1kb[:2]) != "UN" {
-		dst.dictEntry.VR = string(elr._1kb[:2])
+	// the size of the length component is determined by the source VR
+	elr.sourceVR = string(elr._1kb[:2])
+	// overwrite the existing dictionary entry's VR if we have UN and source has
+	// something else (has added value), or if source has another recognised VR,
+	// such as OW for PixelData: the source VR describes the value as encoded.
+	if elr.sourceVR == "UN" || elr.sourceVR == dst.GetVR() {
+		return nil
+	}
+	if dst.GetVR() == "UN" || dst.GetVR() == "" || isRecognisedVR(elr.sourceVR) {
+		dst.setVR(elr.sourceVR)
 	}
 	return nil
 }
@@ -754,9 +1268,14 @@ func (elr *ElementReader) readElementLength(dst *Element) error {
 		}
 	} else {
 		// issue #6: use *source* VR as basis for deciding whether to skip / size of length integer.
-		// in explicit VR mode, if the VR is OB, OW, SQ, UN or UT, skip two bytes and read as uint32, else uint16.
-		switch dst.GetVR() {
-		case "OB", "OW", "SQ", "UN", "UT":
+		// in explicit VR mode, if the VR is OB, OW, SQ, UN, UT etc., skip two bytes and read as uint32, else uint16.
+		vr := elr.sourceVR
+		if vr == "" {
+			vr = dst.GetVR()
+		}
+		elr.sourceVR = ""
+		switch {
+		case hasLongLength(vr):
 			// skip 2 bytes
 			if elr.err = elr.br.Discard(2); elr.err != nil {
 				return elr.err
@@ -776,6 +1295,16 @@ func (elr *ElementReader) readElementLength(dst *Element) error {
 	return nil
 }
 
+// hasLongLength returns whether, in explicit VR mode, the length of an element
+// with the given VR is encoded as two reserved bytes followed by a 32 bit integer.
+func hasLongLength(vr string) bool {
+	switch vr {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UC", "UN", "UR", "UT", "UV":
+		return true
+	}
+	return false
+}
+
 // tagFromBytes parses a dicom tag from a block of four bytes.
 // If "src" is not of length four, an error will be returned.
 func (elr *ElementReader) tagFromBytes(src []byte, dst *uint32) error {
@@ -989,9 +1518,9 @@ func (elr *ElementReader) readElementData(dst *Element) error {
 		return elr.err
 	}
 
+	// binary VRs (OB, OD, OF, OL, OV, OW) are not stripped, as their trailing bytes are significant
 	padchars := []byte{0x00, 0x20}
-	switch dst.GetVR() {
-	case "UI", "OB", "CS", "DS", "IS", "AE", "AS", "DA", "DT", "LO", "LT", "OD", "OF", "OW", "PN", "SH", "ST", "TM", "UT":
+	if isTextualVR(dst.GetVR()) {
 		for _, chr := range padchars {
 			if dst.data[len(dst.data)-1] == chr {
 				dst.data = dst.data[:len(dst.data)-1]
@@ -1005,28 +1534,50 @@ func (elr *ElementReader) readElementData(dst *Element) error {
 	return nil
 }
 
+// isTextualVR returns whether values of the given VR are character strings,
+// which may be padded to an even length.
+func isTextualVR(vr string) bool {
+	switch vr {
+	case "UI", "CS", "DS", "IS", "AE", "AS", "DA", "DT", "LO", "LT", "PN", "SH", "ST", "TM", "UC", "UR", "UT":
+		return true
+	}
+	return false
+}
+
 // readPixelData attempts to read a PixelData element.
 // it is handled separately due to its unique structure.
 // assumed position of reader: after PixelData VR
 func (elr *ElementReader) readPixelData(dst *Element) error {
-	Errorf("PixelData VR: %s", dst.GetVR())
-	Errorf("PixelData Length: %X", dst.datalen)
+	Debugf("PixelData VR: %s", dst.GetVR())
+	Debugf("PixelData Length: %X", dst.datalen)
 	if dst.datalen == 0xFFFFFFFF {
-		elr.readElementDataUndefLength(dst)
+		return elr.readElementDataUndefLength(dst)
 	}
-	return nil
+	// native pixel data is read as arbitrary bytes
+	return elr.readElementData(dst)
 }
 
 // ReadElement attempts to completely read an element into `dst`.
 //
 // All types of elements are expected to be compatible.
 func (elr *ElementReader) ReadElement(dst *Element) error {
+	if elr.err = elr.readElementHeader(dst); elr.err != nil {
+		return elr.err
+	}
+	return elr.readElementValue(dst)
+}
+
+// readElementHeader attempts to read the "Tag", "VR" and "Length" components
+// of an element into `dst`.
+func (elr *ElementReader) readElementHeader(dst *Element) error {
 	// read tag
 	if elr.err = elr.readTag(&elr.ui32); elr.err != nil {
 		return elr.err
 	}
 	// set element.dictentry to an entry in dictionary
 	dst.dictEntry, elr._bool = lookupTag(elr.ui32)
+	// binary values are stored in the byte order of the source
+	dst.isLittleEndian = elr.IsLittleEndian()
 
 	// read vr
 	if elr.err = elr.readElementVR(dst); elr.err != nil {
@@ -1037,6 +1588,18 @@ func (elr *ElementReader) ReadElement(dst *Element) error {
 	if elr.err = elr.readElementLength(dst); elr.err != nil {
 		return elr.err
 	}
+	dst.offset = elr.GetPosition()
+	return nil
+}
+
+// readElementValue attempts to read the "Data" component of an element
+// into `dst`, following its header.
+//
+// Should be careful calling this, as it assumes specific Reader offset.
+func (elr *ElementReader) readElementValue(dst *Element) error {
+	if elr.shouldDefer(dst) {
+		return elr.deferElementData(dst)
+	}
 
 	// handle PixelData
 	if dst.GetTag() == pixelDataTag {
@@ -1047,6 +1610,66 @@ func (elr *ElementReader) ReadElement(dst *Element) error {
 	return elr.readElementData(dst)
 }
 
+// shouldDefer returns whether the value of `e` should be deferred, according
+// to the options of this ElementReader. See: `ParseOptions`
+func (elr *ElementReader) shouldDefer(e *Element) bool {
+	if e.GetTag() == pixelDataTag && elr.opts.SkipPixelData {
+		return true
+	}
+	if elr.opts.MaxEagerElementSize == 0 || e.datalen == 0xFFFFFFFF || e.datalen <= elr.opts.MaxEagerElementSize {
+		return false
+	}
+	// textual values must be decoded, and sequences contain elements of their own
+	return e.GetVR() != "SQ" && !isTextualVR(e.GetVR())
+}
+
+// deferElementData records the offset and length of the "Data" component of an
+// element into `dst`, and discards it from the reader.
+//
+// Should be careful calling this, as it assumes specific Reader offset.
+func (elr *ElementReader) deferElementData(dst *Element) error {
+	startPos := elr.GetPosition()
+	dst.deferred = &deferredValue{
+		source:       elr.source,
+		offset:       elr.sourceOffset + startPos,
+		encapsulated: dst.datalen == 0xFFFFFFFF,
+	}
+	if dst.deferred.encapsulated {
+		// the length of encapsulated data is only known after reading each fragment header
+		if elr.err = elr.discardFragments(); elr.err != nil {
+			return elr.err
+		}
+	} else if elr.err = elr.br.Discard(int64(dst.datalen)); elr.err != nil {
+		return elr.err
+	}
+	dst.deferred.length = elr.GetPosition() - startPos
+	return nil
+}
+
+// discardFragments discards a series of fragments from the reader, up to and
+// including the sequence delimitation item.
+func (elr *ElementReader) discardFragments() error {
+	for {
+		if elr.err = elr.readTag(&elr.ui32); elr.err != nil {
+			return elr.err
+		}
+		tag := elr.ui32
+		if elr.err = elr.br.ReadUint32(&elr.ui32); elr.err != nil {
+			return elr.err
+		}
+		switch tag {
+		case seqDelimTag:
+			return nil
+		case itemTag:
+			if elr.err = elr.br.Discard(int64(elr.ui32)); elr.err != nil {
+				return elr.err
+			}
+		default:
+			return errors.New("did not find ItemStartTag")
+		}
+	}
+}
+
 // readTag attempts to read/decode a dicom "Tag" from the reader into `dst`.
 //
 // Should be careful calling this, as it assumes specific Reader offset.
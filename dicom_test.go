@@ -2,8 +2,10 @@ package opendcm
 
 import (
 	"bytes"
+	"compress/flate"
 	"encoding/binary"
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -186,6 +188,30 @@ func TestGetElementValue(t *testing.T) {
 	assert.False(t, found)
 }
 
+func TestGetByKeyword(t *testing.T) {
+	// ensures that `GetByKeyword` retrieves values by the keyword of their tag.
+	t.Parallel()
+	ds := make(DataSet, 0)
+	e := NewElementWithTag(0x0020000D)
+	e.data = []byte("1.2.3.4")
+	ds.addElement(e)
+	var out string
+	found, err := ds.GetByKeyword("StudyInstanceUID", &out)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", out)
+
+	// recognised keyword, but not in the dataset
+	found, err = ds.GetByKeyword("SeriesInstanceUID", &out)
+	assert.False(t, found)
+	assert.NoError(t, err)
+
+	// unrecognised keyword
+	found, err = ds.GetByKeyword("StudyUID", &out)
+	assert.False(t, found)
+	assert.Error(t, err)
+}
+
 func TestAddElement(t *testing.T) {
 	// ensures that `addElement` does not panic.
 	t.Parallel()
@@ -193,6 +219,80 @@ func TestAddElement(t *testing.T) {
 	ds.addElement(NewElement())
 }
 
+func TestPutDelete(t *testing.T) {
+	// ensures that `Put` adds or replaces elements, and `Delete` removes them.
+	t.Parallel()
+	ds := make(DataSet, 0)
+	ds.Put(NewElement())
+	e := NewElementWithTag(0x00100010)
+	e.data = []byte("Doe^Jane")
+	ds.Put(e)
+	e = NewElementWithTag(0x00100010)
+	e.data = []byte("Doe^John")
+	ds.Put(e)
+	assert.Equal(t, 2, ds.Len())
+	name := ""
+	_, err := ds.GetElementValue(0x00100010, &name)
+	assert.NoError(t, err)
+	assert.Equal(t, "Doe^John", name)
+	ds.Delete(0x00100010)
+	ds.Delete(0x00100020)
+	assert.False(t, ds.HasElement(0x00100010))
+	assert.Equal(t, 1, ds.Len())
+}
+
+func TestTags(t *testing.T) {
+	// ensures that `Tags` and `Range` visit elements in ascending order of tag,
+	// and `InsertionOrder` in the order in which they were put.
+	t.Parallel()
+	ds := make(DataSet, 0)
+	for _, tag := range []uint32{0x00280010, 0x00080018, 0x00100010, 0x00080016} {
+		ds.Put(NewElementWithTag(tag))
+	}
+	// replacements retain their position
+	ds.Put(NewElementWithTag(0x00100010))
+	assert.Equal(t, []uint32{0x00080016, 0x00080018, 0x00100010, 0x00280010}, ds.Tags())
+	assert.Equal(t, []uint32{0x00280010, 0x00080018, 0x00100010, 0x00080016}, ds.InsertionOrder())
+	visited := []uint32{}
+	ds.Range(func(e Element) bool {
+		visited = append(visited, e.GetTag())
+		return len(visited) < 3
+	})
+	assert.Equal(t, []uint32{0x00080016, 0x00080018, 0x00100010}, visited)
+	// deletions do not disturb the order of the remaining elements
+	ds.Delete(0x00080018)
+	ds.Put(NewElementWithTag(0x00080018))
+	assert.Equal(t, []uint32{0x00280010, 0x00100010, 0x00080016, 0x00080018}, ds.InsertionOrder())
+
+	// elements written to the map directly follow those put, in ascending order
+	// of tag, while those read from the map and written back keep their position
+	ds[0x00200013] = NewElementWithTag(0x00200013)
+	ds[0x00200011] = NewElementWithTag(0x00200011)
+	e := ds[0x00100010]
+	e.data = []byte("Doe^Jane")
+	ds[0x00100010] = e
+	ds.Put(NewElementWithTag(0x00080020))
+	assert.Equal(t, []uint32{0x00280010, 0x00100010, 0x00080016, 0x00080018, 0x00080020, 0x00200011, 0x00200013}, ds.InsertionOrder())
+	// and their deletion does not disturb the order of those put
+	ds.Delete(0x00200011)
+	ds.Delete(0x00080016)
+	ds.Put(NewElementWithTag(0x00080012))
+	assert.Equal(t, []uint32{0x00280010, 0x00100010, 0x00080018, 0x00080020, 0x00080012, 0x00200013}, ds.InsertionOrder())
+
+	// parsed data sets are in the order of their source, including (0008,0005)
+	for _, name := range []string{"VRTest.dcm", "ISO_IR100.dcm"} {
+		dcm, err := FromFile(filepath.Join("testdata", "synthetic", name))
+		assert.NoError(t, err)
+		order := dcm.InsertionOrder()
+		assert.Len(t, order, dcm.Len())
+		assert.Equal(t, uint32(0x00020000), order[0], name)
+		for i := 1; i < len(order); i++ {
+			previous, current := dcm.DataSet[order[i-1]], dcm.DataSet[order[i]]
+			assert.True(t, previous.GetOffset() < current.GetOffset(), name)
+		}
+	}
+}
+
 func TestHasElement(t *testing.T) {
 	// ensures that `HasElement` correctly identifies
 	// elements that are / are not present.
@@ -336,6 +436,99 @@ func TestGetValue(t *testing.T) {
 				assert.NoError(t, e.GetValue(&dst))
 				dst2 := []int32{}
 				assert.NoError(t, e.GetValue(&dst2))
+			case "US":
+				e.data = []byte{0x01, 0x02}
+				dst := uint16(0)
+				assert.NoError(t, e.GetValue(&dst))
+				if isLittle {
+					assert.Equal(t, uint16(0x0201), dst)
+				} else {
+					assert.Equal(t, uint16(0x0102), dst)
+				}
+				dst2 := []uint16{}
+				assert.NoError(t, e.GetValue(&dst2))
+				assert.Equal(t, []uint16{dst}, dst2)
+				// value too short
+				e.data = e.data[:1]
+				assert.Error(t, e.GetValue(&dst))
+			case "UL":
+				e.data = []byte{0x01, 0x02, 0x03, 0x04}
+				dst := uint32(0)
+				assert.NoError(t, e.GetValue(&dst))
+				if isLittle {
+					assert.Equal(t, uint32(0x04030201), dst)
+				} else {
+					assert.Equal(t, uint32(0x01020304), dst)
+				}
+				dst2 := []uint32{}
+				assert.NoError(t, e.GetValue(&dst2))
+				assert.Equal(t, []uint32{dst}, dst2)
+				// value too short
+				e.data = e.data[:3]
+				assert.Error(t, e.GetValue(&dst))
+			case "AT":
+				// group and element are ordered as two 16 bit values
+				e.data = []byte{0x00, 0x28, 0x00, 0x10, 0x00, 0x28, 0x00, 0x11}
+				if isLittle {
+					e.data = []byte{0x28, 0x00, 0x10, 0x00, 0x28, 0x00, 0x11, 0x00}
+				}
+				dst := uint32(0)
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Equal(t, uint32(0x00280010), dst)
+				dst2 := []uint32{}
+				assert.NoError(t, e.GetValue(&dst2))
+				assert.Equal(t, []uint32{0x00280010, 0x00280011}, dst2)
+			case "OL":
+				e.data = []byte{0x01, 0x02, 0x03, 0x04}
+				dst := []uint32{}
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Len(t, dst, 1)
+			case "OW":
+				e.data = []byte{0x01, 0x02, 0x03, 0x04}
+				dst := []uint16{}
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Len(t, dst, 2)
+			case "OF":
+				e.data = make([]byte, 8)
+				dst := []float32{}
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Len(t, dst, 2)
+			case "OD":
+				e.data = make([]byte, 16)
+				dst := []float64{}
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Len(t, dst, 2)
+			case "SV":
+				e.data = []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}
+				if isLittle {
+					e.data = []byte{0xFE, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+				}
+				dst := int64(0)
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Equal(t, int64(-2), dst)
+				dst2 := []int64{}
+				assert.NoError(t, e.GetValue(&dst2))
+				assert.Equal(t, []int64{dst}, dst2)
+				// value too short
+				e.data = e.data[:7]
+				assert.Error(t, e.GetValue(&dst))
+			case "UV", "OV":
+				e.data = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+				dst := uint64(0)
+				assert.NoError(t, e.GetValue(&dst))
+				if isLittle {
+					assert.Equal(t, uint64(0x0807060504030201), dst)
+				} else {
+					assert.Equal(t, uint64(0x0102030405060708), dst)
+				}
+				dst2 := []uint64{}
+				assert.NoError(t, e.GetValue(&dst2))
+				assert.Equal(t, []uint64{dst}, dst2)
+			case "UC", "UR":
+				e.data = []byte("http://dicom.nema.org")
+				dst := ""
+				assert.NoError(t, e.GetValue(&dst))
+				assert.Equal(t, "http://dicom.nema.org", dst)
 			case "UN":
 				e.data = make([]byte, 4)
 				dst := make([]byte, 4)
@@ -346,6 +539,89 @@ func TestGetValue(t *testing.T) {
 	}
 }
 
+func TestGetValueBigEndian(t *testing.T) {
+	// ensures that each binary value of an Explicit VR Big Endian source is
+	// equal to that of Explicit VR Little Endian, as both scalar and slice.
+	t.Parallel()
+	little, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	// VRs for which VRTest.dcm has no elements are added as private elements, and
+	// its encapsulated PixelData is replaced by native PixelData
+	for tag, vr := range map[uint32]string{0x00091010: "SV", 0x00091011: "UV", 0x00091012: "OL", 0x00091013: "OV", pixelDataTag: "OW"} {
+		e := NewElementWithTag(tag)
+		e.setVR(vr)
+		e.isLittleEndian = true
+		e.data = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0xF0}
+		little.addElement(e)
+	}
+	ts := NewElementWithTag(0x00020010)
+	ts.data = []byte(ExplicitVRBigEndian)
+	little.addElement(ts)
+	buf := bytes.Buffer{}
+	_, err = little.WriteTo(&buf)
+	assert.NoError(t, err)
+	big, err := FromReader(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, ExplicitVRBigEndian, big.GetTransferSyntax().UID)
+	little, err = FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+
+	destinations := map[string]func() []interface{}{
+		"AT": func() []interface{} { return []interface{}{new(uint32), new([]uint32)} },
+		"FL": func() []interface{} { return []interface{}{new(float32), new([]float32)} },
+		"OF": func() []interface{} { return []interface{}{new(float32), new([]float32)} },
+		"FD": func() []interface{} { return []interface{}{new(float64), new([]float64)} },
+		"OD": func() []interface{} { return []interface{}{new(float64), new([]float64)} },
+		"SS": func() []interface{} { return []interface{}{new(int16), new([]int16)} },
+		"SL": func() []interface{} { return []interface{}{new(int32), new([]int32)} },
+		"SV": func() []interface{} { return []interface{}{new(int64), new([]int64)} },
+		"US": func() []interface{} { return []interface{}{new(uint16), new([]uint16)} },
+		"OW": func() []interface{} { return []interface{}{new(uint16), new([]uint16)} },
+		"UL": func() []interface{} { return []interface{}{new(uint32), new([]uint32)} },
+		"OL": func() []interface{} { return []interface{}{new(uint32), new([]uint32)} },
+		"UV": func() []interface{} { return []interface{}{new(uint64), new([]uint64)} },
+		"OV": func() []interface{} { return []interface{}{new(uint64), new([]uint64)} },
+	}
+	tested := map[string]bool{}
+	for tag, e := range big.DataSet {
+		newDsts, found := destinations[e.GetVR()]
+		if !found {
+			continue
+		}
+		tested[e.GetVR()] = true
+		bigDsts := newDsts()
+		littleDsts := newDsts()
+		for i := range bigDsts {
+			found, err := big.GetElementValue(tag, bigDsts[i])
+			assert.True(t, found)
+			assert.NoError(t, err)
+			if tag>>16 == 0x0009 || tag == pixelDataTag {
+				// added elements are compared with their little endian values
+				e.isLittleEndian = true
+				e.data = []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0xF0}
+				assert.NoError(t, e.GetValue(littleDsts[i]))
+			} else {
+				found, err = little.GetElementValue(tag, littleDsts[i])
+				assert.True(t, found)
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, littleDsts[i], bigDsts[i], "%08X %s", tag, e.GetVR())
+		}
+	}
+	assert.Len(t, tested, len(destinations))
+	pd := big.DataSet[pixelDataTag]
+	assert.Equal(t, "OW", pd.GetVR())
+
+	sl := int32(0)
+	_, err = big.GetElementValue(0x0072007C, &sl)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-1234), sl)
+	at := uint32(0)
+	_, err = big.GetElementValue(0x00720060, &at)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x24429001), at)
+}
+
 func TestGetValueError(t *testing.T) {
 	// ensures that the error condition of `GetValue`
 	// responds correctly.
@@ -413,6 +689,45 @@ func TestLookupTag(t *testing.T) {
 	assert.Equal(t, "PixelData", de.Name)
 }
 
+func TestLookupKeyword(t *testing.T) {
+	// ensures that keywords of the dictionary are indexed by their tag.
+	t.Parallel()
+	for keyword, expected := range map[string]uint32{
+		"PatientName":               0x00100010,
+		"StudyInstanceUID":          0x0020000D,
+		"TransferSyntaxUID":         0x00020010,
+		"SelectorCodeSequenceValue": 0x00720080,
+	} {
+		tag, found := LookupKeyword(keyword)
+		assert.True(t, found, keyword)
+		assert.Equal(t, expected, tag, keyword)
+	}
+	for _, keyword := range []string{"", "1", "patientname", "Unknown(0000,0000)"} {
+		_, found := LookupKeyword(keyword)
+		assert.False(t, found, keyword)
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	// ensures that tags are parsed from their hexadecimal representations, and from keywords.
+	t.Parallel()
+	for s, expected := range map[string]uint32{
+		"(0010,0010)":  0x00100010,
+		"0020,000e":    0x0020000E,
+		"7FE00010":     0x7FE00010,
+		" PatientName": 0x00100010,
+		"PixelData":    0x7FE00010,
+	} {
+		tag, err := ParseTag(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, tag, s)
+	}
+	for _, s := range []string{"", "(010,0010)", "0010,00100", "0010001G", "NotAKeyword", "1"} {
+		_, err := ParseTag(s)
+		assert.Error(t, err, s)
+	}
+}
+
 func TestNewElementReader(t *testing.T) {
 	t.Parallel()
 	src := bin.NewReader(bytes.NewReader(make([]byte, 64)), binary.LittleEndian)
@@ -513,6 +828,17 @@ func TestReadElementLength(t *testing.T) {
 	e = NewElementWithTag(0x000100010)
 	assert.NoError(t, reader.readElementLength(&e))
 	assert.Equal(t, uint32(0xFFFF), e.datalen)
+	// explicit VR, 32 bit length according to the source VR (UT) rather
+	// than the VR of the dictionary (ST), which the element takes
+	buf = []byte("UT\x00\x00\x78\x56\x34\x12")
+	reader = NewElementReader(bin.NewReader(bytes.NewReader(buf), binary.LittleEndian))
+	reader.SetImplicitVR(false)
+	e = NewElementWithTag(0x0072006E)
+	assert.NoError(t, reader.readElementVR(&e))
+	assert.NoError(t, reader.readElementLength(&e))
+	assert.Equal(t, "UT", e.GetVR())
+	assert.Equal(t, "ST", dictionary.DicomDictionary[0x0072006E].VR)
+	assert.Equal(t, uint32(0x12345678), e.datalen)
 }
 
 func TestReadElementLengthError(t *testing.T) {
@@ -601,6 +927,50 @@ func TestHasReachedTagError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestInflate(t *testing.T) {
+	// ensures that `inflate` decompresses the remainder of the source,
+	// and that positions continue from where the compressed data began.
+	t.Parallel()
+	compressed := bytes.Buffer{}
+	fw, err := flate.NewWriter(&compressed, flate.BestCompression)
+	assert.NoError(t, err)
+	_, err = fw.Write(validUL1)
+	assert.NoError(t, err)
+	assert.NoError(t, fw.Close())
+
+	source := append([]byte{0x00, 0x00, 0x00, 0x00}, compressed.Bytes()...)
+	reader := NewElementReader(bin.NewReaderBytes(source, binary.LittleEndian))
+	assert.NoError(t, reader.br.Discard(4))
+	reader.inflate()
+	assert.Equal(t, int64(4), reader.GetPosition())
+	assert.True(t, reader.IsLittleEndian())
+
+	inflated := make([]byte, len(validUL1))
+	assert.NoError(t, reader.br.ReadBytes(inflated))
+	assert.Equal(t, validUL1, inflated)
+	assert.Equal(t, int64(4+len(validUL1)), reader.GetPosition())
+
+	// truncated compressed data
+	reader = NewElementReader(bin.NewReaderBytes(compressed.Bytes()[:4], binary.LittleEndian))
+	reader.inflate()
+	assert.Error(t, reader.br.ReadBytes(inflated))
+}
+
+func TestByteReader(t *testing.T) {
+	t.Parallel()
+	r := byteReader{br: bin.NewReaderBytes([]byte{0x01, 0x02, 0x03}, binary.LittleEndian)}
+	b, err := r.ReadByte()
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0x01), b)
+
+	// short read returns what is available
+	p := make([]byte, 4)
+	n, err := r.Read(p)
+	assert.Error(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte{0x02, 0x03}, p[:n])
+}
+
 func TestReadItemUndefLength(t *testing.T) {
 	// ensures that `readItemUndefLength` correctly
 	// parses an "undefined length" item from the reader.
@@ -612,6 +982,50 @@ func TestReadItemUndefLength(t *testing.T) {
 	r.readItemUndefLength(true, &itm)
 }
 
+func TestItem(t *testing.T) {
+	// ensures that the data set and fragment of items are accessible,
+	// after being written and read back.
+	t.Parallel()
+	uid := NewElementWithTag(0x00081155)
+	uid.data = []byte("1.2.3.4\x00")
+	item := NewItem()
+	item.dataset.addElement(uid)
+	sq := NewElementWithTag(0x00081140)
+	sq.items = append(sq.items, item)
+	dcm := newDicom()
+	dcm.addElement(sq)
+	buf := bytes.Buffer{}
+	_, err := dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+	dcm, err = FromReader(&buf)
+	assert.NoError(t, err)
+
+	assert.True(t, dcm.GetElement(0x00081140, &sq))
+	assert.Len(t, sq.GetItems(), 1)
+	item = sq.GetItems()[0]
+	assert.True(t, item.HasElement(0x00081155))
+	assert.False(t, item.HasElement(0x00081150))
+	assert.Equal(t, 1, item.GetDataSet().Len())
+	assert.Nil(t, item.GetFragment())
+	e := NewElement()
+	assert.True(t, item.GetElement(0x00081155, &e))
+	assert.Equal(t, "ReferencedSOPInstanceUID", e.GetName())
+	value := ""
+	found, err := item.GetElementValue(0x00081155, &value)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", value)
+	found, err = item.GetElementValue(0x00081150, &value)
+	assert.False(t, found)
+	assert.NoError(t, err)
+
+	// items of encapsulated pixel data hold fragments
+	dcm = newPixelDataDicom(t, [][]byte{nil, {0x01, 0x02}})
+	assert.True(t, dcm.GetElement(pixelDataTag, &e))
+	assert.Equal(t, []byte{0x01, 0x02}, e.GetItems()[1].GetFragment())
+	assert.Equal(t, 0, e.GetItems()[1].GetDataSet().Len())
+}
+
 /*
 ===============================================================================
     Dicom
@@ -746,11 +1160,186 @@ func TestFromReaderError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestFromReaderDeflated(t *testing.T) {
+	// ensures that `FromReader` transparently inflates data sets encoded
+	// with "Deflated Explicit VR Little Endian".
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "ISO_IR192.dcm"))
+	assert.NoError(t, err)
+	ts := NewElementWithTag(0x00020010)
+	ts.data = []byte(DeflatedExplicitVRLittleEndian)
+	dcm.addElement(ts)
+	buf := bytes.Buffer{}
+	_, err = dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	assert.True(t, decoded.GetTransferSyntax().Deflated)
+	assert.Equal(t, dcm.Len(), decoded.Len())
+	for tag, e := range dcm.DataSet {
+		if tag == 0x00020000 {
+			// group length is recalculated by `WriteTo`
+			continue
+		}
+		d := NewElement()
+		assert.True(t, decoded.GetElement(tag, &d))
+		assert.Equal(t, e.data, d.data)
+	}
+}
+
+// newPixelDataDicom returns the contents of "VRTest.dcm", with the addition of
+// PixelData: native if `fragments` is nil, and encapsulated otherwise.
+func newPixelDataDicom(t *testing.T, fragments [][]byte) Dicom {
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	var pd Element
+	if fragments == nil {
+		pd = NewElementWithTag(pixelDataTag)
+		pd.setVR("OW")
+		pd.data = sequentialBytes(64)
+	} else {
+		pd = newEncapsulatedPixelData(fragments...)
+		ts := NewElementWithTag(0x00020010)
+		ts.data = []byte("1.2.840.10008.1.2.4.50")
+		dcm.addElement(ts)
+	}
+	dcm.addElement(pd)
+	return dcm
+}
+
+func TestFromReaderWithOptions(t *testing.T) {
+	// ensures that `FromReaderWithOptions` stops at, and defers
+	// elements according to the given options.
+	t.Parallel()
+	// stop before the first non-meta element
+	dcm, err := FromFileWithOptions(filepath.Join("testdata", "synthetic", "VRTest.dcm"), ParseOptions{StopAtTag: 0x00080000})
+	assert.NoError(t, err)
+	assert.NotZero(t, dcm.Len())
+	for tag := range dcm.DataSet {
+		assert.True(t, tag < 0x00080000)
+	}
+
+	for _, fragments := range [][][]byte{nil, {nil, {0x01, 0x02}, {0x03, 0x04, 0x05, 0x00}}} {
+		expected := newPixelDataDicom(t, fragments)
+		buf := bytes.Buffer{}
+		_, err = expected.WriteTo(&buf)
+		assert.NoError(t, err)
+		expectedPixelData := expected.DataSet[pixelDataTag]
+
+		// skip pixel data, from a source supporting random access
+		dcm, err = FromReaderWithOptions(bytes.NewReader(buf.Bytes()), ParseOptions{SkipPixelData: true})
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Len(), dcm.Len())
+		assert.Equal(t, 0, dcm.GetPixelData().NumFrames())
+		pd := dcm.DataSet[pixelDataTag]
+		assert.True(t, pd.IsDeferred())
+		assert.True(t, pd.GetOffset() > 0)
+		assert.True(t, pd.GetOffset()+int64(pd.Len()) <= int64(buf.Len()))
+		assert.NoError(t, dcm.LoadPixelData())
+		pd = dcm.DataSet[pixelDataTag]
+		assert.False(t, pd.IsDeferred())
+		assert.Equal(t, expectedPixelData.data, pd.data)
+		assert.Equal(t, len(expectedPixelData.items), len(pd.items))
+		for i, item := range pd.items {
+			assert.Equal(t, expectedPixelData.items[i].fragment, item.fragment)
+		}
+
+		// skip pixel data, from a source that does not support random access
+		dcm, err = FromReaderWithOptions(bytes.NewBuffer(buf.Bytes()), ParseOptions{SkipPixelData: true})
+		assert.NoError(t, err)
+		assert.Error(t, dcm.LoadPixelData())
+	}
+
+	// defer large values, which are read on demand
+	expected := newPixelDataDicom(t, nil)
+	buf := bytes.Buffer{}
+	_, err = expected.WriteTo(&buf)
+	assert.NoError(t, err)
+	eager, err := FromReader(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+	dcm, err = FromReaderWithOptions(bytes.NewReader(buf.Bytes()), ParseOptions{MaxEagerElementSize: 8})
+	assert.NoError(t, err)
+	assert.Equal(t, eager.Len(), dcm.Len())
+	nDeferred := 0
+	for tag, e := range dcm.DataSet {
+		if e.IsDeferred() {
+			nDeferred++
+			assert.True(t, e.Len() > 8)
+			assert.False(t, isTextualVR(e.GetVR()))
+		}
+		value := []byte{}
+		found, err := dcm.GetElementValue(tag, &value)
+		assert.True(t, found)
+		assert.NoError(t, err)
+		assert.Equal(t, eager.DataSet[tag].data, value)
+	}
+	assert.NotZero(t, nDeferred)
+	// values read by `GetElementValue` are retained, rather than read again
+	for _, e := range dcm.DataSet {
+		assert.False(t, e.IsDeferred())
+	}
+	// deferred pixel data is only decoded once loaded
+	assert.Equal(t, 0, dcm.GetPixelData().NumFrames())
+	assert.NoError(t, dcm.LoadPixelData())
+	assert.Equal(t, eager.GetPixelData().NumFrames(), dcm.GetPixelData().NumFrames())
+}
+
+func TestFromFileWithOptions(t *testing.T) {
+	// ensures that deferred values are read from the file at the
+	// correct offset, until the dicom is closed.
+	t.Parallel()
+	expected := newPixelDataDicom(t, nil)
+	f, err := ioutil.TempFile("", "")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	// the dicom does not begin at the start of the file
+	_, err = f.Write([]byte("NOT A DICOM"))
+	assert.NoError(t, err)
+	_, err = expected.WriteTo(f)
+	assert.NoError(t, err)
+	_, err = f.Seek(int64(len("NOT A DICOM")), io.SeekStart)
+	assert.NoError(t, err)
+
+	dcm, err := FromReaderWithOptions(f, ParseOptions{SkipPixelData: true})
+	assert.NoError(t, err)
+	assert.NoError(t, dcm.LoadPixelData())
+	assert.Equal(t, expected.DataSet[pixelDataTag].data, dcm.DataSet[pixelDataTag].data)
+	assert.NoError(t, f.Close())
+
+	// file is retained until closed
+	assert.NoError(t, expected.ToFile(f.Name()))
+	dcm, err = FromFileWithOptions(f.Name(), ParseOptions{SkipPixelData: true})
+	assert.NoError(t, err)
+	pd := dcm.DataSet[pixelDataTag]
+	assert.True(t, pd.IsDeferred())
+	assert.NoError(t, dcm.Close())
+	assert.NoError(t, dcm.Close())
+	assert.Error(t, dcm.LoadPixelData())
+
+	// no pixel data to load
+	dcm, err = FromFileWithOptions(filepath.Join("testdata", "synthetic", "ISO_IR192.dcm"), ParseOptions{SkipPixelData: true})
+	assert.NoError(t, err)
+	assert.Error(t, dcm.LoadPixelData())
+	assert.NoError(t, dcm.Close())
+}
+
 func TestFromFile(t *testing.T) {
 	t.Parallel()
 	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
 	assert.NoError(t, err)
-	assert.Equal(t, 27, dcm.Len())
+	assert.Equal(t, 37, dcm.Len())
+	// the source VR (UT) of this element differs from that of the dictionary (ST)
+	value := ""
+	found, err := dcm.QueryValue("SelectorCodeSequenceValue[1].SelectorSTValue", &value)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, "Unlimited\\Text", value)
+	// OD and OF values are of 32 bit length
+	od := []float64{}
+	_, err = dcm.GetElementValue(0x00720073, &od)
+	assert.NoError(t, err)
+	assert.Len(t, od, 2)
 }
 
 func TestFromFileError(t *testing.T) {
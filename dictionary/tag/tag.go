@@ -0,0 +1,8394 @@
+// Code generated using util:gendatadict. DO NOT EDIT.
+
+// Package tag provides a constant for the tag of each data element of the
+// data dictionary, named by its keyword. For example: `tag.SeriesInstanceUID`
+package tag
+
+const (
+	// FileMetaInformationGroupLength (0002,0000) UL
+	FileMetaInformationGroupLength uint32 = 0x00020000
+	// FileMetaInformationVersion (0002,0001) OB
+	FileMetaInformationVersion uint32 = 0x00020001
+	// MediaStorageSOPClassUID (0002,0002) UI
+	MediaStorageSOPClassUID uint32 = 0x00020002
+	// MediaStorageSOPInstanceUID (0002,0003) UI
+	MediaStorageSOPInstanceUID uint32 = 0x00020003
+	// TransferSyntaxUID (0002,0010) UI
+	TransferSyntaxUID uint32 = 0x00020010
+	// ImplementationClassUID (0002,0012) UI
+	ImplementationClassUID uint32 = 0x00020012
+	// ImplementationVersionName (0002,0013) SH
+	ImplementationVersionName uint32 = 0x00020013
+	// SourceApplicationEntityTitle (0002,0016) AE
+	SourceApplicationEntityTitle uint32 = 0x00020016
+	// SendingApplicationEntityTitle (0002,0017) AE
+	SendingApplicationEntityTitle uint32 = 0x00020017
+	// ReceivingApplicationEntityTitle (0002,0018) AE
+	ReceivingApplicationEntityTitle uint32 = 0x00020018
+	// PrivateInformationCreatorUID (0002,0100) UI
+	PrivateInformationCreatorUID uint32 = 0x00020100
+	// PrivateInformation (0002,0102) OB
+	PrivateInformation uint32 = 0x00020102
+	// FileSetID (0004,1130) CS
+	FileSetID uint32 = 0x00041130
+	// FileSetDescriptorFileID (0004,1141) CS
+	FileSetDescriptorFileID uint32 = 0x00041141
+	// SpecificCharacterSetOfFileSetDescriptorFile (0004,1142) CS
+	SpecificCharacterSetOfFileSetDescriptorFile uint32 = 0x00041142
+	// OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity (0004,1200) UL
+	OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity uint32 = 0x00041200
+	// OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity (0004,1202) UL
+	OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity uint32 = 0x00041202
+	// FileSetConsistencyFlag (0004,1212) US
+	FileSetConsistencyFlag uint32 = 0x00041212
+	// DirectoryRecordSequence (0004,1220) SQ
+	DirectoryRecordSequence uint32 = 0x00041220
+	// OffsetOfTheNextDirectoryRecord (0004,1400) UL
+	OffsetOfTheNextDirectoryRecord uint32 = 0x00041400
+	// RecordInUseFlag (0004,1410) US
+	RecordInUseFlag uint32 = 0x00041410
+	// OffsetOfReferencedLowerLevelDirectoryEntity (0004,1420) UL
+	OffsetOfReferencedLowerLevelDirectoryEntity uint32 = 0x00041420
+	// DirectoryRecordType (0004,1430) CS
+	DirectoryRecordType uint32 = 0x00041430
+	// PrivateRecordUID (0004,1432) UI
+	PrivateRecordUID uint32 = 0x00041432
+	// ReferencedFileID (0004,1500) CS
+	ReferencedFileID uint32 = 0x00041500
+	// MRDRDirectoryRecordOffset (0004,1504) UL, retired
+	MRDRDirectoryRecordOffset uint32 = 0x00041504
+	// ReferencedSOPClassUIDInFile (0004,1510) UI
+	ReferencedSOPClassUIDInFile uint32 = 0x00041510
+	// ReferencedSOPInstanceUIDInFile (0004,1511) UI
+	ReferencedSOPInstanceUIDInFile uint32 = 0x00041511
+	// ReferencedTransferSyntaxUIDInFile (0004,1512) UI
+	ReferencedTransferSyntaxUIDInFile uint32 = 0x00041512
+	// ReferencedRelatedGeneralSOPClassUIDInFile (0004,151A) UI
+	ReferencedRelatedGeneralSOPClassUIDInFile uint32 = 0x0004151A
+	// NumberOfReferences (0004,1600) UL, retired
+	NumberOfReferences uint32 = 0x00041600
+	// LengthToEnd (0008,0001) UL, retired
+	LengthToEnd uint32 = 0x00080001
+	// SpecificCharacterSet (0008,0005) CS
+	SpecificCharacterSet uint32 = 0x00080005
+	// LanguageCodeSequence (0008,0006) SQ
+	LanguageCodeSequence uint32 = 0x00080006
+	// ImageType (0008,0008) CS
+	ImageType uint32 = 0x00080008
+	// RecognitionCode (0008,0010) SH, retired
+	RecognitionCode uint32 = 0x00080010
+	// InstanceCreationDate (0008,0012) DA
+	InstanceCreationDate uint32 = 0x00080012
+	// InstanceCreationTime (0008,0013) TM
+	InstanceCreationTime uint32 = 0x00080013
+	// InstanceCreatorUID (0008,0014) UI
+	InstanceCreatorUID uint32 = 0x00080014
+	// InstanceCoercionDateTime (0008,0015) DT
+	InstanceCoercionDateTime uint32 = 0x00080015
+	// SOPClassUID (0008,0016) UI
+	SOPClassUID uint32 = 0x00080016
+	// SOPInstanceUID (0008,0018) UI
+	SOPInstanceUID uint32 = 0x00080018
+	// RelatedGeneralSOPClassUID (0008,001A) UI
+	RelatedGeneralSOPClassUID uint32 = 0x0008001A
+	// OriginalSpecializedSOPClassUID (0008,001B) UI
+	OriginalSpecializedSOPClassUID uint32 = 0x0008001B
+	// StudyDate (0008,0020) DA
+	StudyDate uint32 = 0x00080020
+	// SeriesDate (0008,0021) DA
+	SeriesDate uint32 = 0x00080021
+	// AcquisitionDate (0008,0022) DA
+	AcquisitionDate uint32 = 0x00080022
+	// ContentDate (0008,0023) DA
+	ContentDate uint32 = 0x00080023
+	// OverlayDate (0008,0024) DA, retired
+	OverlayDate uint32 = 0x00080024
+	// CurveDate (0008,0025) DA, retired
+	CurveDate uint32 = 0x00080025
+	// AcquisitionDateTime (0008,002A) DT
+	AcquisitionDateTime uint32 = 0x0008002A
+	// StudyTime (0008,0030) TM
+	StudyTime uint32 = 0x00080030
+	// SeriesTime (0008,0031) TM
+	SeriesTime uint32 = 0x00080031
+	// AcquisitionTime (0008,0032) TM
+	AcquisitionTime uint32 = 0x00080032
+	// ContentTime (0008,0033) TM
+	ContentTime uint32 = 0x00080033
+	// OverlayTime (0008,0034) TM, retired
+	OverlayTime uint32 = 0x00080034
+	// CurveTime (0008,0035) TM, retired
+	CurveTime uint32 = 0x00080035
+	// DataSetType (0008,0040) US, retired
+	DataSetType uint32 = 0x00080040
+	// DataSetSubtype (0008,0041) LO, retired
+	DataSetSubtype uint32 = 0x00080041
+	// NuclearMedicineSeriesType (0008,0042) CS, retired
+	NuclearMedicineSeriesType uint32 = 0x00080042
+	// AccessionNumber (0008,0050) SH
+	AccessionNumber uint32 = 0x00080050
+	// IssuerOfAccessionNumberSequence (0008,0051) SQ
+	IssuerOfAccessionNumberSequence uint32 = 0x00080051
+	// QueryRetrieveLevel (0008,0052) CS
+	QueryRetrieveLevel uint32 = 0x00080052
+	// QueryRetrieveView (0008,0053) CS
+	QueryRetrieveView uint32 = 0x00080053
+	// RetrieveAETitle (0008,0054) AE
+	RetrieveAETitle uint32 = 0x00080054
+	// StationAETitle (0008,0055) AE
+	StationAETitle uint32 = 0x00080055
+	// InstanceAvailability (0008,0056) CS
+	InstanceAvailability uint32 = 0x00080056
+	// FailedSOPInstanceUIDList (0008,0058) UI
+	FailedSOPInstanceUIDList uint32 = 0x00080058
+	// Modality (0008,0060) CS
+	Modality uint32 = 0x00080060
+	// ModalitiesInStudy (0008,0061) CS
+	ModalitiesInStudy uint32 = 0x00080061
+	// SOPClassesInStudy (0008,0062) UI
+	SOPClassesInStudy uint32 = 0x00080062
+	// AnatomicRegionsInStudyCodeSequence (0008,0063) SQ
+	AnatomicRegionsInStudyCodeSequence uint32 = 0x00080063
+	// ConversionType (0008,0064) CS
+	ConversionType uint32 = 0x00080064
+	// PresentationIntentType (0008,0068) CS
+	PresentationIntentType uint32 = 0x00080068
+	// Manufacturer (0008,0070) LO
+	Manufacturer uint32 = 0x00080070
+	// InstitutionName (0008,0080) LO
+	InstitutionName uint32 = 0x00080080
+	// InstitutionAddress (0008,0081) ST
+	InstitutionAddress uint32 = 0x00080081
+	// InstitutionCodeSequence (0008,0082) SQ
+	InstitutionCodeSequence uint32 = 0x00080082
+	// ReferringPhysicianName (0008,0090) PN
+	ReferringPhysicianName uint32 = 0x00080090
+	// ReferringPhysicianAddress (0008,0092) ST
+	ReferringPhysicianAddress uint32 = 0x00080092
+	// ReferringPhysicianTelephoneNumbers (0008,0094) SH
+	ReferringPhysicianTelephoneNumbers uint32 = 0x00080094
+	// ReferringPhysicianIdentificationSequence (0008,0096) SQ
+	ReferringPhysicianIdentificationSequence uint32 = 0x00080096
+	// ConsultingPhysicianName (0008,009C) PN
+	ConsultingPhysicianName uint32 = 0x0008009C
+	// ConsultingPhysicianIdentificationSequence (0008,009D) SQ
+	ConsultingPhysicianIdentificationSequence uint32 = 0x0008009D
+	// CodeValue (0008,0100) SH
+	CodeValue uint32 = 0x00080100
+	// ExtendedCodeValue (0008,0101) LO
+	ExtendedCodeValue uint32 = 0x00080101
+	// CodingSchemeDesignator (0008,0102) SH
+	CodingSchemeDesignator uint32 = 0x00080102
+	// CodingSchemeVersion (0008,0103) SH
+	CodingSchemeVersion uint32 = 0x00080103
+	// CodeMeaning (0008,0104) LO
+	CodeMeaning uint32 = 0x00080104
+	// MappingResource (0008,0105) CS
+	MappingResource uint32 = 0x00080105
+	// ContextGroupVersion (0008,0106) DT
+	ContextGroupVersion uint32 = 0x00080106
+	// ContextGroupLocalVersion (0008,0107) DT
+	ContextGroupLocalVersion uint32 = 0x00080107
+	// ExtendedCodeMeaning (0008,0108) LT
+	ExtendedCodeMeaning uint32 = 0x00080108
+	// CodingSchemeResourcesSequence (0008,0109) SQ
+	CodingSchemeResourcesSequence uint32 = 0x00080109
+	// CodingSchemeURLType (0008,010A) CS
+	CodingSchemeURLType uint32 = 0x0008010A
+	// ContextGroupExtensionFlag (0008,010B) CS
+	ContextGroupExtensionFlag uint32 = 0x0008010B
+	// CodingSchemeUID (0008,010C) UI
+	CodingSchemeUID uint32 = 0x0008010C
+	// ContextGroupExtensionCreatorUID (0008,010D) UI
+	ContextGroupExtensionCreatorUID uint32 = 0x0008010D
+	// CodingSchemeURL (0008,010E) UR
+	CodingSchemeURL uint32 = 0x0008010E
+	// ContextIdentifier (0008,010F) CS
+	ContextIdentifier uint32 = 0x0008010F
+	// CodingSchemeIdentificationSequence (0008,0110) SQ
+	CodingSchemeIdentificationSequence uint32 = 0x00080110
+	// CodingSchemeRegistry (0008,0112) LO
+	CodingSchemeRegistry uint32 = 0x00080112
+	// CodingSchemeExternalID (0008,0114) ST
+	CodingSchemeExternalID uint32 = 0x00080114
+	// CodingSchemeName (0008,0115) ST
+	CodingSchemeName uint32 = 0x00080115
+	// CodingSchemeResponsibleOrganization (0008,0116) ST
+	CodingSchemeResponsibleOrganization uint32 = 0x00080116
+	// ContextUID (0008,0117) UI
+	ContextUID uint32 = 0x00080117
+	// MappingResourceUID (0008,0118) UI
+	MappingResourceUID uint32 = 0x00080118
+	// LongCodeValue (0008,0119) UC
+	LongCodeValue uint32 = 0x00080119
+	// URNCodeValue (0008,0120) UR
+	URNCodeValue uint32 = 0x00080120
+	// EquivalentCodeSequence (0008,0121) SQ
+	EquivalentCodeSequence uint32 = 0x00080121
+	// MappingResourceName (0008,0122) LO
+	MappingResourceName uint32 = 0x00080122
+	// ContextGroupIdentificationSequence (0008,0123) SQ
+	ContextGroupIdentificationSequence uint32 = 0x00080123
+	// MappingResourceIdentificationSequence (0008,0124) SQ
+	MappingResourceIdentificationSequence uint32 = 0x00080124
+	// TimezoneOffsetFromUTC (0008,0201) SH
+	TimezoneOffsetFromUTC uint32 = 0x00080201
+	// ResponsibleGroupCodeSequence (0008,0220) SQ
+	ResponsibleGroupCodeSequence uint32 = 0x00080220
+	// EquipmentModality (0008,0221) CS
+	EquipmentModality uint32 = 0x00080221
+	// ManufacturerRelatedModelGroup (0008,0222) LO
+	ManufacturerRelatedModelGroup uint32 = 0x00080222
+	// PrivateDataElementCharacteristicsSequence (0008,0300) SQ
+	PrivateDataElementCharacteristicsSequence uint32 = 0x00080300
+	// PrivateGroupReference (0008,0301) US
+	PrivateGroupReference uint32 = 0x00080301
+	// PrivateCreatorReference (0008,0302) LO
+	PrivateCreatorReference uint32 = 0x00080302
+	// BlockIdentifyingInformationStatus (0008,0303) CS
+	BlockIdentifyingInformationStatus uint32 = 0x00080303
+	// NonidentifyingPrivateElements (0008,0304) US
+	NonidentifyingPrivateElements uint32 = 0x00080304
+	// DeidentificationActionSequence (0008,0305) SQ
+	DeidentificationActionSequence uint32 = 0x00080305
+	// IdentifyingPrivateElements (0008,0306) US
+	IdentifyingPrivateElements uint32 = 0x00080306
+	// DeidentificationAction (0008,0307) CS
+	DeidentificationAction uint32 = 0x00080307
+	// PrivateDataElement (0008,0308) US
+	PrivateDataElement uint32 = 0x00080308
+	// PrivateDataElementValueMultiplicity (0008,0309) UL
+	PrivateDataElementValueMultiplicity uint32 = 0x00080309
+	// PrivateDataElementValueRepresentation (0008,030A) CS
+	PrivateDataElementValueRepresentation uint32 = 0x0008030A
+	// PrivateDataElementNumberOfItems (0008,030B) UL
+	PrivateDataElementNumberOfItems uint32 = 0x0008030B
+	// PrivateDataElementName (0008,030C) UC
+	PrivateDataElementName uint32 = 0x0008030C
+	// PrivateDataElementKeyword (0008,030D) UC
+	PrivateDataElementKeyword uint32 = 0x0008030D
+	// PrivateDataElementDescription (0008,030E) UT
+	PrivateDataElementDescription uint32 = 0x0008030E
+	// PrivateDataElementEncoding (0008,030F) UT
+	PrivateDataElementEncoding uint32 = 0x0008030F
+	// PrivateDataElementDefinitionSequence (0008,0310) SQ
+	PrivateDataElementDefinitionSequence uint32 = 0x00080310
+	// NetworkID (0008,1000) AE, retired
+	NetworkID uint32 = 0x00081000
+	// StationName (0008,1010) SH
+	StationName uint32 = 0x00081010
+	// StudyDescription (0008,1030) LO
+	StudyDescription uint32 = 0x00081030
+	// ProcedureCodeSequence (0008,1032) SQ
+	ProcedureCodeSequence uint32 = 0x00081032
+	// SeriesDescription (0008,103E) LO
+	SeriesDescription uint32 = 0x0008103E
+	// SeriesDescriptionCodeSequence (0008,103F) SQ
+	SeriesDescriptionCodeSequence uint32 = 0x0008103F
+	// InstitutionalDepartmentName (0008,1040) LO
+	InstitutionalDepartmentName uint32 = 0x00081040
+	// PhysiciansOfRecord (0008,1048) PN
+	PhysiciansOfRecord uint32 = 0x00081048
+	// PhysiciansOfRecordIdentificationSequence (0008,1049) SQ
+	PhysiciansOfRecordIdentificationSequence uint32 = 0x00081049
+	// PerformingPhysicianName (0008,1050) PN
+	PerformingPhysicianName uint32 = 0x00081050
+	// PerformingPhysicianIdentificationSequence (0008,1052) SQ
+	PerformingPhysicianIdentificationSequence uint32 = 0x00081052
+	// NameOfPhysiciansReadingStudy (0008,1060) PN
+	NameOfPhysiciansReadingStudy uint32 = 0x00081060
+	// PhysiciansReadingStudyIdentificationSequence (0008,1062) SQ
+	PhysiciansReadingStudyIdentificationSequence uint32 = 0x00081062
+	// OperatorsName (0008,1070) PN
+	OperatorsName uint32 = 0x00081070
+	// OperatorIdentificationSequence (0008,1072) SQ
+	OperatorIdentificationSequence uint32 = 0x00081072
+	// AdmittingDiagnosesDescription (0008,1080) LO
+	AdmittingDiagnosesDescription uint32 = 0x00081080
+	// AdmittingDiagnosesCodeSequence (0008,1084) SQ
+	AdmittingDiagnosesCodeSequence uint32 = 0x00081084
+	// ManufacturerModelName (0008,1090) LO
+	ManufacturerModelName uint32 = 0x00081090
+	// ReferencedResultsSequence (0008,1100) SQ, retired
+	ReferencedResultsSequence uint32 = 0x00081100
+	// ReferencedStudySequence (0008,1110) SQ
+	ReferencedStudySequence uint32 = 0x00081110
+	// ReferencedPerformedProcedureStepSequence (0008,1111) SQ
+	ReferencedPerformedProcedureStepSequence uint32 = 0x00081111
+	// ReferencedSeriesSequence (0008,1115) SQ
+	ReferencedSeriesSequence uint32 = 0x00081115
+	// ReferencedPatientSequence (0008,1120) SQ
+	ReferencedPatientSequence uint32 = 0x00081120
+	// ReferencedVisitSequence (0008,1125) SQ
+	ReferencedVisitSequence uint32 = 0x00081125
+	// ReferencedOverlaySequence (0008,1130) SQ, retired
+	ReferencedOverlaySequence uint32 = 0x00081130
+	// ReferencedStereometricInstanceSequence (0008,1134) SQ
+	ReferencedStereometricInstanceSequence uint32 = 0x00081134
+	// ReferencedWaveformSequence (0008,113A) SQ
+	ReferencedWaveformSequence uint32 = 0x0008113A
+	// ReferencedImageSequence (0008,1140) SQ
+	ReferencedImageSequence uint32 = 0x00081140
+	// ReferencedCurveSequence (0008,1145) SQ, retired
+	ReferencedCurveSequence uint32 = 0x00081145
+	// ReferencedInstanceSequence (0008,114A) SQ
+	ReferencedInstanceSequence uint32 = 0x0008114A
+	// ReferencedRealWorldValueMappingInstanceSequence (0008,114B) SQ
+	ReferencedRealWorldValueMappingInstanceSequence uint32 = 0x0008114B
+	// ReferencedSOPClassUID (0008,1150) UI
+	ReferencedSOPClassUID uint32 = 0x00081150
+	// ReferencedSOPInstanceUID (0008,1155) UI
+	ReferencedSOPInstanceUID uint32 = 0x00081155
+	// DefinitionSourceSequence (0008,1156) SQ
+	DefinitionSourceSequence uint32 = 0x00081156
+	// SOPClassesSupported (0008,115A) UI
+	SOPClassesSupported uint32 = 0x0008115A
+	// ReferencedFrameNumber (0008,1160) IS
+	ReferencedFrameNumber uint32 = 0x00081160
+	// SimpleFrameList (0008,1161) UL
+	SimpleFrameList uint32 = 0x00081161
+	// CalculatedFrameList (0008,1162) UL
+	CalculatedFrameList uint32 = 0x00081162
+	// TimeRange (0008,1163) FD
+	TimeRange uint32 = 0x00081163
+	// FrameExtractionSequence (0008,1164) SQ
+	FrameExtractionSequence uint32 = 0x00081164
+	// MultiFrameSourceSOPInstanceUID (0008,1167) UI
+	MultiFrameSourceSOPInstanceUID uint32 = 0x00081167
+	// RetrieveURL (0008,1190) UR
+	RetrieveURL uint32 = 0x00081190
+	// TransactionUID (0008,1195) UI
+	TransactionUID uint32 = 0x00081195
+	// WarningReason (0008,1196) US
+	WarningReason uint32 = 0x00081196
+	// FailureReason (0008,1197) US
+	FailureReason uint32 = 0x00081197
+	// FailedSOPSequence (0008,1198) SQ
+	FailedSOPSequence uint32 = 0x00081198
+	// ReferencedSOPSequence (0008,1199) SQ
+	ReferencedSOPSequence uint32 = 0x00081199
+	// OtherFailuresSequence (0008,119A) SQ
+	OtherFailuresSequence uint32 = 0x0008119A
+	// StudiesContainingOtherReferencedInstancesSequence (0008,1200) SQ
+	StudiesContainingOtherReferencedInstancesSequence uint32 = 0x00081200
+	// RelatedSeriesSequence (0008,1250) SQ
+	RelatedSeriesSequence uint32 = 0x00081250
+	// LossyImageCompressionRetired (0008,2110) CS, retired
+	LossyImageCompressionRetired uint32 = 0x00082110
+	// DerivationDescription (0008,2111) ST
+	DerivationDescription uint32 = 0x00082111
+	// SourceImageSequence (0008,2112) SQ
+	SourceImageSequence uint32 = 0x00082112
+	// StageName (0008,2120) SH
+	StageName uint32 = 0x00082120
+	// StageNumber (0008,2122) IS
+	StageNumber uint32 = 0x00082122
+	// NumberOfStages (0008,2124) IS
+	NumberOfStages uint32 = 0x00082124
+	// ViewName (0008,2127) SH
+	ViewName uint32 = 0x00082127
+	// ViewNumber (0008,2128) IS
+	ViewNumber uint32 = 0x00082128
+	// NumberOfEventTimers (0008,2129) IS
+	NumberOfEventTimers uint32 = 0x00082129
+	// NumberOfViewsInStage (0008,212A) IS
+	NumberOfViewsInStage uint32 = 0x0008212A
+	// EventElapsedTimes (0008,2130) DS
+	EventElapsedTimes uint32 = 0x00082130
+	// EventTimerNames (0008,2132) LO
+	EventTimerNames uint32 = 0x00082132
+	// EventTimerSequence (0008,2133) SQ
+	EventTimerSequence uint32 = 0x00082133
+	// EventTimeOffset (0008,2134) FD
+	EventTimeOffset uint32 = 0x00082134
+	// EventCodeSequence (0008,2135) SQ
+	EventCodeSequence uint32 = 0x00082135
+	// StartTrim (0008,2142) IS
+	StartTrim uint32 = 0x00082142
+	// StopTrim (0008,2143) IS
+	StopTrim uint32 = 0x00082143
+	// RecommendedDisplayFrameRate (0008,2144) IS
+	RecommendedDisplayFrameRate uint32 = 0x00082144
+	// TransducerPosition (0008,2200) CS, retired
+	TransducerPosition uint32 = 0x00082200
+	// TransducerOrientation (0008,2204) CS, retired
+	TransducerOrientation uint32 = 0x00082204
+	// AnatomicStructure (0008,2208) CS, retired
+	AnatomicStructure uint32 = 0x00082208
+	// AnatomicRegionSequence (0008,2218) SQ
+	AnatomicRegionSequence uint32 = 0x00082218
+	// AnatomicRegionModifierSequence (0008,2220) SQ
+	AnatomicRegionModifierSequence uint32 = 0x00082220
+	// PrimaryAnatomicStructureSequence (0008,2228) SQ
+	PrimaryAnatomicStructureSequence uint32 = 0x00082228
+	// AnatomicStructureSpaceOrRegionSequence (0008,2229) SQ, retired
+	AnatomicStructureSpaceOrRegionSequence uint32 = 0x00082229
+	// PrimaryAnatomicStructureModifierSequence (0008,2230) SQ
+	PrimaryAnatomicStructureModifierSequence uint32 = 0x00082230
+	// TransducerPositionSequence (0008,2240) SQ, retired
+	TransducerPositionSequence uint32 = 0x00082240
+	// TransducerPositionModifierSequence (0008,2242) SQ, retired
+	TransducerPositionModifierSequence uint32 = 0x00082242
+	// TransducerOrientationSequence (0008,2244) SQ, retired
+	TransducerOrientationSequence uint32 = 0x00082244
+	// TransducerOrientationModifierSequence (0008,2246) SQ, retired
+	TransducerOrientationModifierSequence uint32 = 0x00082246
+	// AnatomicStructureSpaceOrRegionCodeSequenceTrial (0008,2251) SQ, retired
+	AnatomicStructureSpaceOrRegionCodeSequenceTrial uint32 = 0x00082251
+	// AnatomicPortalOfEntranceCodeSequenceTrial (0008,2253) SQ, retired
+	AnatomicPortalOfEntranceCodeSequenceTrial uint32 = 0x00082253
+	// AnatomicApproachDirectionCodeSequenceTrial (0008,2255) SQ, retired
+	AnatomicApproachDirectionCodeSequenceTrial uint32 = 0x00082255
+	// AnatomicPerspectiveDescriptionTrial (0008,2256) ST, retired
+	AnatomicPerspectiveDescriptionTrial uint32 = 0x00082256
+	// AnatomicPerspectiveCodeSequenceTrial (0008,2257) SQ, retired
+	AnatomicPerspectiveCodeSequenceTrial uint32 = 0x00082257
+	// AnatomicLocationOfExaminingInstrumentDescriptionTrial (0008,2258) ST, retired
+	AnatomicLocationOfExaminingInstrumentDescriptionTrial uint32 = 0x00082258
+	// AnatomicLocationOfExaminingInstrumentCodeSequenceTrial (0008,2259) SQ, retired
+	AnatomicLocationOfExaminingInstrumentCodeSequenceTrial uint32 = 0x00082259
+	// AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial (0008,225A) SQ, retired
+	AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial uint32 = 0x0008225A
+	// OnAxisBackgroundAnatomicStructureCodeSequenceTrial (0008,225C) SQ, retired
+	OnAxisBackgroundAnatomicStructureCodeSequenceTrial uint32 = 0x0008225C
+	// AlternateRepresentationSequence (0008,3001) SQ
+	AlternateRepresentationSequence uint32 = 0x00083001
+	// IrradiationEventUID (0008,3010) UI
+	IrradiationEventUID uint32 = 0x00083010
+	// SourceIrradiationEventSequence (0008,3011) SQ
+	SourceIrradiationEventSequence uint32 = 0x00083011
+	// RadiopharmaceuticalAdministrationEventUID (0008,3012) UI
+	RadiopharmaceuticalAdministrationEventUID uint32 = 0x00083012
+	// IdentifyingComments (0008,4000) LT, retired
+	IdentifyingComments uint32 = 0x00084000
+	// FrameType (0008,9007) CS
+	FrameType uint32 = 0x00089007
+	// ReferencedImageEvidenceSequence (0008,9092) SQ
+	ReferencedImageEvidenceSequence uint32 = 0x00089092
+	// ReferencedRawDataSequence (0008,9121) SQ
+	ReferencedRawDataSequence uint32 = 0x00089121
+	// CreatorVersionUID (0008,9123) UI
+	CreatorVersionUID uint32 = 0x00089123
+	// DerivationImageSequence (0008,9124) SQ
+	DerivationImageSequence uint32 = 0x00089124
+	// SourceImageEvidenceSequence (0008,9154) SQ
+	SourceImageEvidenceSequence uint32 = 0x00089154
+	// PixelPresentation (0008,9205) CS
+	PixelPresentation uint32 = 0x00089205
+	// VolumetricProperties (0008,9206) CS
+	VolumetricProperties uint32 = 0x00089206
+	// VolumeBasedCalculationTechnique (0008,9207) CS
+	VolumeBasedCalculationTechnique uint32 = 0x00089207
+	// ComplexImageComponent (0008,9208) CS
+	ComplexImageComponent uint32 = 0x00089208
+	// AcquisitionContrast (0008,9209) CS
+	AcquisitionContrast uint32 = 0x00089209
+	// DerivationCodeSequence (0008,9215) SQ
+	DerivationCodeSequence uint32 = 0x00089215
+	// ReferencedPresentationStateSequence (0008,9237) SQ
+	ReferencedPresentationStateSequence uint32 = 0x00089237
+	// ReferencedOtherPlaneSequence (0008,9410) SQ
+	ReferencedOtherPlaneSequence uint32 = 0x00089410
+	// FrameDisplaySequence (0008,9458) SQ
+	FrameDisplaySequence uint32 = 0x00089458
+	// RecommendedDisplayFrameRateInFloat (0008,9459) FL
+	RecommendedDisplayFrameRateInFloat uint32 = 0x00089459
+	// SkipFrameRangeFlag (0008,9460) CS
+	SkipFrameRangeFlag uint32 = 0x00089460
+	// PatientName (0010,0010) PN
+	PatientName uint32 = 0x00100010
+	// PatientID (0010,0020) LO
+	PatientID uint32 = 0x00100020
+	// IssuerOfPatientID (0010,0021) LO
+	IssuerOfPatientID uint32 = 0x00100021
+	// TypeOfPatientID (0010,0022) CS
+	TypeOfPatientID uint32 = 0x00100022
+	// IssuerOfPatientIDQualifiersSequence (0010,0024) SQ
+	IssuerOfPatientIDQualifiersSequence uint32 = 0x00100024
+	// SourcePatientGroupIdentificationSequence (0010,0026) SQ
+	SourcePatientGroupIdentificationSequence uint32 = 0x00100026
+	// GroupOfPatientsIdentificationSequence (0010,0027) SQ
+	GroupOfPatientsIdentificationSequence uint32 = 0x00100027
+	// SubjectRelativePositionInImage (0010,0028) US
+	SubjectRelativePositionInImage uint32 = 0x00100028
+	// PatientBirthDate (0010,0030) DA
+	PatientBirthDate uint32 = 0x00100030
+	// PatientBirthTime (0010,0032) TM
+	PatientBirthTime uint32 = 0x00100032
+	// PatientBirthDateInAlternativeCalendar (0010,0033) LO
+	PatientBirthDateInAlternativeCalendar uint32 = 0x00100033
+	// PatientDeathDateInAlternativeCalendar (0010,0034) LO
+	PatientDeathDateInAlternativeCalendar uint32 = 0x00100034
+	// PatientAlternativeCalendar (0010,0035) CS
+	PatientAlternativeCalendar uint32 = 0x00100035
+	// PatientSex (0010,0040) CS
+	PatientSex uint32 = 0x00100040
+	// PatientInsurancePlanCodeSequence (0010,0050) SQ
+	PatientInsurancePlanCodeSequence uint32 = 0x00100050
+	// PatientPrimaryLanguageCodeSequence (0010,0101) SQ
+	PatientPrimaryLanguageCodeSequence uint32 = 0x00100101
+	// PatientPrimaryLanguageModifierCodeSequence (0010,0102) SQ
+	PatientPrimaryLanguageModifierCodeSequence uint32 = 0x00100102
+	// QualityControlSubject (0010,0200) CS
+	QualityControlSubject uint32 = 0x00100200
+	// QualityControlSubjectTypeCodeSequence (0010,0201) SQ
+	QualityControlSubjectTypeCodeSequence uint32 = 0x00100201
+	// StrainDescription (0010,0212) UC
+	StrainDescription uint32 = 0x00100212
+	// StrainNomenclature (0010,0213) LO
+	StrainNomenclature uint32 = 0x00100213
+	// StrainStockNumber (0010,0214) LO
+	StrainStockNumber uint32 = 0x00100214
+	// StrainSourceRegistryCodeSequence (0010,0215) SQ
+	StrainSourceRegistryCodeSequence uint32 = 0x00100215
+	// StrainStockSequence (0010,0216) SQ
+	StrainStockSequence uint32 = 0x00100216
+	// StrainSource (0010,0217) LO
+	StrainSource uint32 = 0x00100217
+	// StrainAdditionalInformation (0010,0218) UT
+	StrainAdditionalInformation uint32 = 0x00100218
+	// StrainCodeSequence (0010,0219) SQ
+	StrainCodeSequence uint32 = 0x00100219
+	// GeneticModificationsSequence (0010,0221) SQ
+	GeneticModificationsSequence uint32 = 0x00100221
+	// GeneticModificationsDescription (0010,0222) UC
+	GeneticModificationsDescription uint32 = 0x00100222
+	// GeneticModificationsNomenclature (0010,0223) LO
+	GeneticModificationsNomenclature uint32 = 0x00100223
+	// GeneticModificationsCodeSequence (0010,0229) SQ
+	GeneticModificationsCodeSequence uint32 = 0x00100229
+	// OtherPatientIDs (0010,1000) LO, retired
+	OtherPatientIDs uint32 = 0x00101000
+	// OtherPatientNames (0010,1001) PN
+	OtherPatientNames uint32 = 0x00101001
+	// OtherPatientIDsSequence (0010,1002) SQ
+	OtherPatientIDsSequence uint32 = 0x00101002
+	// PatientBirthName (0010,1005) PN
+	PatientBirthName uint32 = 0x00101005
+	// PatientAge (0010,1010) AS
+	PatientAge uint32 = 0x00101010
+	// PatientSize (0010,1020) DS
+	PatientSize uint32 = 0x00101020
+	// PatientSizeCodeSequence (0010,1021) SQ
+	PatientSizeCodeSequence uint32 = 0x00101021
+	// PatientBodyMassIndex (0010,1022) DS
+	PatientBodyMassIndex uint32 = 0x00101022
+	// MeasuredAPDimension (0010,1023) DS
+	MeasuredAPDimension uint32 = 0x00101023
+	// MeasuredLateralDimension (0010,1024) DS
+	MeasuredLateralDimension uint32 = 0x00101024
+	// PatientWeight (0010,1030) DS
+	PatientWeight uint32 = 0x00101030
+	// PatientAddress (0010,1040) LO
+	PatientAddress uint32 = 0x00101040
+	// InsurancePlanIdentification (0010,1050) LO, retired
+	InsurancePlanIdentification uint32 = 0x00101050
+	// PatientMotherBirthName (0010,1060) PN
+	PatientMotherBirthName uint32 = 0x00101060
+	// MilitaryRank (0010,1080) LO
+	MilitaryRank uint32 = 0x00101080
+	// BranchOfService (0010,1081) LO
+	BranchOfService uint32 = 0x00101081
+	// MedicalRecordLocator (0010,1090) LO, retired
+	MedicalRecordLocator uint32 = 0x00101090
+	// ReferencedPatientPhotoSequence (0010,1100) SQ
+	ReferencedPatientPhotoSequence uint32 = 0x00101100
+	// MedicalAlerts (0010,2000) LO
+	MedicalAlerts uint32 = 0x00102000
+	// Allergies (0010,2110) LO
+	Allergies uint32 = 0x00102110
+	// CountryOfResidence (0010,2150) LO
+	CountryOfResidence uint32 = 0x00102150
+	// RegionOfResidence (0010,2152) LO
+	RegionOfResidence uint32 = 0x00102152
+	// PatientTelephoneNumbers (0010,2154) SH
+	PatientTelephoneNumbers uint32 = 0x00102154
+	// PatientTelecomInformation (0010,2155) LT
+	PatientTelecomInformation uint32 = 0x00102155
+	// EthnicGroup (0010,2160) SH
+	EthnicGroup uint32 = 0x00102160
+	// Occupation (0010,2180) SH
+	Occupation uint32 = 0x00102180
+	// SmokingStatus (0010,21A0) CS
+	SmokingStatus uint32 = 0x001021A0
+	// AdditionalPatientHistory (0010,21B0) LT
+	AdditionalPatientHistory uint32 = 0x001021B0
+	// PregnancyStatus (0010,21C0) US
+	PregnancyStatus uint32 = 0x001021C0
+	// LastMenstrualDate (0010,21D0) DA
+	LastMenstrualDate uint32 = 0x001021D0
+	// PatientReligiousPreference (0010,21F0) LO
+	PatientReligiousPreference uint32 = 0x001021F0
+	// PatientSpeciesDescription (0010,2201) LO
+	PatientSpeciesDescription uint32 = 0x00102201
+	// PatientSpeciesCodeSequence (0010,2202) SQ
+	PatientSpeciesCodeSequence uint32 = 0x00102202
+	// PatientSexNeutered (0010,2203) CS
+	PatientSexNeutered uint32 = 0x00102203
+	// AnatomicalOrientationType (0010,2210) CS
+	AnatomicalOrientationType uint32 = 0x00102210
+	// PatientBreedDescription (0010,2292) LO
+	PatientBreedDescription uint32 = 0x00102292
+	// PatientBreedCodeSequence (0010,2293) SQ
+	PatientBreedCodeSequence uint32 = 0x00102293
+	// BreedRegistrationSequence (0010,2294) SQ
+	BreedRegistrationSequence uint32 = 0x00102294
+	// BreedRegistrationNumber (0010,2295) LO
+	BreedRegistrationNumber uint32 = 0x00102295
+	// BreedRegistryCodeSequence (0010,2296) SQ
+	BreedRegistryCodeSequence uint32 = 0x00102296
+	// ResponsiblePerson (0010,2297) PN
+	ResponsiblePerson uint32 = 0x00102297
+	// ResponsiblePersonRole (0010,2298) CS
+	ResponsiblePersonRole uint32 = 0x00102298
+	// ResponsibleOrganization (0010,2299) LO
+	ResponsibleOrganization uint32 = 0x00102299
+	// PatientComments (0010,4000) LT
+	PatientComments uint32 = 0x00104000
+	// ExaminedBodyThickness (0010,9431) FL
+	ExaminedBodyThickness uint32 = 0x00109431
+	// ClinicalTrialSponsorName (0012,0010) LO
+	ClinicalTrialSponsorName uint32 = 0x00120010
+	// ClinicalTrialProtocolID (0012,0020) LO
+	ClinicalTrialProtocolID uint32 = 0x00120020
+	// ClinicalTrialProtocolName (0012,0021) LO
+	ClinicalTrialProtocolName uint32 = 0x00120021
+	// ClinicalTrialSiteID (0012,0030) LO
+	ClinicalTrialSiteID uint32 = 0x00120030
+	// ClinicalTrialSiteName (0012,0031) LO
+	ClinicalTrialSiteName uint32 = 0x00120031
+	// ClinicalTrialSubjectID (0012,0040) LO
+	ClinicalTrialSubjectID uint32 = 0x00120040
+	// ClinicalTrialSubjectReadingID (0012,0042) LO
+	ClinicalTrialSubjectReadingID uint32 = 0x00120042
+	// ClinicalTrialTimePointID (0012,0050) LO
+	ClinicalTrialTimePointID uint32 = 0x00120050
+	// ClinicalTrialTimePointDescription (0012,0051) ST
+	ClinicalTrialTimePointDescription uint32 = 0x00120051
+	// LongitudinalTemporalOffsetFromEvent (0012,0052) FD
+	LongitudinalTemporalOffsetFromEvent uint32 = 0x00120052
+	// LongitudinalTemporalEventType (0012,0053) CS
+	LongitudinalTemporalEventType uint32 = 0x00120053
+	// ClinicalTrialCoordinatingCenterName (0012,0060) LO
+	ClinicalTrialCoordinatingCenterName uint32 = 0x00120060
+	// PatientIdentityRemoved (0012,0062) CS
+	PatientIdentityRemoved uint32 = 0x00120062
+	// DeidentificationMethod (0012,0063) LO
+	DeidentificationMethod uint32 = 0x00120063
+	// DeidentificationMethodCodeSequence (0012,0064) SQ
+	DeidentificationMethodCodeSequence uint32 = 0x00120064
+	// ClinicalTrialSeriesID (0012,0071) LO
+	ClinicalTrialSeriesID uint32 = 0x00120071
+	// ClinicalTrialSeriesDescription (0012,0072) LO
+	ClinicalTrialSeriesDescription uint32 = 0x00120072
+	// ClinicalTrialProtocolEthicsCommitteeName (0012,0081) LO
+	ClinicalTrialProtocolEthicsCommitteeName uint32 = 0x00120081
+	// ClinicalTrialProtocolEthicsCommitteeApprovalNumber (0012,0082) LO
+	ClinicalTrialProtocolEthicsCommitteeApprovalNumber uint32 = 0x00120082
+	// ConsentForClinicalTrialUseSequence (0012,0083) SQ
+	ConsentForClinicalTrialUseSequence uint32 = 0x00120083
+	// DistributionType (0012,0084) CS
+	DistributionType uint32 = 0x00120084
+	// ConsentForDistributionFlag (0012,0085) CS
+	ConsentForDistributionFlag uint32 = 0x00120085
+	// EthicsCommitteeApprovalEffectivenessStartDate (0012,0086) DA
+	EthicsCommitteeApprovalEffectivenessStartDate uint32 = 0x00120086
+	// EthicsCommitteeApprovalEffectivenessEndDate (0012,0087) DA
+	EthicsCommitteeApprovalEffectivenessEndDate uint32 = 0x00120087
+	// CADFileFormat (0014,0023) ST, retired
+	CADFileFormat uint32 = 0x00140023
+	// ComponentReferenceSystem (0014,0024) ST, retired
+	ComponentReferenceSystem uint32 = 0x00140024
+	// ComponentManufacturingProcedure (0014,0025) ST
+	ComponentManufacturingProcedure uint32 = 0x00140025
+	// ComponentManufacturer (0014,0028) ST
+	ComponentManufacturer uint32 = 0x00140028
+	// MaterialThickness (0014,0030) DS
+	MaterialThickness uint32 = 0x00140030
+	// MaterialPipeDiameter (0014,0032) DS
+	MaterialPipeDiameter uint32 = 0x00140032
+	// MaterialIsolationDiameter (0014,0034) DS
+	MaterialIsolationDiameter uint32 = 0x00140034
+	// MaterialGrade (0014,0042) ST
+	MaterialGrade uint32 = 0x00140042
+	// MaterialPropertiesDescription (0014,0044) ST
+	MaterialPropertiesDescription uint32 = 0x00140044
+	// MaterialPropertiesFileFormatRetired (0014,0045) ST, retired
+	MaterialPropertiesFileFormatRetired uint32 = 0x00140045
+	// MaterialNotes (0014,0046) LT
+	MaterialNotes uint32 = 0x00140046
+	// ComponentShape (0014,0050) CS
+	ComponentShape uint32 = 0x00140050
+	// CurvatureType (0014,0052) CS
+	CurvatureType uint32 = 0x00140052
+	// OuterDiameter (0014,0054) DS
+	OuterDiameter uint32 = 0x00140054
+	// InnerDiameter (0014,0056) DS
+	InnerDiameter uint32 = 0x00140056
+	// ComponentWelderIDs (0014,0100) LO
+	ComponentWelderIDs uint32 = 0x00140100
+	// SecondaryApprovalStatus (0014,0101) CS
+	SecondaryApprovalStatus uint32 = 0x00140101
+	// SecondaryReviewDate (0014,0102) DA
+	SecondaryReviewDate uint32 = 0x00140102
+	// SecondaryReviewTime (0014,0103) TM
+	SecondaryReviewTime uint32 = 0x00140103
+	// SecondaryReviewerName (0014,0104) PN
+	SecondaryReviewerName uint32 = 0x00140104
+	// RepairID (0014,0105) ST
+	RepairID uint32 = 0x00140105
+	// MultipleComponentApprovalSequence (0014,0106) SQ
+	MultipleComponentApprovalSequence uint32 = 0x00140106
+	// OtherApprovalStatus (0014,0107) CS
+	OtherApprovalStatus uint32 = 0x00140107
+	// OtherSecondaryApprovalStatus (0014,0108) CS
+	OtherSecondaryApprovalStatus uint32 = 0x00140108
+	// ActualEnvironmentalConditions (0014,1010) ST
+	ActualEnvironmentalConditions uint32 = 0x00141010
+	// ExpiryDate (0014,1020) DA
+	ExpiryDate uint32 = 0x00141020
+	// EnvironmentalConditions (0014,1040) ST
+	EnvironmentalConditions uint32 = 0x00141040
+	// EvaluatorSequence (0014,2002) SQ
+	EvaluatorSequence uint32 = 0x00142002
+	// EvaluatorNumber (0014,2004) IS
+	EvaluatorNumber uint32 = 0x00142004
+	// EvaluatorName (0014,2006) PN
+	EvaluatorName uint32 = 0x00142006
+	// EvaluationAttempt (0014,2008) IS
+	EvaluationAttempt uint32 = 0x00142008
+	// IndicationSequence (0014,2012) SQ
+	IndicationSequence uint32 = 0x00142012
+	// IndicationNumber (0014,2014) IS
+	IndicationNumber uint32 = 0x00142014
+	// IndicationLabel (0014,2016) SH
+	IndicationLabel uint32 = 0x00142016
+	// IndicationDescription (0014,2018) ST
+	IndicationDescription uint32 = 0x00142018
+	// IndicationType (0014,201A) CS
+	IndicationType uint32 = 0x0014201A
+	// IndicationDisposition (0014,201C) CS
+	IndicationDisposition uint32 = 0x0014201C
+	// IndicationROISequence (0014,201E) SQ
+	IndicationROISequence uint32 = 0x0014201E
+	// IndicationPhysicalPropertySequence (0014,2030) SQ
+	IndicationPhysicalPropertySequence uint32 = 0x00142030
+	// PropertyLabel (0014,2032) SH
+	PropertyLabel uint32 = 0x00142032
+	// CoordinateSystemNumberOfAxes (0014,2202) IS
+	CoordinateSystemNumberOfAxes uint32 = 0x00142202
+	// CoordinateSystemAxesSequence (0014,2204) SQ
+	CoordinateSystemAxesSequence uint32 = 0x00142204
+	// CoordinateSystemAxisDescription (0014,2206) ST
+	CoordinateSystemAxisDescription uint32 = 0x00142206
+	// CoordinateSystemDataSetMapping (0014,2208) CS
+	CoordinateSystemDataSetMapping uint32 = 0x00142208
+	// CoordinateSystemAxisNumber (0014,220A) IS
+	CoordinateSystemAxisNumber uint32 = 0x0014220A
+	// CoordinateSystemAxisType (0014,220C) CS
+	CoordinateSystemAxisType uint32 = 0x0014220C
+	// CoordinateSystemAxisUnits (0014,220E) CS
+	CoordinateSystemAxisUnits uint32 = 0x0014220E
+	// CoordinateSystemAxisValues (0014,2210) OB
+	CoordinateSystemAxisValues uint32 = 0x00142210
+	// CoordinateSystemTransformSequence (0014,2220) SQ
+	CoordinateSystemTransformSequence uint32 = 0x00142220
+	// TransformDescription (0014,2222) ST
+	TransformDescription uint32 = 0x00142222
+	// TransformNumberOfAxes (0014,2224) IS
+	TransformNumberOfAxes uint32 = 0x00142224
+	// TransformOrderOfAxes (0014,2226) IS
+	TransformOrderOfAxes uint32 = 0x00142226
+	// TransformedAxisUnits (0014,2228) CS
+	TransformedAxisUnits uint32 = 0x00142228
+	// CoordinateSystemTransformRotationAndScaleMatrix (0014,222A) DS
+	CoordinateSystemTransformRotationAndScaleMatrix uint32 = 0x0014222A
+	// CoordinateSystemTransformTranslationMatrix (0014,222C) DS
+	CoordinateSystemTransformTranslationMatrix uint32 = 0x0014222C
+	// InternalDetectorFrameTime (0014,3011) DS
+	InternalDetectorFrameTime uint32 = 0x00143011
+	// NumberOfFramesIntegrated (0014,3012) DS
+	NumberOfFramesIntegrated uint32 = 0x00143012
+	// DetectorTemperatureSequence (0014,3020) SQ
+	DetectorTemperatureSequence uint32 = 0x00143020
+	// SensorName (0014,3022) ST
+	SensorName uint32 = 0x00143022
+	// HorizontalOffsetOfSensor (0014,3024) DS
+	HorizontalOffsetOfSensor uint32 = 0x00143024
+	// VerticalOffsetOfSensor (0014,3026) DS
+	VerticalOffsetOfSensor uint32 = 0x00143026
+	// SensorTemperature (0014,3028) DS
+	SensorTemperature uint32 = 0x00143028
+	// DarkCurrentSequence (0014,3040) SQ
+	DarkCurrentSequence uint32 = 0x00143040
+	// DarkCurrentCounts (0014,3050) OB
+	DarkCurrentCounts uint32 = 0x00143050
+	// GainCorrectionReferenceSequence (0014,3060) SQ
+	GainCorrectionReferenceSequence uint32 = 0x00143060
+	// AirCounts (0014,3070) OB
+	AirCounts uint32 = 0x00143070
+	// KVUsedInGainCalibration (0014,3071) DS
+	KVUsedInGainCalibration uint32 = 0x00143071
+	// MAUsedInGainCalibration (0014,3072) DS
+	MAUsedInGainCalibration uint32 = 0x00143072
+	// NumberOfFramesUsedForIntegration (0014,3073) DS
+	NumberOfFramesUsedForIntegration uint32 = 0x00143073
+	// FilterMaterialUsedInGainCalibration (0014,3074) LO
+	FilterMaterialUsedInGainCalibration uint32 = 0x00143074
+	// FilterThicknessUsedInGainCalibration (0014,3075) DS
+	FilterThicknessUsedInGainCalibration uint32 = 0x00143075
+	// DateOfGainCalibration (0014,3076) DA
+	DateOfGainCalibration uint32 = 0x00143076
+	// TimeOfGainCalibration (0014,3077) TM
+	TimeOfGainCalibration uint32 = 0x00143077
+	// BadPixelImage (0014,3080) OB
+	BadPixelImage uint32 = 0x00143080
+	// CalibrationNotes (0014,3099) LT
+	CalibrationNotes uint32 = 0x00143099
+	// PulserEquipmentSequence (0014,4002) SQ
+	PulserEquipmentSequence uint32 = 0x00144002
+	// PulserType (0014,4004) CS
+	PulserType uint32 = 0x00144004
+	// PulserNotes (0014,4006) LT
+	PulserNotes uint32 = 0x00144006
+	// ReceiverEquipmentSequence (0014,4008) SQ
+	ReceiverEquipmentSequence uint32 = 0x00144008
+	// AmplifierType (0014,400A) CS
+	AmplifierType uint32 = 0x0014400A
+	// ReceiverNotes (0014,400C) LT
+	ReceiverNotes uint32 = 0x0014400C
+	// PreAmplifierEquipmentSequence (0014,400E) SQ
+	PreAmplifierEquipmentSequence uint32 = 0x0014400E
+	// PreAmplifierNotes (0014,400F) LT
+	PreAmplifierNotes uint32 = 0x0014400F
+	// TransmitTransducerSequence (0014,4010) SQ
+	TransmitTransducerSequence uint32 = 0x00144010
+	// ReceiveTransducerSequence (0014,4011) SQ
+	ReceiveTransducerSequence uint32 = 0x00144011
+	// NumberOfElements (0014,4012) US
+	NumberOfElements uint32 = 0x00144012
+	// ElementShape (0014,4013) CS
+	ElementShape uint32 = 0x00144013
+	// ElementDimensionA (0014,4014) DS
+	ElementDimensionA uint32 = 0x00144014
+	// ElementDimensionB (0014,4015) DS
+	ElementDimensionB uint32 = 0x00144015
+	// ElementPitchA (0014,4016) DS
+	ElementPitchA uint32 = 0x00144016
+	// MeasuredBeamDimensionA (0014,4017) DS
+	MeasuredBeamDimensionA uint32 = 0x00144017
+	// MeasuredBeamDimensionB (0014,4018) DS
+	MeasuredBeamDimensionB uint32 = 0x00144018
+	// LocationOfMeasuredBeamDiameter (0014,4019) DS
+	LocationOfMeasuredBeamDiameter uint32 = 0x00144019
+	// NominalFrequency (0014,401A) DS
+	NominalFrequency uint32 = 0x0014401A
+	// MeasuredCenterFrequency (0014,401B) DS
+	MeasuredCenterFrequency uint32 = 0x0014401B
+	// MeasuredBandwidth (0014,401C) DS
+	MeasuredBandwidth uint32 = 0x0014401C
+	// ElementPitchB (0014,401D) DS
+	ElementPitchB uint32 = 0x0014401D
+	// PulserSettingsSequence (0014,4020) SQ
+	PulserSettingsSequence uint32 = 0x00144020
+	// PulseWidth (0014,4022) DS
+	PulseWidth uint32 = 0x00144022
+	// ExcitationFrequency (0014,4024) DS
+	ExcitationFrequency uint32 = 0x00144024
+	// ModulationType (0014,4026) CS
+	ModulationType uint32 = 0x00144026
+	// Damping (0014,4028) DS
+	Damping uint32 = 0x00144028
+	// ReceiverSettingsSequence (0014,4030) SQ
+	ReceiverSettingsSequence uint32 = 0x00144030
+	// AcquiredSoundpathLength (0014,4031) DS
+	AcquiredSoundpathLength uint32 = 0x00144031
+	// AcquisitionCompressionType (0014,4032) CS
+	AcquisitionCompressionType uint32 = 0x00144032
+	// AcquisitionSampleSize (0014,4033) IS
+	AcquisitionSampleSize uint32 = 0x00144033
+	// RectifierSmoothing (0014,4034) DS
+	RectifierSmoothing uint32 = 0x00144034
+	// DACSequence (0014,4035) SQ
+	DACSequence uint32 = 0x00144035
+	// DACType (0014,4036) CS
+	DACType uint32 = 0x00144036
+	// DACGainPoints (0014,4038) DS
+	DACGainPoints uint32 = 0x00144038
+	// DACTimePoints (0014,403A) DS
+	DACTimePoints uint32 = 0x0014403A
+	// DACAmplitude (0014,403C) DS
+	DACAmplitude uint32 = 0x0014403C
+	// PreAmplifierSettingsSequence (0014,4040) SQ
+	PreAmplifierSettingsSequence uint32 = 0x00144040
+	// TransmitTransducerSettingsSequence (0014,4050) SQ
+	TransmitTransducerSettingsSequence uint32 = 0x00144050
+	// ReceiveTransducerSettingsSequence (0014,4051) SQ
+	ReceiveTransducerSettingsSequence uint32 = 0x00144051
+	// IncidentAngle (0014,4052) DS
+	IncidentAngle uint32 = 0x00144052
+	// CouplingTechnique (0014,4054) ST
+	CouplingTechnique uint32 = 0x00144054
+	// CouplingMedium (0014,4056) ST
+	CouplingMedium uint32 = 0x00144056
+	// CouplingVelocity (0014,4057) DS
+	CouplingVelocity uint32 = 0x00144057
+	// ProbeCenterLocationX (0014,4058) DS
+	ProbeCenterLocationX uint32 = 0x00144058
+	// ProbeCenterLocationZ (0014,4059) DS
+	ProbeCenterLocationZ uint32 = 0x00144059
+	// SoundPathLength (0014,405A) DS
+	SoundPathLength uint32 = 0x0014405A
+	// DelayLawIdentifier (0014,405C) ST
+	DelayLawIdentifier uint32 = 0x0014405C
+	// GateSettingsSequence (0014,4060) SQ
+	GateSettingsSequence uint32 = 0x00144060
+	// GateThreshold (0014,4062) DS
+	GateThreshold uint32 = 0x00144062
+	// VelocityOfSound (0014,4064) DS
+	VelocityOfSound uint32 = 0x00144064
+	// CalibrationSettingsSequence (0014,4070) SQ
+	CalibrationSettingsSequence uint32 = 0x00144070
+	// CalibrationProcedure (0014,4072) ST
+	CalibrationProcedure uint32 = 0x00144072
+	// ProcedureVersion (0014,4074) SH
+	ProcedureVersion uint32 = 0x00144074
+	// ProcedureCreationDate (0014,4076) DA
+	ProcedureCreationDate uint32 = 0x00144076
+	// ProcedureExpirationDate (0014,4078) DA
+	ProcedureExpirationDate uint32 = 0x00144078
+	// ProcedureLastModifiedDate (0014,407A) DA
+	ProcedureLastModifiedDate uint32 = 0x0014407A
+	// CalibrationTime (0014,407C) TM
+	CalibrationTime uint32 = 0x0014407C
+	// CalibrationDate (0014,407E) DA
+	CalibrationDate uint32 = 0x0014407E
+	// ProbeDriveEquipmentSequence (0014,4080) SQ
+	ProbeDriveEquipmentSequence uint32 = 0x00144080
+	// DriveType (0014,4081) CS
+	DriveType uint32 = 0x00144081
+	// ProbeDriveNotes (0014,4082) LT
+	ProbeDriveNotes uint32 = 0x00144082
+	// DriveProbeSequence (0014,4083) SQ
+	DriveProbeSequence uint32 = 0x00144083
+	// ProbeInductance (0014,4084) DS
+	ProbeInductance uint32 = 0x00144084
+	// ProbeResistance (0014,4085) DS
+	ProbeResistance uint32 = 0x00144085
+	// ReceiveProbeSequence (0014,4086) SQ
+	ReceiveProbeSequence uint32 = 0x00144086
+	// ProbeDriveSettingsSequence (0014,4087) SQ
+	ProbeDriveSettingsSequence uint32 = 0x00144087
+	// BridgeResistors (0014,4088) DS
+	BridgeResistors uint32 = 0x00144088
+	// ProbeOrientationAngle (0014,4089) DS
+	ProbeOrientationAngle uint32 = 0x00144089
+	// UserSelectedGainY (0014,408B) DS
+	UserSelectedGainY uint32 = 0x0014408B
+	// UserSelectedPhase (0014,408C) DS
+	UserSelectedPhase uint32 = 0x0014408C
+	// UserSelectedOffsetX (0014,408D) DS
+	UserSelectedOffsetX uint32 = 0x0014408D
+	// UserSelectedOffsetY (0014,408E) DS
+	UserSelectedOffsetY uint32 = 0x0014408E
+	// ChannelSettingsSequence (0014,4091) SQ
+	ChannelSettingsSequence uint32 = 0x00144091
+	// ChannelThreshold (0014,4092) DS
+	ChannelThreshold uint32 = 0x00144092
+	// ScannerSettingsSequence (0014,409A) SQ
+	ScannerSettingsSequence uint32 = 0x0014409A
+	// ScanProcedure (0014,409B) ST
+	ScanProcedure uint32 = 0x0014409B
+	// TranslationRateX (0014,409C) DS
+	TranslationRateX uint32 = 0x0014409C
+	// TranslationRateY (0014,409D) DS
+	TranslationRateY uint32 = 0x0014409D
+	// ChannelOverlap (0014,409F) DS
+	ChannelOverlap uint32 = 0x0014409F
+	// ImageQualityIndicatorType (0014,40A0) LO
+	ImageQualityIndicatorType uint32 = 0x001440A0
+	// ImageQualityIndicatorMaterial (0014,40A1) LO
+	ImageQualityIndicatorMaterial uint32 = 0x001440A1
+	// ImageQualityIndicatorSize (0014,40A2) LO
+	ImageQualityIndicatorSize uint32 = 0x001440A2
+	// LINACEnergy (0014,5002) IS
+	LINACEnergy uint32 = 0x00145002
+	// LINACOutput (0014,5004) IS
+	LINACOutput uint32 = 0x00145004
+	// ActiveAperture (0014,5100) US
+	ActiveAperture uint32 = 0x00145100
+	// TotalAperture (0014,5101) DS
+	TotalAperture uint32 = 0x00145101
+	// ApertureElevation (0014,5102) DS
+	ApertureElevation uint32 = 0x00145102
+	// MainLobeAngle (0014,5103) DS
+	MainLobeAngle uint32 = 0x00145103
+	// MainRoofAngle (0014,5104) DS
+	MainRoofAngle uint32 = 0x00145104
+	// ConnectorType (0014,5105) CS
+	ConnectorType uint32 = 0x00145105
+	// WedgeModelNumber (0014,5106) SH
+	WedgeModelNumber uint32 = 0x00145106
+	// WedgeAngleFloat (0014,5107) DS
+	WedgeAngleFloat uint32 = 0x00145107
+	// WedgeRoofAngle (0014,5108) DS
+	WedgeRoofAngle uint32 = 0x00145108
+	// WedgeElement1Position (0014,5109) CS
+	WedgeElement1Position uint32 = 0x00145109
+	// WedgeMaterialVelocity (0014,510A) DS
+	WedgeMaterialVelocity uint32 = 0x0014510A
+	// WedgeMaterial (0014,510B) SH
+	WedgeMaterial uint32 = 0x0014510B
+	// WedgeOffsetZ (0014,510C) DS
+	WedgeOffsetZ uint32 = 0x0014510C
+	// WedgeOriginOffsetX (0014,510D) DS
+	WedgeOriginOffsetX uint32 = 0x0014510D
+	// WedgeTimeDelay (0014,510E) DS
+	WedgeTimeDelay uint32 = 0x0014510E
+	// WedgeName (0014,510F) SH
+	WedgeName uint32 = 0x0014510F
+	// WedgeManufacturerName (0014,5110) SH
+	WedgeManufacturerName uint32 = 0x00145110
+	// WedgeDescription (0014,5111) LO
+	WedgeDescription uint32 = 0x00145111
+	// NominalBeamAngle (0014,5112) DS
+	NominalBeamAngle uint32 = 0x00145112
+	// WedgeOffsetX (0014,5113) DS
+	WedgeOffsetX uint32 = 0x00145113
+	// WedgeOffsetY (0014,5114) DS
+	WedgeOffsetY uint32 = 0x00145114
+	// WedgeTotalLength (0014,5115) DS
+	WedgeTotalLength uint32 = 0x00145115
+	// WedgeInContactLength (0014,5116) DS
+	WedgeInContactLength uint32 = 0x00145116
+	// WedgeFrontGap (0014,5117) DS
+	WedgeFrontGap uint32 = 0x00145117
+	// WedgeTotalHeight (0014,5118) DS
+	WedgeTotalHeight uint32 = 0x00145118
+	// WedgeFrontHeight (0014,5119) DS
+	WedgeFrontHeight uint32 = 0x00145119
+	// WedgeRearHeight (0014,511A) DS
+	WedgeRearHeight uint32 = 0x0014511A
+	// WedgeTotalWidth (0014,511B) DS
+	WedgeTotalWidth uint32 = 0x0014511B
+	// WedgeInContactWidth (0014,511C) DS
+	WedgeInContactWidth uint32 = 0x0014511C
+	// WedgeChamferHeight (0014,511D) DS
+	WedgeChamferHeight uint32 = 0x0014511D
+	// WedgeCurve (0014,511E) CS
+	WedgeCurve uint32 = 0x0014511E
+	// RadiusAlongWedge (0014,511F) DS
+	RadiusAlongWedge uint32 = 0x0014511F
+	// ContrastBolusAgent (0018,0010) LO
+	ContrastBolusAgent uint32 = 0x00180010
+	// ContrastBolusAgentSequence (0018,0012) SQ
+	ContrastBolusAgentSequence uint32 = 0x00180012
+	// ContrastBolusT1Relaxivity (0018,0013) FL
+	ContrastBolusT1Relaxivity uint32 = 0x00180013
+	// ContrastBolusAdministrationRouteSequence (0018,0014) SQ
+	ContrastBolusAdministrationRouteSequence uint32 = 0x00180014
+	// BodyPartExamined (0018,0015) CS
+	BodyPartExamined uint32 = 0x00180015
+	// ScanningSequence (0018,0020) CS
+	ScanningSequence uint32 = 0x00180020
+	// SequenceVariant (0018,0021) CS
+	SequenceVariant uint32 = 0x00180021
+	// ScanOptions (0018,0022) CS
+	ScanOptions uint32 = 0x00180022
+	// MRAcquisitionType (0018,0023) CS
+	MRAcquisitionType uint32 = 0x00180023
+	// SequenceName (0018,0024) SH
+	SequenceName uint32 = 0x00180024
+	// AngioFlag (0018,0025) CS
+	AngioFlag uint32 = 0x00180025
+	// InterventionDrugInformationSequence (0018,0026) SQ
+	InterventionDrugInformationSequence uint32 = 0x00180026
+	// InterventionDrugStopTime (0018,0027) TM
+	InterventionDrugStopTime uint32 = 0x00180027
+	// InterventionDrugDose (0018,0028) DS
+	InterventionDrugDose uint32 = 0x00180028
+	// InterventionDrugCodeSequence (0018,0029) SQ
+	InterventionDrugCodeSequence uint32 = 0x00180029
+	// AdditionalDrugSequence (0018,002A) SQ
+	AdditionalDrugSequence uint32 = 0x0018002A
+	// Radionuclide (0018,0030) LO, retired
+	Radionuclide uint32 = 0x00180030
+	// Radiopharmaceutical (0018,0031) LO
+	Radiopharmaceutical uint32 = 0x00180031
+	// EnergyWindowCenterline (0018,0032) DS, retired
+	EnergyWindowCenterline uint32 = 0x00180032
+	// EnergyWindowTotalWidth (0018,0033) DS, retired
+	EnergyWindowTotalWidth uint32 = 0x00180033
+	// InterventionDrugName (0018,0034) LO
+	InterventionDrugName uint32 = 0x00180034
+	// InterventionDrugStartTime (0018,0035) TM
+	InterventionDrugStartTime uint32 = 0x00180035
+	// InterventionSequence (0018,0036) SQ
+	InterventionSequence uint32 = 0x00180036
+	// TherapyType (0018,0037) CS, retired
+	TherapyType uint32 = 0x00180037
+	// InterventionStatus (0018,0038) CS
+	InterventionStatus uint32 = 0x00180038
+	// TherapyDescription (0018,0039) CS, retired
+	TherapyDescription uint32 = 0x00180039
+	// InterventionDescription (0018,003A) ST
+	InterventionDescription uint32 = 0x0018003A
+	// CineRate (0018,0040) IS
+	CineRate uint32 = 0x00180040
+	// InitialCineRunState (0018,0042) CS
+	InitialCineRunState uint32 = 0x00180042
+	// SliceThickness (0018,0050) DS
+	SliceThickness uint32 = 0x00180050
+	// KVP (0018,0060) DS
+	KVP uint32 = 0x00180060
+	// CountsAccumulated (0018,0070) IS
+	CountsAccumulated uint32 = 0x00180070
+	// AcquisitionTerminationCondition (0018,0071) CS
+	AcquisitionTerminationCondition uint32 = 0x00180071
+	// EffectiveDuration (0018,0072) DS
+	EffectiveDuration uint32 = 0x00180072
+	// AcquisitionStartCondition (0018,0073) CS
+	AcquisitionStartCondition uint32 = 0x00180073
+	// AcquisitionStartConditionData (0018,0074) IS
+	AcquisitionStartConditionData uint32 = 0x00180074
+	// AcquisitionTerminationConditionData (0018,0075) IS
+	AcquisitionTerminationConditionData uint32 = 0x00180075
+	// RepetitionTime (0018,0080) DS
+	RepetitionTime uint32 = 0x00180080
+	// EchoTime (0018,0081) DS
+	EchoTime uint32 = 0x00180081
+	// InversionTime (0018,0082) DS
+	InversionTime uint32 = 0x00180082
+	// NumberOfAverages (0018,0083) DS
+	NumberOfAverages uint32 = 0x00180083
+	// ImagingFrequency (0018,0084) DS
+	ImagingFrequency uint32 = 0x00180084
+	// ImagedNucleus (0018,0085) SH
+	ImagedNucleus uint32 = 0x00180085
+	// EchoNumbers (0018,0086) IS
+	EchoNumbers uint32 = 0x00180086
+	// MagneticFieldStrength (0018,0087) DS
+	MagneticFieldStrength uint32 = 0x00180087
+	// SpacingBetweenSlices (0018,0088) DS
+	SpacingBetweenSlices uint32 = 0x00180088
+	// NumberOfPhaseEncodingSteps (0018,0089) IS
+	NumberOfPhaseEncodingSteps uint32 = 0x00180089
+	// DataCollectionDiameter (0018,0090) DS
+	DataCollectionDiameter uint32 = 0x00180090
+	// EchoTrainLength (0018,0091) IS
+	EchoTrainLength uint32 = 0x00180091
+	// PercentSampling (0018,0093) DS
+	PercentSampling uint32 = 0x00180093
+	// PercentPhaseFieldOfView (0018,0094) DS
+	PercentPhaseFieldOfView uint32 = 0x00180094
+	// PixelBandwidth (0018,0095) DS
+	PixelBandwidth uint32 = 0x00180095
+	// DeviceSerialNumber (0018,1000) LO
+	DeviceSerialNumber uint32 = 0x00181000
+	// DeviceUID (0018,1002) UI
+	DeviceUID uint32 = 0x00181002
+	// DeviceID (0018,1003) LO
+	DeviceID uint32 = 0x00181003
+	// PlateID (0018,1004) LO
+	PlateID uint32 = 0x00181004
+	// GeneratorID (0018,1005) LO
+	GeneratorID uint32 = 0x00181005
+	// GridID (0018,1006) LO
+	GridID uint32 = 0x00181006
+	// CassetteID (0018,1007) LO
+	CassetteID uint32 = 0x00181007
+	// GantryID (0018,1008) LO
+	GantryID uint32 = 0x00181008
+	// UniqueDeviceIdentifier (0018,1009) UT
+	UniqueDeviceIdentifier uint32 = 0x00181009
+	// UDISequence (0018,100A) SQ
+	UDISequence uint32 = 0x0018100A
+	// SecondaryCaptureDeviceID (0018,1010) LO
+	SecondaryCaptureDeviceID uint32 = 0x00181010
+	// HardcopyCreationDeviceID (0018,1011) LO, retired
+	HardcopyCreationDeviceID uint32 = 0x00181011
+	// DateOfSecondaryCapture (0018,1012) DA
+	DateOfSecondaryCapture uint32 = 0x00181012
+	// TimeOfSecondaryCapture (0018,1014) TM
+	TimeOfSecondaryCapture uint32 = 0x00181014
+	// SecondaryCaptureDeviceManufacturer (0018,1016) LO
+	SecondaryCaptureDeviceManufacturer uint32 = 0x00181016
+	// HardcopyDeviceManufacturer (0018,1017) LO, retired
+	HardcopyDeviceManufacturer uint32 = 0x00181017
+	// SecondaryCaptureDeviceManufacturerModelName (0018,1018) LO
+	SecondaryCaptureDeviceManufacturerModelName uint32 = 0x00181018
+	// SecondaryCaptureDeviceSoftwareVersions (0018,1019) LO
+	SecondaryCaptureDeviceSoftwareVersions uint32 = 0x00181019
+	// HardcopyDeviceSoftwareVersion (0018,101A) LO, retired
+	HardcopyDeviceSoftwareVersion uint32 = 0x0018101A
+	// HardcopyDeviceManufacturerModelName (0018,101B) LO, retired
+	HardcopyDeviceManufacturerModelName uint32 = 0x0018101B
+	// SoftwareVersions (0018,1020) LO
+	SoftwareVersions uint32 = 0x00181020
+	// VideoImageFormatAcquired (0018,1022) SH
+	VideoImageFormatAcquired uint32 = 0x00181022
+	// DigitalImageFormatAcquired (0018,1023) LO
+	DigitalImageFormatAcquired uint32 = 0x00181023
+	// ProtocolName (0018,1030) LO
+	ProtocolName uint32 = 0x00181030
+	// ContrastBolusRoute (0018,1040) LO
+	ContrastBolusRoute uint32 = 0x00181040
+	// ContrastBolusVolume (0018,1041) DS
+	ContrastBolusVolume uint32 = 0x00181041
+	// ContrastBolusStartTime (0018,1042) TM
+	ContrastBolusStartTime uint32 = 0x00181042
+	// ContrastBolusStopTime (0018,1043) TM
+	ContrastBolusStopTime uint32 = 0x00181043
+	// ContrastBolusTotalDose (0018,1044) DS
+	ContrastBolusTotalDose uint32 = 0x00181044
+	// SyringeCounts (0018,1045) IS
+	SyringeCounts uint32 = 0x00181045
+	// ContrastFlowRate (0018,1046) DS
+	ContrastFlowRate uint32 = 0x00181046
+	// ContrastFlowDuration (0018,1047) DS
+	ContrastFlowDuration uint32 = 0x00181047
+	// ContrastBolusIngredient (0018,1048) CS
+	ContrastBolusIngredient uint32 = 0x00181048
+	// ContrastBolusIngredientConcentration (0018,1049) DS
+	ContrastBolusIngredientConcentration uint32 = 0x00181049
+	// SpatialResolution (0018,1050) DS
+	SpatialResolution uint32 = 0x00181050
+	// TriggerTime (0018,1060) DS
+	TriggerTime uint32 = 0x00181060
+	// TriggerSourceOrType (0018,1061) LO
+	TriggerSourceOrType uint32 = 0x00181061
+	// NominalInterval (0018,1062) IS
+	NominalInterval uint32 = 0x00181062
+	// FrameTime (0018,1063) DS
+	FrameTime uint32 = 0x00181063
+	// CardiacFramingType (0018,1064) LO
+	CardiacFramingType uint32 = 0x00181064
+	// FrameTimeVector (0018,1065) DS
+	FrameTimeVector uint32 = 0x00181065
+	// FrameDelay (0018,1066) DS
+	FrameDelay uint32 = 0x00181066
+	// ImageTriggerDelay (0018,1067) DS
+	ImageTriggerDelay uint32 = 0x00181067
+	// MultiplexGroupTimeOffset (0018,1068) DS
+	MultiplexGroupTimeOffset uint32 = 0x00181068
+	// TriggerTimeOffset (0018,1069) DS
+	TriggerTimeOffset uint32 = 0x00181069
+	// SynchronizationTrigger (0018,106A) CS
+	SynchronizationTrigger uint32 = 0x0018106A
+	// SynchronizationChannel (0018,106C) US
+	SynchronizationChannel uint32 = 0x0018106C
+	// TriggerSamplePosition (0018,106E) UL
+	TriggerSamplePosition uint32 = 0x0018106E
+	// RadiopharmaceuticalRoute (0018,1070) LO
+	RadiopharmaceuticalRoute uint32 = 0x00181070
+	// RadiopharmaceuticalVolume (0018,1071) DS
+	RadiopharmaceuticalVolume uint32 = 0x00181071
+	// RadiopharmaceuticalStartTime (0018,1072) TM
+	RadiopharmaceuticalStartTime uint32 = 0x00181072
+	// RadiopharmaceuticalStopTime (0018,1073) TM
+	RadiopharmaceuticalStopTime uint32 = 0x00181073
+	// RadionuclideTotalDose (0018,1074) DS
+	RadionuclideTotalDose uint32 = 0x00181074
+	// RadionuclideHalfLife (0018,1075) DS
+	RadionuclideHalfLife uint32 = 0x00181075
+	// RadionuclidePositronFraction (0018,1076) DS
+	RadionuclidePositronFraction uint32 = 0x00181076
+	// RadiopharmaceuticalSpecificActivity (0018,1077) DS
+	RadiopharmaceuticalSpecificActivity uint32 = 0x00181077
+	// RadiopharmaceuticalStartDateTime (0018,1078) DT
+	RadiopharmaceuticalStartDateTime uint32 = 0x00181078
+	// RadiopharmaceuticalStopDateTime (0018,1079) DT
+	RadiopharmaceuticalStopDateTime uint32 = 0x00181079
+	// BeatRejectionFlag (0018,1080) CS
+	BeatRejectionFlag uint32 = 0x00181080
+	// LowRRValue (0018,1081) IS
+	LowRRValue uint32 = 0x00181081
+	// HighRRValue (0018,1082) IS
+	HighRRValue uint32 = 0x00181082
+	// IntervalsAcquired (0018,1083) IS
+	IntervalsAcquired uint32 = 0x00181083
+	// IntervalsRejected (0018,1084) IS
+	IntervalsRejected uint32 = 0x00181084
+	// PVCRejection (0018,1085) LO
+	PVCRejection uint32 = 0x00181085
+	// SkipBeats (0018,1086) IS
+	SkipBeats uint32 = 0x00181086
+	// HeartRate (0018,1088) IS
+	HeartRate uint32 = 0x00181088
+	// CardiacNumberOfImages (0018,1090) IS
+	CardiacNumberOfImages uint32 = 0x00181090
+	// TriggerWindow (0018,1094) IS
+	TriggerWindow uint32 = 0x00181094
+	// ReconstructionDiameter (0018,1100) DS
+	ReconstructionDiameter uint32 = 0x00181100
+	// DistanceSourceToDetector (0018,1110) DS
+	DistanceSourceToDetector uint32 = 0x00181110
+	// DistanceSourceToPatient (0018,1111) DS
+	DistanceSourceToPatient uint32 = 0x00181111
+	// EstimatedRadiographicMagnificationFactor (0018,1114) DS
+	EstimatedRadiographicMagnificationFactor uint32 = 0x00181114
+	// GantryDetectorTilt (0018,1120) DS
+	GantryDetectorTilt uint32 = 0x00181120
+	// GantryDetectorSlew (0018,1121) DS
+	GantryDetectorSlew uint32 = 0x00181121
+	// TableHeight (0018,1130) DS
+	TableHeight uint32 = 0x00181130
+	// TableTraverse (0018,1131) DS
+	TableTraverse uint32 = 0x00181131
+	// TableMotion (0018,1134) CS
+	TableMotion uint32 = 0x00181134
+	// TableVerticalIncrement (0018,1135) DS
+	TableVerticalIncrement uint32 = 0x00181135
+	// TableLateralIncrement (0018,1136) DS
+	TableLateralIncrement uint32 = 0x00181136
+	// TableLongitudinalIncrement (0018,1137) DS
+	TableLongitudinalIncrement uint32 = 0x00181137
+	// TableAngle (0018,1138) DS
+	TableAngle uint32 = 0x00181138
+	// TableType (0018,113A) CS
+	TableType uint32 = 0x0018113A
+	// RotationDirection (0018,1140) CS
+	RotationDirection uint32 = 0x00181140
+	// AngularPosition (0018,1141) DS, retired
+	AngularPosition uint32 = 0x00181141
+	// RadialPosition (0018,1142) DS
+	RadialPosition uint32 = 0x00181142
+	// ScanArc (0018,1143) DS
+	ScanArc uint32 = 0x00181143
+	// AngularStep (0018,1144) DS
+	AngularStep uint32 = 0x00181144
+	// CenterOfRotationOffset (0018,1145) DS
+	CenterOfRotationOffset uint32 = 0x00181145
+	// RotationOffset (0018,1146) DS, retired
+	RotationOffset uint32 = 0x00181146
+	// FieldOfViewShape (0018,1147) CS
+	FieldOfViewShape uint32 = 0x00181147
+	// FieldOfViewDimensions (0018,1149) IS
+	FieldOfViewDimensions uint32 = 0x00181149
+	// ExposureTime (0018,1150) IS
+	ExposureTime uint32 = 0x00181150
+	// XRayTubeCurrent (0018,1151) IS
+	XRayTubeCurrent uint32 = 0x00181151
+	// Exposure (0018,1152) IS
+	Exposure uint32 = 0x00181152
+	// ExposureInuAs (0018,1153) IS
+	ExposureInuAs uint32 = 0x00181153
+	// AveragePulseWidth (0018,1154) DS
+	AveragePulseWidth uint32 = 0x00181154
+	// RadiationSetting (0018,1155) CS
+	RadiationSetting uint32 = 0x00181155
+	// RectificationType (0018,1156) CS
+	RectificationType uint32 = 0x00181156
+	// RadiationMode (0018,115A) CS
+	RadiationMode uint32 = 0x0018115A
+	// ImageAndFluoroscopyAreaDoseProduct (0018,115E) DS
+	ImageAndFluoroscopyAreaDoseProduct uint32 = 0x0018115E
+	// FilterType (0018,1160) SH
+	FilterType uint32 = 0x00181160
+	// TypeOfFilters (0018,1161) LO
+	TypeOfFilters uint32 = 0x00181161
+	// IntensifierSize (0018,1162) DS
+	IntensifierSize uint32 = 0x00181162
+	// ImagerPixelSpacing (0018,1164) DS
+	ImagerPixelSpacing uint32 = 0x00181164
+	// Grid (0018,1166) CS
+	Grid uint32 = 0x00181166
+	// GeneratorPower (0018,1170) IS
+	GeneratorPower uint32 = 0x00181170
+	// CollimatorGridName (0018,1180) SH
+	CollimatorGridName uint32 = 0x00181180
+	// CollimatorType (0018,1181) CS
+	CollimatorType uint32 = 0x00181181
+	// FocalDistance (0018,1182) IS
+	FocalDistance uint32 = 0x00181182
+	// XFocusCenter (0018,1183) DS
+	XFocusCenter uint32 = 0x00181183
+	// YFocusCenter (0018,1184) DS
+	YFocusCenter uint32 = 0x00181184
+	// FocalSpots (0018,1190) DS
+	FocalSpots uint32 = 0x00181190
+	// AnodeTargetMaterial (0018,1191) CS
+	AnodeTargetMaterial uint32 = 0x00181191
+	// BodyPartThickness (0018,11A0) DS
+	BodyPartThickness uint32 = 0x001811A0
+	// CompressionForce (0018,11A2) DS
+	CompressionForce uint32 = 0x001811A2
+	// CompressionPressure (0018,11A3) DS
+	CompressionPressure uint32 = 0x001811A3
+	// PaddleDescription (0018,11A4) LO
+	PaddleDescription uint32 = 0x001811A4
+	// CompressionContactArea (0018,11A5) DS
+	CompressionContactArea uint32 = 0x001811A5
+	// DateOfLastCalibration (0018,1200) DA
+	DateOfLastCalibration uint32 = 0x00181200
+	// TimeOfLastCalibration (0018,1201) TM
+	TimeOfLastCalibration uint32 = 0x00181201
+	// DateTimeOfLastCalibration (0018,1202) DT
+	DateTimeOfLastCalibration uint32 = 0x00181202
+	// ConvolutionKernel (0018,1210) SH
+	ConvolutionKernel uint32 = 0x00181210
+	// UpperLowerPixelValues (0018,1240) IS, retired
+	UpperLowerPixelValues uint32 = 0x00181240
+	// ActualFrameDuration (0018,1242) IS
+	ActualFrameDuration uint32 = 0x00181242
+	// CountRate (0018,1243) IS
+	CountRate uint32 = 0x00181243
+	// PreferredPlaybackSequencing (0018,1244) US
+	PreferredPlaybackSequencing uint32 = 0x00181244
+	// ReceiveCoilName (0018,1250) SH
+	ReceiveCoilName uint32 = 0x00181250
+	// TransmitCoilName (0018,1251) SH
+	TransmitCoilName uint32 = 0x00181251
+	// PlateType (0018,1260) SH
+	PlateType uint32 = 0x00181260
+	// PhosphorType (0018,1261) LO
+	PhosphorType uint32 = 0x00181261
+	// WaterEquivalentDiameter (0018,1271) FD
+	WaterEquivalentDiameter uint32 = 0x00181271
+	// WaterEquivalentDiameterCalculationMethodCodeSequence (0018,1272) SQ
+	WaterEquivalentDiameterCalculationMethodCodeSequence uint32 = 0x00181272
+	// ScanVelocity (0018,1300) DS
+	ScanVelocity uint32 = 0x00181300
+	// WholeBodyTechnique (0018,1301) CS
+	WholeBodyTechnique uint32 = 0x00181301
+	// ScanLength (0018,1302) IS
+	ScanLength uint32 = 0x00181302
+	// AcquisitionMatrix (0018,1310) US
+	AcquisitionMatrix uint32 = 0x00181310
+	// InPlanePhaseEncodingDirection (0018,1312) CS
+	InPlanePhaseEncodingDirection uint32 = 0x00181312
+	// FlipAngle (0018,1314) DS
+	FlipAngle uint32 = 0x00181314
+	// VariableFlipAngleFlag (0018,1315) CS
+	VariableFlipAngleFlag uint32 = 0x00181315
+	// SAR (0018,1316) DS
+	SAR uint32 = 0x00181316
+	// B1rms (0018,1320) FL
+	B1rms uint32 = 0x00181320
+	// AcquisitionDeviceProcessingDescription (0018,1400) LO
+	AcquisitionDeviceProcessingDescription uint32 = 0x00181400
+	// AcquisitionDeviceProcessingCode (0018,1401) LO
+	AcquisitionDeviceProcessingCode uint32 = 0x00181401
+	// CassetteOrientation (0018,1402) CS
+	CassetteOrientation uint32 = 0x00181402
+	// CassetteSize (0018,1403) CS
+	CassetteSize uint32 = 0x00181403
+	// ExposuresOnPlate (0018,1404) US
+	ExposuresOnPlate uint32 = 0x00181404
+	// RelativeXRayExposure (0018,1405) IS
+	RelativeXRayExposure uint32 = 0x00181405
+	// ExposureIndex (0018,1411) DS
+	ExposureIndex uint32 = 0x00181411
+	// TargetExposureIndex (0018,1412) DS
+	TargetExposureIndex uint32 = 0x00181412
+	// DeviationIndex (0018,1413) DS
+	DeviationIndex uint32 = 0x00181413
+	// ColumnAngulation (0018,1450) DS
+	ColumnAngulation uint32 = 0x00181450
+	// TomoLayerHeight (0018,1460) DS
+	TomoLayerHeight uint32 = 0x00181460
+	// TomoAngle (0018,1470) DS
+	TomoAngle uint32 = 0x00181470
+	// TomoTime (0018,1480) DS
+	TomoTime uint32 = 0x00181480
+	// TomoType (0018,1490) CS
+	TomoType uint32 = 0x00181490
+	// TomoClass (0018,1491) CS
+	TomoClass uint32 = 0x00181491
+	// NumberOfTomosynthesisSourceImages (0018,1495) IS
+	NumberOfTomosynthesisSourceImages uint32 = 0x00181495
+	// PositionerMotion (0018,1500) CS
+	PositionerMotion uint32 = 0x00181500
+	// PositionerType (0018,1508) CS
+	PositionerType uint32 = 0x00181508
+	// PositionerPrimaryAngle (0018,1510) DS
+	PositionerPrimaryAngle uint32 = 0x00181510
+	// PositionerSecondaryAngle (0018,1511) DS
+	PositionerSecondaryAngle uint32 = 0x00181511
+	// PositionerPrimaryAngleIncrement (0018,1520) DS
+	PositionerPrimaryAngleIncrement uint32 = 0x00181520
+	// PositionerSecondaryAngleIncrement (0018,1521) DS
+	PositionerSecondaryAngleIncrement uint32 = 0x00181521
+	// DetectorPrimaryAngle (0018,1530) DS
+	DetectorPrimaryAngle uint32 = 0x00181530
+	// DetectorSecondaryAngle (0018,1531) DS
+	DetectorSecondaryAngle uint32 = 0x00181531
+	// ShutterShape (0018,1600) CS
+	ShutterShape uint32 = 0x00181600
+	// ShutterLeftVerticalEdge (0018,1602) IS
+	ShutterLeftVerticalEdge uint32 = 0x00181602
+	// ShutterRightVerticalEdge (0018,1604) IS
+	ShutterRightVerticalEdge uint32 = 0x00181604
+	// ShutterUpperHorizontalEdge (0018,1606) IS
+	ShutterUpperHorizontalEdge uint32 = 0x00181606
+	// ShutterLowerHorizontalEdge (0018,1608) IS
+	ShutterLowerHorizontalEdge uint32 = 0x00181608
+	// CenterOfCircularShutter (0018,1610) IS
+	CenterOfCircularShutter uint32 = 0x00181610
+	// RadiusOfCircularShutter (0018,1612) IS
+	RadiusOfCircularShutter uint32 = 0x00181612
+	// VerticesOfThePolygonalShutter (0018,1620) IS
+	VerticesOfThePolygonalShutter uint32 = 0x00181620
+	// ShutterPresentationValue (0018,1622) US
+	ShutterPresentationValue uint32 = 0x00181622
+	// ShutterOverlayGroup (0018,1623) US
+	ShutterOverlayGroup uint32 = 0x00181623
+	// ShutterPresentationColorCIELabValue (0018,1624) US
+	ShutterPresentationColorCIELabValue uint32 = 0x00181624
+	// CollimatorShape (0018,1700) CS
+	CollimatorShape uint32 = 0x00181700
+	// CollimatorLeftVerticalEdge (0018,1702) IS
+	CollimatorLeftVerticalEdge uint32 = 0x00181702
+	// CollimatorRightVerticalEdge (0018,1704) IS
+	CollimatorRightVerticalEdge uint32 = 0x00181704
+	// CollimatorUpperHorizontalEdge (0018,1706) IS
+	CollimatorUpperHorizontalEdge uint32 = 0x00181706
+	// CollimatorLowerHorizontalEdge (0018,1708) IS
+	CollimatorLowerHorizontalEdge uint32 = 0x00181708
+	// CenterOfCircularCollimator (0018,1710) IS
+	CenterOfCircularCollimator uint32 = 0x00181710
+	// RadiusOfCircularCollimator (0018,1712) IS
+	RadiusOfCircularCollimator uint32 = 0x00181712
+	// VerticesOfThePolygonalCollimator (0018,1720) IS
+	VerticesOfThePolygonalCollimator uint32 = 0x00181720
+	// AcquisitionTimeSynchronized (0018,1800) CS
+	AcquisitionTimeSynchronized uint32 = 0x00181800
+	// TimeSource (0018,1801) SH
+	TimeSource uint32 = 0x00181801
+	// TimeDistributionProtocol (0018,1802) CS
+	TimeDistributionProtocol uint32 = 0x00181802
+	// NTPSourceAddress (0018,1803) LO
+	NTPSourceAddress uint32 = 0x00181803
+	// PageNumberVector (0018,2001) IS
+	PageNumberVector uint32 = 0x00182001
+	// FrameLabelVector (0018,2002) SH
+	FrameLabelVector uint32 = 0x00182002
+	// FramePrimaryAngleVector (0018,2003) DS
+	FramePrimaryAngleVector uint32 = 0x00182003
+	// FrameSecondaryAngleVector (0018,2004) DS
+	FrameSecondaryAngleVector uint32 = 0x00182004
+	// SliceLocationVector (0018,2005) DS
+	SliceLocationVector uint32 = 0x00182005
+	// DisplayWindowLabelVector (0018,2006) SH
+	DisplayWindowLabelVector uint32 = 0x00182006
+	// NominalScannedPixelSpacing (0018,2010) DS
+	NominalScannedPixelSpacing uint32 = 0x00182010
+	// DigitizingDeviceTransportDirection (0018,2020) CS
+	DigitizingDeviceTransportDirection uint32 = 0x00182020
+	// RotationOfScannedFilm (0018,2030) DS
+	RotationOfScannedFilm uint32 = 0x00182030
+	// BiopsyTargetSequence (0018,2041) SQ
+	BiopsyTargetSequence uint32 = 0x00182041
+	// TargetUID (0018,2042) UI
+	TargetUID uint32 = 0x00182042
+	// LocalizingCursorPosition (0018,2043) FL
+	LocalizingCursorPosition uint32 = 0x00182043
+	// CalculatedTargetPosition (0018,2044) FL
+	CalculatedTargetPosition uint32 = 0x00182044
+	// TargetLabel (0018,2045) SH
+	TargetLabel uint32 = 0x00182045
+	// DisplayedZValue (0018,2046) FL
+	DisplayedZValue uint32 = 0x00182046
+	// IVUSAcquisition (0018,3100) CS
+	IVUSAcquisition uint32 = 0x00183100
+	// IVUSPullbackRate (0018,3101) DS
+	IVUSPullbackRate uint32 = 0x00183101
+	// IVUSGatedRate (0018,3102) DS
+	IVUSGatedRate uint32 = 0x00183102
+	// IVUSPullbackStartFrameNumber (0018,3103) IS
+	IVUSPullbackStartFrameNumber uint32 = 0x00183103
+	// IVUSPullbackStopFrameNumber (0018,3104) IS
+	IVUSPullbackStopFrameNumber uint32 = 0x00183104
+	// LesionNumber (0018,3105) IS
+	LesionNumber uint32 = 0x00183105
+	// AcquisitionComments (0018,4000) LT, retired
+	AcquisitionComments uint32 = 0x00184000
+	// OutputPower (0018,5000) SH
+	OutputPower uint32 = 0x00185000
+	// TransducerData (0018,5010) LO
+	TransducerData uint32 = 0x00185010
+	// FocusDepth (0018,5012) DS
+	FocusDepth uint32 = 0x00185012
+	// ProcessingFunction (0018,5020) LO
+	ProcessingFunction uint32 = 0x00185020
+	// PostprocessingFunction (0018,5021) LO, retired
+	PostprocessingFunction uint32 = 0x00185021
+	// MechanicalIndex (0018,5022) DS
+	MechanicalIndex uint32 = 0x00185022
+	// BoneThermalIndex (0018,5024) DS
+	BoneThermalIndex uint32 = 0x00185024
+	// CranialThermalIndex (0018,5026) DS
+	CranialThermalIndex uint32 = 0x00185026
+	// SoftTissueThermalIndex (0018,5027) DS
+	SoftTissueThermalIndex uint32 = 0x00185027
+	// SoftTissueFocusThermalIndex (0018,5028) DS
+	SoftTissueFocusThermalIndex uint32 = 0x00185028
+	// SoftTissueSurfaceThermalIndex (0018,5029) DS
+	SoftTissueSurfaceThermalIndex uint32 = 0x00185029
+	// DynamicRange (0018,5030) DS, retired
+	DynamicRange uint32 = 0x00185030
+	// TotalGain (0018,5040) DS, retired
+	TotalGain uint32 = 0x00185040
+	// DepthOfScanField (0018,5050) IS
+	DepthOfScanField uint32 = 0x00185050
+	// PatientPosition (0018,5100) CS
+	PatientPosition uint32 = 0x00185100
+	// ViewPosition (0018,5101) CS
+	ViewPosition uint32 = 0x00185101
+	// ProjectionEponymousNameCodeSequence (0018,5104) SQ
+	ProjectionEponymousNameCodeSequence uint32 = 0x00185104
+	// ImageTransformationMatrix (0018,5210) DS, retired
+	ImageTransformationMatrix uint32 = 0x00185210
+	// ImageTranslationVector (0018,5212) DS, retired
+	ImageTranslationVector uint32 = 0x00185212
+	// Sensitivity (0018,6000) DS
+	Sensitivity uint32 = 0x00186000
+	// SequenceOfUltrasoundRegions (0018,6011) SQ
+	SequenceOfUltrasoundRegions uint32 = 0x00186011
+	// RegionSpatialFormat (0018,6012) US
+	RegionSpatialFormat uint32 = 0x00186012
+	// RegionDataType (0018,6014) US
+	RegionDataType uint32 = 0x00186014
+	// RegionFlags (0018,6016) UL
+	RegionFlags uint32 = 0x00186016
+	// RegionLocationMinX0 (0018,6018) UL
+	RegionLocationMinX0 uint32 = 0x00186018
+	// RegionLocationMinY0 (0018,601A) UL
+	RegionLocationMinY0 uint32 = 0x0018601A
+	// RegionLocationMaxX1 (0018,601C) UL
+	RegionLocationMaxX1 uint32 = 0x0018601C
+	// RegionLocationMaxY1 (0018,601E) UL
+	RegionLocationMaxY1 uint32 = 0x0018601E
+	// ReferencePixelX0 (0018,6020) SL
+	ReferencePixelX0 uint32 = 0x00186020
+	// ReferencePixelY0 (0018,6022) SL
+	ReferencePixelY0 uint32 = 0x00186022
+	// PhysicalUnitsXDirection (0018,6024) US
+	PhysicalUnitsXDirection uint32 = 0x00186024
+	// PhysicalUnitsYDirection (0018,6026) US
+	PhysicalUnitsYDirection uint32 = 0x00186026
+	// ReferencePixelPhysicalValueX (0018,6028) FD
+	ReferencePixelPhysicalValueX uint32 = 0x00186028
+	// ReferencePixelPhysicalValueY (0018,602A) FD
+	ReferencePixelPhysicalValueY uint32 = 0x0018602A
+	// PhysicalDeltaX (0018,602C) FD
+	PhysicalDeltaX uint32 = 0x0018602C
+	// PhysicalDeltaY (0018,602E) FD
+	PhysicalDeltaY uint32 = 0x0018602E
+	// TransducerFrequency (0018,6030) UL
+	TransducerFrequency uint32 = 0x00186030
+	// TransducerType (0018,6031) CS
+	TransducerType uint32 = 0x00186031
+	// PulseRepetitionFrequency (0018,6032) UL
+	PulseRepetitionFrequency uint32 = 0x00186032
+	// DopplerCorrectionAngle (0018,6034) FD
+	DopplerCorrectionAngle uint32 = 0x00186034
+	// SteeringAngle (0018,6036) FD
+	SteeringAngle uint32 = 0x00186036
+	// DopplerSampleVolumeXPositionRetired (0018,6038) UL, retired
+	DopplerSampleVolumeXPositionRetired uint32 = 0x00186038
+	// DopplerSampleVolumeXPosition (0018,6039) SL
+	DopplerSampleVolumeXPosition uint32 = 0x00186039
+	// DopplerSampleVolumeYPositionRetired (0018,603A) UL, retired
+	DopplerSampleVolumeYPositionRetired uint32 = 0x0018603A
+	// DopplerSampleVolumeYPosition (0018,603B) SL
+	DopplerSampleVolumeYPosition uint32 = 0x0018603B
+	// TMLinePositionX0Retired (0018,603C) UL, retired
+	TMLinePositionX0Retired uint32 = 0x0018603C
+	// TMLinePositionX0 (0018,603D) SL
+	TMLinePositionX0 uint32 = 0x0018603D
+	// TMLinePositionY0Retired (0018,603E) UL, retired
+	TMLinePositionY0Retired uint32 = 0x0018603E
+	// TMLinePositionY0 (0018,603F) SL
+	TMLinePositionY0 uint32 = 0x0018603F
+	// TMLinePositionX1Retired (0018,6040) UL, retired
+	TMLinePositionX1Retired uint32 = 0x00186040
+	// TMLinePositionX1 (0018,6041) SL
+	TMLinePositionX1 uint32 = 0x00186041
+	// TMLinePositionY1Retired (0018,6042) UL, retired
+	TMLinePositionY1Retired uint32 = 0x00186042
+	// TMLinePositionY1 (0018,6043) SL
+	TMLinePositionY1 uint32 = 0x00186043
+	// PixelComponentOrganization (0018,6044) US
+	PixelComponentOrganization uint32 = 0x00186044
+	// PixelComponentMask (0018,6046) UL
+	PixelComponentMask uint32 = 0x00186046
+	// PixelComponentRangeStart (0018,6048) UL
+	PixelComponentRangeStart uint32 = 0x00186048
+	// PixelComponentRangeStop (0018,604A) UL
+	PixelComponentRangeStop uint32 = 0x0018604A
+	// PixelComponentPhysicalUnits (0018,604C) US
+	PixelComponentPhysicalUnits uint32 = 0x0018604C
+	// PixelComponentDataType (0018,604E) US
+	PixelComponentDataType uint32 = 0x0018604E
+	// NumberOfTableBreakPoints (0018,6050) UL
+	NumberOfTableBreakPoints uint32 = 0x00186050
+	// TableOfXBreakPoints (0018,6052) UL
+	TableOfXBreakPoints uint32 = 0x00186052
+	// TableOfYBreakPoints (0018,6054) FD
+	TableOfYBreakPoints uint32 = 0x00186054
+	// NumberOfTableEntries (0018,6056) UL
+	NumberOfTableEntries uint32 = 0x00186056
+	// TableOfPixelValues (0018,6058) UL
+	TableOfPixelValues uint32 = 0x00186058
+	// TableOfParameterValues (0018,605A) FL
+	TableOfParameterValues uint32 = 0x0018605A
+	// RWaveTimeVector (0018,6060) FL
+	RWaveTimeVector uint32 = 0x00186060
+	// DetectorConditionsNominalFlag (0018,7000) CS
+	DetectorConditionsNominalFlag uint32 = 0x00187000
+	// DetectorTemperature (0018,7001) DS
+	DetectorTemperature uint32 = 0x00187001
+	// DetectorType (0018,7004) CS
+	DetectorType uint32 = 0x00187004
+	// DetectorConfiguration (0018,7005) CS
+	DetectorConfiguration uint32 = 0x00187005
+	// DetectorDescription (0018,7006) LT
+	DetectorDescription uint32 = 0x00187006
+	// DetectorMode (0018,7008) LT
+	DetectorMode uint32 = 0x00187008
+	// DetectorID (0018,700A) SH
+	DetectorID uint32 = 0x0018700A
+	// DateOfLastDetectorCalibration (0018,700C) DA
+	DateOfLastDetectorCalibration uint32 = 0x0018700C
+	// TimeOfLastDetectorCalibration (0018,700E) TM
+	TimeOfLastDetectorCalibration uint32 = 0x0018700E
+	// ExposuresOnDetectorSinceLastCalibration (0018,7010) IS
+	ExposuresOnDetectorSinceLastCalibration uint32 = 0x00187010
+	// ExposuresOnDetectorSinceManufactured (0018,7011) IS
+	ExposuresOnDetectorSinceManufactured uint32 = 0x00187011
+	// DetectorTimeSinceLastExposure (0018,7012) DS
+	DetectorTimeSinceLastExposure uint32 = 0x00187012
+	// DetectorActiveTime (0018,7014) DS
+	DetectorActiveTime uint32 = 0x00187014
+	// DetectorActivationOffsetFromExposure (0018,7016) DS
+	DetectorActivationOffsetFromExposure uint32 = 0x00187016
+	// DetectorBinning (0018,701A) DS
+	DetectorBinning uint32 = 0x0018701A
+	// DetectorElementPhysicalSize (0018,7020) DS
+	DetectorElementPhysicalSize uint32 = 0x00187020
+	// DetectorElementSpacing (0018,7022) DS
+	DetectorElementSpacing uint32 = 0x00187022
+	// DetectorActiveShape (0018,7024) CS
+	DetectorActiveShape uint32 = 0x00187024
+	// DetectorActiveDimensions (0018,7026) DS
+	DetectorActiveDimensions uint32 = 0x00187026
+	// DetectorActiveOrigin (0018,7028) DS
+	DetectorActiveOrigin uint32 = 0x00187028
+	// DetectorManufacturerName (0018,702A) LO
+	DetectorManufacturerName uint32 = 0x0018702A
+	// DetectorManufacturerModelName (0018,702B) LO
+	DetectorManufacturerModelName uint32 = 0x0018702B
+	// FieldOfViewOrigin (0018,7030) DS
+	FieldOfViewOrigin uint32 = 0x00187030
+	// FieldOfViewRotation (0018,7032) DS
+	FieldOfViewRotation uint32 = 0x00187032
+	// FieldOfViewHorizontalFlip (0018,7034) CS
+	FieldOfViewHorizontalFlip uint32 = 0x00187034
+	// PixelDataAreaOriginRelativeToFOV (0018,7036) FL
+	PixelDataAreaOriginRelativeToFOV uint32 = 0x00187036
+	// PixelDataAreaRotationAngleRelativeToFOV (0018,7038) FL
+	PixelDataAreaRotationAngleRelativeToFOV uint32 = 0x00187038
+	// GridAbsorbingMaterial (0018,7040) LT
+	GridAbsorbingMaterial uint32 = 0x00187040
+	// GridSpacingMaterial (0018,7041) LT
+	GridSpacingMaterial uint32 = 0x00187041
+	// GridThickness (0018,7042) DS
+	GridThickness uint32 = 0x00187042
+	// GridPitch (0018,7044) DS
+	GridPitch uint32 = 0x00187044
+	// GridAspectRatio (0018,7046) IS
+	GridAspectRatio uint32 = 0x00187046
+	// GridPeriod (0018,7048) DS
+	GridPeriod uint32 = 0x00187048
+	// GridFocalDistance (0018,704C) DS
+	GridFocalDistance uint32 = 0x0018704C
+	// FilterMaterial (0018,7050) CS
+	FilterMaterial uint32 = 0x00187050
+	// FilterThicknessMinimum (0018,7052) DS
+	FilterThicknessMinimum uint32 = 0x00187052
+	// FilterThicknessMaximum (0018,7054) DS
+	FilterThicknessMaximum uint32 = 0x00187054
+	// FilterBeamPathLengthMinimum (0018,7056) FL
+	FilterBeamPathLengthMinimum uint32 = 0x00187056
+	// FilterBeamPathLengthMaximum (0018,7058) FL
+	FilterBeamPathLengthMaximum uint32 = 0x00187058
+	// ExposureControlMode (0018,7060) CS
+	ExposureControlMode uint32 = 0x00187060
+	// ExposureControlModeDescription (0018,7062) LT
+	ExposureControlModeDescription uint32 = 0x00187062
+	// ExposureStatus (0018,7064) CS
+	ExposureStatus uint32 = 0x00187064
+	// PhototimerSetting (0018,7065) DS
+	PhototimerSetting uint32 = 0x00187065
+	// ExposureTimeInuS (0018,8150) DS
+	ExposureTimeInuS uint32 = 0x00188150
+	// XRayTubeCurrentInuA (0018,8151) DS
+	XRayTubeCurrentInuA uint32 = 0x00188151
+	// ContentQualification (0018,9004) CS
+	ContentQualification uint32 = 0x00189004
+	// PulseSequenceName (0018,9005) SH
+	PulseSequenceName uint32 = 0x00189005
+	// MRImagingModifierSequence (0018,9006) SQ
+	MRImagingModifierSequence uint32 = 0x00189006
+	// EchoPulseSequence (0018,9008) CS
+	EchoPulseSequence uint32 = 0x00189008
+	// InversionRecovery (0018,9009) CS
+	InversionRecovery uint32 = 0x00189009
+	// FlowCompensation (0018,9010) CS
+	FlowCompensation uint32 = 0x00189010
+	// MultipleSpinEcho (0018,9011) CS
+	MultipleSpinEcho uint32 = 0x00189011
+	// MultiPlanarExcitation (0018,9012) CS
+	MultiPlanarExcitation uint32 = 0x00189012
+	// PhaseContrast (0018,9014) CS
+	PhaseContrast uint32 = 0x00189014
+	// TimeOfFlightContrast (0018,9015) CS
+	TimeOfFlightContrast uint32 = 0x00189015
+	// Spoiling (0018,9016) CS
+	Spoiling uint32 = 0x00189016
+	// SteadyStatePulseSequence (0018,9017) CS
+	SteadyStatePulseSequence uint32 = 0x00189017
+	// EchoPlanarPulseSequence (0018,9018) CS
+	EchoPlanarPulseSequence uint32 = 0x00189018
+	// TagAngleFirstAxis (0018,9019) FD
+	TagAngleFirstAxis uint32 = 0x00189019
+	// MagnetizationTransfer (0018,9020) CS
+	MagnetizationTransfer uint32 = 0x00189020
+	// T2Preparation (0018,9021) CS
+	T2Preparation uint32 = 0x00189021
+	// BloodSignalNulling (0018,9022) CS
+	BloodSignalNulling uint32 = 0x00189022
+	// SaturationRecovery (0018,9024) CS
+	SaturationRecovery uint32 = 0x00189024
+	// SpectrallySelectedSuppression (0018,9025) CS
+	SpectrallySelectedSuppression uint32 = 0x00189025
+	// SpectrallySelectedExcitation (0018,9026) CS
+	SpectrallySelectedExcitation uint32 = 0x00189026
+	// SpatialPresaturation (0018,9027) CS
+	SpatialPresaturation uint32 = 0x00189027
+	// Tagging (0018,9028) CS
+	Tagging uint32 = 0x00189028
+	// OversamplingPhase (0018,9029) CS
+	OversamplingPhase uint32 = 0x00189029
+	// TagSpacingFirstDimension (0018,9030) FD
+	TagSpacingFirstDimension uint32 = 0x00189030
+	// GeometryOfKSpaceTraversal (0018,9032) CS
+	GeometryOfKSpaceTraversal uint32 = 0x00189032
+	// SegmentedKSpaceTraversal (0018,9033) CS
+	SegmentedKSpaceTraversal uint32 = 0x00189033
+	// RectilinearPhaseEncodeReordering (0018,9034) CS
+	RectilinearPhaseEncodeReordering uint32 = 0x00189034
+	// TagThickness (0018,9035) FD
+	TagThickness uint32 = 0x00189035
+	// PartialFourierDirection (0018,9036) CS
+	PartialFourierDirection uint32 = 0x00189036
+	// CardiacSynchronizationTechnique (0018,9037) CS
+	CardiacSynchronizationTechnique uint32 = 0x00189037
+	// ReceiveCoilManufacturerName (0018,9041) LO
+	ReceiveCoilManufacturerName uint32 = 0x00189041
+	// MRReceiveCoilSequence (0018,9042) SQ
+	MRReceiveCoilSequence uint32 = 0x00189042
+	// ReceiveCoilType (0018,9043) CS
+	ReceiveCoilType uint32 = 0x00189043
+	// QuadratureReceiveCoil (0018,9044) CS
+	QuadratureReceiveCoil uint32 = 0x00189044
+	// MultiCoilDefinitionSequence (0018,9045) SQ
+	MultiCoilDefinitionSequence uint32 = 0x00189045
+	// MultiCoilConfiguration (0018,9046) LO
+	MultiCoilConfiguration uint32 = 0x00189046
+	// MultiCoilElementName (0018,9047) SH
+	MultiCoilElementName uint32 = 0x00189047
+	// MultiCoilElementUsed (0018,9048) CS
+	MultiCoilElementUsed uint32 = 0x00189048
+	// MRTransmitCoilSequence (0018,9049) SQ
+	MRTransmitCoilSequence uint32 = 0x00189049
+	// TransmitCoilManufacturerName (0018,9050) LO
+	TransmitCoilManufacturerName uint32 = 0x00189050
+	// TransmitCoilType (0018,9051) CS
+	TransmitCoilType uint32 = 0x00189051
+	// SpectralWidth (0018,9052) FD
+	SpectralWidth uint32 = 0x00189052
+	// ChemicalShiftReference (0018,9053) FD
+	ChemicalShiftReference uint32 = 0x00189053
+	// VolumeLocalizationTechnique (0018,9054) CS
+	VolumeLocalizationTechnique uint32 = 0x00189054
+	// MRAcquisitionFrequencyEncodingSteps (0018,9058) US
+	MRAcquisitionFrequencyEncodingSteps uint32 = 0x00189058
+	// Decoupling (0018,9059) CS
+	Decoupling uint32 = 0x00189059
+	// DecoupledNucleus (0018,9060) CS
+	DecoupledNucleus uint32 = 0x00189060
+	// DecouplingFrequency (0018,9061) FD
+	DecouplingFrequency uint32 = 0x00189061
+	// DecouplingMethod (0018,9062) CS
+	DecouplingMethod uint32 = 0x00189062
+	// DecouplingChemicalShiftReference (0018,9063) FD
+	DecouplingChemicalShiftReference uint32 = 0x00189063
+	// KSpaceFiltering (0018,9064) CS
+	KSpaceFiltering uint32 = 0x00189064
+	// TimeDomainFiltering (0018,9065) CS
+	TimeDomainFiltering uint32 = 0x00189065
+	// NumberOfZeroFills (0018,9066) US
+	NumberOfZeroFills uint32 = 0x00189066
+	// BaselineCorrection (0018,9067) CS
+	BaselineCorrection uint32 = 0x00189067
+	// ParallelReductionFactorInPlane (0018,9069) FD
+	ParallelReductionFactorInPlane uint32 = 0x00189069
+	// CardiacRRIntervalSpecified (0018,9070) FD
+	CardiacRRIntervalSpecified uint32 = 0x00189070
+	// AcquisitionDuration (0018,9073) FD
+	AcquisitionDuration uint32 = 0x00189073
+	// FrameAcquisitionDateTime (0018,9074) DT
+	FrameAcquisitionDateTime uint32 = 0x00189074
+	// DiffusionDirectionality (0018,9075) CS
+	DiffusionDirectionality uint32 = 0x00189075
+	// DiffusionGradientDirectionSequence (0018,9076) SQ
+	DiffusionGradientDirectionSequence uint32 = 0x00189076
+	// ParallelAcquisition (0018,9077) CS
+	ParallelAcquisition uint32 = 0x00189077
+	// ParallelAcquisitionTechnique (0018,9078) CS
+	ParallelAcquisitionTechnique uint32 = 0x00189078
+	// InversionTimes (0018,9079) FD
+	InversionTimes uint32 = 0x00189079
+	// MetaboliteMapDescription (0018,9080) ST
+	MetaboliteMapDescription uint32 = 0x00189080
+	// PartialFourier (0018,9081) CS
+	PartialFourier uint32 = 0x00189081
+	// EffectiveEchoTime (0018,9082) FD
+	EffectiveEchoTime uint32 = 0x00189082
+	// MetaboliteMapCodeSequence (0018,9083) SQ
+	MetaboliteMapCodeSequence uint32 = 0x00189083
+	// ChemicalShiftSequence (0018,9084) SQ
+	ChemicalShiftSequence uint32 = 0x00189084
+	// CardiacSignalSource (0018,9085) CS
+	CardiacSignalSource uint32 = 0x00189085
+	// DiffusionBValue (0018,9087) FD
+	DiffusionBValue uint32 = 0x00189087
+	// DiffusionGradientOrientation (0018,9089) FD
+	DiffusionGradientOrientation uint32 = 0x00189089
+	// VelocityEncodingDirection (0018,9090) FD
+	VelocityEncodingDirection uint32 = 0x00189090
+	// VelocityEncodingMinimumValue (0018,9091) FD
+	VelocityEncodingMinimumValue uint32 = 0x00189091
+	// VelocityEncodingAcquisitionSequence (0018,9092) SQ
+	VelocityEncodingAcquisitionSequence uint32 = 0x00189092
+	// NumberOfKSpaceTrajectories (0018,9093) US
+	NumberOfKSpaceTrajectories uint32 = 0x00189093
+	// CoverageOfKSpace (0018,9094) CS
+	CoverageOfKSpace uint32 = 0x00189094
+	// SpectroscopyAcquisitionPhaseRows (0018,9095) UL
+	SpectroscopyAcquisitionPhaseRows uint32 = 0x00189095
+	// ParallelReductionFactorInPlaneRetired (0018,9096) FD, retired
+	ParallelReductionFactorInPlaneRetired uint32 = 0x00189096
+	// TransmitterFrequency (0018,9098) FD
+	TransmitterFrequency uint32 = 0x00189098
+	// ResonantNucleus (0018,9100) CS
+	ResonantNucleus uint32 = 0x00189100
+	// FrequencyCorrection (0018,9101) CS
+	FrequencyCorrection uint32 = 0x00189101
+	// MRSpectroscopyFOVGeometrySequence (0018,9103) SQ
+	MRSpectroscopyFOVGeometrySequence uint32 = 0x00189103
+	// SlabThickness (0018,9104) FD
+	SlabThickness uint32 = 0x00189104
+	// SlabOrientation (0018,9105) FD
+	SlabOrientation uint32 = 0x00189105
+	// MidSlabPosition (0018,9106) FD
+	MidSlabPosition uint32 = 0x00189106
+	// MRSpatialSaturationSequence (0018,9107) SQ
+	MRSpatialSaturationSequence uint32 = 0x00189107
+	// MRTimingAndRelatedParametersSequence (0018,9112) SQ
+	MRTimingAndRelatedParametersSequence uint32 = 0x00189112
+	// MREchoSequence (0018,9114) SQ
+	MREchoSequence uint32 = 0x00189114
+	// MRModifierSequence (0018,9115) SQ
+	MRModifierSequence uint32 = 0x00189115
+	// MRDiffusionSequence (0018,9117) SQ
+	MRDiffusionSequence uint32 = 0x00189117
+	// CardiacSynchronizationSequence (0018,9118) SQ
+	CardiacSynchronizationSequence uint32 = 0x00189118
+	// MRAveragesSequence (0018,9119) SQ
+	MRAveragesSequence uint32 = 0x00189119
+	// MRFOVGeometrySequence (0018,9125) SQ
+	MRFOVGeometrySequence uint32 = 0x00189125
+	// VolumeLocalizationSequence (0018,9126) SQ
+	VolumeLocalizationSequence uint32 = 0x00189126
+	// SpectroscopyAcquisitionDataColumns (0018,9127) UL
+	SpectroscopyAcquisitionDataColumns uint32 = 0x00189127
+	// DiffusionAnisotropyType (0018,9147) CS
+	DiffusionAnisotropyType uint32 = 0x00189147
+	// FrameReferenceDateTime (0018,9151) DT
+	FrameReferenceDateTime uint32 = 0x00189151
+	// MRMetaboliteMapSequence (0018,9152) SQ
+	MRMetaboliteMapSequence uint32 = 0x00189152
+	// ParallelReductionFactorOutOfPlane (0018,9155) FD
+	ParallelReductionFactorOutOfPlane uint32 = 0x00189155
+	// SpectroscopyAcquisitionOutOfPlanePhaseSteps (0018,9159) UL
+	SpectroscopyAcquisitionOutOfPlanePhaseSteps uint32 = 0x00189159
+	// BulkMotionStatus (0018,9166) CS, retired
+	BulkMotionStatus uint32 = 0x00189166
+	// ParallelReductionFactorSecondInPlane (0018,9168) FD
+	ParallelReductionFactorSecondInPlane uint32 = 0x00189168
+	// CardiacBeatRejectionTechnique (0018,9169) CS
+	CardiacBeatRejectionTechnique uint32 = 0x00189169
+	// RespiratoryMotionCompensationTechnique (0018,9170) CS
+	RespiratoryMotionCompensationTechnique uint32 = 0x00189170
+	// RespiratorySignalSource (0018,9171) CS
+	RespiratorySignalSource uint32 = 0x00189171
+	// BulkMotionCompensationTechnique (0018,9172) CS
+	BulkMotionCompensationTechnique uint32 = 0x00189172
+	// BulkMotionSignalSource (0018,9173) CS
+	BulkMotionSignalSource uint32 = 0x00189173
+	// ApplicableSafetyStandardAgency (0018,9174) CS
+	ApplicableSafetyStandardAgency uint32 = 0x00189174
+	// ApplicableSafetyStandardDescription (0018,9175) LO
+	ApplicableSafetyStandardDescription uint32 = 0x00189175
+	// OperatingModeSequence (0018,9176) SQ
+	OperatingModeSequence uint32 = 0x00189176
+	// OperatingModeType (0018,9177) CS
+	OperatingModeType uint32 = 0x00189177
+	// OperatingMode (0018,9178) CS
+	OperatingMode uint32 = 0x00189178
+	// SpecificAbsorptionRateDefinition (0018,9179) CS
+	SpecificAbsorptionRateDefinition uint32 = 0x00189179
+	// GradientOutputType (0018,9180) CS
+	GradientOutputType uint32 = 0x00189180
+	// SpecificAbsorptionRateValue (0018,9181) FD
+	SpecificAbsorptionRateValue uint32 = 0x00189181
+	// GradientOutput (0018,9182) FD
+	GradientOutput uint32 = 0x00189182
+	// FlowCompensationDirection (0018,9183) CS
+	FlowCompensationDirection uint32 = 0x00189183
+	// TaggingDelay (0018,9184) FD
+	TaggingDelay uint32 = 0x00189184
+	// RespiratoryMotionCompensationTechniqueDescription (0018,9185) ST
+	RespiratoryMotionCompensationTechniqueDescription uint32 = 0x00189185
+	// RespiratorySignalSourceID (0018,9186) SH
+	RespiratorySignalSourceID uint32 = 0x00189186
+	// ChemicalShiftMinimumIntegrationLimitInHz (0018,9195) FD, retired
+	ChemicalShiftMinimumIntegrationLimitInHz uint32 = 0x00189195
+	// ChemicalShiftMaximumIntegrationLimitInHz (0018,9196) FD, retired
+	ChemicalShiftMaximumIntegrationLimitInHz uint32 = 0x00189196
+	// MRVelocityEncodingSequence (0018,9197) SQ
+	MRVelocityEncodingSequence uint32 = 0x00189197
+	// FirstOrderPhaseCorrection (0018,9198) CS
+	FirstOrderPhaseCorrection uint32 = 0x00189198
+	// WaterReferencedPhaseCorrection (0018,9199) CS
+	WaterReferencedPhaseCorrection uint32 = 0x00189199
+	// MRSpectroscopyAcquisitionType (0018,9200) CS
+	MRSpectroscopyAcquisitionType uint32 = 0x00189200
+	// RespiratoryCyclePosition (0018,9214) CS
+	RespiratoryCyclePosition uint32 = 0x00189214
+	// VelocityEncodingMaximumValue (0018,9217) FD
+	VelocityEncodingMaximumValue uint32 = 0x00189217
+	// TagSpacingSecondDimension (0018,9218) FD
+	TagSpacingSecondDimension uint32 = 0x00189218
+	// TagAngleSecondAxis (0018,9219) SS
+	TagAngleSecondAxis uint32 = 0x00189219
+	// FrameAcquisitionDuration (0018,9220) FD
+	FrameAcquisitionDuration uint32 = 0x00189220
+	// MRImageFrameTypeSequence (0018,9226) SQ
+	MRImageFrameTypeSequence uint32 = 0x00189226
+	// MRSpectroscopyFrameTypeSequence (0018,9227) SQ
+	MRSpectroscopyFrameTypeSequence uint32 = 0x00189227
+	// MRAcquisitionPhaseEncodingStepsInPlane (0018,9231) US
+	MRAcquisitionPhaseEncodingStepsInPlane uint32 = 0x00189231
+	// MRAcquisitionPhaseEncodingStepsOutOfPlane (0018,9232) US
+	MRAcquisitionPhaseEncodingStepsOutOfPlane uint32 = 0x00189232
+	// SpectroscopyAcquisitionPhaseColumns (0018,9234) UL
+	SpectroscopyAcquisitionPhaseColumns uint32 = 0x00189234
+	// CardiacCyclePosition (0018,9236) CS
+	CardiacCyclePosition uint32 = 0x00189236
+	// SpecificAbsorptionRateSequence (0018,9239) SQ
+	SpecificAbsorptionRateSequence uint32 = 0x00189239
+	// RFEchoTrainLength (0018,9240) US
+	RFEchoTrainLength uint32 = 0x00189240
+	// GradientEchoTrainLength (0018,9241) US
+	GradientEchoTrainLength uint32 = 0x00189241
+	// ArterialSpinLabelingContrast (0018,9250) CS
+	ArterialSpinLabelingContrast uint32 = 0x00189250
+	// MRArterialSpinLabelingSequence (0018,9251) SQ
+	MRArterialSpinLabelingSequence uint32 = 0x00189251
+	// ASLTechniqueDescription (0018,9252) LO
+	ASLTechniqueDescription uint32 = 0x00189252
+	// ASLSlabNumber (0018,9253) US
+	ASLSlabNumber uint32 = 0x00189253
+	// ASLSlabThickness (0018,9254) FD
+	ASLSlabThickness uint32 = 0x00189254
+	// ASLSlabOrientation (0018,9255) FD
+	ASLSlabOrientation uint32 = 0x00189255
+	// ASLMidSlabPosition (0018,9256) FD
+	ASLMidSlabPosition uint32 = 0x00189256
+	// ASLContext (0018,9257) CS
+	ASLContext uint32 = 0x00189257
+	// ASLPulseTrainDuration (0018,9258) UL
+	ASLPulseTrainDuration uint32 = 0x00189258
+	// ASLCrusherFlag (0018,9259) CS
+	ASLCrusherFlag uint32 = 0x00189259
+	// ASLCrusherFlowLimit (0018,925A) FD
+	ASLCrusherFlowLimit uint32 = 0x0018925A
+	// ASLCrusherDescription (0018,925B) LO
+	ASLCrusherDescription uint32 = 0x0018925B
+	// ASLBolusCutoffFlag (0018,925C) CS
+	ASLBolusCutoffFlag uint32 = 0x0018925C
+	// ASLBolusCutoffTimingSequence (0018,925D) SQ
+	ASLBolusCutoffTimingSequence uint32 = 0x0018925D
+	// ASLBolusCutoffTechnique (0018,925E) LO
+	ASLBolusCutoffTechnique uint32 = 0x0018925E
+	// ASLBolusCutoffDelayTime (0018,925F) UL
+	ASLBolusCutoffDelayTime uint32 = 0x0018925F
+	// ASLSlabSequence (0018,9260) SQ
+	ASLSlabSequence uint32 = 0x00189260
+	// ChemicalShiftMinimumIntegrationLimitInppm (0018,9295) FD
+	ChemicalShiftMinimumIntegrationLimitInppm uint32 = 0x00189295
+	// ChemicalShiftMaximumIntegrationLimitInppm (0018,9296) FD
+	ChemicalShiftMaximumIntegrationLimitInppm uint32 = 0x00189296
+	// WaterReferenceAcquisition (0018,9297) CS
+	WaterReferenceAcquisition uint32 = 0x00189297
+	// EchoPeakPosition (0018,9298) IS
+	EchoPeakPosition uint32 = 0x00189298
+	// CTAcquisitionTypeSequence (0018,9301) SQ
+	CTAcquisitionTypeSequence uint32 = 0x00189301
+	// AcquisitionType (0018,9302) CS
+	AcquisitionType uint32 = 0x00189302
+	// TubeAngle (0018,9303) FD
+	TubeAngle uint32 = 0x00189303
+	// CTAcquisitionDetailsSequence (0018,9304) SQ
+	CTAcquisitionDetailsSequence uint32 = 0x00189304
+	// RevolutionTime (0018,9305) FD
+	RevolutionTime uint32 = 0x00189305
+	// SingleCollimationWidth (0018,9306) FD
+	SingleCollimationWidth uint32 = 0x00189306
+	// TotalCollimationWidth (0018,9307) FD
+	TotalCollimationWidth uint32 = 0x00189307
+	// CTTableDynamicsSequence (0018,9308) SQ
+	CTTableDynamicsSequence uint32 = 0x00189308
+	// TableSpeed (0018,9309) FD
+	TableSpeed uint32 = 0x00189309
+	// TableFeedPerRotation (0018,9310) FD
+	TableFeedPerRotation uint32 = 0x00189310
+	// SpiralPitchFactor (0018,9311) FD
+	SpiralPitchFactor uint32 = 0x00189311
+	// CTGeometrySequence (0018,9312) SQ
+	CTGeometrySequence uint32 = 0x00189312
+	// DataCollectionCenterPatient (0018,9313) FD
+	DataCollectionCenterPatient uint32 = 0x00189313
+	// CTReconstructionSequence (0018,9314) SQ
+	CTReconstructionSequence uint32 = 0x00189314
+	// ReconstructionAlgorithm (0018,9315) CS
+	ReconstructionAlgorithm uint32 = 0x00189315
+	// ConvolutionKernelGroup (0018,9316) CS
+	ConvolutionKernelGroup uint32 = 0x00189316
+	// ReconstructionFieldOfView (0018,9317) FD
+	ReconstructionFieldOfView uint32 = 0x00189317
+	// ReconstructionTargetCenterPatient (0018,9318) FD
+	ReconstructionTargetCenterPatient uint32 = 0x00189318
+	// ReconstructionAngle (0018,9319) FD
+	ReconstructionAngle uint32 = 0x00189319
+	// ImageFilter (0018,9320) SH
+	ImageFilter uint32 = 0x00189320
+	// CTExposureSequence (0018,9321) SQ
+	CTExposureSequence uint32 = 0x00189321
+	// ReconstructionPixelSpacing (0018,9322) FD
+	ReconstructionPixelSpacing uint32 = 0x00189322
+	// ExposureModulationType (0018,9323) CS
+	ExposureModulationType uint32 = 0x00189323
+	// EstimatedDoseSaving (0018,9324) FD
+	EstimatedDoseSaving uint32 = 0x00189324
+	// CTXRayDetailsSequence (0018,9325) SQ
+	CTXRayDetailsSequence uint32 = 0x00189325
+	// CTPositionSequence (0018,9326) SQ
+	CTPositionSequence uint32 = 0x00189326
+	// TablePosition (0018,9327) FD
+	TablePosition uint32 = 0x00189327
+	// ExposureTimeInms (0018,9328) FD
+	ExposureTimeInms uint32 = 0x00189328
+	// CTImageFrameTypeSequence (0018,9329) SQ
+	CTImageFrameTypeSequence uint32 = 0x00189329
+	// XRayTubeCurrentInmA (0018,9330) FD
+	XRayTubeCurrentInmA uint32 = 0x00189330
+	// ExposureInmAs (0018,9332) FD
+	ExposureInmAs uint32 = 0x00189332
+	// ConstantVolumeFlag (0018,9333) CS
+	ConstantVolumeFlag uint32 = 0x00189333
+	// FluoroscopyFlag (0018,9334) CS
+	FluoroscopyFlag uint32 = 0x00189334
+	// DistanceSourceToDataCollectionCenter (0018,9335) FD
+	DistanceSourceToDataCollectionCenter uint32 = 0x00189335
+	// ContrastBolusAgentNumber (0018,9337) US
+	ContrastBolusAgentNumber uint32 = 0x00189337
+	// ContrastBolusIngredientCodeSequence (0018,9338) SQ
+	ContrastBolusIngredientCodeSequence uint32 = 0x00189338
+	// ContrastAdministrationProfileSequence (0018,9340) SQ
+	ContrastAdministrationProfileSequence uint32 = 0x00189340
+	// ContrastBolusUsageSequence (0018,9341) SQ
+	ContrastBolusUsageSequence uint32 = 0x00189341
+	// ContrastBolusAgentAdministered (0018,9342) CS
+	ContrastBolusAgentAdministered uint32 = 0x00189342
+	// ContrastBolusAgentDetected (0018,9343) CS
+	ContrastBolusAgentDetected uint32 = 0x00189343
+	// ContrastBolusAgentPhase (0018,9344) CS
+	ContrastBolusAgentPhase uint32 = 0x00189344
+	// CTDIvol (0018,9345) FD
+	CTDIvol uint32 = 0x00189345
+	// CTDIPhantomTypeCodeSequence (0018,9346) SQ
+	CTDIPhantomTypeCodeSequence uint32 = 0x00189346
+	// CalciumScoringMassFactorPatient (0018,9351) FL
+	CalciumScoringMassFactorPatient uint32 = 0x00189351
+	// CalciumScoringMassFactorDevice (0018,9352) FL
+	CalciumScoringMassFactorDevice uint32 = 0x00189352
+	// EnergyWeightingFactor (0018,9353) FL
+	EnergyWeightingFactor uint32 = 0x00189353
+	// CTAdditionalXRaySourceSequence (0018,9360) SQ
+	CTAdditionalXRaySourceSequence uint32 = 0x00189360
+	// ProjectionPixelCalibrationSequence (0018,9401) SQ
+	ProjectionPixelCalibrationSequence uint32 = 0x00189401
+	// DistanceSourceToIsocenter (0018,9402) FL
+	DistanceSourceToIsocenter uint32 = 0x00189402
+	// DistanceObjectToTableTop (0018,9403) FL
+	DistanceObjectToTableTop uint32 = 0x00189403
+	// ObjectPixelSpacingInCenterOfBeam (0018,9404) FL
+	ObjectPixelSpacingInCenterOfBeam uint32 = 0x00189404
+	// PositionerPositionSequence (0018,9405) SQ
+	PositionerPositionSequence uint32 = 0x00189405
+	// TablePositionSequence (0018,9406) SQ
+	TablePositionSequence uint32 = 0x00189406
+	// CollimatorShapeSequence (0018,9407) SQ
+	CollimatorShapeSequence uint32 = 0x00189407
+	// PlanesInAcquisition (0018,9410) CS
+	PlanesInAcquisition uint32 = 0x00189410
+	// XAXRFFrameCharacteristicsSequence (0018,9412) SQ
+	XAXRFFrameCharacteristicsSequence uint32 = 0x00189412
+	// FrameAcquisitionSequence (0018,9417) SQ
+	FrameAcquisitionSequence uint32 = 0x00189417
+	// XRayReceptorType (0018,9420) CS
+	XRayReceptorType uint32 = 0x00189420
+	// AcquisitionProtocolName (0018,9423) LO
+	AcquisitionProtocolName uint32 = 0x00189423
+	// AcquisitionProtocolDescription (0018,9424) LT
+	AcquisitionProtocolDescription uint32 = 0x00189424
+	// ContrastBolusIngredientOpaque (0018,9425) CS
+	ContrastBolusIngredientOpaque uint32 = 0x00189425
+	// DistanceReceptorPlaneToDetectorHousing (0018,9426) FL
+	DistanceReceptorPlaneToDetectorHousing uint32 = 0x00189426
+	// IntensifierActiveShape (0018,9427) CS
+	IntensifierActiveShape uint32 = 0x00189427
+	// IntensifierActiveDimensions (0018,9428) FL
+	IntensifierActiveDimensions uint32 = 0x00189428
+	// PhysicalDetectorSize (0018,9429) FL
+	PhysicalDetectorSize uint32 = 0x00189429
+	// PositionOfIsocenterProjection (0018,9430) FL
+	PositionOfIsocenterProjection uint32 = 0x00189430
+	// FieldOfViewSequence (0018,9432) SQ
+	FieldOfViewSequence uint32 = 0x00189432
+	// FieldOfViewDescription (0018,9433) LO
+	FieldOfViewDescription uint32 = 0x00189433
+	// ExposureControlSensingRegionsSequence (0018,9434) SQ
+	ExposureControlSensingRegionsSequence uint32 = 0x00189434
+	// ExposureControlSensingRegionShape (0018,9435) CS
+	ExposureControlSensingRegionShape uint32 = 0x00189435
+	// ExposureControlSensingRegionLeftVerticalEdge (0018,9436) SS
+	ExposureControlSensingRegionLeftVerticalEdge uint32 = 0x00189436
+	// ExposureControlSensingRegionRightVerticalEdge (0018,9437) SS
+	ExposureControlSensingRegionRightVerticalEdge uint32 = 0x00189437
+	// ExposureControlSensingRegionUpperHorizontalEdge (0018,9438) SS
+	ExposureControlSensingRegionUpperHorizontalEdge uint32 = 0x00189438
+	// ExposureControlSensingRegionLowerHorizontalEdge (0018,9439) SS
+	ExposureControlSensingRegionLowerHorizontalEdge uint32 = 0x00189439
+	// CenterOfCircularExposureControlSensingRegion (0018,9440) SS
+	CenterOfCircularExposureControlSensingRegion uint32 = 0x00189440
+	// RadiusOfCircularExposureControlSensingRegion (0018,9441) US
+	RadiusOfCircularExposureControlSensingRegion uint32 = 0x00189441
+	// VerticesOfThePolygonalExposureControlSensingRegion (0018,9442) SS
+	VerticesOfThePolygonalExposureControlSensingRegion uint32 = 0x00189442
+	// ColumnAngulationPatient (0018,9447) FL
+	ColumnAngulationPatient uint32 = 0x00189447
+	// BeamAngle (0018,9449) FL
+	BeamAngle uint32 = 0x00189449
+	// FrameDetectorParametersSequence (0018,9451) SQ
+	FrameDetectorParametersSequence uint32 = 0x00189451
+	// CalculatedAnatomyThickness (0018,9452) FL
+	CalculatedAnatomyThickness uint32 = 0x00189452
+	// CalibrationSequence (0018,9455) SQ
+	CalibrationSequence uint32 = 0x00189455
+	// ObjectThicknessSequence (0018,9456) SQ
+	ObjectThicknessSequence uint32 = 0x00189456
+	// PlaneIdentification (0018,9457) CS
+	PlaneIdentification uint32 = 0x00189457
+	// FieldOfViewDimensionsInFloat (0018,9461) FL
+	FieldOfViewDimensionsInFloat uint32 = 0x00189461
+	// IsocenterReferenceSystemSequence (0018,9462) SQ
+	IsocenterReferenceSystemSequence uint32 = 0x00189462
+	// PositionerIsocenterPrimaryAngle (0018,9463) FL
+	PositionerIsocenterPrimaryAngle uint32 = 0x00189463
+	// PositionerIsocenterSecondaryAngle (0018,9464) FL
+	PositionerIsocenterSecondaryAngle uint32 = 0x00189464
+	// PositionerIsocenterDetectorRotationAngle (0018,9465) FL
+	PositionerIsocenterDetectorRotationAngle uint32 = 0x00189465
+	// TableXPositionToIsocenter (0018,9466) FL
+	TableXPositionToIsocenter uint32 = 0x00189466
+	// TableYPositionToIsocenter (0018,9467) FL
+	TableYPositionToIsocenter uint32 = 0x00189467
+	// TableZPositionToIsocenter (0018,9468) FL
+	TableZPositionToIsocenter uint32 = 0x00189468
+	// TableHorizontalRotationAngle (0018,9469) FL
+	TableHorizontalRotationAngle uint32 = 0x00189469
+	// TableHeadTiltAngle (0018,9470) FL
+	TableHeadTiltAngle uint32 = 0x00189470
+	// TableCradleTiltAngle (0018,9471) FL
+	TableCradleTiltAngle uint32 = 0x00189471
+	// FrameDisplayShutterSequence (0018,9472) SQ
+	FrameDisplayShutterSequence uint32 = 0x00189472
+	// AcquiredImageAreaDoseProduct (0018,9473) FL
+	AcquiredImageAreaDoseProduct uint32 = 0x00189473
+	// CArmPositionerTabletopRelationship (0018,9474) CS
+	CArmPositionerTabletopRelationship uint32 = 0x00189474
+	// XRayGeometrySequence (0018,9476) SQ
+	XRayGeometrySequence uint32 = 0x00189476
+	// IrradiationEventIdentificationSequence (0018,9477) SQ
+	IrradiationEventIdentificationSequence uint32 = 0x00189477
+	// XRay3DFrameTypeSequence (0018,9504) SQ
+	XRay3DFrameTypeSequence uint32 = 0x00189504
+	// ContributingSourcesSequence (0018,9506) SQ
+	ContributingSourcesSequence uint32 = 0x00189506
+	// XRay3DAcquisitionSequence (0018,9507) SQ
+	XRay3DAcquisitionSequence uint32 = 0x00189507
+	// PrimaryPositionerScanArc (0018,9508) FL
+	PrimaryPositionerScanArc uint32 = 0x00189508
+	// SecondaryPositionerScanArc (0018,9509) FL
+	SecondaryPositionerScanArc uint32 = 0x00189509
+	// PrimaryPositionerScanStartAngle (0018,9510) FL
+	PrimaryPositionerScanStartAngle uint32 = 0x00189510
+	// SecondaryPositionerScanStartAngle (0018,9511) FL
+	SecondaryPositionerScanStartAngle uint32 = 0x00189511
+	// PrimaryPositionerIncrement (0018,9514) FL
+	PrimaryPositionerIncrement uint32 = 0x00189514
+	// SecondaryPositionerIncrement (0018,9515) FL
+	SecondaryPositionerIncrement uint32 = 0x00189515
+	// StartAcquisitionDateTime (0018,9516) DT
+	StartAcquisitionDateTime uint32 = 0x00189516
+	// EndAcquisitionDateTime (0018,9517) DT
+	EndAcquisitionDateTime uint32 = 0x00189517
+	// PrimaryPositionerIncrementSign (0018,9518) SS
+	PrimaryPositionerIncrementSign uint32 = 0x00189518
+	// SecondaryPositionerIncrementSign (0018,9519) SS
+	SecondaryPositionerIncrementSign uint32 = 0x00189519
+	// ApplicationName (0018,9524) LO
+	ApplicationName uint32 = 0x00189524
+	// ApplicationVersion (0018,9525) LO
+	ApplicationVersion uint32 = 0x00189525
+	// ApplicationManufacturer (0018,9526) LO
+	ApplicationManufacturer uint32 = 0x00189526
+	// AlgorithmType (0018,9527) CS
+	AlgorithmType uint32 = 0x00189527
+	// AlgorithmDescription (0018,9528) LO
+	AlgorithmDescription uint32 = 0x00189528
+	// XRay3DReconstructionSequence (0018,9530) SQ
+	XRay3DReconstructionSequence uint32 = 0x00189530
+	// ReconstructionDescription (0018,9531) LO
+	ReconstructionDescription uint32 = 0x00189531
+	// PerProjectionAcquisitionSequence (0018,9538) SQ
+	PerProjectionAcquisitionSequence uint32 = 0x00189538
+	// DetectorPositionSequence (0018,9541) SQ
+	DetectorPositionSequence uint32 = 0x00189541
+	// XRayAcquisitionDoseSequence (0018,9542) SQ
+	XRayAcquisitionDoseSequence uint32 = 0x00189542
+	// XRaySourceIsocenterPrimaryAngle (0018,9543) FD
+	XRaySourceIsocenterPrimaryAngle uint32 = 0x00189543
+	// XRaySourceIsocenterSecondaryAngle (0018,9544) FD
+	XRaySourceIsocenterSecondaryAngle uint32 = 0x00189544
+	// BreastSupportIsocenterPrimaryAngle (0018,9545) FD
+	BreastSupportIsocenterPrimaryAngle uint32 = 0x00189545
+	// BreastSupportIsocenterSecondaryAngle (0018,9546) FD
+	BreastSupportIsocenterSecondaryAngle uint32 = 0x00189546
+	// BreastSupportXPositionToIsocenter (0018,9547) FD
+	BreastSupportXPositionToIsocenter uint32 = 0x00189547
+	// BreastSupportYPositionToIsocenter (0018,9548) FD
+	BreastSupportYPositionToIsocenter uint32 = 0x00189548
+	// BreastSupportZPositionToIsocenter (0018,9549) FD
+	BreastSupportZPositionToIsocenter uint32 = 0x00189549
+	// DetectorIsocenterPrimaryAngle (0018,9550) FD
+	DetectorIsocenterPrimaryAngle uint32 = 0x00189550
+	// DetectorIsocenterSecondaryAngle (0018,9551) FD
+	DetectorIsocenterSecondaryAngle uint32 = 0x00189551
+	// DetectorXPositionToIsocenter (0018,9552) FD
+	DetectorXPositionToIsocenter uint32 = 0x00189552
+	// DetectorYPositionToIsocenter (0018,9553) FD
+	DetectorYPositionToIsocenter uint32 = 0x00189553
+	// DetectorZPositionToIsocenter (0018,9554) FD
+	DetectorZPositionToIsocenter uint32 = 0x00189554
+	// XRayGridSequence (0018,9555) SQ
+	XRayGridSequence uint32 = 0x00189555
+	// XRayFilterSequence (0018,9556) SQ
+	XRayFilterSequence uint32 = 0x00189556
+	// DetectorActiveAreaTLHCPosition (0018,9557) FD
+	DetectorActiveAreaTLHCPosition uint32 = 0x00189557
+	// DetectorActiveAreaOrientation (0018,9558) FD
+	DetectorActiveAreaOrientation uint32 = 0x00189558
+	// PositionerPrimaryAngleDirection (0018,9559) CS
+	PositionerPrimaryAngleDirection uint32 = 0x00189559
+	// DiffusionBMatrixSequence (0018,9601) SQ
+	DiffusionBMatrixSequence uint32 = 0x00189601
+	// DiffusionBValueXX (0018,9602) FD
+	DiffusionBValueXX uint32 = 0x00189602
+	// DiffusionBValueXY (0018,9603) FD
+	DiffusionBValueXY uint32 = 0x00189603
+	// DiffusionBValueXZ (0018,9604) FD
+	DiffusionBValueXZ uint32 = 0x00189604
+	// DiffusionBValueYY (0018,9605) FD
+	DiffusionBValueYY uint32 = 0x00189605
+	// DiffusionBValueYZ (0018,9606) FD
+	DiffusionBValueYZ uint32 = 0x00189606
+	// DiffusionBValueZZ (0018,9607) FD
+	DiffusionBValueZZ uint32 = 0x00189607
+	// FunctionalMRSequence (0018,9621) SQ
+	FunctionalMRSequence uint32 = 0x00189621
+	// FunctionalSettlingPhaseFramesPresent (0018,9622) CS
+	FunctionalSettlingPhaseFramesPresent uint32 = 0x00189622
+	// FunctionalSyncPulse (0018,9623) DT
+	FunctionalSyncPulse uint32 = 0x00189623
+	// SettlingPhaseFrame (0018,9624) CS
+	SettlingPhaseFrame uint32 = 0x00189624
+	// DecayCorrectionDateTime (0018,9701) DT
+	DecayCorrectionDateTime uint32 = 0x00189701
+	// StartDensityThreshold (0018,9715) FD
+	StartDensityThreshold uint32 = 0x00189715
+	// StartRelativeDensityDifferenceThreshold (0018,9716) FD
+	StartRelativeDensityDifferenceThreshold uint32 = 0x00189716
+	// StartCardiacTriggerCountThreshold (0018,9717) FD
+	StartCardiacTriggerCountThreshold uint32 = 0x00189717
+	// StartRespiratoryTriggerCountThreshold (0018,9718) FD
+	StartRespiratoryTriggerCountThreshold uint32 = 0x00189718
+	// TerminationCountsThreshold (0018,9719) FD
+	TerminationCountsThreshold uint32 = 0x00189719
+	// TerminationDensityThreshold (0018,9720) FD
+	TerminationDensityThreshold uint32 = 0x00189720
+	// TerminationRelativeDensityThreshold (0018,9721) FD
+	TerminationRelativeDensityThreshold uint32 = 0x00189721
+	// TerminationTimeThreshold (0018,9722) FD
+	TerminationTimeThreshold uint32 = 0x00189722
+	// TerminationCardiacTriggerCountThreshold (0018,9723) FD
+	TerminationCardiacTriggerCountThreshold uint32 = 0x00189723
+	// TerminationRespiratoryTriggerCountThreshold (0018,9724) FD
+	TerminationRespiratoryTriggerCountThreshold uint32 = 0x00189724
+	// DetectorGeometry (0018,9725) CS
+	DetectorGeometry uint32 = 0x00189725
+	// TransverseDetectorSeparation (0018,9726) FD
+	TransverseDetectorSeparation uint32 = 0x00189726
+	// AxialDetectorDimension (0018,9727) FD
+	AxialDetectorDimension uint32 = 0x00189727
+	// RadiopharmaceuticalAgentNumber (0018,9729) US
+	RadiopharmaceuticalAgentNumber uint32 = 0x00189729
+	// PETFrameAcquisitionSequence (0018,9732) SQ
+	PETFrameAcquisitionSequence uint32 = 0x00189732
+	// PETDetectorMotionDetailsSequence (0018,9733) SQ
+	PETDetectorMotionDetailsSequence uint32 = 0x00189733
+	// PETTableDynamicsSequence (0018,9734) SQ
+	PETTableDynamicsSequence uint32 = 0x00189734
+	// PETPositionSequence (0018,9735) SQ
+	PETPositionSequence uint32 = 0x00189735
+	// PETFrameCorrectionFactorsSequence (0018,9736) SQ
+	PETFrameCorrectionFactorsSequence uint32 = 0x00189736
+	// RadiopharmaceuticalUsageSequence (0018,9737) SQ
+	RadiopharmaceuticalUsageSequence uint32 = 0x00189737
+	// AttenuationCorrectionSource (0018,9738) CS
+	AttenuationCorrectionSource uint32 = 0x00189738
+	// NumberOfIterations (0018,9739) US
+	NumberOfIterations uint32 = 0x00189739
+	// NumberOfSubsets (0018,9740) US
+	NumberOfSubsets uint32 = 0x00189740
+	// PETReconstructionSequence (0018,9749) SQ
+	PETReconstructionSequence uint32 = 0x00189749
+	// PETFrameTypeSequence (0018,9751) SQ
+	PETFrameTypeSequence uint32 = 0x00189751
+	// TimeOfFlightInformationUsed (0018,9755) CS
+	TimeOfFlightInformationUsed uint32 = 0x00189755
+	// ReconstructionType (0018,9756) CS
+	ReconstructionType uint32 = 0x00189756
+	// DecayCorrected (0018,9758) CS
+	DecayCorrected uint32 = 0x00189758
+	// AttenuationCorrected (0018,9759) CS
+	AttenuationCorrected uint32 = 0x00189759
+	// ScatterCorrected (0018,9760) CS
+	ScatterCorrected uint32 = 0x00189760
+	// DeadTimeCorrected (0018,9761) CS
+	DeadTimeCorrected uint32 = 0x00189761
+	// GantryMotionCorrected (0018,9762) CS
+	GantryMotionCorrected uint32 = 0x00189762
+	// PatientMotionCorrected (0018,9763) CS
+	PatientMotionCorrected uint32 = 0x00189763
+	// CountLossNormalizationCorrected (0018,9764) CS
+	CountLossNormalizationCorrected uint32 = 0x00189764
+	// RandomsCorrected (0018,9765) CS
+	RandomsCorrected uint32 = 0x00189765
+	// NonUniformRadialSamplingCorrected (0018,9766) CS
+	NonUniformRadialSamplingCorrected uint32 = 0x00189766
+	// SensitivityCalibrated (0018,9767) CS
+	SensitivityCalibrated uint32 = 0x00189767
+	// DetectorNormalizationCorrection (0018,9768) CS
+	DetectorNormalizationCorrection uint32 = 0x00189768
+	// IterativeReconstructionMethod (0018,9769) CS
+	IterativeReconstructionMethod uint32 = 0x00189769
+	// AttenuationCorrectionTemporalRelationship (0018,9770) CS
+	AttenuationCorrectionTemporalRelationship uint32 = 0x00189770
+	// PatientPhysiologicalStateSequence (0018,9771) SQ
+	PatientPhysiologicalStateSequence uint32 = 0x00189771
+	// PatientPhysiologicalStateCodeSequence (0018,9772) SQ
+	PatientPhysiologicalStateCodeSequence uint32 = 0x00189772
+	// DepthsOfFocus (0018,9801) FD
+	DepthsOfFocus uint32 = 0x00189801
+	// ExcludedIntervalsSequence (0018,9803) SQ
+	ExcludedIntervalsSequence uint32 = 0x00189803
+	// ExclusionStartDateTime (0018,9804) DT
+	ExclusionStartDateTime uint32 = 0x00189804
+	// ExclusionDuration (0018,9805) FD
+	ExclusionDuration uint32 = 0x00189805
+	// USImageDescriptionSequence (0018,9806) SQ
+	USImageDescriptionSequence uint32 = 0x00189806
+	// ImageDataTypeSequence (0018,9807) SQ
+	ImageDataTypeSequence uint32 = 0x00189807
+	// DataType (0018,9808) CS
+	DataType uint32 = 0x00189808
+	// TransducerScanPatternCodeSequence (0018,9809) SQ
+	TransducerScanPatternCodeSequence uint32 = 0x00189809
+	// AliasedDataType (0018,980B) CS
+	AliasedDataType uint32 = 0x0018980B
+	// PositionMeasuringDeviceUsed (0018,980C) CS
+	PositionMeasuringDeviceUsed uint32 = 0x0018980C
+	// TransducerGeometryCodeSequence (0018,980D) SQ
+	TransducerGeometryCodeSequence uint32 = 0x0018980D
+	// TransducerBeamSteeringCodeSequence (0018,980E) SQ
+	TransducerBeamSteeringCodeSequence uint32 = 0x0018980E
+	// TransducerApplicationCodeSequence (0018,980F) SQ
+	TransducerApplicationCodeSequence uint32 = 0x0018980F
+	// ZeroVelocityPixelValue (0018,9810) US
+	ZeroVelocityPixelValue uint32 = 0x00189810
+	// ReferenceLocationLabel (0018,9900) LO
+	ReferenceLocationLabel uint32 = 0x00189900
+	// ReferenceLocationDescription (0018,9901) UT
+	ReferenceLocationDescription uint32 = 0x00189901
+	// ReferenceBasisCodeSequence (0018,9902) SQ
+	ReferenceBasisCodeSequence uint32 = 0x00189902
+	// ReferenceGeometryCodeSequence (0018,9903) SQ
+	ReferenceGeometryCodeSequence uint32 = 0x00189903
+	// OffsetDistance (0018,9904) DS
+	OffsetDistance uint32 = 0x00189904
+	// OffsetDirection (0018,9905) CS
+	OffsetDirection uint32 = 0x00189905
+	// PotentialScheduledProtocolCodeSequence (0018,9906) SQ
+	PotentialScheduledProtocolCodeSequence uint32 = 0x00189906
+	// PotentialRequestedProcedureCodeSequence (0018,9907) SQ
+	PotentialRequestedProcedureCodeSequence uint32 = 0x00189907
+	// PotentialReasonsForProcedure (0018,9908) UC
+	PotentialReasonsForProcedure uint32 = 0x00189908
+	// PotentialReasonsForProcedureCodeSequence (0018,9909) SQ
+	PotentialReasonsForProcedureCodeSequence uint32 = 0x00189909
+	// PotentialDiagnosticTasks (0018,990A) UC
+	PotentialDiagnosticTasks uint32 = 0x0018990A
+	// ContraindicationsCodeSequence (0018,990B) SQ
+	ContraindicationsCodeSequence uint32 = 0x0018990B
+	// ReferencedDefinedProtocolSequence (0018,990C) SQ
+	ReferencedDefinedProtocolSequence uint32 = 0x0018990C
+	// ReferencedPerformedProtocolSequence (0018,990D) SQ
+	ReferencedPerformedProtocolSequence uint32 = 0x0018990D
+	// PredecessorProtocolSequence (0018,990E) SQ
+	PredecessorProtocolSequence uint32 = 0x0018990E
+	// ProtocolPlanningInformation (0018,990F) UT
+	ProtocolPlanningInformation uint32 = 0x0018990F
+	// ProtocolDesignRationale (0018,9910) UT
+	ProtocolDesignRationale uint32 = 0x00189910
+	// PatientSpecificationSequence (0018,9911) SQ
+	PatientSpecificationSequence uint32 = 0x00189911
+	// ModelSpecificationSequence (0018,9912) SQ
+	ModelSpecificationSequence uint32 = 0x00189912
+	// ParametersSpecificationSequence (0018,9913) SQ
+	ParametersSpecificationSequence uint32 = 0x00189913
+	// InstructionSequence (0018,9914) SQ
+	InstructionSequence uint32 = 0x00189914
+	// InstructionIndex (0018,9915) US
+	InstructionIndex uint32 = 0x00189915
+	// InstructionText (0018,9916) LO
+	InstructionText uint32 = 0x00189916
+	// InstructionDescription (0018,9917) UT
+	InstructionDescription uint32 = 0x00189917
+	// InstructionPerformedFlag (0018,9918) CS
+	InstructionPerformedFlag uint32 = 0x00189918
+	// InstructionPerformedDateTime (0018,9919) DT
+	InstructionPerformedDateTime uint32 = 0x00189919
+	// InstructionPerformanceComment (0018,991A) UT
+	InstructionPerformanceComment uint32 = 0x0018991A
+	// PatientPositioningInstructionSequence (0018,991B) SQ
+	PatientPositioningInstructionSequence uint32 = 0x0018991B
+	// PositioningMethodCodeSequence (0018,991C) SQ
+	PositioningMethodCodeSequence uint32 = 0x0018991C
+	// PositioningLandmarkSequence (0018,991D) SQ
+	PositioningLandmarkSequence uint32 = 0x0018991D
+	// TargetFrameOfReferenceUID (0018,991E) UI
+	TargetFrameOfReferenceUID uint32 = 0x0018991E
+	// AcquisitionProtocolElementSpecificationSequence (0018,991F) SQ
+	AcquisitionProtocolElementSpecificationSequence uint32 = 0x0018991F
+	// AcquisitionProtocolElementSequence (0018,9920) SQ
+	AcquisitionProtocolElementSequence uint32 = 0x00189920
+	// ProtocolElementNumber (0018,9921) US
+	ProtocolElementNumber uint32 = 0x00189921
+	// ProtocolElementName (0018,9922) LO
+	ProtocolElementName uint32 = 0x00189922
+	// ProtocolElementCharacteristicsSummary (0018,9923) UT
+	ProtocolElementCharacteristicsSummary uint32 = 0x00189923
+	// ProtocolElementPurpose (0018,9924) UT
+	ProtocolElementPurpose uint32 = 0x00189924
+	// AcquisitionMotion (0018,9930) CS
+	AcquisitionMotion uint32 = 0x00189930
+	// AcquisitionStartLocationSequence (0018,9931) SQ
+	AcquisitionStartLocationSequence uint32 = 0x00189931
+	// AcquisitionEndLocationSequence (0018,9932) SQ
+	AcquisitionEndLocationSequence uint32 = 0x00189932
+	// ReconstructionProtocolElementSpecificationSequence (0018,9933) SQ
+	ReconstructionProtocolElementSpecificationSequence uint32 = 0x00189933
+	// ReconstructionProtocolElementSequence (0018,9934) SQ
+	ReconstructionProtocolElementSequence uint32 = 0x00189934
+	// StorageProtocolElementSpecificationSequence (0018,9935) SQ
+	StorageProtocolElementSpecificationSequence uint32 = 0x00189935
+	// StorageProtocolElementSequence (0018,9936) SQ
+	StorageProtocolElementSequence uint32 = 0x00189936
+	// RequestedSeriesDescription (0018,9937) LO
+	RequestedSeriesDescription uint32 = 0x00189937
+	// SourceAcquisitionProtocolElementNumber (0018,9938) US
+	SourceAcquisitionProtocolElementNumber uint32 = 0x00189938
+	// SourceAcquisitionBeamNumber (0018,9939) US
+	SourceAcquisitionBeamNumber uint32 = 0x00189939
+	// SourceReconstructionProtocolElementNumber (0018,993A) US
+	SourceReconstructionProtocolElementNumber uint32 = 0x0018993A
+	// ReconstructionStartLocationSequence (0018,993B) SQ
+	ReconstructionStartLocationSequence uint32 = 0x0018993B
+	// ReconstructionEndLocationSequence (0018,993C) SQ
+	ReconstructionEndLocationSequence uint32 = 0x0018993C
+	// ReconstructionAlgorithmSequence (0018,993D) SQ
+	ReconstructionAlgorithmSequence uint32 = 0x0018993D
+	// ReconstructionTargetCenterLocationSequence (0018,993E) SQ
+	ReconstructionTargetCenterLocationSequence uint32 = 0x0018993E
+	// ImageFilterDescription (0018,9941) UT
+	ImageFilterDescription uint32 = 0x00189941
+	// CTDIvolNotificationTrigger (0018,9942) FD
+	CTDIvolNotificationTrigger uint32 = 0x00189942
+	// DLPNotificationTrigger (0018,9943) FD
+	DLPNotificationTrigger uint32 = 0x00189943
+	// AutoKVPSelectionType (0018,9944) CS
+	AutoKVPSelectionType uint32 = 0x00189944
+	// AutoKVPUpperBound (0018,9945) FD
+	AutoKVPUpperBound uint32 = 0x00189945
+	// AutoKVPLowerBound (0018,9946) FD
+	AutoKVPLowerBound uint32 = 0x00189946
+	// ProtocolDefinedPatientPosition (0018,9947) CS
+	ProtocolDefinedPatientPosition uint32 = 0x00189947
+	// ContributingEquipmentSequence (0018,A001) SQ
+	ContributingEquipmentSequence uint32 = 0x0018A001
+	// ContributionDateTime (0018,A002) DT
+	ContributionDateTime uint32 = 0x0018A002
+	// ContributionDescription (0018,A003) ST
+	ContributionDescription uint32 = 0x0018A003
+	// StudyInstanceUID (0020,000D) UI
+	StudyInstanceUID uint32 = 0x0020000D
+	// SeriesInstanceUID (0020,000E) UI
+	SeriesInstanceUID uint32 = 0x0020000E
+	// StudyID (0020,0010) SH
+	StudyID uint32 = 0x00200010
+	// SeriesNumber (0020,0011) IS
+	SeriesNumber uint32 = 0x00200011
+	// AcquisitionNumber (0020,0012) IS
+	AcquisitionNumber uint32 = 0x00200012
+	// InstanceNumber (0020,0013) IS
+	InstanceNumber uint32 = 0x00200013
+	// IsotopeNumber (0020,0014) IS, retired
+	IsotopeNumber uint32 = 0x00200014
+	// PhaseNumber (0020,0015) IS, retired
+	PhaseNumber uint32 = 0x00200015
+	// IntervalNumber (0020,0016) IS, retired
+	IntervalNumber uint32 = 0x00200016
+	// TimeSlotNumber (0020,0017) IS, retired
+	TimeSlotNumber uint32 = 0x00200017
+	// AngleNumber (0020,0018) IS, retired
+	AngleNumber uint32 = 0x00200018
+	// ItemNumber (0020,0019) IS
+	ItemNumber uint32 = 0x00200019
+	// PatientOrientation (0020,0020) CS
+	PatientOrientation uint32 = 0x00200020
+	// OverlayNumber (0020,0022) IS, retired
+	OverlayNumber uint32 = 0x00200022
+	// CurveNumber (0020,0024) IS, retired
+	CurveNumber uint32 = 0x00200024
+	// LUTNumber (0020,0026) IS, retired
+	LUTNumber uint32 = 0x00200026
+	// ImagePosition (0020,0030) DS, retired
+	ImagePosition uint32 = 0x00200030
+	// ImagePositionPatient (0020,0032) DS
+	ImagePositionPatient uint32 = 0x00200032
+	// ImageOrientation (0020,0035) DS, retired
+	ImageOrientation uint32 = 0x00200035
+	// ImageOrientationPatient (0020,0037) DS
+	ImageOrientationPatient uint32 = 0x00200037
+	// Location (0020,0050) DS, retired
+	Location uint32 = 0x00200050
+	// FrameOfReferenceUID (0020,0052) UI
+	FrameOfReferenceUID uint32 = 0x00200052
+	// Laterality (0020,0060) CS
+	Laterality uint32 = 0x00200060
+	// ImageLaterality (0020,0062) CS
+	ImageLaterality uint32 = 0x00200062
+	// ImageGeometryType (0020,0070) LO, retired
+	ImageGeometryType uint32 = 0x00200070
+	// MaskingImage (0020,0080) CS, retired
+	MaskingImage uint32 = 0x00200080
+	// ReportNumber (0020,00AA) IS, retired
+	ReportNumber uint32 = 0x002000AA
+	// TemporalPositionIdentifier (0020,0100) IS
+	TemporalPositionIdentifier uint32 = 0x00200100
+	// NumberOfTemporalPositions (0020,0105) IS
+	NumberOfTemporalPositions uint32 = 0x00200105
+	// TemporalResolution (0020,0110) DS
+	TemporalResolution uint32 = 0x00200110
+	// SynchronizationFrameOfReferenceUID (0020,0200) UI
+	SynchronizationFrameOfReferenceUID uint32 = 0x00200200
+	// SOPInstanceUIDOfConcatenationSource (0020,0242) UI
+	SOPInstanceUIDOfConcatenationSource uint32 = 0x00200242
+	// SeriesInStudy (0020,1000) IS, retired
+	SeriesInStudy uint32 = 0x00201000
+	// AcquisitionsInSeries (0020,1001) IS, retired
+	AcquisitionsInSeries uint32 = 0x00201001
+	// ImagesInAcquisition (0020,1002) IS
+	ImagesInAcquisition uint32 = 0x00201002
+	// ImagesInSeries (0020,1003) IS, retired
+	ImagesInSeries uint32 = 0x00201003
+	// AcquisitionsInStudy (0020,1004) IS, retired
+	AcquisitionsInStudy uint32 = 0x00201004
+	// ImagesInStudy (0020,1005) IS, retired
+	ImagesInStudy uint32 = 0x00201005
+	// Reference (0020,1020) LO, retired
+	Reference uint32 = 0x00201020
+	// TargetPositionReferenceIndicator (0020,103F) LO
+	TargetPositionReferenceIndicator uint32 = 0x0020103F
+	// PositionReferenceIndicator (0020,1040) LO
+	PositionReferenceIndicator uint32 = 0x00201040
+	// SliceLocation (0020,1041) DS
+	SliceLocation uint32 = 0x00201041
+	// OtherStudyNumbers (0020,1070) IS, retired
+	OtherStudyNumbers uint32 = 0x00201070
+	// NumberOfPatientRelatedStudies (0020,1200) IS
+	NumberOfPatientRelatedStudies uint32 = 0x00201200
+	// NumberOfPatientRelatedSeries (0020,1202) IS
+	NumberOfPatientRelatedSeries uint32 = 0x00201202
+	// NumberOfPatientRelatedInstances (0020,1204) IS
+	NumberOfPatientRelatedInstances uint32 = 0x00201204
+	// NumberOfStudyRelatedSeries (0020,1206) IS
+	NumberOfStudyRelatedSeries uint32 = 0x00201206
+	// NumberOfStudyRelatedInstances (0020,1208) IS
+	NumberOfStudyRelatedInstances uint32 = 0x00201208
+	// NumberOfSeriesRelatedInstances (0020,1209) IS
+	NumberOfSeriesRelatedInstances uint32 = 0x00201209
+	// ModifyingDeviceID (0020,3401) CS, retired
+	ModifyingDeviceID uint32 = 0x00203401
+	// ModifiedImageID (0020,3402) CS, retired
+	ModifiedImageID uint32 = 0x00203402
+	// ModifiedImageDate (0020,3403) DA, retired
+	ModifiedImageDate uint32 = 0x00203403
+	// ModifyingDeviceManufacturer (0020,3404) LO, retired
+	ModifyingDeviceManufacturer uint32 = 0x00203404
+	// ModifiedImageTime (0020,3405) TM, retired
+	ModifiedImageTime uint32 = 0x00203405
+	// ModifiedImageDescription (0020,3406) LO, retired
+	ModifiedImageDescription uint32 = 0x00203406
+	// ImageComments (0020,4000) LT
+	ImageComments uint32 = 0x00204000
+	// OriginalImageIdentification (0020,5000) AT, retired
+	OriginalImageIdentification uint32 = 0x00205000
+	// OriginalImageIdentificationNomenclature (0020,5002) LO, retired
+	OriginalImageIdentificationNomenclature uint32 = 0x00205002
+	// StackID (0020,9056) SH
+	StackID uint32 = 0x00209056
+	// InStackPositionNumber (0020,9057) UL
+	InStackPositionNumber uint32 = 0x00209057
+	// FrameAnatomySequence (0020,9071) SQ
+	FrameAnatomySequence uint32 = 0x00209071
+	// FrameLaterality (0020,9072) CS
+	FrameLaterality uint32 = 0x00209072
+	// FrameContentSequence (0020,9111) SQ
+	FrameContentSequence uint32 = 0x00209111
+	// PlanePositionSequence (0020,9113) SQ
+	PlanePositionSequence uint32 = 0x00209113
+	// PlaneOrientationSequence (0020,9116) SQ
+	PlaneOrientationSequence uint32 = 0x00209116
+	// TemporalPositionIndex (0020,9128) UL
+	TemporalPositionIndex uint32 = 0x00209128
+	// NominalCardiacTriggerDelayTime (0020,9153) FD
+	NominalCardiacTriggerDelayTime uint32 = 0x00209153
+	// NominalCardiacTriggerTimePriorToRPeak (0020,9154) FL
+	NominalCardiacTriggerTimePriorToRPeak uint32 = 0x00209154
+	// ActualCardiacTriggerTimePriorToRPeak (0020,9155) FL
+	ActualCardiacTriggerTimePriorToRPeak uint32 = 0x00209155
+	// FrameAcquisitionNumber (0020,9156) US
+	FrameAcquisitionNumber uint32 = 0x00209156
+	// DimensionIndexValues (0020,9157) UL
+	DimensionIndexValues uint32 = 0x00209157
+	// FrameComments (0020,9158) LT
+	FrameComments uint32 = 0x00209158
+	// ConcatenationUID (0020,9161) UI
+	ConcatenationUID uint32 = 0x00209161
+	// InConcatenationNumber (0020,9162) US
+	InConcatenationNumber uint32 = 0x00209162
+	// InConcatenationTotalNumber (0020,9163) US
+	InConcatenationTotalNumber uint32 = 0x00209163
+	// DimensionOrganizationUID (0020,9164) UI
+	DimensionOrganizationUID uint32 = 0x00209164
+	// DimensionIndexPointer (0020,9165) AT
+	DimensionIndexPointer uint32 = 0x00209165
+	// FunctionalGroupPointer (0020,9167) AT
+	FunctionalGroupPointer uint32 = 0x00209167
+	// UnassignedSharedConvertedAttributesSequence (0020,9170) SQ
+	UnassignedSharedConvertedAttributesSequence uint32 = 0x00209170
+	// UnassignedPerFrameConvertedAttributesSequence (0020,9171) SQ
+	UnassignedPerFrameConvertedAttributesSequence uint32 = 0x00209171
+	// ConversionSourceAttributesSequence (0020,9172) SQ
+	ConversionSourceAttributesSequence uint32 = 0x00209172
+	// DimensionIndexPrivateCreator (0020,9213) LO
+	DimensionIndexPrivateCreator uint32 = 0x00209213
+	// DimensionOrganizationSequence (0020,9221) SQ
+	DimensionOrganizationSequence uint32 = 0x00209221
+	// DimensionIndexSequence (0020,9222) SQ
+	DimensionIndexSequence uint32 = 0x00209222
+	// ConcatenationFrameOffsetNumber (0020,9228) UL
+	ConcatenationFrameOffsetNumber uint32 = 0x00209228
+	// FunctionalGroupPrivateCreator (0020,9238) LO
+	FunctionalGroupPrivateCreator uint32 = 0x00209238
+	// NominalPercentageOfCardiacPhase (0020,9241) FL
+	NominalPercentageOfCardiacPhase uint32 = 0x00209241
+	// NominalPercentageOfRespiratoryPhase (0020,9245) FL
+	NominalPercentageOfRespiratoryPhase uint32 = 0x00209245
+	// StartingRespiratoryAmplitude (0020,9246) FL
+	StartingRespiratoryAmplitude uint32 = 0x00209246
+	// StartingRespiratoryPhase (0020,9247) CS
+	StartingRespiratoryPhase uint32 = 0x00209247
+	// EndingRespiratoryAmplitude (0020,9248) FL
+	EndingRespiratoryAmplitude uint32 = 0x00209248
+	// EndingRespiratoryPhase (0020,9249) CS
+	EndingRespiratoryPhase uint32 = 0x00209249
+	// RespiratoryTriggerType (0020,9250) CS
+	RespiratoryTriggerType uint32 = 0x00209250
+	// RRIntervalTimeNominal (0020,9251) FD
+	RRIntervalTimeNominal uint32 = 0x00209251
+	// ActualCardiacTriggerDelayTime (0020,9252) FD
+	ActualCardiacTriggerDelayTime uint32 = 0x00209252
+	// RespiratorySynchronizationSequence (0020,9253) SQ
+	RespiratorySynchronizationSequence uint32 = 0x00209253
+	// RespiratoryIntervalTime (0020,9254) FD
+	RespiratoryIntervalTime uint32 = 0x00209254
+	// NominalRespiratoryTriggerDelayTime (0020,9255) FD
+	NominalRespiratoryTriggerDelayTime uint32 = 0x00209255
+	// RespiratoryTriggerDelayThreshold (0020,9256) FD
+	RespiratoryTriggerDelayThreshold uint32 = 0x00209256
+	// ActualRespiratoryTriggerDelayTime (0020,9257) FD
+	ActualRespiratoryTriggerDelayTime uint32 = 0x00209257
+	// ImagePositionVolume (0020,9301) FD
+	ImagePositionVolume uint32 = 0x00209301
+	// ImageOrientationVolume (0020,9302) FD
+	ImageOrientationVolume uint32 = 0x00209302
+	// UltrasoundAcquisitionGeometry (0020,9307) CS
+	UltrasoundAcquisitionGeometry uint32 = 0x00209307
+	// ApexPosition (0020,9308) FD
+	ApexPosition uint32 = 0x00209308
+	// VolumeToTransducerMappingMatrix (0020,9309) FD
+	VolumeToTransducerMappingMatrix uint32 = 0x00209309
+	// VolumeToTableMappingMatrix (0020,930A) FD
+	VolumeToTableMappingMatrix uint32 = 0x0020930A
+	// VolumeToTransducerRelationship (0020,930B) CS
+	VolumeToTransducerRelationship uint32 = 0x0020930B
+	// PatientFrameOfReferenceSource (0020,930C) CS
+	PatientFrameOfReferenceSource uint32 = 0x0020930C
+	// TemporalPositionTimeOffset (0020,930D) FD
+	TemporalPositionTimeOffset uint32 = 0x0020930D
+	// PlanePositionVolumeSequence (0020,930E) SQ
+	PlanePositionVolumeSequence uint32 = 0x0020930E
+	// PlaneOrientationVolumeSequence (0020,930F) SQ
+	PlaneOrientationVolumeSequence uint32 = 0x0020930F
+	// TemporalPositionSequence (0020,9310) SQ
+	TemporalPositionSequence uint32 = 0x00209310
+	// DimensionOrganizationType (0020,9311) CS
+	DimensionOrganizationType uint32 = 0x00209311
+	// VolumeFrameOfReferenceUID (0020,9312) UI
+	VolumeFrameOfReferenceUID uint32 = 0x00209312
+	// TableFrameOfReferenceUID (0020,9313) UI
+	TableFrameOfReferenceUID uint32 = 0x00209313
+	// DimensionDescriptionLabel (0020,9421) LO
+	DimensionDescriptionLabel uint32 = 0x00209421
+	// PatientOrientationInFrameSequence (0020,9450) SQ
+	PatientOrientationInFrameSequence uint32 = 0x00209450
+	// FrameLabel (0020,9453) LO
+	FrameLabel uint32 = 0x00209453
+	// AcquisitionIndex (0020,9518) US
+	AcquisitionIndex uint32 = 0x00209518
+	// ContributingSOPInstancesReferenceSequence (0020,9529) SQ
+	ContributingSOPInstancesReferenceSequence uint32 = 0x00209529
+	// ReconstructionIndex (0020,9536) US
+	ReconstructionIndex uint32 = 0x00209536
+	// LightPathFilterPassThroughWavelength (0022,0001) US
+	LightPathFilterPassThroughWavelength uint32 = 0x00220001
+	// LightPathFilterPassBand (0022,0002) US
+	LightPathFilterPassBand uint32 = 0x00220002
+	// ImagePathFilterPassThroughWavelength (0022,0003) US
+	ImagePathFilterPassThroughWavelength uint32 = 0x00220003
+	// ImagePathFilterPassBand (0022,0004) US
+	ImagePathFilterPassBand uint32 = 0x00220004
+	// PatientEyeMovementCommanded (0022,0005) CS
+	PatientEyeMovementCommanded uint32 = 0x00220005
+	// PatientEyeMovementCommandCodeSequence (0022,0006) SQ
+	PatientEyeMovementCommandCodeSequence uint32 = 0x00220006
+	// SphericalLensPower (0022,0007) FL
+	SphericalLensPower uint32 = 0x00220007
+	// CylinderLensPower (0022,0008) FL
+	CylinderLensPower uint32 = 0x00220008
+	// CylinderAxis (0022,0009) FL
+	CylinderAxis uint32 = 0x00220009
+	// EmmetropicMagnification (0022,000A) FL
+	EmmetropicMagnification uint32 = 0x0022000A
+	// IntraOcularPressure (0022,000B) FL
+	IntraOcularPressure uint32 = 0x0022000B
+	// HorizontalFieldOfView (0022,000C) FL
+	HorizontalFieldOfView uint32 = 0x0022000C
+	// PupilDilated (0022,000D) CS
+	PupilDilated uint32 = 0x0022000D
+	// DegreeOfDilation (0022,000E) FL
+	DegreeOfDilation uint32 = 0x0022000E
+	// StereoBaselineAngle (0022,0010) FL
+	StereoBaselineAngle uint32 = 0x00220010
+	// StereoBaselineDisplacement (0022,0011) FL
+	StereoBaselineDisplacement uint32 = 0x00220011
+	// StereoHorizontalPixelOffset (0022,0012) FL
+	StereoHorizontalPixelOffset uint32 = 0x00220012
+	// StereoVerticalPixelOffset (0022,0013) FL
+	StereoVerticalPixelOffset uint32 = 0x00220013
+	// StereoRotation (0022,0014) FL
+	StereoRotation uint32 = 0x00220014
+	// AcquisitionDeviceTypeCodeSequence (0022,0015) SQ
+	AcquisitionDeviceTypeCodeSequence uint32 = 0x00220015
+	// IlluminationTypeCodeSequence (0022,0016) SQ
+	IlluminationTypeCodeSequence uint32 = 0x00220016
+	// LightPathFilterTypeStackCodeSequence (0022,0017) SQ
+	LightPathFilterTypeStackCodeSequence uint32 = 0x00220017
+	// ImagePathFilterTypeStackCodeSequence (0022,0018) SQ
+	ImagePathFilterTypeStackCodeSequence uint32 = 0x00220018
+	// LensesCodeSequence (0022,0019) SQ
+	LensesCodeSequence uint32 = 0x00220019
+	// ChannelDescriptionCodeSequence (0022,001A) SQ
+	ChannelDescriptionCodeSequence uint32 = 0x0022001A
+	// RefractiveStateSequence (0022,001B) SQ
+	RefractiveStateSequence uint32 = 0x0022001B
+	// MydriaticAgentCodeSequence (0022,001C) SQ
+	MydriaticAgentCodeSequence uint32 = 0x0022001C
+	// RelativeImagePositionCodeSequence (0022,001D) SQ
+	RelativeImagePositionCodeSequence uint32 = 0x0022001D
+	// CameraAngleOfView (0022,001E) FL
+	CameraAngleOfView uint32 = 0x0022001E
+	// StereoPairsSequence (0022,0020) SQ
+	StereoPairsSequence uint32 = 0x00220020
+	// LeftImageSequence (0022,0021) SQ
+	LeftImageSequence uint32 = 0x00220021
+	// RightImageSequence (0022,0022) SQ
+	RightImageSequence uint32 = 0x00220022
+	// StereoPairsPresent (0022,0028) CS
+	StereoPairsPresent uint32 = 0x00220028
+	// AxialLengthOfTheEye (0022,0030) FL
+	AxialLengthOfTheEye uint32 = 0x00220030
+	// OphthalmicFrameLocationSequence (0022,0031) SQ
+	OphthalmicFrameLocationSequence uint32 = 0x00220031
+	// ReferenceCoordinates (0022,0032) FL
+	ReferenceCoordinates uint32 = 0x00220032
+	// DepthSpatialResolution (0022,0035) FL
+	DepthSpatialResolution uint32 = 0x00220035
+	// MaximumDepthDistortion (0022,0036) FL
+	MaximumDepthDistortion uint32 = 0x00220036
+	// AlongScanSpatialResolution (0022,0037) FL
+	AlongScanSpatialResolution uint32 = 0x00220037
+	// MaximumAlongScanDistortion (0022,0038) FL
+	MaximumAlongScanDistortion uint32 = 0x00220038
+	// OphthalmicImageOrientation (0022,0039) CS
+	OphthalmicImageOrientation uint32 = 0x00220039
+	// DepthOfTransverseImage (0022,0041) FL
+	DepthOfTransverseImage uint32 = 0x00220041
+	// MydriaticAgentConcentrationUnitsSequence (0022,0042) SQ
+	MydriaticAgentConcentrationUnitsSequence uint32 = 0x00220042
+	// AcrossScanSpatialResolution (0022,0048) FL
+	AcrossScanSpatialResolution uint32 = 0x00220048
+	// MaximumAcrossScanDistortion (0022,0049) FL
+	MaximumAcrossScanDistortion uint32 = 0x00220049
+	// MydriaticAgentConcentration (0022,004E) DS
+	MydriaticAgentConcentration uint32 = 0x0022004E
+	// IlluminationWaveLength (0022,0055) FL
+	IlluminationWaveLength uint32 = 0x00220055
+	// IlluminationPower (0022,0056) FL
+	IlluminationPower uint32 = 0x00220056
+	// IlluminationBandwidth (0022,0057) FL
+	IlluminationBandwidth uint32 = 0x00220057
+	// MydriaticAgentSequence (0022,0058) SQ
+	MydriaticAgentSequence uint32 = 0x00220058
+	// OphthalmicAxialMeasurementsRightEyeSequence (0022,1007) SQ
+	OphthalmicAxialMeasurementsRightEyeSequence uint32 = 0x00221007
+	// OphthalmicAxialMeasurementsLeftEyeSequence (0022,1008) SQ
+	OphthalmicAxialMeasurementsLeftEyeSequence uint32 = 0x00221008
+	// OphthalmicAxialMeasurementsDeviceType (0022,1009) CS
+	OphthalmicAxialMeasurementsDeviceType uint32 = 0x00221009
+	// OphthalmicAxialLengthMeasurementsType (0022,1010) CS
+	OphthalmicAxialLengthMeasurementsType uint32 = 0x00221010
+	// OphthalmicAxialLengthSequence (0022,1012) SQ
+	OphthalmicAxialLengthSequence uint32 = 0x00221012
+	// OphthalmicAxialLength (0022,1019) FL
+	OphthalmicAxialLength uint32 = 0x00221019
+	// LensStatusCodeSequence (0022,1024) SQ
+	LensStatusCodeSequence uint32 = 0x00221024
+	// VitreousStatusCodeSequence (0022,1025) SQ
+	VitreousStatusCodeSequence uint32 = 0x00221025
+	// IOLFormulaCodeSequence (0022,1028) SQ
+	IOLFormulaCodeSequence uint32 = 0x00221028
+	// IOLFormulaDetail (0022,1029) LO
+	IOLFormulaDetail uint32 = 0x00221029
+	// KeratometerIndex (0022,1033) FL
+	KeratometerIndex uint32 = 0x00221033
+	// SourceOfOphthalmicAxialLengthCodeSequence (0022,1035) SQ
+	SourceOfOphthalmicAxialLengthCodeSequence uint32 = 0x00221035
+	// TargetRefraction (0022,1037) FL
+	TargetRefraction uint32 = 0x00221037
+	// RefractiveProcedureOccurred (0022,1039) CS
+	RefractiveProcedureOccurred uint32 = 0x00221039
+	// RefractiveSurgeryTypeCodeSequence (0022,1040) SQ
+	RefractiveSurgeryTypeCodeSequence uint32 = 0x00221040
+	// OphthalmicUltrasoundMethodCodeSequence (0022,1044) SQ
+	OphthalmicUltrasoundMethodCodeSequence uint32 = 0x00221044
+	// OphthalmicAxialLengthMeasurementsSequence (0022,1050) SQ
+	OphthalmicAxialLengthMeasurementsSequence uint32 = 0x00221050
+	// IOLPower (0022,1053) FL
+	IOLPower uint32 = 0x00221053
+	// PredictedRefractiveError (0022,1054) FL
+	PredictedRefractiveError uint32 = 0x00221054
+	// OphthalmicAxialLengthVelocity (0022,1059) FL
+	OphthalmicAxialLengthVelocity uint32 = 0x00221059
+	// LensStatusDescription (0022,1065) LO
+	LensStatusDescription uint32 = 0x00221065
+	// VitreousStatusDescription (0022,1066) LO
+	VitreousStatusDescription uint32 = 0x00221066
+	// IOLPowerSequence (0022,1090) SQ
+	IOLPowerSequence uint32 = 0x00221090
+	// LensConstantSequence (0022,1092) SQ
+	LensConstantSequence uint32 = 0x00221092
+	// IOLManufacturer (0022,1093) LO
+	IOLManufacturer uint32 = 0x00221093
+	// LensConstantDescription (0022,1094) LO, retired
+	LensConstantDescription uint32 = 0x00221094
+	// ImplantName (0022,1095) LO
+	ImplantName uint32 = 0x00221095
+	// KeratometryMeasurementTypeCodeSequence (0022,1096) SQ
+	KeratometryMeasurementTypeCodeSequence uint32 = 0x00221096
+	// ImplantPartNumber (0022,1097) LO
+	ImplantPartNumber uint32 = 0x00221097
+	// ReferencedOphthalmicAxialMeasurementsSequence (0022,1100) SQ
+	ReferencedOphthalmicAxialMeasurementsSequence uint32 = 0x00221100
+	// OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence (0022,1101) SQ
+	OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence uint32 = 0x00221101
+	// RefractiveErrorBeforeRefractiveSurgeryCodeSequence (0022,1103) SQ
+	RefractiveErrorBeforeRefractiveSurgeryCodeSequence uint32 = 0x00221103
+	// IOLPowerForExactEmmetropia (0022,1121) FL
+	IOLPowerForExactEmmetropia uint32 = 0x00221121
+	// IOLPowerForExactTargetRefraction (0022,1122) FL
+	IOLPowerForExactTargetRefraction uint32 = 0x00221122
+	// AnteriorChamberDepthDefinitionCodeSequence (0022,1125) SQ
+	AnteriorChamberDepthDefinitionCodeSequence uint32 = 0x00221125
+	// LensThicknessSequence (0022,1127) SQ
+	LensThicknessSequence uint32 = 0x00221127
+	// AnteriorChamberDepthSequence (0022,1128) SQ
+	AnteriorChamberDepthSequence uint32 = 0x00221128
+	// LensThickness (0022,1130) FL
+	LensThickness uint32 = 0x00221130
+	// AnteriorChamberDepth (0022,1131) FL
+	AnteriorChamberDepth uint32 = 0x00221131
+	// SourceOfLensThicknessDataCodeSequence (0022,1132) SQ
+	SourceOfLensThicknessDataCodeSequence uint32 = 0x00221132
+	// SourceOfAnteriorChamberDepthDataCodeSequence (0022,1133) SQ
+	SourceOfAnteriorChamberDepthDataCodeSequence uint32 = 0x00221133
+	// SourceOfRefractiveMeasurementsSequence (0022,1134) SQ
+	SourceOfRefractiveMeasurementsSequence uint32 = 0x00221134
+	// SourceOfRefractiveMeasurementsCodeSequence (0022,1135) SQ
+	SourceOfRefractiveMeasurementsCodeSequence uint32 = 0x00221135
+	// OphthalmicAxialLengthMeasurementModified (0022,1140) CS
+	OphthalmicAxialLengthMeasurementModified uint32 = 0x00221140
+	// OphthalmicAxialLengthDataSourceCodeSequence (0022,1150) SQ
+	OphthalmicAxialLengthDataSourceCodeSequence uint32 = 0x00221150
+	// OphthalmicAxialLengthAcquisitionMethodCodeSequence (0022,1153) SQ, retired
+	OphthalmicAxialLengthAcquisitionMethodCodeSequence uint32 = 0x00221153
+	// SignalToNoiseRatio (0022,1155) FL
+	SignalToNoiseRatio uint32 = 0x00221155
+	// OphthalmicAxialLengthDataSourceDescription (0022,1159) LO
+	OphthalmicAxialLengthDataSourceDescription uint32 = 0x00221159
+	// OphthalmicAxialLengthMeasurementsTotalLengthSequence (0022,1210) SQ
+	OphthalmicAxialLengthMeasurementsTotalLengthSequence uint32 = 0x00221210
+	// OphthalmicAxialLengthMeasurementsSegmentalLengthSequence (0022,1211) SQ
+	OphthalmicAxialLengthMeasurementsSegmentalLengthSequence uint32 = 0x00221211
+	// OphthalmicAxialLengthMeasurementsLengthSummationSequence (0022,1212) SQ
+	OphthalmicAxialLengthMeasurementsLengthSummationSequence uint32 = 0x00221212
+	// UltrasoundOphthalmicAxialLengthMeasurementsSequence (0022,1220) SQ
+	UltrasoundOphthalmicAxialLengthMeasurementsSequence uint32 = 0x00221220
+	// OpticalOphthalmicAxialLengthMeasurementsSequence (0022,1225) SQ
+	OpticalOphthalmicAxialLengthMeasurementsSequence uint32 = 0x00221225
+	// UltrasoundSelectedOphthalmicAxialLengthSequence (0022,1230) SQ
+	UltrasoundSelectedOphthalmicAxialLengthSequence uint32 = 0x00221230
+	// OphthalmicAxialLengthSelectionMethodCodeSequence (0022,1250) SQ
+	OphthalmicAxialLengthSelectionMethodCodeSequence uint32 = 0x00221250
+	// OpticalSelectedOphthalmicAxialLengthSequence (0022,1255) SQ
+	OpticalSelectedOphthalmicAxialLengthSequence uint32 = 0x00221255
+	// SelectedSegmentalOphthalmicAxialLengthSequence (0022,1257) SQ
+	SelectedSegmentalOphthalmicAxialLengthSequence uint32 = 0x00221257
+	// SelectedTotalOphthalmicAxialLengthSequence (0022,1260) SQ
+	SelectedTotalOphthalmicAxialLengthSequence uint32 = 0x00221260
+	// OphthalmicAxialLengthQualityMetricSequence (0022,1262) SQ
+	OphthalmicAxialLengthQualityMetricSequence uint32 = 0x00221262
+	// OphthalmicAxialLengthQualityMetricTypeCodeSequence (0022,1265) SQ, retired
+	OphthalmicAxialLengthQualityMetricTypeCodeSequence uint32 = 0x00221265
+	// OphthalmicAxialLengthQualityMetricTypeDescription (0022,1273) LO, retired
+	OphthalmicAxialLengthQualityMetricTypeDescription uint32 = 0x00221273
+	// IntraocularLensCalculationsRightEyeSequence (0022,1300) SQ
+	IntraocularLensCalculationsRightEyeSequence uint32 = 0x00221300
+	// IntraocularLensCalculationsLeftEyeSequence (0022,1310) SQ
+	IntraocularLensCalculationsLeftEyeSequence uint32 = 0x00221310
+	// ReferencedOphthalmicAxialLengthMeasurementQCImageSequence (0022,1330) SQ
+	ReferencedOphthalmicAxialLengthMeasurementQCImageSequence uint32 = 0x00221330
+	// OphthalmicMappingDeviceType (0022,1415) CS
+	OphthalmicMappingDeviceType uint32 = 0x00221415
+	// AcquisitionMethodCodeSequence (0022,1420) SQ
+	AcquisitionMethodCodeSequence uint32 = 0x00221420
+	// AcquisitionMethodAlgorithmSequence (0022,1423) SQ
+	AcquisitionMethodAlgorithmSequence uint32 = 0x00221423
+	// OphthalmicThicknessMapTypeCodeSequence (0022,1436) SQ
+	OphthalmicThicknessMapTypeCodeSequence uint32 = 0x00221436
+	// OphthalmicThicknessMappingNormalsSequence (0022,1443) SQ
+	OphthalmicThicknessMappingNormalsSequence uint32 = 0x00221443
+	// RetinalThicknessDefinitionCodeSequence (0022,1445) SQ
+	RetinalThicknessDefinitionCodeSequence uint32 = 0x00221445
+	// PixelValueMappingToCodedConceptSequence (0022,1450) SQ
+	PixelValueMappingToCodedConceptSequence uint32 = 0x00221450
+	// MappedPixelValue (0022,1452) US
+	MappedPixelValue uint32 = 0x00221452
+	// PixelValueMappingExplanation (0022,1454) LO
+	PixelValueMappingExplanation uint32 = 0x00221454
+	// OphthalmicThicknessMapQualityThresholdSequence (0022,1458) SQ
+	OphthalmicThicknessMapQualityThresholdSequence uint32 = 0x00221458
+	// OphthalmicThicknessMapThresholdQualityRating (0022,1460) FL
+	OphthalmicThicknessMapThresholdQualityRating uint32 = 0x00221460
+	// AnatomicStructureReferencePoint (0022,1463) FL
+	AnatomicStructureReferencePoint uint32 = 0x00221463
+	// RegistrationToLocalizerSequence (0022,1465) SQ
+	RegistrationToLocalizerSequence uint32 = 0x00221465
+	// RegisteredLocalizerUnits (0022,1466) CS
+	RegisteredLocalizerUnits uint32 = 0x00221466
+	// RegisteredLocalizerTopLeftHandCorner (0022,1467) FL
+	RegisteredLocalizerTopLeftHandCorner uint32 = 0x00221467
+	// RegisteredLocalizerBottomRightHandCorner (0022,1468) FL
+	RegisteredLocalizerBottomRightHandCorner uint32 = 0x00221468
+	// OphthalmicThicknessMapQualityRatingSequence (0022,1470) SQ
+	OphthalmicThicknessMapQualityRatingSequence uint32 = 0x00221470
+	// RelevantOPTAttributesSequence (0022,1472) SQ
+	RelevantOPTAttributesSequence uint32 = 0x00221472
+	// TransformationMethodCodeSequence (0022,1512) SQ
+	TransformationMethodCodeSequence uint32 = 0x00221512
+	// TransformationAlgorithmSequence (0022,1513) SQ
+	TransformationAlgorithmSequence uint32 = 0x00221513
+	// OphthalmicAxialLengthMethod (0022,1515) CS
+	OphthalmicAxialLengthMethod uint32 = 0x00221515
+	// OphthalmicFOV (0022,1517) FL
+	OphthalmicFOV uint32 = 0x00221517
+	// TwoDimensionalToThreeDimensionalMapSequence (0022,1518) SQ
+	TwoDimensionalToThreeDimensionalMapSequence uint32 = 0x00221518
+	// WideFieldOphthalmicPhotographyQualityRatingSequence (0022,1525) SQ
+	WideFieldOphthalmicPhotographyQualityRatingSequence uint32 = 0x00221525
+	// WideFieldOphthalmicPhotographyQualityThresholdSequence (0022,1526) SQ
+	WideFieldOphthalmicPhotographyQualityThresholdSequence uint32 = 0x00221526
+	// WideFieldOphthalmicPhotographyThresholdQualityRating (0022,1527) FL
+	WideFieldOphthalmicPhotographyThresholdQualityRating uint32 = 0x00221527
+	// XCoordinatesCenterPixelViewAngle (0022,1528) FL
+	XCoordinatesCenterPixelViewAngle uint32 = 0x00221528
+	// YCoordinatesCenterPixelViewAngle (0022,1529) FL
+	YCoordinatesCenterPixelViewAngle uint32 = 0x00221529
+	// NumberOfMapPoints (0022,1530) UL
+	NumberOfMapPoints uint32 = 0x00221530
+	// TwoDimensionalToThreeDimensionalMapData (0022,1531) OF
+	TwoDimensionalToThreeDimensionalMapData uint32 = 0x00221531
+	// DerivationAlgorithmSequence (0022,1612) SQ
+	DerivationAlgorithmSequence uint32 = 0x00221612
+	// OphthalmicImageTypeCodeSequence (0022,1615) SQ
+	OphthalmicImageTypeCodeSequence uint32 = 0x00221615
+	// OphthalmicImageTypeDescription (0022,1616) LO
+	OphthalmicImageTypeDescription uint32 = 0x00221616
+	// ScanPatternTypeCodeSequence (0022,1618) SQ
+	ScanPatternTypeCodeSequence uint32 = 0x00221618
+	// ReferencedSurfaceMeshIdentificationSequence (0022,1620) SQ
+	ReferencedSurfaceMeshIdentificationSequence uint32 = 0x00221620
+	// OphthalmicVolumetricPropertiesFlag (0022,1622) CS
+	OphthalmicVolumetricPropertiesFlag uint32 = 0x00221622
+	// OphthalmicAnatomicReferencePointXCoordinate (0022,1624) FL
+	OphthalmicAnatomicReferencePointXCoordinate uint32 = 0x00221624
+	// OphthalmicAnatomicReferencePointYCoordinate (0022,1626) FL
+	OphthalmicAnatomicReferencePointYCoordinate uint32 = 0x00221626
+	// OphthalmicEnFaceImageQualityRatingSequence (0022,1628) SQ
+	OphthalmicEnFaceImageQualityRatingSequence uint32 = 0x00221628
+	// QualityThreshold (0022,1630) DS
+	QualityThreshold uint32 = 0x00221630
+	// OCTBscanAnalysisAcquisitionParametersSequence (0022,1640) SQ
+	OCTBscanAnalysisAcquisitionParametersSequence uint32 = 0x00221640
+	// NumberofBscansPerFrame (0022,1642) UL
+	NumberofBscansPerFrame uint32 = 0x00221642
+	// BscanSlabThickness (0022,1643) FL
+	BscanSlabThickness uint32 = 0x00221643
+	// DistanceBetweenBscanSlabs (0022,1644) FL
+	DistanceBetweenBscanSlabs uint32 = 0x00221644
+	// BscanCycleTime (0022,1645) FL
+	BscanCycleTime uint32 = 0x00221645
+	// BscanCycleTimeVector (0022,1646) FL
+	BscanCycleTimeVector uint32 = 0x00221646
+	// AscanRate (0022,1649) FL
+	AscanRate uint32 = 0x00221649
+	// BscanRate (0022,1650) FL
+	BscanRate uint32 = 0x00221650
+	// SurfaceMeshZPixelOffset (0022,1658) UL
+	SurfaceMeshZPixelOffset uint32 = 0x00221658
+	// VisualFieldHorizontalExtent (0024,0010) FL
+	VisualFieldHorizontalExtent uint32 = 0x00240010
+	// VisualFieldVerticalExtent (0024,0011) FL
+	VisualFieldVerticalExtent uint32 = 0x00240011
+	// VisualFieldShape (0024,0012) CS
+	VisualFieldShape uint32 = 0x00240012
+	// ScreeningTestModeCodeSequence (0024,0016) SQ
+	ScreeningTestModeCodeSequence uint32 = 0x00240016
+	// MaximumStimulusLuminance (0024,0018) FL
+	MaximumStimulusLuminance uint32 = 0x00240018
+	// BackgroundLuminance (0024,0020) FL
+	BackgroundLuminance uint32 = 0x00240020
+	// StimulusColorCodeSequence (0024,0021) SQ
+	StimulusColorCodeSequence uint32 = 0x00240021
+	// BackgroundIlluminationColorCodeSequence (0024,0024) SQ
+	BackgroundIlluminationColorCodeSequence uint32 = 0x00240024
+	// StimulusArea (0024,0025) FL
+	StimulusArea uint32 = 0x00240025
+	// StimulusPresentationTime (0024,0028) FL
+	StimulusPresentationTime uint32 = 0x00240028
+	// FixationSequence (0024,0032) SQ
+	FixationSequence uint32 = 0x00240032
+	// FixationMonitoringCodeSequence (0024,0033) SQ
+	FixationMonitoringCodeSequence uint32 = 0x00240033
+	// VisualFieldCatchTrialSequence (0024,0034) SQ
+	VisualFieldCatchTrialSequence uint32 = 0x00240034
+	// FixationCheckedQuantity (0024,0035) US
+	FixationCheckedQuantity uint32 = 0x00240035
+	// PatientNotProperlyFixatedQuantity (0024,0036) US
+	PatientNotProperlyFixatedQuantity uint32 = 0x00240036
+	// PresentedVisualStimuliDataFlag (0024,0037) CS
+	PresentedVisualStimuliDataFlag uint32 = 0x00240037
+	// NumberOfVisualStimuli (0024,0038) US
+	NumberOfVisualStimuli uint32 = 0x00240038
+	// ExcessiveFixationLossesDataFlag (0024,0039) CS
+	ExcessiveFixationLossesDataFlag uint32 = 0x00240039
+	// ExcessiveFixationLosses (0024,0040) CS
+	ExcessiveFixationLosses uint32 = 0x00240040
+	// StimuliRetestingQuantity (0024,0042) US
+	StimuliRetestingQuantity uint32 = 0x00240042
+	// CommentsOnPatientPerformanceOfVisualField (0024,0044) LT
+	CommentsOnPatientPerformanceOfVisualField uint32 = 0x00240044
+	// FalseNegativesEstimateFlag (0024,0045) CS
+	FalseNegativesEstimateFlag uint32 = 0x00240045
+	// FalseNegativesEstimate (0024,0046) FL
+	FalseNegativesEstimate uint32 = 0x00240046
+	// NegativeCatchTrialsQuantity (0024,0048) US
+	NegativeCatchTrialsQuantity uint32 = 0x00240048
+	// FalseNegativesQuantity (0024,0050) US
+	FalseNegativesQuantity uint32 = 0x00240050
+	// ExcessiveFalseNegativesDataFlag (0024,0051) CS
+	ExcessiveFalseNegativesDataFlag uint32 = 0x00240051
+	// ExcessiveFalseNegatives (0024,0052) CS
+	ExcessiveFalseNegatives uint32 = 0x00240052
+	// FalsePositivesEstimateFlag (0024,0053) CS
+	FalsePositivesEstimateFlag uint32 = 0x00240053
+	// FalsePositivesEstimate (0024,0054) FL
+	FalsePositivesEstimate uint32 = 0x00240054
+	// CatchTrialsDataFlag (0024,0055) CS
+	CatchTrialsDataFlag uint32 = 0x00240055
+	// PositiveCatchTrialsQuantity (0024,0056) US
+	PositiveCatchTrialsQuantity uint32 = 0x00240056
+	// TestPointNormalsDataFlag (0024,0057) CS
+	TestPointNormalsDataFlag uint32 = 0x00240057
+	// TestPointNormalsSequence (0024,0058) SQ
+	TestPointNormalsSequence uint32 = 0x00240058
+	// GlobalDeviationProbabilityNormalsFlag (0024,0059) CS
+	GlobalDeviationProbabilityNormalsFlag uint32 = 0x00240059
+	// FalsePositivesQuantity (0024,0060) US
+	FalsePositivesQuantity uint32 = 0x00240060
+	// ExcessiveFalsePositivesDataFlag (0024,0061) CS
+	ExcessiveFalsePositivesDataFlag uint32 = 0x00240061
+	// ExcessiveFalsePositives (0024,0062) CS
+	ExcessiveFalsePositives uint32 = 0x00240062
+	// VisualFieldTestNormalsFlag (0024,0063) CS
+	VisualFieldTestNormalsFlag uint32 = 0x00240063
+	// ResultsNormalsSequence (0024,0064) SQ
+	ResultsNormalsSequence uint32 = 0x00240064
+	// AgeCorrectedSensitivityDeviationAlgorithmSequence (0024,0065) SQ
+	AgeCorrectedSensitivityDeviationAlgorithmSequence uint32 = 0x00240065
+	// GlobalDeviationFromNormal (0024,0066) FL
+	GlobalDeviationFromNormal uint32 = 0x00240066
+	// GeneralizedDefectSensitivityDeviationAlgorithmSequence (0024,0067) SQ
+	GeneralizedDefectSensitivityDeviationAlgorithmSequence uint32 = 0x00240067
+	// LocalizedDeviationFromNormal (0024,0068) FL
+	LocalizedDeviationFromNormal uint32 = 0x00240068
+	// PatientReliabilityIndicator (0024,0069) LO
+	PatientReliabilityIndicator uint32 = 0x00240069
+	// VisualFieldMeanSensitivity (0024,0070) FL
+	VisualFieldMeanSensitivity uint32 = 0x00240070
+	// GlobalDeviationProbability (0024,0071) FL
+	GlobalDeviationProbability uint32 = 0x00240071
+	// LocalDeviationProbabilityNormalsFlag (0024,0072) CS
+	LocalDeviationProbabilityNormalsFlag uint32 = 0x00240072
+	// LocalizedDeviationProbability (0024,0073) FL
+	LocalizedDeviationProbability uint32 = 0x00240073
+	// ShortTermFluctuationCalculated (0024,0074) CS
+	ShortTermFluctuationCalculated uint32 = 0x00240074
+	// ShortTermFluctuation (0024,0075) FL
+	ShortTermFluctuation uint32 = 0x00240075
+	// ShortTermFluctuationProbabilityCalculated (0024,0076) CS
+	ShortTermFluctuationProbabilityCalculated uint32 = 0x00240076
+	// ShortTermFluctuationProbability (0024,0077) FL
+	ShortTermFluctuationProbability uint32 = 0x00240077
+	// CorrectedLocalizedDeviationFromNormalCalculated (0024,0078) CS
+	CorrectedLocalizedDeviationFromNormalCalculated uint32 = 0x00240078
+	// CorrectedLocalizedDeviationFromNormal (0024,0079) FL
+	CorrectedLocalizedDeviationFromNormal uint32 = 0x00240079
+	// CorrectedLocalizedDeviationFromNormalProbabilityCalculated (0024,0080) CS
+	CorrectedLocalizedDeviationFromNormalProbabilityCalculated uint32 = 0x00240080
+	// CorrectedLocalizedDeviationFromNormalProbability (0024,0081) FL
+	CorrectedLocalizedDeviationFromNormalProbability uint32 = 0x00240081
+	// GlobalDeviationProbabilitySequence (0024,0083) SQ
+	GlobalDeviationProbabilitySequence uint32 = 0x00240083
+	// LocalizedDeviationProbabilitySequence (0024,0085) SQ
+	LocalizedDeviationProbabilitySequence uint32 = 0x00240085
+	// FovealSensitivityMeasured (0024,0086) CS
+	FovealSensitivityMeasured uint32 = 0x00240086
+	// FovealSensitivity (0024,0087) FL
+	FovealSensitivity uint32 = 0x00240087
+	// VisualFieldTestDuration (0024,0088) FL
+	VisualFieldTestDuration uint32 = 0x00240088
+	// VisualFieldTestPointSequence (0024,0089) SQ
+	VisualFieldTestPointSequence uint32 = 0x00240089
+	// VisualFieldTestPointXCoordinate (0024,0090) FL
+	VisualFieldTestPointXCoordinate uint32 = 0x00240090
+	// VisualFieldTestPointYCoordinate (0024,0091) FL
+	VisualFieldTestPointYCoordinate uint32 = 0x00240091
+	// AgeCorrectedSensitivityDeviationValue (0024,0092) FL
+	AgeCorrectedSensitivityDeviationValue uint32 = 0x00240092
+	// StimulusResults (0024,0093) CS
+	StimulusResults uint32 = 0x00240093
+	// SensitivityValue (0024,0094) FL
+	SensitivityValue uint32 = 0x00240094
+	// RetestStimulusSeen (0024,0095) CS
+	RetestStimulusSeen uint32 = 0x00240095
+	// RetestSensitivityValue (0024,0096) FL
+	RetestSensitivityValue uint32 = 0x00240096
+	// VisualFieldTestPointNormalsSequence (0024,0097) SQ
+	VisualFieldTestPointNormalsSequence uint32 = 0x00240097
+	// QuantifiedDefect (0024,0098) FL
+	QuantifiedDefect uint32 = 0x00240098
+	// AgeCorrectedSensitivityDeviationProbabilityValue (0024,0100) FL
+	AgeCorrectedSensitivityDeviationProbabilityValue uint32 = 0x00240100
+	// GeneralizedDefectCorrectedSensitivityDeviationFlag (0024,0102) CS
+	GeneralizedDefectCorrectedSensitivityDeviationFlag uint32 = 0x00240102
+	// GeneralizedDefectCorrectedSensitivityDeviationValue (0024,0103) FL
+	GeneralizedDefectCorrectedSensitivityDeviationValue uint32 = 0x00240103
+	// GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue (0024,0104) FL
+	GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue uint32 = 0x00240104
+	// MinimumSensitivityValue (0024,0105) FL
+	MinimumSensitivityValue uint32 = 0x00240105
+	// BlindSpotLocalized (0024,0106) CS
+	BlindSpotLocalized uint32 = 0x00240106
+	// BlindSpotXCoordinate (0024,0107) FL
+	BlindSpotXCoordinate uint32 = 0x00240107
+	// BlindSpotYCoordinate (0024,0108) FL
+	BlindSpotYCoordinate uint32 = 0x00240108
+	// VisualAcuityMeasurementSequence (0024,0110) SQ
+	VisualAcuityMeasurementSequence uint32 = 0x00240110
+	// RefractiveParametersUsedOnPatientSequence (0024,0112) SQ
+	RefractiveParametersUsedOnPatientSequence uint32 = 0x00240112
+	// MeasurementLaterality (0024,0113) CS
+	MeasurementLaterality uint32 = 0x00240113
+	// OphthalmicPatientClinicalInformationLeftEyeSequence (0024,0114) SQ
+	OphthalmicPatientClinicalInformationLeftEyeSequence uint32 = 0x00240114
+	// OphthalmicPatientClinicalInformationRightEyeSequence (0024,0115) SQ
+	OphthalmicPatientClinicalInformationRightEyeSequence uint32 = 0x00240115
+	// FovealPointNormativeDataFlag (0024,0117) CS
+	FovealPointNormativeDataFlag uint32 = 0x00240117
+	// FovealPointProbabilityValue (0024,0118) FL
+	FovealPointProbabilityValue uint32 = 0x00240118
+	// ScreeningBaselineMeasured (0024,0120) CS
+	ScreeningBaselineMeasured uint32 = 0x00240120
+	// ScreeningBaselineMeasuredSequence (0024,0122) SQ
+	ScreeningBaselineMeasuredSequence uint32 = 0x00240122
+	// ScreeningBaselineType (0024,0124) CS
+	ScreeningBaselineType uint32 = 0x00240124
+	// ScreeningBaselineValue (0024,0126) FL
+	ScreeningBaselineValue uint32 = 0x00240126
+	// AlgorithmSource (0024,0202) LO
+	AlgorithmSource uint32 = 0x00240202
+	// DataSetName (0024,0306) LO
+	DataSetName uint32 = 0x00240306
+	// DataSetVersion (0024,0307) LO
+	DataSetVersion uint32 = 0x00240307
+	// DataSetSource (0024,0308) LO
+	DataSetSource uint32 = 0x00240308
+	// DataSetDescription (0024,0309) LO
+	DataSetDescription uint32 = 0x00240309
+	// VisualFieldTestReliabilityGlobalIndexSequence (0024,0317) SQ
+	VisualFieldTestReliabilityGlobalIndexSequence uint32 = 0x00240317
+	// VisualFieldGlobalResultsIndexSequence (0024,0320) SQ
+	VisualFieldGlobalResultsIndexSequence uint32 = 0x00240320
+	// DataObservationSequence (0024,0325) SQ
+	DataObservationSequence uint32 = 0x00240325
+	// IndexNormalsFlag (0024,0338) CS
+	IndexNormalsFlag uint32 = 0x00240338
+	// IndexProbability (0024,0341) FL
+	IndexProbability uint32 = 0x00240341
+	// IndexProbabilitySequence (0024,0344) SQ
+	IndexProbabilitySequence uint32 = 0x00240344
+	// SamplesPerPixel (0028,0002) US
+	SamplesPerPixel uint32 = 0x00280002
+	// SamplesPerPixelUsed (0028,0003) US
+	SamplesPerPixelUsed uint32 = 0x00280003
+	// PhotometricInterpretation (0028,0004) CS
+	PhotometricInterpretation uint32 = 0x00280004
+	// ImageDimensions (0028,0005) US, retired
+	ImageDimensions uint32 = 0x00280005
+	// PlanarConfiguration (0028,0006) US
+	PlanarConfiguration uint32 = 0x00280006
+	// NumberOfFrames (0028,0008) IS
+	NumberOfFrames uint32 = 0x00280008
+	// FrameIncrementPointer (0028,0009) AT
+	FrameIncrementPointer uint32 = 0x00280009
+	// FrameDimensionPointer (0028,000A) AT
+	FrameDimensionPointer uint32 = 0x0028000A
+	// Rows (0028,0010) US
+	Rows uint32 = 0x00280010
+	// Columns (0028,0011) US
+	Columns uint32 = 0x00280011
+	// Planes (0028,0012) US, retired
+	Planes uint32 = 0x00280012
+	// UltrasoundColorDataPresent (0028,0014) US
+	UltrasoundColorDataPresent uint32 = 0x00280014
+	// PixelSpacing (0028,0030) DS
+	PixelSpacing uint32 = 0x00280030
+	// ZoomFactor (0028,0031) DS
+	ZoomFactor uint32 = 0x00280031
+	// ZoomCenter (0028,0032) DS
+	ZoomCenter uint32 = 0x00280032
+	// PixelAspectRatio (0028,0034) IS
+	PixelAspectRatio uint32 = 0x00280034
+	// ImageFormat (0028,0040) CS, retired
+	ImageFormat uint32 = 0x00280040
+	// ManipulatedImage (0028,0050) LO, retired
+	ManipulatedImage uint32 = 0x00280050
+	// CorrectedImage (0028,0051) CS
+	CorrectedImage uint32 = 0x00280051
+	// CompressionRecognitionCode (0028,005F) LO, retired
+	CompressionRecognitionCode uint32 = 0x0028005F
+	// CompressionCode (0028,0060) CS, retired
+	CompressionCode uint32 = 0x00280060
+	// CompressionOriginator (0028,0061) SH, retired
+	CompressionOriginator uint32 = 0x00280061
+	// CompressionLabel (0028,0062) LO, retired
+	CompressionLabel uint32 = 0x00280062
+	// CompressionDescription (0028,0063) SH, retired
+	CompressionDescription uint32 = 0x00280063
+	// CompressionSequence (0028,0065) CS, retired
+	CompressionSequence uint32 = 0x00280065
+	// CompressionStepPointers (0028,0066) AT, retired
+	CompressionStepPointers uint32 = 0x00280066
+	// RepeatInterval (0028,0068) US, retired
+	RepeatInterval uint32 = 0x00280068
+	// BitsGrouped (0028,0069) US, retired
+	BitsGrouped uint32 = 0x00280069
+	// PerimeterTable (0028,0070) US, retired
+	PerimeterTable uint32 = 0x00280070
+	// PerimeterValue (0028,0071) US, retired
+	PerimeterValue uint32 = 0x00280071
+	// PredictorRows (0028,0080) US, retired
+	PredictorRows uint32 = 0x00280080
+	// PredictorColumns (0028,0081) US, retired
+	PredictorColumns uint32 = 0x00280081
+	// PredictorConstants (0028,0082) US, retired
+	PredictorConstants uint32 = 0x00280082
+	// BlockedPixels (0028,0090) CS, retired
+	BlockedPixels uint32 = 0x00280090
+	// BlockRows (0028,0091) US, retired
+	BlockRows uint32 = 0x00280091
+	// BlockColumns (0028,0092) US, retired
+	BlockColumns uint32 = 0x00280092
+	// RowOverlap (0028,0093) US, retired
+	RowOverlap uint32 = 0x00280093
+	// ColumnOverlap (0028,0094) US, retired
+	ColumnOverlap uint32 = 0x00280094
+	// BitsAllocated (0028,0100) US
+	BitsAllocated uint32 = 0x00280100
+	// BitsStored (0028,0101) US
+	BitsStored uint32 = 0x00280101
+	// HighBit (0028,0102) US
+	HighBit uint32 = 0x00280102
+	// PixelRepresentation (0028,0103) US
+	PixelRepresentation uint32 = 0x00280103
+	// SmallestValidPixelValue (0028,0104) US, retired
+	SmallestValidPixelValue uint32 = 0x00280104
+	// LargestValidPixelValue (0028,0105) US, retired
+	LargestValidPixelValue uint32 = 0x00280105
+	// SmallestImagePixelValue (0028,0106) US
+	SmallestImagePixelValue uint32 = 0x00280106
+	// LargestImagePixelValue (0028,0107) US
+	LargestImagePixelValue uint32 = 0x00280107
+	// SmallestPixelValueInSeries (0028,0108) US
+	SmallestPixelValueInSeries uint32 = 0x00280108
+	// LargestPixelValueInSeries (0028,0109) US
+	LargestPixelValueInSeries uint32 = 0x00280109
+	// SmallestImagePixelValueInPlane (0028,0110) US, retired
+	SmallestImagePixelValueInPlane uint32 = 0x00280110
+	// LargestImagePixelValueInPlane (0028,0111) US, retired
+	LargestImagePixelValueInPlane uint32 = 0x00280111
+	// PixelPaddingValue (0028,0120) US
+	PixelPaddingValue uint32 = 0x00280120
+	// PixelPaddingRangeLimit (0028,0121) US
+	PixelPaddingRangeLimit uint32 = 0x00280121
+	// FloatPixelPaddingValue (0028,0122) FL
+	FloatPixelPaddingValue uint32 = 0x00280122
+	// DoubleFloatPixelPaddingValue (0028,0123) FD
+	DoubleFloatPixelPaddingValue uint32 = 0x00280123
+	// FloatPixelPaddingRangeLimit (0028,0124) FL
+	FloatPixelPaddingRangeLimit uint32 = 0x00280124
+	// DoubleFloatPixelPaddingRangeLimit (0028,0125) FD
+	DoubleFloatPixelPaddingRangeLimit uint32 = 0x00280125
+	// ImageLocation (0028,0200) US, retired
+	ImageLocation uint32 = 0x00280200
+	// QualityControlImage (0028,0300) CS
+	QualityControlImage uint32 = 0x00280300
+	// BurnedInAnnotation (0028,0301) CS
+	BurnedInAnnotation uint32 = 0x00280301
+	// RecognizableVisualFeatures (0028,0302) CS
+	RecognizableVisualFeatures uint32 = 0x00280302
+	// LongitudinalTemporalInformationModified (0028,0303) CS
+	LongitudinalTemporalInformationModified uint32 = 0x00280303
+	// ReferencedColorPaletteInstanceUID (0028,0304) UI
+	ReferencedColorPaletteInstanceUID uint32 = 0x00280304
+	// TransformLabel (0028,0400) LO, retired
+	TransformLabel uint32 = 0x00280400
+	// TransformVersionNumber (0028,0401) LO, retired
+	TransformVersionNumber uint32 = 0x00280401
+	// NumberOfTransformSteps (0028,0402) US, retired
+	NumberOfTransformSteps uint32 = 0x00280402
+	// SequenceOfCompressedData (0028,0403) LO, retired
+	SequenceOfCompressedData uint32 = 0x00280403
+	// DetailsOfCoefficients (0028,0404) AT, retired
+	DetailsOfCoefficients uint32 = 0x00280404
+	// DCTLabel (0028,0700) LO, retired
+	DCTLabel uint32 = 0x00280700
+	// DataBlockDescription (0028,0701) CS, retired
+	DataBlockDescription uint32 = 0x00280701
+	// DataBlock (0028,0702) AT, retired
+	DataBlock uint32 = 0x00280702
+	// NormalizationFactorFormat (0028,0710) US, retired
+	NormalizationFactorFormat uint32 = 0x00280710
+	// ZonalMapNumberFormat (0028,0720) US, retired
+	ZonalMapNumberFormat uint32 = 0x00280720
+	// ZonalMapLocation (0028,0721) AT, retired
+	ZonalMapLocation uint32 = 0x00280721
+	// ZonalMapFormat (0028,0722) US, retired
+	ZonalMapFormat uint32 = 0x00280722
+	// AdaptiveMapFormat (0028,0730) US, retired
+	AdaptiveMapFormat uint32 = 0x00280730
+	// CodeNumberFormat (0028,0740) US, retired
+	CodeNumberFormat uint32 = 0x00280740
+	// PixelSpacingCalibrationType (0028,0A02) CS
+	PixelSpacingCalibrationType uint32 = 0x00280A02
+	// PixelSpacingCalibrationDescription (0028,0A04) LO
+	PixelSpacingCalibrationDescription uint32 = 0x00280A04
+	// PixelIntensityRelationship (0028,1040) CS
+	PixelIntensityRelationship uint32 = 0x00281040
+	// PixelIntensityRelationshipSign (0028,1041) SS
+	PixelIntensityRelationshipSign uint32 = 0x00281041
+	// WindowCenter (0028,1050) DS
+	WindowCenter uint32 = 0x00281050
+	// WindowWidth (0028,1051) DS
+	WindowWidth uint32 = 0x00281051
+	// RescaleIntercept (0028,1052) DS
+	RescaleIntercept uint32 = 0x00281052
+	// RescaleSlope (0028,1053) DS
+	RescaleSlope uint32 = 0x00281053
+	// RescaleType (0028,1054) LO
+	RescaleType uint32 = 0x00281054
+	// WindowCenterWidthExplanation (0028,1055) LO
+	WindowCenterWidthExplanation uint32 = 0x00281055
+	// VOILUTFunction (0028,1056) CS
+	VOILUTFunction uint32 = 0x00281056
+	// GrayScale (0028,1080) CS, retired
+	GrayScale uint32 = 0x00281080
+	// RecommendedViewingMode (0028,1090) CS
+	RecommendedViewingMode uint32 = 0x00281090
+	// GrayLookupTableDescriptor (0028,1100) US, retired
+	GrayLookupTableDescriptor uint32 = 0x00281100
+	// RedPaletteColorLookupTableDescriptor (0028,1101) US
+	RedPaletteColorLookupTableDescriptor uint32 = 0x00281101
+	// GreenPaletteColorLookupTableDescriptor (0028,1102) US
+	GreenPaletteColorLookupTableDescriptor uint32 = 0x00281102
+	// BluePaletteColorLookupTableDescriptor (0028,1103) US
+	BluePaletteColorLookupTableDescriptor uint32 = 0x00281103
+	// AlphaPaletteColorLookupTableDescriptor (0028,1104) US
+	AlphaPaletteColorLookupTableDescriptor uint32 = 0x00281104
+	// LargeRedPaletteColorLookupTableDescriptor (0028,1111) US, retired
+	LargeRedPaletteColorLookupTableDescriptor uint32 = 0x00281111
+	// LargeGreenPaletteColorLookupTableDescriptor (0028,1112) US, retired
+	LargeGreenPaletteColorLookupTableDescriptor uint32 = 0x00281112
+	// LargeBluePaletteColorLookupTableDescriptor (0028,1113) US, retired
+	LargeBluePaletteColorLookupTableDescriptor uint32 = 0x00281113
+	// PaletteColorLookupTableUID (0028,1199) UI
+	PaletteColorLookupTableUID uint32 = 0x00281199
+	// GrayLookupTableData (0028,1200) US, retired
+	GrayLookupTableData uint32 = 0x00281200
+	// RedPaletteColorLookupTableData (0028,1201) OW
+	RedPaletteColorLookupTableData uint32 = 0x00281201
+	// GreenPaletteColorLookupTableData (0028,1202) OW
+	GreenPaletteColorLookupTableData uint32 = 0x00281202
+	// BluePaletteColorLookupTableData (0028,1203) OW
+	BluePaletteColorLookupTableData uint32 = 0x00281203
+	// AlphaPaletteColorLookupTableData (0028,1204) OW
+	AlphaPaletteColorLookupTableData uint32 = 0x00281204
+	// LargeRedPaletteColorLookupTableData (0028,1211) OW, retired
+	LargeRedPaletteColorLookupTableData uint32 = 0x00281211
+	// LargeGreenPaletteColorLookupTableData (0028,1212) OW, retired
+	LargeGreenPaletteColorLookupTableData uint32 = 0x00281212
+	// LargeBluePaletteColorLookupTableData (0028,1213) OW, retired
+	LargeBluePaletteColorLookupTableData uint32 = 0x00281213
+	// LargePaletteColorLookupTableUID (0028,1214) UI, retired
+	LargePaletteColorLookupTableUID uint32 = 0x00281214
+	// SegmentedRedPaletteColorLookupTableData (0028,1221) OW
+	SegmentedRedPaletteColorLookupTableData uint32 = 0x00281221
+	// SegmentedGreenPaletteColorLookupTableData (0028,1222) OW
+	SegmentedGreenPaletteColorLookupTableData uint32 = 0x00281222
+	// SegmentedBluePaletteColorLookupTableData (0028,1223) OW
+	SegmentedBluePaletteColorLookupTableData uint32 = 0x00281223
+	// SegmentedAlphaPaletteColorLookupTableData (0028,1224) OW
+	SegmentedAlphaPaletteColorLookupTableData uint32 = 0x00281224
+	// StoredValueColorRangeSequence (0028,1230) SQ
+	StoredValueColorRangeSequence uint32 = 0x00281230
+	// MinimumStoredValueMapped (0028,1231) FD
+	MinimumStoredValueMapped uint32 = 0x00281231
+	// MaximumStoredValueMapped (0028,1232) FD
+	MaximumStoredValueMapped uint32 = 0x00281232
+	// BreastImplantPresent (0028,1300) CS
+	BreastImplantPresent uint32 = 0x00281300
+	// PartialView (0028,1350) CS
+	PartialView uint32 = 0x00281350
+	// PartialViewDescription (0028,1351) ST
+	PartialViewDescription uint32 = 0x00281351
+	// PartialViewCodeSequence (0028,1352) SQ
+	PartialViewCodeSequence uint32 = 0x00281352
+	// SpatialLocationsPreserved (0028,135A) CS
+	SpatialLocationsPreserved uint32 = 0x0028135A
+	// DataFrameAssignmentSequence (0028,1401) SQ
+	DataFrameAssignmentSequence uint32 = 0x00281401
+	// DataPathAssignment (0028,1402) CS
+	DataPathAssignment uint32 = 0x00281402
+	// BitsMappedToColorLookupTable (0028,1403) US
+	BitsMappedToColorLookupTable uint32 = 0x00281403
+	// BlendingLUT1Sequence (0028,1404) SQ
+	BlendingLUT1Sequence uint32 = 0x00281404
+	// BlendingLUT1TransferFunction (0028,1405) CS
+	BlendingLUT1TransferFunction uint32 = 0x00281405
+	// BlendingWeightConstant (0028,1406) FD
+	BlendingWeightConstant uint32 = 0x00281406
+	// BlendingLookupTableDescriptor (0028,1407) US
+	BlendingLookupTableDescriptor uint32 = 0x00281407
+	// BlendingLookupTableData (0028,1408) OW
+	BlendingLookupTableData uint32 = 0x00281408
+	// EnhancedPaletteColorLookupTableSequence (0028,140B) SQ
+	EnhancedPaletteColorLookupTableSequence uint32 = 0x0028140B
+	// BlendingLUT2Sequence (0028,140C) SQ
+	BlendingLUT2Sequence uint32 = 0x0028140C
+	// BlendingLUT2TransferFunction (0028,140D) CS
+	BlendingLUT2TransferFunction uint32 = 0x0028140D
+	// DataPathID (0028,140E) CS
+	DataPathID uint32 = 0x0028140E
+	// RGBLUTTransferFunction (0028,140F) CS
+	RGBLUTTransferFunction uint32 = 0x0028140F
+	// AlphaLUTTransferFunction (0028,1410) CS
+	AlphaLUTTransferFunction uint32 = 0x00281410
+	// ICCProfile (0028,2000) OB
+	ICCProfile uint32 = 0x00282000
+	// ColorSpace (0028,2002) CS
+	ColorSpace uint32 = 0x00282002
+	// LossyImageCompression (0028,2110) CS
+	LossyImageCompression uint32 = 0x00282110
+	// LossyImageCompressionRatio (0028,2112) DS
+	LossyImageCompressionRatio uint32 = 0x00282112
+	// LossyImageCompressionMethod (0028,2114) CS
+	LossyImageCompressionMethod uint32 = 0x00282114
+	// ModalityLUTSequence (0028,3000) SQ
+	ModalityLUTSequence uint32 = 0x00283000
+	// LUTDescriptor (0028,3002) US
+	LUTDescriptor uint32 = 0x00283002
+	// LUTExplanation (0028,3003) LO
+	LUTExplanation uint32 = 0x00283003
+	// ModalityLUTType (0028,3004) LO
+	ModalityLUTType uint32 = 0x00283004
+	// LUTData (0028,3006) US
+	LUTData uint32 = 0x00283006
+	// VOILUTSequence (0028,3010) SQ
+	VOILUTSequence uint32 = 0x00283010
+	// SoftcopyVOILUTSequence (0028,3110) SQ
+	SoftcopyVOILUTSequence uint32 = 0x00283110
+	// ImagePresentationComments (0028,4000) LT, retired
+	ImagePresentationComments uint32 = 0x00284000
+	// BiPlaneAcquisitionSequence (0028,5000) SQ, retired
+	BiPlaneAcquisitionSequence uint32 = 0x00285000
+	// RepresentativeFrameNumber (0028,6010) US
+	RepresentativeFrameNumber uint32 = 0x00286010
+	// FrameNumbersOfInterest (0028,6020) US
+	FrameNumbersOfInterest uint32 = 0x00286020
+	// FrameOfInterestDescription (0028,6022) LO
+	FrameOfInterestDescription uint32 = 0x00286022
+	// FrameOfInterestType (0028,6023) CS
+	FrameOfInterestType uint32 = 0x00286023
+	// MaskPointers (0028,6030) US, retired
+	MaskPointers uint32 = 0x00286030
+	// RWavePointer (0028,6040) US
+	RWavePointer uint32 = 0x00286040
+	// MaskSubtractionSequence (0028,6100) SQ
+	MaskSubtractionSequence uint32 = 0x00286100
+	// MaskOperation (0028,6101) CS
+	MaskOperation uint32 = 0x00286101
+	// ApplicableFrameRange (0028,6102) US
+	ApplicableFrameRange uint32 = 0x00286102
+	// MaskFrameNumbers (0028,6110) US
+	MaskFrameNumbers uint32 = 0x00286110
+	// ContrastFrameAveraging (0028,6112) US
+	ContrastFrameAveraging uint32 = 0x00286112
+	// MaskSubPixelShift (0028,6114) FL
+	MaskSubPixelShift uint32 = 0x00286114
+	// TIDOffset (0028,6120) SS
+	TIDOffset uint32 = 0x00286120
+	// MaskOperationExplanation (0028,6190) ST
+	MaskOperationExplanation uint32 = 0x00286190
+	// EquipmentAdministratorSequence (0028,7000) SQ
+	EquipmentAdministratorSequence uint32 = 0x00287000
+	// NumberOfDisplaySubsystems (0028,7001) US
+	NumberOfDisplaySubsystems uint32 = 0x00287001
+	// CurrentConfigurationID (0028,7002) US
+	CurrentConfigurationID uint32 = 0x00287002
+	// DisplaySubsystemID (0028,7003) US
+	DisplaySubsystemID uint32 = 0x00287003
+	// DisplaySubsystemName (0028,7004) SH
+	DisplaySubsystemName uint32 = 0x00287004
+	// DisplaySubsystemDescription (0028,7005) LO
+	DisplaySubsystemDescription uint32 = 0x00287005
+	// SystemStatus (0028,7006) CS
+	SystemStatus uint32 = 0x00287006
+	// SystemStatusComment (0028,7007) LO
+	SystemStatusComment uint32 = 0x00287007
+	// TargetLuminanceCharacteristicsSequence (0028,7008) SQ
+	TargetLuminanceCharacteristicsSequence uint32 = 0x00287008
+	// LuminanceCharacteristicsID (0028,7009) US
+	LuminanceCharacteristicsID uint32 = 0x00287009
+	// DisplaySubsystemConfigurationSequence (0028,700A) SQ
+	DisplaySubsystemConfigurationSequence uint32 = 0x0028700A
+	// ConfigurationID (0028,700B) US
+	ConfigurationID uint32 = 0x0028700B
+	// ConfigurationName (0028,700C) SH
+	ConfigurationName uint32 = 0x0028700C
+	// ConfigurationDescription (0028,700D) LO
+	ConfigurationDescription uint32 = 0x0028700D
+	// ReferencedTargetLuminanceCharacteristicsID (0028,700E) US
+	ReferencedTargetLuminanceCharacteristicsID uint32 = 0x0028700E
+	// QAResultsSequence (0028,700F) SQ
+	QAResultsSequence uint32 = 0x0028700F
+	// DisplaySubsystemQAResultsSequence (0028,7010) SQ
+	DisplaySubsystemQAResultsSequence uint32 = 0x00287010
+	// ConfigurationQAResultsSequence (0028,7011) SQ
+	ConfigurationQAResultsSequence uint32 = 0x00287011
+	// MeasurementEquipmentSequence (0028,7012) SQ
+	MeasurementEquipmentSequence uint32 = 0x00287012
+	// MeasurementFunctions (0028,7013) CS
+	MeasurementFunctions uint32 = 0x00287013
+	// MeasurementEquipmentType (0028,7014) CS
+	MeasurementEquipmentType uint32 = 0x00287014
+	// VisualEvaluationResultSequence (0028,7015) SQ
+	VisualEvaluationResultSequence uint32 = 0x00287015
+	// DisplayCalibrationResultSequence (0028,7016) SQ
+	DisplayCalibrationResultSequence uint32 = 0x00287016
+	// DDLValue (0028,7017) US
+	DDLValue uint32 = 0x00287017
+	// CIExyWhitePoint (0028,7018) FL
+	CIExyWhitePoint uint32 = 0x00287018
+	// DisplayFunctionType (0028,7019) CS
+	DisplayFunctionType uint32 = 0x00287019
+	// GammaValue (0028,701A) FL
+	GammaValue uint32 = 0x0028701A
+	// NumberOfLuminancePoints (0028,701B) US
+	NumberOfLuminancePoints uint32 = 0x0028701B
+	// LuminanceResponseSequence (0028,701C) SQ
+	LuminanceResponseSequence uint32 = 0x0028701C
+	// TargetMinimumLuminance (0028,701D) FL
+	TargetMinimumLuminance uint32 = 0x0028701D
+	// TargetMaximumLuminance (0028,701E) FL
+	TargetMaximumLuminance uint32 = 0x0028701E
+	// LuminanceValue (0028,701F) FL
+	LuminanceValue uint32 = 0x0028701F
+	// LuminanceResponseDescription (0028,7020) LO
+	LuminanceResponseDescription uint32 = 0x00287020
+	// WhitePointFlag (0028,7021) CS
+	WhitePointFlag uint32 = 0x00287021
+	// DisplayDeviceTypeCodeSequence (0028,7022) SQ
+	DisplayDeviceTypeCodeSequence uint32 = 0x00287022
+	// DisplaySubsystemSequence (0028,7023) SQ
+	DisplaySubsystemSequence uint32 = 0x00287023
+	// LuminanceResultSequence (0028,7024) SQ
+	LuminanceResultSequence uint32 = 0x00287024
+	// AmbientLightValueSource (0028,7025) CS
+	AmbientLightValueSource uint32 = 0x00287025
+	// MeasuredCharacteristics (0028,7026) CS
+	MeasuredCharacteristics uint32 = 0x00287026
+	// LuminanceUniformityResultSequence (0028,7027) SQ
+	LuminanceUniformityResultSequence uint32 = 0x00287027
+	// VisualEvaluationTestSequence (0028,7028) SQ
+	VisualEvaluationTestSequence uint32 = 0x00287028
+	// TestResult (0028,7029) CS
+	TestResult uint32 = 0x00287029
+	// TestResultComment (0028,702A) LO
+	TestResultComment uint32 = 0x0028702A
+	// TestImageValidation (0028,702B) CS
+	TestImageValidation uint32 = 0x0028702B
+	// TestPatternCodeSequence (0028,702C) SQ
+	TestPatternCodeSequence uint32 = 0x0028702C
+	// MeasurementPatternCodeSequence (0028,702D) SQ
+	MeasurementPatternCodeSequence uint32 = 0x0028702D
+	// VisualEvaluationMethodCodeSequence (0028,702E) SQ
+	VisualEvaluationMethodCodeSequence uint32 = 0x0028702E
+	// PixelDataProviderURL (0028,7FE0) UR
+	PixelDataProviderURL uint32 = 0x00287FE0
+	// DataPointRows (0028,9001) UL
+	DataPointRows uint32 = 0x00289001
+	// DataPointColumns (0028,9002) UL
+	DataPointColumns uint32 = 0x00289002
+	// SignalDomainColumns (0028,9003) CS
+	SignalDomainColumns uint32 = 0x00289003
+	// LargestMonochromePixelValue (0028,9099) US, retired
+	LargestMonochromePixelValue uint32 = 0x00289099
+	// DataRepresentation (0028,9108) CS
+	DataRepresentation uint32 = 0x00289108
+	// PixelMeasuresSequence (0028,9110) SQ
+	PixelMeasuresSequence uint32 = 0x00289110
+	// FrameVOILUTSequence (0028,9132) SQ
+	FrameVOILUTSequence uint32 = 0x00289132
+	// PixelValueTransformationSequence (0028,9145) SQ
+	PixelValueTransformationSequence uint32 = 0x00289145
+	// SignalDomainRows (0028,9235) CS
+	SignalDomainRows uint32 = 0x00289235
+	// DisplayFilterPercentage (0028,9411) FL
+	DisplayFilterPercentage uint32 = 0x00289411
+	// FramePixelShiftSequence (0028,9415) SQ
+	FramePixelShiftSequence uint32 = 0x00289415
+	// SubtractionItemID (0028,9416) US
+	SubtractionItemID uint32 = 0x00289416
+	// PixelIntensityRelationshipLUTSequence (0028,9422) SQ
+	PixelIntensityRelationshipLUTSequence uint32 = 0x00289422
+	// FramePixelDataPropertiesSequence (0028,9443) SQ
+	FramePixelDataPropertiesSequence uint32 = 0x00289443
+	// GeometricalProperties (0028,9444) CS
+	GeometricalProperties uint32 = 0x00289444
+	// GeometricMaximumDistortion (0028,9445) FL
+	GeometricMaximumDistortion uint32 = 0x00289445
+	// ImageProcessingApplied (0028,9446) CS
+	ImageProcessingApplied uint32 = 0x00289446
+	// MaskSelectionMode (0028,9454) CS
+	MaskSelectionMode uint32 = 0x00289454
+	// LUTFunction (0028,9474) CS
+	LUTFunction uint32 = 0x00289474
+	// MaskVisibilityPercentage (0028,9478) FL
+	MaskVisibilityPercentage uint32 = 0x00289478
+	// PixelShiftSequence (0028,9501) SQ
+	PixelShiftSequence uint32 = 0x00289501
+	// RegionPixelShiftSequence (0028,9502) SQ
+	RegionPixelShiftSequence uint32 = 0x00289502
+	// VerticesOfTheRegion (0028,9503) SS
+	VerticesOfTheRegion uint32 = 0x00289503
+	// MultiFramePresentationSequence (0028,9505) SQ
+	MultiFramePresentationSequence uint32 = 0x00289505
+	// PixelShiftFrameRange (0028,9506) US
+	PixelShiftFrameRange uint32 = 0x00289506
+	// LUTFrameRange (0028,9507) US
+	LUTFrameRange uint32 = 0x00289507
+	// ImageToEquipmentMappingMatrix (0028,9520) DS
+	ImageToEquipmentMappingMatrix uint32 = 0x00289520
+	// EquipmentCoordinateSystemIdentification (0028,9537) CS
+	EquipmentCoordinateSystemIdentification uint32 = 0x00289537
+	// StudyStatusID (0032,000A) CS, retired
+	StudyStatusID uint32 = 0x0032000A
+	// StudyPriorityID (0032,000C) CS, retired
+	StudyPriorityID uint32 = 0x0032000C
+	// StudyIDIssuer (0032,0012) LO, retired
+	StudyIDIssuer uint32 = 0x00320012
+	// StudyVerifiedDate (0032,0032) DA, retired
+	StudyVerifiedDate uint32 = 0x00320032
+	// StudyVerifiedTime (0032,0033) TM, retired
+	StudyVerifiedTime uint32 = 0x00320033
+	// StudyReadDate (0032,0034) DA, retired
+	StudyReadDate uint32 = 0x00320034
+	// StudyReadTime (0032,0035) TM, retired
+	StudyReadTime uint32 = 0x00320035
+	// ScheduledStudyStartDate (0032,1000) DA, retired
+	ScheduledStudyStartDate uint32 = 0x00321000
+	// ScheduledStudyStartTime (0032,1001) TM, retired
+	ScheduledStudyStartTime uint32 = 0x00321001
+	// ScheduledStudyStopDate (0032,1010) DA, retired
+	ScheduledStudyStopDate uint32 = 0x00321010
+	// ScheduledStudyStopTime (0032,1011) TM, retired
+	ScheduledStudyStopTime uint32 = 0x00321011
+	// ScheduledStudyLocation (0032,1020) LO, retired
+	ScheduledStudyLocation uint32 = 0x00321020
+	// ScheduledStudyLocationAETitle (0032,1021) AE, retired
+	ScheduledStudyLocationAETitle uint32 = 0x00321021
+	// ReasonForStudy (0032,1030) LO, retired
+	ReasonForStudy uint32 = 0x00321030
+	// RequestingPhysicianIdentificationSequence (0032,1031) SQ
+	RequestingPhysicianIdentificationSequence uint32 = 0x00321031
+	// RequestingPhysician (0032,1032) PN
+	RequestingPhysician uint32 = 0x00321032
+	// RequestingService (0032,1033) LO
+	RequestingService uint32 = 0x00321033
+	// RequestingServiceCodeSequence (0032,1034) SQ
+	RequestingServiceCodeSequence uint32 = 0x00321034
+	// StudyArrivalDate (0032,1040) DA, retired
+	StudyArrivalDate uint32 = 0x00321040
+	// StudyArrivalTime (0032,1041) TM, retired
+	StudyArrivalTime uint32 = 0x00321041
+	// StudyCompletionDate (0032,1050) DA, retired
+	StudyCompletionDate uint32 = 0x00321050
+	// StudyCompletionTime (0032,1051) TM, retired
+	StudyCompletionTime uint32 = 0x00321051
+	// StudyComponentStatusID (0032,1055) CS, retired
+	StudyComponentStatusID uint32 = 0x00321055
+	// RequestedProcedureDescription (0032,1060) LO
+	RequestedProcedureDescription uint32 = 0x00321060
+	// RequestedProcedureCodeSequence (0032,1064) SQ
+	RequestedProcedureCodeSequence uint32 = 0x00321064
+	// RequestedContrastAgent (0032,1070) LO
+	RequestedContrastAgent uint32 = 0x00321070
+	// StudyComments (0032,4000) LT, retired
+	StudyComments uint32 = 0x00324000
+	// ReferencedPatientAliasSequence (0038,0004) SQ
+	ReferencedPatientAliasSequence uint32 = 0x00380004
+	// VisitStatusID (0038,0008) CS
+	VisitStatusID uint32 = 0x00380008
+	// AdmissionID (0038,0010) LO
+	AdmissionID uint32 = 0x00380010
+	// IssuerOfAdmissionID (0038,0011) LO, retired
+	IssuerOfAdmissionID uint32 = 0x00380011
+	// IssuerOfAdmissionIDSequence (0038,0014) SQ
+	IssuerOfAdmissionIDSequence uint32 = 0x00380014
+	// RouteOfAdmissions (0038,0016) LO
+	RouteOfAdmissions uint32 = 0x00380016
+	// ScheduledAdmissionDate (0038,001A) DA, retired
+	ScheduledAdmissionDate uint32 = 0x0038001A
+	// ScheduledAdmissionTime (0038,001B) TM, retired
+	ScheduledAdmissionTime uint32 = 0x0038001B
+	// ScheduledDischargeDate (0038,001C) DA, retired
+	ScheduledDischargeDate uint32 = 0x0038001C
+	// ScheduledDischargeTime (0038,001D) TM, retired
+	ScheduledDischargeTime uint32 = 0x0038001D
+	// ScheduledPatientInstitutionResidence (0038,001E) LO, retired
+	ScheduledPatientInstitutionResidence uint32 = 0x0038001E
+	// AdmittingDate (0038,0020) DA
+	AdmittingDate uint32 = 0x00380020
+	// AdmittingTime (0038,0021) TM
+	AdmittingTime uint32 = 0x00380021
+	// DischargeDate (0038,0030) DA, retired
+	DischargeDate uint32 = 0x00380030
+	// DischargeTime (0038,0032) TM, retired
+	DischargeTime uint32 = 0x00380032
+	// DischargeDiagnosisDescription (0038,0040) LO, retired
+	DischargeDiagnosisDescription uint32 = 0x00380040
+	// DischargeDiagnosisCodeSequence (0038,0044) SQ, retired
+	DischargeDiagnosisCodeSequence uint32 = 0x00380044
+	// SpecialNeeds (0038,0050) LO
+	SpecialNeeds uint32 = 0x00380050
+	// ServiceEpisodeID (0038,0060) LO
+	ServiceEpisodeID uint32 = 0x00380060
+	// IssuerOfServiceEpisodeID (0038,0061) LO, retired
+	IssuerOfServiceEpisodeID uint32 = 0x00380061
+	// ServiceEpisodeDescription (0038,0062) LO
+	ServiceEpisodeDescription uint32 = 0x00380062
+	// IssuerOfServiceEpisodeIDSequence (0038,0064) SQ
+	IssuerOfServiceEpisodeIDSequence uint32 = 0x00380064
+	// PertinentDocumentsSequence (0038,0100) SQ
+	PertinentDocumentsSequence uint32 = 0x00380100
+	// PertinentResourcesSequence (0038,0101) SQ
+	PertinentResourcesSequence uint32 = 0x00380101
+	// ResourceDescription (0038,0102) LO
+	ResourceDescription uint32 = 0x00380102
+	// CurrentPatientLocation (0038,0300) LO
+	CurrentPatientLocation uint32 = 0x00380300
+	// PatientInstitutionResidence (0038,0400) LO
+	PatientInstitutionResidence uint32 = 0x00380400
+	// PatientState (0038,0500) LO
+	PatientState uint32 = 0x00380500
+	// PatientClinicalTrialParticipationSequence (0038,0502) SQ
+	PatientClinicalTrialParticipationSequence uint32 = 0x00380502
+	// VisitComments (0038,4000) LT
+	VisitComments uint32 = 0x00384000
+	// WaveformOriginality (003A,0004) CS
+	WaveformOriginality uint32 = 0x003A0004
+	// NumberOfWaveformChannels (003A,0005) US
+	NumberOfWaveformChannels uint32 = 0x003A0005
+	// NumberOfWaveformSamples (003A,0010) UL
+	NumberOfWaveformSamples uint32 = 0x003A0010
+	// SamplingFrequency (003A,001A) DS
+	SamplingFrequency uint32 = 0x003A001A
+	// MultiplexGroupLabel (003A,0020) SH
+	MultiplexGroupLabel uint32 = 0x003A0020
+	// ChannelDefinitionSequence (003A,0200) SQ
+	ChannelDefinitionSequence uint32 = 0x003A0200
+	// WaveformChannelNumber (003A,0202) IS
+	WaveformChannelNumber uint32 = 0x003A0202
+	// ChannelLabel (003A,0203) SH
+	ChannelLabel uint32 = 0x003A0203
+	// ChannelStatus (003A,0205) CS
+	ChannelStatus uint32 = 0x003A0205
+	// ChannelSourceSequence (003A,0208) SQ
+	ChannelSourceSequence uint32 = 0x003A0208
+	// ChannelSourceModifiersSequence (003A,0209) SQ
+	ChannelSourceModifiersSequence uint32 = 0x003A0209
+	// SourceWaveformSequence (003A,020A) SQ
+	SourceWaveformSequence uint32 = 0x003A020A
+	// ChannelDerivationDescription (003A,020C) LO
+	ChannelDerivationDescription uint32 = 0x003A020C
+	// ChannelSensitivity (003A,0210) DS
+	ChannelSensitivity uint32 = 0x003A0210
+	// ChannelSensitivityUnitsSequence (003A,0211) SQ
+	ChannelSensitivityUnitsSequence uint32 = 0x003A0211
+	// ChannelSensitivityCorrectionFactor (003A,0212) DS
+	ChannelSensitivityCorrectionFactor uint32 = 0x003A0212
+	// ChannelBaseline (003A,0213) DS
+	ChannelBaseline uint32 = 0x003A0213
+	// ChannelTimeSkew (003A,0214) DS
+	ChannelTimeSkew uint32 = 0x003A0214
+	// ChannelSampleSkew (003A,0215) DS
+	ChannelSampleSkew uint32 = 0x003A0215
+	// ChannelOffset (003A,0218) DS
+	ChannelOffset uint32 = 0x003A0218
+	// WaveformBitsStored (003A,021A) US
+	WaveformBitsStored uint32 = 0x003A021A
+	// FilterLowFrequency (003A,0220) DS
+	FilterLowFrequency uint32 = 0x003A0220
+	// FilterHighFrequency (003A,0221) DS
+	FilterHighFrequency uint32 = 0x003A0221
+	// NotchFilterFrequency (003A,0222) DS
+	NotchFilterFrequency uint32 = 0x003A0222
+	// NotchFilterBandwidth (003A,0223) DS
+	NotchFilterBandwidth uint32 = 0x003A0223
+	// WaveformDataDisplayScale (003A,0230) FL
+	WaveformDataDisplayScale uint32 = 0x003A0230
+	// WaveformDisplayBackgroundCIELabValue (003A,0231) US
+	WaveformDisplayBackgroundCIELabValue uint32 = 0x003A0231
+	// WaveformPresentationGroupSequence (003A,0240) SQ
+	WaveformPresentationGroupSequence uint32 = 0x003A0240
+	// PresentationGroupNumber (003A,0241) US
+	PresentationGroupNumber uint32 = 0x003A0241
+	// ChannelDisplaySequence (003A,0242) SQ
+	ChannelDisplaySequence uint32 = 0x003A0242
+	// ChannelRecommendedDisplayCIELabValue (003A,0244) US
+	ChannelRecommendedDisplayCIELabValue uint32 = 0x003A0244
+	// ChannelPosition (003A,0245) FL
+	ChannelPosition uint32 = 0x003A0245
+	// DisplayShadingFlag (003A,0246) CS
+	DisplayShadingFlag uint32 = 0x003A0246
+	// FractionalChannelDisplayScale (003A,0247) FL
+	FractionalChannelDisplayScale uint32 = 0x003A0247
+	// AbsoluteChannelDisplayScale (003A,0248) FL
+	AbsoluteChannelDisplayScale uint32 = 0x003A0248
+	// MultiplexedAudioChannelsDescriptionCodeSequence (003A,0300) SQ
+	MultiplexedAudioChannelsDescriptionCodeSequence uint32 = 0x003A0300
+	// ChannelIdentificationCode (003A,0301) IS
+	ChannelIdentificationCode uint32 = 0x003A0301
+	// ChannelMode (003A,0302) CS
+	ChannelMode uint32 = 0x003A0302
+	// ScheduledStationAETitle (0040,0001) AE
+	ScheduledStationAETitle uint32 = 0x00400001
+	// ScheduledProcedureStepStartDate (0040,0002) DA
+	ScheduledProcedureStepStartDate uint32 = 0x00400002
+	// ScheduledProcedureStepStartTime (0040,0003) TM
+	ScheduledProcedureStepStartTime uint32 = 0x00400003
+	// ScheduledProcedureStepEndDate (0040,0004) DA
+	ScheduledProcedureStepEndDate uint32 = 0x00400004
+	// ScheduledProcedureStepEndTime (0040,0005) TM
+	ScheduledProcedureStepEndTime uint32 = 0x00400005
+	// ScheduledPerformingPhysicianName (0040,0006) PN
+	ScheduledPerformingPhysicianName uint32 = 0x00400006
+	// ScheduledProcedureStepDescription (0040,0007) LO
+	ScheduledProcedureStepDescription uint32 = 0x00400007
+	// ScheduledProtocolCodeSequence (0040,0008) SQ
+	ScheduledProtocolCodeSequence uint32 = 0x00400008
+	// ScheduledProcedureStepID (0040,0009) SH
+	ScheduledProcedureStepID uint32 = 0x00400009
+	// StageCodeSequence (0040,000A) SQ
+	StageCodeSequence uint32 = 0x0040000A
+	// ScheduledPerformingPhysicianIdentificationSequence (0040,000B) SQ
+	ScheduledPerformingPhysicianIdentificationSequence uint32 = 0x0040000B
+	// ScheduledStationName (0040,0010) SH
+	ScheduledStationName uint32 = 0x00400010
+	// ScheduledProcedureStepLocation (0040,0011) SH
+	ScheduledProcedureStepLocation uint32 = 0x00400011
+	// PreMedication (0040,0012) LO
+	PreMedication uint32 = 0x00400012
+	// ScheduledProcedureStepStatus (0040,0020) CS
+	ScheduledProcedureStepStatus uint32 = 0x00400020
+	// OrderPlacerIdentifierSequence (0040,0026) SQ
+	OrderPlacerIdentifierSequence uint32 = 0x00400026
+	// OrderFillerIdentifierSequence (0040,0027) SQ
+	OrderFillerIdentifierSequence uint32 = 0x00400027
+	// LocalNamespaceEntityID (0040,0031) UT
+	LocalNamespaceEntityID uint32 = 0x00400031
+	// UniversalEntityID (0040,0032) UT
+	UniversalEntityID uint32 = 0x00400032
+	// UniversalEntityIDType (0040,0033) CS
+	UniversalEntityIDType uint32 = 0x00400033
+	// IdentifierTypeCode (0040,0035) CS
+	IdentifierTypeCode uint32 = 0x00400035
+	// AssigningFacilitySequence (0040,0036) SQ
+	AssigningFacilitySequence uint32 = 0x00400036
+	// AssigningJurisdictionCodeSequence (0040,0039) SQ
+	AssigningJurisdictionCodeSequence uint32 = 0x00400039
+	// AssigningAgencyOrDepartmentCodeSequence (0040,003A) SQ
+	AssigningAgencyOrDepartmentCodeSequence uint32 = 0x0040003A
+	// ScheduledProcedureStepSequence (0040,0100) SQ
+	ScheduledProcedureStepSequence uint32 = 0x00400100
+	// ReferencedNonImageCompositeSOPInstanceSequence (0040,0220) SQ
+	ReferencedNonImageCompositeSOPInstanceSequence uint32 = 0x00400220
+	// PerformedStationAETitle (0040,0241) AE
+	PerformedStationAETitle uint32 = 0x00400241
+	// PerformedStationName (0040,0242) SH
+	PerformedStationName uint32 = 0x00400242
+	// PerformedLocation (0040,0243) SH
+	PerformedLocation uint32 = 0x00400243
+	// PerformedProcedureStepStartDate (0040,0244) DA
+	PerformedProcedureStepStartDate uint32 = 0x00400244
+	// PerformedProcedureStepStartTime (0040,0245) TM
+	PerformedProcedureStepStartTime uint32 = 0x00400245
+	// PerformedProcedureStepEndDate (0040,0250) DA
+	PerformedProcedureStepEndDate uint32 = 0x00400250
+	// PerformedProcedureStepEndTime (0040,0251) TM
+	PerformedProcedureStepEndTime uint32 = 0x00400251
+	// PerformedProcedureStepStatus (0040,0252) CS
+	PerformedProcedureStepStatus uint32 = 0x00400252
+	// PerformedProcedureStepID (0040,0253) SH
+	PerformedProcedureStepID uint32 = 0x00400253
+	// PerformedProcedureStepDescription (0040,0254) LO
+	PerformedProcedureStepDescription uint32 = 0x00400254
+	// PerformedProcedureTypeDescription (0040,0255) LO
+	PerformedProcedureTypeDescription uint32 = 0x00400255
+	// PerformedProtocolCodeSequence (0040,0260) SQ
+	PerformedProtocolCodeSequence uint32 = 0x00400260
+	// PerformedProtocolType (0040,0261) CS
+	PerformedProtocolType uint32 = 0x00400261
+	// ScheduledStepAttributesSequence (0040,0270) SQ
+	ScheduledStepAttributesSequence uint32 = 0x00400270
+	// RequestAttributesSequence (0040,0275) SQ
+	RequestAttributesSequence uint32 = 0x00400275
+	// CommentsOnThePerformedProcedureStep (0040,0280) ST
+	CommentsOnThePerformedProcedureStep uint32 = 0x00400280
+	// PerformedProcedureStepDiscontinuationReasonCodeSequence (0040,0281) SQ
+	PerformedProcedureStepDiscontinuationReasonCodeSequence uint32 = 0x00400281
+	// QuantitySequence (0040,0293) SQ
+	QuantitySequence uint32 = 0x00400293
+	// Quantity (0040,0294) DS
+	Quantity uint32 = 0x00400294
+	// MeasuringUnitsSequence (0040,0295) SQ
+	MeasuringUnitsSequence uint32 = 0x00400295
+	// BillingItemSequence (0040,0296) SQ
+	BillingItemSequence uint32 = 0x00400296
+	// TotalTimeOfFluoroscopy (0040,0300) US, retired
+	TotalTimeOfFluoroscopy uint32 = 0x00400300
+	// TotalNumberOfExposures (0040,0301) US, retired
+	TotalNumberOfExposures uint32 = 0x00400301
+	// EntranceDose (0040,0302) US
+	EntranceDose uint32 = 0x00400302
+	// ExposedArea (0040,0303) US
+	ExposedArea uint32 = 0x00400303
+	// DistanceSourceToEntrance (0040,0306) DS
+	DistanceSourceToEntrance uint32 = 0x00400306
+	// DistanceSourceToSupport (0040,0307) DS, retired
+	DistanceSourceToSupport uint32 = 0x00400307
+	// ExposureDoseSequence (0040,030E) SQ, retired
+	ExposureDoseSequence uint32 = 0x0040030E
+	// CommentsOnRadiationDose (0040,0310) ST
+	CommentsOnRadiationDose uint32 = 0x00400310
+	// XRayOutput (0040,0312) DS
+	XRayOutput uint32 = 0x00400312
+	// HalfValueLayer (0040,0314) DS
+	HalfValueLayer uint32 = 0x00400314
+	// OrganDose (0040,0316) DS
+	OrganDose uint32 = 0x00400316
+	// OrganExposed (0040,0318) CS
+	OrganExposed uint32 = 0x00400318
+	// BillingProcedureStepSequence (0040,0320) SQ
+	BillingProcedureStepSequence uint32 = 0x00400320
+	// FilmConsumptionSequence (0040,0321) SQ
+	FilmConsumptionSequence uint32 = 0x00400321
+	// BillingSuppliesAndDevicesSequence (0040,0324) SQ
+	BillingSuppliesAndDevicesSequence uint32 = 0x00400324
+	// ReferencedProcedureStepSequence (0040,0330) SQ, retired
+	ReferencedProcedureStepSequence uint32 = 0x00400330
+	// PerformedSeriesSequence (0040,0340) SQ
+	PerformedSeriesSequence uint32 = 0x00400340
+	// CommentsOnTheScheduledProcedureStep (0040,0400) LT
+	CommentsOnTheScheduledProcedureStep uint32 = 0x00400400
+	// ProtocolContextSequence (0040,0440) SQ
+	ProtocolContextSequence uint32 = 0x00400440
+	// ContentItemModifierSequence (0040,0441) SQ
+	ContentItemModifierSequence uint32 = 0x00400441
+	// ScheduledSpecimenSequence (0040,0500) SQ
+	ScheduledSpecimenSequence uint32 = 0x00400500
+	// SpecimenAccessionNumber (0040,050A) LO, retired
+	SpecimenAccessionNumber uint32 = 0x0040050A
+	// ContainerIdentifier (0040,0512) LO
+	ContainerIdentifier uint32 = 0x00400512
+	// IssuerOfTheContainerIdentifierSequence (0040,0513) SQ
+	IssuerOfTheContainerIdentifierSequence uint32 = 0x00400513
+	// AlternateContainerIdentifierSequence (0040,0515) SQ
+	AlternateContainerIdentifierSequence uint32 = 0x00400515
+	// ContainerTypeCodeSequence (0040,0518) SQ
+	ContainerTypeCodeSequence uint32 = 0x00400518
+	// ContainerDescription (0040,051A) LO
+	ContainerDescription uint32 = 0x0040051A
+	// ContainerComponentSequence (0040,0520) SQ
+	ContainerComponentSequence uint32 = 0x00400520
+	// SpecimenSequence (0040,0550) SQ, retired
+	SpecimenSequence uint32 = 0x00400550
+	// SpecimenIdentifier (0040,0551) LO
+	SpecimenIdentifier uint32 = 0x00400551
+	// SpecimenDescriptionSequenceTrial (0040,0552) SQ, retired
+	SpecimenDescriptionSequenceTrial uint32 = 0x00400552
+	// SpecimenDescriptionTrial (0040,0553) ST, retired
+	SpecimenDescriptionTrial uint32 = 0x00400553
+	// SpecimenUID (0040,0554) UI
+	SpecimenUID uint32 = 0x00400554
+	// AcquisitionContextSequence (0040,0555) SQ
+	AcquisitionContextSequence uint32 = 0x00400555
+	// AcquisitionContextDescription (0040,0556) ST
+	AcquisitionContextDescription uint32 = 0x00400556
+	// SpecimenDescriptionSequence (0040,0560) SQ
+	SpecimenDescriptionSequence uint32 = 0x00400560
+	// IssuerOfTheSpecimenIdentifierSequence (0040,0562) SQ
+	IssuerOfTheSpecimenIdentifierSequence uint32 = 0x00400562
+	// SpecimenTypeCodeSequence (0040,059A) SQ
+	SpecimenTypeCodeSequence uint32 = 0x0040059A
+	// SpecimenShortDescription (0040,0600) LO
+	SpecimenShortDescription uint32 = 0x00400600
+	// SpecimenDetailedDescription (0040,0602) UT
+	SpecimenDetailedDescription uint32 = 0x00400602
+	// SpecimenPreparationSequence (0040,0610) SQ
+	SpecimenPreparationSequence uint32 = 0x00400610
+	// SpecimenPreparationStepContentItemSequence (0040,0612) SQ
+	SpecimenPreparationStepContentItemSequence uint32 = 0x00400612
+	// SpecimenLocalizationContentItemSequence (0040,0620) SQ
+	SpecimenLocalizationContentItemSequence uint32 = 0x00400620
+	// SlideIdentifier (0040,06FA) LO, retired
+	SlideIdentifier uint32 = 0x004006FA
+	// ImageCenterPointCoordinatesSequence (0040,071A) SQ
+	ImageCenterPointCoordinatesSequence uint32 = 0x0040071A
+	// XOffsetInSlideCoordinateSystem (0040,072A) DS
+	XOffsetInSlideCoordinateSystem uint32 = 0x0040072A
+	// YOffsetInSlideCoordinateSystem (0040,073A) DS
+	YOffsetInSlideCoordinateSystem uint32 = 0x0040073A
+	// ZOffsetInSlideCoordinateSystem (0040,074A) DS
+	ZOffsetInSlideCoordinateSystem uint32 = 0x0040074A
+	// PixelSpacingSequence (0040,08D8) SQ, retired
+	PixelSpacingSequence uint32 = 0x004008D8
+	// CoordinateSystemAxisCodeSequence (0040,08DA) SQ, retired
+	CoordinateSystemAxisCodeSequence uint32 = 0x004008DA
+	// MeasurementUnitsCodeSequence (0040,08EA) SQ
+	MeasurementUnitsCodeSequence uint32 = 0x004008EA
+	// VitalStainCodeSequenceTrial (0040,09F8) SQ, retired
+	VitalStainCodeSequenceTrial uint32 = 0x004009F8
+	// RequestedProcedureID (0040,1001) SH
+	RequestedProcedureID uint32 = 0x00401001
+	// ReasonForTheRequestedProcedure (0040,1002) LO
+	ReasonForTheRequestedProcedure uint32 = 0x00401002
+	// RequestedProcedurePriority (0040,1003) SH
+	RequestedProcedurePriority uint32 = 0x00401003
+	// PatientTransportArrangements (0040,1004) LO
+	PatientTransportArrangements uint32 = 0x00401004
+	// RequestedProcedureLocation (0040,1005) LO
+	RequestedProcedureLocation uint32 = 0x00401005
+	// PlacerOrderNumberProcedure (0040,1006) SH, retired
+	PlacerOrderNumberProcedure uint32 = 0x00401006
+	// FillerOrderNumberProcedure (0040,1007) SH, retired
+	FillerOrderNumberProcedure uint32 = 0x00401007
+	// ConfidentialityCode (0040,1008) LO
+	ConfidentialityCode uint32 = 0x00401008
+	// ReportingPriority (0040,1009) SH
+	ReportingPriority uint32 = 0x00401009
+	// ReasonForRequestedProcedureCodeSequence (0040,100A) SQ
+	ReasonForRequestedProcedureCodeSequence uint32 = 0x0040100A
+	// NamesOfIntendedRecipientsOfResults (0040,1010) PN
+	NamesOfIntendedRecipientsOfResults uint32 = 0x00401010
+	// IntendedRecipientsOfResultsIdentificationSequence (0040,1011) SQ
+	IntendedRecipientsOfResultsIdentificationSequence uint32 = 0x00401011
+	// ReasonForPerformedProcedureCodeSequence (0040,1012) SQ
+	ReasonForPerformedProcedureCodeSequence uint32 = 0x00401012
+	// RequestedProcedureDescriptionTrial (0040,1060) LO, retired
+	RequestedProcedureDescriptionTrial uint32 = 0x00401060
+	// PersonIdentificationCodeSequence (0040,1101) SQ
+	PersonIdentificationCodeSequence uint32 = 0x00401101
+	// PersonAddress (0040,1102) ST
+	PersonAddress uint32 = 0x00401102
+	// PersonTelephoneNumbers (0040,1103) LO
+	PersonTelephoneNumbers uint32 = 0x00401103
+	// PersonTelecomInformation (0040,1104) LT
+	PersonTelecomInformation uint32 = 0x00401104
+	// RequestedProcedureComments (0040,1400) LT
+	RequestedProcedureComments uint32 = 0x00401400
+	// ReasonForTheImagingServiceRequest (0040,2001) LO, retired
+	ReasonForTheImagingServiceRequest uint32 = 0x00402001
+	// IssueDateOfImagingServiceRequest (0040,2004) DA
+	IssueDateOfImagingServiceRequest uint32 = 0x00402004
+	// IssueTimeOfImagingServiceRequest (0040,2005) TM
+	IssueTimeOfImagingServiceRequest uint32 = 0x00402005
+	// PlacerOrderNumberImagingServiceRequestRetired (0040,2006) SH, retired
+	PlacerOrderNumberImagingServiceRequestRetired uint32 = 0x00402006
+	// FillerOrderNumberImagingServiceRequestRetired (0040,2007) SH, retired
+	FillerOrderNumberImagingServiceRequestRetired uint32 = 0x00402007
+	// OrderEnteredBy (0040,2008) PN
+	OrderEnteredBy uint32 = 0x00402008
+	// OrderEntererLocation (0040,2009) SH
+	OrderEntererLocation uint32 = 0x00402009
+	// OrderCallbackPhoneNumber (0040,2010) SH
+	OrderCallbackPhoneNumber uint32 = 0x00402010
+	// OrderCallbackTelecomInformation (0040,2011) LT
+	OrderCallbackTelecomInformation uint32 = 0x00402011
+	// PlacerOrderNumberImagingServiceRequest (0040,2016) LO
+	PlacerOrderNumberImagingServiceRequest uint32 = 0x00402016
+	// FillerOrderNumberImagingServiceRequest (0040,2017) LO
+	FillerOrderNumberImagingServiceRequest uint32 = 0x00402017
+	// ImagingServiceRequestComments (0040,2400) LT
+	ImagingServiceRequestComments uint32 = 0x00402400
+	// ConfidentialityConstraintOnPatientDataDescription (0040,3001) LO
+	ConfidentialityConstraintOnPatientDataDescription uint32 = 0x00403001
+	// GeneralPurposeScheduledProcedureStepStatus (0040,4001) CS, retired
+	GeneralPurposeScheduledProcedureStepStatus uint32 = 0x00404001
+	// GeneralPurposePerformedProcedureStepStatus (0040,4002) CS, retired
+	GeneralPurposePerformedProcedureStepStatus uint32 = 0x00404002
+	// GeneralPurposeScheduledProcedureStepPriority (0040,4003) CS, retired
+	GeneralPurposeScheduledProcedureStepPriority uint32 = 0x00404003
+	// ScheduledProcessingApplicationsCodeSequence (0040,4004) SQ, retired
+	ScheduledProcessingApplicationsCodeSequence uint32 = 0x00404004
+	// ScheduledProcedureStepStartDateTime (0040,4005) DT
+	ScheduledProcedureStepStartDateTime uint32 = 0x00404005
+	// MultipleCopiesFlag (0040,4006) CS, retired
+	MultipleCopiesFlag uint32 = 0x00404006
+	// PerformedProcessingApplicationsCodeSequence (0040,4007) SQ, retired
+	PerformedProcessingApplicationsCodeSequence uint32 = 0x00404007
+	// HumanPerformerCodeSequence (0040,4009) SQ
+	HumanPerformerCodeSequence uint32 = 0x00404009
+	// ScheduledProcedureStepModificationDateTime (0040,4010) DT
+	ScheduledProcedureStepModificationDateTime uint32 = 0x00404010
+	// ExpectedCompletionDateTime (0040,4011) DT
+	ExpectedCompletionDateTime uint32 = 0x00404011
+	// ResultingGeneralPurposePerformedProcedureStepsSequence (0040,4015) SQ, retired
+	ResultingGeneralPurposePerformedProcedureStepsSequence uint32 = 0x00404015
+	// ReferencedGeneralPurposeScheduledProcedureStepSequence (0040,4016) SQ, retired
+	ReferencedGeneralPurposeScheduledProcedureStepSequence uint32 = 0x00404016
+	// ScheduledWorkitemCodeSequence (0040,4018) SQ
+	ScheduledWorkitemCodeSequence uint32 = 0x00404018
+	// PerformedWorkitemCodeSequence (0040,4019) SQ
+	PerformedWorkitemCodeSequence uint32 = 0x00404019
+	// InputAvailabilityFlag (0040,4020) CS, retired
+	InputAvailabilityFlag uint32 = 0x00404020
+	// InputInformationSequence (0040,4021) SQ
+	InputInformationSequence uint32 = 0x00404021
+	// RelevantInformationSequence (0040,4022) SQ, retired
+	RelevantInformationSequence uint32 = 0x00404022
+	// ReferencedGeneralPurposeScheduledProcedureStepTransactionUID (0040,4023) UI, retired
+	ReferencedGeneralPurposeScheduledProcedureStepTransactionUID uint32 = 0x00404023
+	// ScheduledStationNameCodeSequence (0040,4025) SQ
+	ScheduledStationNameCodeSequence uint32 = 0x00404025
+	// ScheduledStationClassCodeSequence (0040,4026) SQ
+	ScheduledStationClassCodeSequence uint32 = 0x00404026
+	// ScheduledStationGeographicLocationCodeSequence (0040,4027) SQ
+	ScheduledStationGeographicLocationCodeSequence uint32 = 0x00404027
+	// PerformedStationNameCodeSequence (0040,4028) SQ
+	PerformedStationNameCodeSequence uint32 = 0x00404028
+	// PerformedStationClassCodeSequence (0040,4029) SQ
+	PerformedStationClassCodeSequence uint32 = 0x00404029
+	// PerformedStationGeographicLocationCodeSequence (0040,4030) SQ
+	PerformedStationGeographicLocationCodeSequence uint32 = 0x00404030
+	// RequestedSubsequentWorkitemCodeSequence (0040,4031) SQ, retired
+	RequestedSubsequentWorkitemCodeSequence uint32 = 0x00404031
+	// NonDICOMOutputCodeSequence (0040,4032) SQ, retired
+	NonDICOMOutputCodeSequence uint32 = 0x00404032
+	// OutputInformationSequence (0040,4033) SQ
+	OutputInformationSequence uint32 = 0x00404033
+	// ScheduledHumanPerformersSequence (0040,4034) SQ
+	ScheduledHumanPerformersSequence uint32 = 0x00404034
+	// ActualHumanPerformersSequence (0040,4035) SQ
+	ActualHumanPerformersSequence uint32 = 0x00404035
+	// HumanPerformerOrganization (0040,4036) LO
+	HumanPerformerOrganization uint32 = 0x00404036
+	// HumanPerformerName (0040,4037) PN
+	HumanPerformerName uint32 = 0x00404037
+	// RawDataHandling (0040,4040) CS
+	RawDataHandling uint32 = 0x00404040
+	// InputReadinessState (0040,4041) CS
+	InputReadinessState uint32 = 0x00404041
+	// PerformedProcedureStepStartDateTime (0040,4050) DT
+	PerformedProcedureStepStartDateTime uint32 = 0x00404050
+	// PerformedProcedureStepEndDateTime (0040,4051) DT
+	PerformedProcedureStepEndDateTime uint32 = 0x00404051
+	// ProcedureStepCancellationDateTime (0040,4052) DT
+	ProcedureStepCancellationDateTime uint32 = 0x00404052
+	// OutputDestinationSequence (0040,4070) SQ
+	OutputDestinationSequence uint32 = 0x00404070
+	// DICOMStorageSequence (0040,4071) SQ
+	DICOMStorageSequence uint32 = 0x00404071
+	// STOWRSStorageSequence (0040,4072) SQ
+	STOWRSStorageSequence uint32 = 0x00404072
+	// StorageURL (0040,4073) UR
+	StorageURL uint32 = 0x00404073
+	// XDSStorageSequence (0040,4074) SQ
+	XDSStorageSequence uint32 = 0x00404074
+	// EntranceDoseInmGy (0040,8302) DS
+	EntranceDoseInmGy uint32 = 0x00408302
+	// EntranceDoseDerivation (0040,8303) CS
+	EntranceDoseDerivation uint32 = 0x00408303
+	// ParametricMapFrameTypeSequence (0040,9092) SQ
+	ParametricMapFrameTypeSequence uint32 = 0x00409092
+	// ReferencedImageRealWorldValueMappingSequence (0040,9094) SQ
+	ReferencedImageRealWorldValueMappingSequence uint32 = 0x00409094
+	// RealWorldValueMappingSequence (0040,9096) SQ
+	RealWorldValueMappingSequence uint32 = 0x00409096
+	// PixelValueMappingCodeSequence (0040,9098) SQ
+	PixelValueMappingCodeSequence uint32 = 0x00409098
+	// LUTLabel (0040,9210) SH
+	LUTLabel uint32 = 0x00409210
+	// RealWorldValueLastValueMapped (0040,9211) US
+	RealWorldValueLastValueMapped uint32 = 0x00409211
+	// RealWorldValueLUTData (0040,9212) FD
+	RealWorldValueLUTData uint32 = 0x00409212
+	// DoubleFloatRealWorldValueLastValueMapped (0040,9213) FD
+	DoubleFloatRealWorldValueLastValueMapped uint32 = 0x00409213
+	// DoubleFloatRealWorldValueFirstValueMapped (0040,9214) FD
+	DoubleFloatRealWorldValueFirstValueMapped uint32 = 0x00409214
+	// RealWorldValueFirstValueMapped (0040,9216) US
+	RealWorldValueFirstValueMapped uint32 = 0x00409216
+	// QuantityDefinitionSequence (0040,9220) SQ
+	QuantityDefinitionSequence uint32 = 0x00409220
+	// RealWorldValueIntercept (0040,9224) FD
+	RealWorldValueIntercept uint32 = 0x00409224
+	// RealWorldValueSlope (0040,9225) FD
+	RealWorldValueSlope uint32 = 0x00409225
+	// FindingsFlagTrial (0040,A007) CS, retired
+	FindingsFlagTrial uint32 = 0x0040A007
+	// RelationshipType (0040,A010) CS
+	RelationshipType uint32 = 0x0040A010
+	// FindingsSequenceTrial (0040,A020) SQ, retired
+	FindingsSequenceTrial uint32 = 0x0040A020
+	// FindingsGroupUIDTrial (0040,A021) UI, retired
+	FindingsGroupUIDTrial uint32 = 0x0040A021
+	// ReferencedFindingsGroupUIDTrial (0040,A022) UI, retired
+	ReferencedFindingsGroupUIDTrial uint32 = 0x0040A022
+	// FindingsGroupRecordingDateTrial (0040,A023) DA, retired
+	FindingsGroupRecordingDateTrial uint32 = 0x0040A023
+	// FindingsGroupRecordingTimeTrial (0040,A024) TM, retired
+	FindingsGroupRecordingTimeTrial uint32 = 0x0040A024
+	// FindingsSourceCategoryCodeSequenceTrial (0040,A026) SQ, retired
+	FindingsSourceCategoryCodeSequenceTrial uint32 = 0x0040A026
+	// VerifyingOrganization (0040,A027) LO
+	VerifyingOrganization uint32 = 0x0040A027
+	// DocumentingOrganizationIdentifierCodeSequenceTrial (0040,A028) SQ, retired
+	DocumentingOrganizationIdentifierCodeSequenceTrial uint32 = 0x0040A028
+	// VerificationDateTime (0040,A030) DT
+	VerificationDateTime uint32 = 0x0040A030
+	// ObservationDateTime (0040,A032) DT
+	ObservationDateTime uint32 = 0x0040A032
+	// ValueType (0040,A040) CS
+	ValueType uint32 = 0x0040A040
+	// ConceptNameCodeSequence (0040,A043) SQ
+	ConceptNameCodeSequence uint32 = 0x0040A043
+	// MeasurementPrecisionDescriptionTrial (0040,A047) LO, retired
+	MeasurementPrecisionDescriptionTrial uint32 = 0x0040A047
+	// ContinuityOfContent (0040,A050) CS
+	ContinuityOfContent uint32 = 0x0040A050
+	// UrgencyOrPriorityAlertsTrial (0040,A057) CS, retired
+	UrgencyOrPriorityAlertsTrial uint32 = 0x0040A057
+	// SequencingIndicatorTrial (0040,A060) LO, retired
+	SequencingIndicatorTrial uint32 = 0x0040A060
+	// DocumentIdentifierCodeSequenceTrial (0040,A066) SQ, retired
+	DocumentIdentifierCodeSequenceTrial uint32 = 0x0040A066
+	// DocumentAuthorTrial (0040,A067) PN, retired
+	DocumentAuthorTrial uint32 = 0x0040A067
+	// DocumentAuthorIdentifierCodeSequenceTrial (0040,A068) SQ, retired
+	DocumentAuthorIdentifierCodeSequenceTrial uint32 = 0x0040A068
+	// IdentifierCodeSequenceTrial (0040,A070) SQ, retired
+	IdentifierCodeSequenceTrial uint32 = 0x0040A070
+	// VerifyingObserverSequence (0040,A073) SQ
+	VerifyingObserverSequence uint32 = 0x0040A073
+	// ObjectBinaryIdentifierTrial (0040,A074) OB, retired
+	ObjectBinaryIdentifierTrial uint32 = 0x0040A074
+	// VerifyingObserverName (0040,A075) PN
+	VerifyingObserverName uint32 = 0x0040A075
+	// DocumentingObserverIdentifierCodeSequenceTrial (0040,A076) SQ, retired
+	DocumentingObserverIdentifierCodeSequenceTrial uint32 = 0x0040A076
+	// AuthorObserverSequence (0040,A078) SQ
+	AuthorObserverSequence uint32 = 0x0040A078
+	// ParticipantSequence (0040,A07A) SQ
+	ParticipantSequence uint32 = 0x0040A07A
+	// CustodialOrganizationSequence (0040,A07C) SQ
+	CustodialOrganizationSequence uint32 = 0x0040A07C
+	// ParticipationType (0040,A080) CS
+	ParticipationType uint32 = 0x0040A080
+	// ParticipationDateTime (0040,A082) DT
+	ParticipationDateTime uint32 = 0x0040A082
+	// ObserverType (0040,A084) CS
+	ObserverType uint32 = 0x0040A084
+	// ProcedureIdentifierCodeSequenceTrial (0040,A085) SQ, retired
+	ProcedureIdentifierCodeSequenceTrial uint32 = 0x0040A085
+	// VerifyingObserverIdentificationCodeSequence (0040,A088) SQ
+	VerifyingObserverIdentificationCodeSequence uint32 = 0x0040A088
+	// ObjectDirectoryBinaryIdentifierTrial (0040,A089) OB, retired
+	ObjectDirectoryBinaryIdentifierTrial uint32 = 0x0040A089
+	// EquivalentCDADocumentSequence (0040,A090) SQ, retired
+	EquivalentCDADocumentSequence uint32 = 0x0040A090
+	// ReferencedWaveformChannels (0040,A0B0) US
+	ReferencedWaveformChannels uint32 = 0x0040A0B0
+	// DateOfDocumentOrVerbalTransactionTrial (0040,A110) DA, retired
+	DateOfDocumentOrVerbalTransactionTrial uint32 = 0x0040A110
+	// TimeOfDocumentCreationOrVerbalTransactionTrial (0040,A112) TM, retired
+	TimeOfDocumentCreationOrVerbalTransactionTrial uint32 = 0x0040A112
+	// DateTime (0040,A120) DT
+	DateTime uint32 = 0x0040A120
+	// Date (0040,A121) DA
+	Date uint32 = 0x0040A121
+	// Time (0040,A122) TM
+	Time uint32 = 0x0040A122
+	// PersonName (0040,A123) PN
+	PersonName uint32 = 0x0040A123
+	// UID (0040,A124) UI
+	UID uint32 = 0x0040A124
+	// ReportStatusIDTrial (0040,A125) CS, retired
+	ReportStatusIDTrial uint32 = 0x0040A125
+	// TemporalRangeType (0040,A130) CS
+	TemporalRangeType uint32 = 0x0040A130
+	// ReferencedSamplePositions (0040,A132) UL
+	ReferencedSamplePositions uint32 = 0x0040A132
+	// ReferencedFrameNumbers (0040,A136) US
+	ReferencedFrameNumbers uint32 = 0x0040A136
+	// ReferencedTimeOffsets (0040,A138) DS
+	ReferencedTimeOffsets uint32 = 0x0040A138
+	// ReferencedDateTime (0040,A13A) DT
+	ReferencedDateTime uint32 = 0x0040A13A
+	// TextValue (0040,A160) UT
+	TextValue uint32 = 0x0040A160
+	// FloatingPointValue (0040,A161) FD
+	FloatingPointValue uint32 = 0x0040A161
+	// RationalNumeratorValue (0040,A162) SL
+	RationalNumeratorValue uint32 = 0x0040A162
+	// RationalDenominatorValue (0040,A163) UL
+	RationalDenominatorValue uint32 = 0x0040A163
+	// ObservationCategoryCodeSequenceTrial (0040,A167) SQ, retired
+	ObservationCategoryCodeSequenceTrial uint32 = 0x0040A167
+	// ConceptCodeSequence (0040,A168) SQ
+	ConceptCodeSequence uint32 = 0x0040A168
+	// BibliographicCitationTrial (0040,A16A) ST, retired
+	BibliographicCitationTrial uint32 = 0x0040A16A
+	// PurposeOfReferenceCodeSequence (0040,A170) SQ
+	PurposeOfReferenceCodeSequence uint32 = 0x0040A170
+	// ObservationUID (0040,A171) UI
+	ObservationUID uint32 = 0x0040A171
+	// ReferencedObservationUIDTrial (0040,A172) UI, retired
+	ReferencedObservationUIDTrial uint32 = 0x0040A172
+	// ReferencedObservationClassTrial (0040,A173) CS, retired
+	ReferencedObservationClassTrial uint32 = 0x0040A173
+	// ReferencedObjectObservationClassTrial (0040,A174) CS, retired
+	ReferencedObjectObservationClassTrial uint32 = 0x0040A174
+	// AnnotationGroupNumber (0040,A180) US
+	AnnotationGroupNumber uint32 = 0x0040A180
+	// ObservationDateTrial (0040,A192) DA, retired
+	ObservationDateTrial uint32 = 0x0040A192
+	// ObservationTimeTrial (0040,A193) TM, retired
+	ObservationTimeTrial uint32 = 0x0040A193
+	// MeasurementAutomationTrial (0040,A194) CS, retired
+	MeasurementAutomationTrial uint32 = 0x0040A194
+	// ModifierCodeSequence (0040,A195) SQ
+	ModifierCodeSequence uint32 = 0x0040A195
+	// IdentificationDescriptionTrial (0040,A224) ST, retired
+	IdentificationDescriptionTrial uint32 = 0x0040A224
+	// CoordinatesSetGeometricTypeTrial (0040,A290) CS, retired
+	CoordinatesSetGeometricTypeTrial uint32 = 0x0040A290
+	// AlgorithmCodeSequenceTrial (0040,A296) SQ, retired
+	AlgorithmCodeSequenceTrial uint32 = 0x0040A296
+	// AlgorithmDescriptionTrial (0040,A297) ST, retired
+	AlgorithmDescriptionTrial uint32 = 0x0040A297
+	// PixelCoordinatesSetTrial (0040,A29A) SL, retired
+	PixelCoordinatesSetTrial uint32 = 0x0040A29A
+	// MeasuredValueSequence (0040,A300) SQ
+	MeasuredValueSequence uint32 = 0x0040A300
+	// NumericValueQualifierCodeSequence (0040,A301) SQ
+	NumericValueQualifierCodeSequence uint32 = 0x0040A301
+	// CurrentObserverTrial (0040,A307) PN, retired
+	CurrentObserverTrial uint32 = 0x0040A307
+	// NumericValue (0040,A30A) DS
+	NumericValue uint32 = 0x0040A30A
+	// ReferencedAccessionSequenceTrial (0040,A313) SQ, retired
+	ReferencedAccessionSequenceTrial uint32 = 0x0040A313
+	// ReportStatusCommentTrial (0040,A33A) ST, retired
+	ReportStatusCommentTrial uint32 = 0x0040A33A
+	// ProcedureContextSequenceTrial (0040,A340) SQ, retired
+	ProcedureContextSequenceTrial uint32 = 0x0040A340
+	// VerbalSourceTrial (0040,A352) PN, retired
+	VerbalSourceTrial uint32 = 0x0040A352
+	// AddressTrial (0040,A353) ST, retired
+	AddressTrial uint32 = 0x0040A353
+	// TelephoneNumberTrial (0040,A354) LO, retired
+	TelephoneNumberTrial uint32 = 0x0040A354
+	// VerbalSourceIdentifierCodeSequenceTrial (0040,A358) SQ, retired
+	VerbalSourceIdentifierCodeSequenceTrial uint32 = 0x0040A358
+	// PredecessorDocumentsSequence (0040,A360) SQ
+	PredecessorDocumentsSequence uint32 = 0x0040A360
+	// ReferencedRequestSequence (0040,A370) SQ
+	ReferencedRequestSequence uint32 = 0x0040A370
+	// PerformedProcedureCodeSequence (0040,A372) SQ
+	PerformedProcedureCodeSequence uint32 = 0x0040A372
+	// CurrentRequestedProcedureEvidenceSequence (0040,A375) SQ
+	CurrentRequestedProcedureEvidenceSequence uint32 = 0x0040A375
+	// ReportDetailSequenceTrial (0040,A380) SQ, retired
+	ReportDetailSequenceTrial uint32 = 0x0040A380
+	// PertinentOtherEvidenceSequence (0040,A385) SQ
+	PertinentOtherEvidenceSequence uint32 = 0x0040A385
+	// HL7StructuredDocumentReferenceSequence (0040,A390) SQ
+	HL7StructuredDocumentReferenceSequence uint32 = 0x0040A390
+	// ObservationSubjectUIDTrial (0040,A402) UI, retired
+	ObservationSubjectUIDTrial uint32 = 0x0040A402
+	// ObservationSubjectClassTrial (0040,A403) CS, retired
+	ObservationSubjectClassTrial uint32 = 0x0040A403
+	// ObservationSubjectTypeCodeSequenceTrial (0040,A404) SQ, retired
+	ObservationSubjectTypeCodeSequenceTrial uint32 = 0x0040A404
+	// CompletionFlag (0040,A491) CS
+	CompletionFlag uint32 = 0x0040A491
+	// CompletionFlagDescription (0040,A492) LO
+	CompletionFlagDescription uint32 = 0x0040A492
+	// VerificationFlag (0040,A493) CS
+	VerificationFlag uint32 = 0x0040A493
+	// ArchiveRequested (0040,A494) CS
+	ArchiveRequested uint32 = 0x0040A494
+	// PreliminaryFlag (0040,A496) CS
+	PreliminaryFlag uint32 = 0x0040A496
+	// ContentTemplateSequence (0040,A504) SQ
+	ContentTemplateSequence uint32 = 0x0040A504
+	// IdenticalDocumentsSequence (0040,A525) SQ
+	IdenticalDocumentsSequence uint32 = 0x0040A525
+	// ObservationSubjectContextFlagTrial (0040,A600) CS, retired
+	ObservationSubjectContextFlagTrial uint32 = 0x0040A600
+	// ObserverContextFlagTrial (0040,A601) CS, retired
+	ObserverContextFlagTrial uint32 = 0x0040A601
+	// ProcedureContextFlagTrial (0040,A603) CS, retired
+	ProcedureContextFlagTrial uint32 = 0x0040A603
+	// ContentSequence (0040,A730) SQ
+	ContentSequence uint32 = 0x0040A730
+	// RelationshipSequenceTrial (0040,A731) SQ, retired
+	RelationshipSequenceTrial uint32 = 0x0040A731
+	// RelationshipTypeCodeSequenceTrial (0040,A732) SQ, retired
+	RelationshipTypeCodeSequenceTrial uint32 = 0x0040A732
+	// LanguageCodeSequenceTrial (0040,A744) SQ, retired
+	LanguageCodeSequenceTrial uint32 = 0x0040A744
+	// UniformResourceLocatorTrial (0040,A992) ST, retired
+	UniformResourceLocatorTrial uint32 = 0x0040A992
+	// WaveformAnnotationSequence (0040,B020) SQ
+	WaveformAnnotationSequence uint32 = 0x0040B020
+	// TemplateIdentifier (0040,DB00) CS
+	TemplateIdentifier uint32 = 0x0040DB00
+	// TemplateVersion (0040,DB06) DT, retired
+	TemplateVersion uint32 = 0x0040DB06
+	// TemplateLocalVersion (0040,DB07) DT, retired
+	TemplateLocalVersion uint32 = 0x0040DB07
+	// TemplateExtensionFlag (0040,DB0B) CS, retired
+	TemplateExtensionFlag uint32 = 0x0040DB0B
+	// TemplateExtensionOrganizationUID (0040,DB0C) UI, retired
+	TemplateExtensionOrganizationUID uint32 = 0x0040DB0C
+	// TemplateExtensionCreatorUID (0040,DB0D) UI, retired
+	TemplateExtensionCreatorUID uint32 = 0x0040DB0D
+	// ReferencedContentItemIdentifier (0040,DB73) UL
+	ReferencedContentItemIdentifier uint32 = 0x0040DB73
+	// HL7InstanceIdentifier (0040,E001) ST
+	HL7InstanceIdentifier uint32 = 0x0040E001
+	// HL7DocumentEffectiveTime (0040,E004) DT
+	HL7DocumentEffectiveTime uint32 = 0x0040E004
+	// HL7DocumentTypeCodeSequence (0040,E006) SQ
+	HL7DocumentTypeCodeSequence uint32 = 0x0040E006
+	// DocumentClassCodeSequence (0040,E008) SQ
+	DocumentClassCodeSequence uint32 = 0x0040E008
+	// RetrieveURI (0040,E010) UR
+	RetrieveURI uint32 = 0x0040E010
+	// RetrieveLocationUID (0040,E011) UI
+	RetrieveLocationUID uint32 = 0x0040E011
+	// TypeOfInstances (0040,E020) CS
+	TypeOfInstances uint32 = 0x0040E020
+	// DICOMRetrievalSequence (0040,E021) SQ
+	DICOMRetrievalSequence uint32 = 0x0040E021
+	// DICOMMediaRetrievalSequence (0040,E022) SQ
+	DICOMMediaRetrievalSequence uint32 = 0x0040E022
+	// WADORetrievalSequence (0040,E023) SQ
+	WADORetrievalSequence uint32 = 0x0040E023
+	// XDSRetrievalSequence (0040,E024) SQ
+	XDSRetrievalSequence uint32 = 0x0040E024
+	// WADORSRetrievalSequence (0040,E025) SQ
+	WADORSRetrievalSequence uint32 = 0x0040E025
+	// RepositoryUniqueID (0040,E030) UI
+	RepositoryUniqueID uint32 = 0x0040E030
+	// HomeCommunityID (0040,E031) UI
+	HomeCommunityID uint32 = 0x0040E031
+	// DocumentTitle (0042,0010) ST
+	DocumentTitle uint32 = 0x00420010
+	// EncapsulatedDocument (0042,0011) OB
+	EncapsulatedDocument uint32 = 0x00420011
+	// MIMETypeOfEncapsulatedDocument (0042,0012) LO
+	MIMETypeOfEncapsulatedDocument uint32 = 0x00420012
+	// SourceInstanceSequence (0042,0013) SQ
+	SourceInstanceSequence uint32 = 0x00420013
+	// ListOfMIMETypes (0042,0014) LO
+	ListOfMIMETypes uint32 = 0x00420014
+	// ProductPackageIdentifier (0044,0001) ST
+	ProductPackageIdentifier uint32 = 0x00440001
+	// SubstanceAdministrationApproval (0044,0002) CS
+	SubstanceAdministrationApproval uint32 = 0x00440002
+	// ApprovalStatusFurtherDescription (0044,0003) LT
+	ApprovalStatusFurtherDescription uint32 = 0x00440003
+	// ApprovalStatusDateTime (0044,0004) DT
+	ApprovalStatusDateTime uint32 = 0x00440004
+	// ProductTypeCodeSequence (0044,0007) SQ
+	ProductTypeCodeSequence uint32 = 0x00440007
+	// ProductName (0044,0008) LO
+	ProductName uint32 = 0x00440008
+	// ProductDescription (0044,0009) LT
+	ProductDescription uint32 = 0x00440009
+	// ProductLotIdentifier (0044,000A) LO
+	ProductLotIdentifier uint32 = 0x0044000A
+	// ProductExpirationDateTime (0044,000B) DT
+	ProductExpirationDateTime uint32 = 0x0044000B
+	// SubstanceAdministrationDateTime (0044,0010) DT
+	SubstanceAdministrationDateTime uint32 = 0x00440010
+	// SubstanceAdministrationNotes (0044,0011) LO
+	SubstanceAdministrationNotes uint32 = 0x00440011
+	// SubstanceAdministrationDeviceID (0044,0012) LO
+	SubstanceAdministrationDeviceID uint32 = 0x00440012
+	// ProductParameterSequence (0044,0013) SQ
+	ProductParameterSequence uint32 = 0x00440013
+	// SubstanceAdministrationParameterSequence (0044,0019) SQ
+	SubstanceAdministrationParameterSequence uint32 = 0x00440019
+	// ApprovalSequence (0044,0100) SQ
+	ApprovalSequence uint32 = 0x00440100
+	// AssertionCodeSequence (0044,0101) SQ
+	AssertionCodeSequence uint32 = 0x00440101
+	// AssertionUID (0044,0102) UI
+	AssertionUID uint32 = 0x00440102
+	// AsserterIdentificationSequence (0044,0103) SQ
+	AsserterIdentificationSequence uint32 = 0x00440103
+	// AssertionDateTime (0044,0104) DT
+	AssertionDateTime uint32 = 0x00440104
+	// AssertionExpirationDateTime (0044,0105) DT
+	AssertionExpirationDateTime uint32 = 0x00440105
+	// AssertionComments (0044,0106) UT
+	AssertionComments uint32 = 0x00440106
+	// RelatedAssertionSequence (0044,0107) SQ
+	RelatedAssertionSequence uint32 = 0x00440107
+	// ReferencedAssertionUID (0044,0108) UI
+	ReferencedAssertionUID uint32 = 0x00440108
+	// ApprovalSubjectSequence (0044,0109) SQ
+	ApprovalSubjectSequence uint32 = 0x00440109
+	// OrganizationalRoleCodeSequence (0044,010A) SQ
+	OrganizationalRoleCodeSequence uint32 = 0x0044010A
+	// LensDescription (0046,0012) LO
+	LensDescription uint32 = 0x00460012
+	// RightLensSequence (0046,0014) SQ
+	RightLensSequence uint32 = 0x00460014
+	// LeftLensSequence (0046,0015) SQ
+	LeftLensSequence uint32 = 0x00460015
+	// UnspecifiedLateralityLensSequence (0046,0016) SQ
+	UnspecifiedLateralityLensSequence uint32 = 0x00460016
+	// CylinderSequence (0046,0018) SQ
+	CylinderSequence uint32 = 0x00460018
+	// PrismSequence (0046,0028) SQ
+	PrismSequence uint32 = 0x00460028
+	// HorizontalPrismPower (0046,0030) FD
+	HorizontalPrismPower uint32 = 0x00460030
+	// HorizontalPrismBase (0046,0032) CS
+	HorizontalPrismBase uint32 = 0x00460032
+	// VerticalPrismPower (0046,0034) FD
+	VerticalPrismPower uint32 = 0x00460034
+	// VerticalPrismBase (0046,0036) CS
+	VerticalPrismBase uint32 = 0x00460036
+	// LensSegmentType (0046,0038) CS
+	LensSegmentType uint32 = 0x00460038
+	// OpticalTransmittance (0046,0040) FD
+	OpticalTransmittance uint32 = 0x00460040
+	// ChannelWidth (0046,0042) FD
+	ChannelWidth uint32 = 0x00460042
+	// PupilSize (0046,0044) FD
+	PupilSize uint32 = 0x00460044
+	// CornealSize (0046,0046) FD
+	CornealSize uint32 = 0x00460046
+	// AutorefractionRightEyeSequence (0046,0050) SQ
+	AutorefractionRightEyeSequence uint32 = 0x00460050
+	// AutorefractionLeftEyeSequence (0046,0052) SQ
+	AutorefractionLeftEyeSequence uint32 = 0x00460052
+	// DistancePupillaryDistance (0046,0060) FD
+	DistancePupillaryDistance uint32 = 0x00460060
+	// NearPupillaryDistance (0046,0062) FD
+	NearPupillaryDistance uint32 = 0x00460062
+	// IntermediatePupillaryDistance (0046,0063) FD
+	IntermediatePupillaryDistance uint32 = 0x00460063
+	// OtherPupillaryDistance (0046,0064) FD
+	OtherPupillaryDistance uint32 = 0x00460064
+	// KeratometryRightEyeSequence (0046,0070) SQ
+	KeratometryRightEyeSequence uint32 = 0x00460070
+	// KeratometryLeftEyeSequence (0046,0071) SQ
+	KeratometryLeftEyeSequence uint32 = 0x00460071
+	// SteepKeratometricAxisSequence (0046,0074) SQ
+	SteepKeratometricAxisSequence uint32 = 0x00460074
+	// RadiusOfCurvature (0046,0075) FD
+	RadiusOfCurvature uint32 = 0x00460075
+	// KeratometricPower (0046,0076) FD
+	KeratometricPower uint32 = 0x00460076
+	// KeratometricAxis (0046,0077) FD
+	KeratometricAxis uint32 = 0x00460077
+	// FlatKeratometricAxisSequence (0046,0080) SQ
+	FlatKeratometricAxisSequence uint32 = 0x00460080
+	// BackgroundColor (0046,0092) CS
+	BackgroundColor uint32 = 0x00460092
+	// Optotype (0046,0094) CS
+	Optotype uint32 = 0x00460094
+	// OptotypePresentation (0046,0095) CS
+	OptotypePresentation uint32 = 0x00460095
+	// SubjectiveRefractionRightEyeSequence (0046,0097) SQ
+	SubjectiveRefractionRightEyeSequence uint32 = 0x00460097
+	// SubjectiveRefractionLeftEyeSequence (0046,0098) SQ
+	SubjectiveRefractionLeftEyeSequence uint32 = 0x00460098
+	// AddNearSequence (0046,0100) SQ
+	AddNearSequence uint32 = 0x00460100
+	// AddIntermediateSequence (0046,0101) SQ
+	AddIntermediateSequence uint32 = 0x00460101
+	// AddOtherSequence (0046,0102) SQ
+	AddOtherSequence uint32 = 0x00460102
+	// AddPower (0046,0104) FD
+	AddPower uint32 = 0x00460104
+	// ViewingDistance (0046,0106) FD
+	ViewingDistance uint32 = 0x00460106
+	// VisualAcuityTypeCodeSequence (0046,0121) SQ
+	VisualAcuityTypeCodeSequence uint32 = 0x00460121
+	// VisualAcuityRightEyeSequence (0046,0122) SQ
+	VisualAcuityRightEyeSequence uint32 = 0x00460122
+	// VisualAcuityLeftEyeSequence (0046,0123) SQ
+	VisualAcuityLeftEyeSequence uint32 = 0x00460123
+	// VisualAcuityBothEyesOpenSequence (0046,0124) SQ
+	VisualAcuityBothEyesOpenSequence uint32 = 0x00460124
+	// ViewingDistanceType (0046,0125) CS
+	ViewingDistanceType uint32 = 0x00460125
+	// VisualAcuityModifiers (0046,0135) SS
+	VisualAcuityModifiers uint32 = 0x00460135
+	// DecimalVisualAcuity (0046,0137) FD
+	DecimalVisualAcuity uint32 = 0x00460137
+	// OptotypeDetailedDefinition (0046,0139) LO
+	OptotypeDetailedDefinition uint32 = 0x00460139
+	// ReferencedRefractiveMeasurementsSequence (0046,0145) SQ
+	ReferencedRefractiveMeasurementsSequence uint32 = 0x00460145
+	// SpherePower (0046,0146) FD
+	SpherePower uint32 = 0x00460146
+	// CylinderPower (0046,0147) FD
+	CylinderPower uint32 = 0x00460147
+	// CornealTopographySurface (0046,0201) CS
+	CornealTopographySurface uint32 = 0x00460201
+	// CornealVertexLocation (0046,0202) FL
+	CornealVertexLocation uint32 = 0x00460202
+	// PupilCentroidXCoordinate (0046,0203) FL
+	PupilCentroidXCoordinate uint32 = 0x00460203
+	// PupilCentroidYCoordinate (0046,0204) FL
+	PupilCentroidYCoordinate uint32 = 0x00460204
+	// EquivalentPupilRadius (0046,0205) FL
+	EquivalentPupilRadius uint32 = 0x00460205
+	// CornealTopographyMapTypeCodeSequence (0046,0207) SQ
+	CornealTopographyMapTypeCodeSequence uint32 = 0x00460207
+	// VerticesOfTheOutlineOfPupil (0046,0208) IS
+	VerticesOfTheOutlineOfPupil uint32 = 0x00460208
+	// CornealTopographyMappingNormalsSequence (0046,0210) SQ
+	CornealTopographyMappingNormalsSequence uint32 = 0x00460210
+	// MaximumCornealCurvatureSequence (0046,0211) SQ
+	MaximumCornealCurvatureSequence uint32 = 0x00460211
+	// MaximumCornealCurvature (0046,0212) FL
+	MaximumCornealCurvature uint32 = 0x00460212
+	// MaximumCornealCurvatureLocation (0046,0213) FL
+	MaximumCornealCurvatureLocation uint32 = 0x00460213
+	// MinimumKeratometricSequence (0046,0215) SQ
+	MinimumKeratometricSequence uint32 = 0x00460215
+	// SimulatedKeratometricCylinderSequence (0046,0218) SQ
+	SimulatedKeratometricCylinderSequence uint32 = 0x00460218
+	// AverageCornealPower (0046,0220) FL
+	AverageCornealPower uint32 = 0x00460220
+	// CornealISValue (0046,0224) FL
+	CornealISValue uint32 = 0x00460224
+	// AnalyzedArea (0046,0227) FL
+	AnalyzedArea uint32 = 0x00460227
+	// SurfaceRegularityIndex (0046,0230) FL
+	SurfaceRegularityIndex uint32 = 0x00460230
+	// SurfaceAsymmetryIndex (0046,0232) FL
+	SurfaceAsymmetryIndex uint32 = 0x00460232
+	// CornealEccentricityIndex (0046,0234) FL
+	CornealEccentricityIndex uint32 = 0x00460234
+	// KeratoconusPredictionIndex (0046,0236) FL
+	KeratoconusPredictionIndex uint32 = 0x00460236
+	// DecimalPotentialVisualAcuity (0046,0238) FL
+	DecimalPotentialVisualAcuity uint32 = 0x00460238
+	// CornealTopographyMapQualityEvaluation (0046,0242) CS
+	CornealTopographyMapQualityEvaluation uint32 = 0x00460242
+	// SourceImageCornealProcessedDataSequence (0046,0244) SQ
+	SourceImageCornealProcessedDataSequence uint32 = 0x00460244
+	// CornealPointLocation (0046,0247) FL
+	CornealPointLocation uint32 = 0x00460247
+	// CornealPointEstimated (0046,0248) CS
+	CornealPointEstimated uint32 = 0x00460248
+	// AxialPower (0046,0249) FL
+	AxialPower uint32 = 0x00460249
+	// TangentialPower (0046,0250) FL
+	TangentialPower uint32 = 0x00460250
+	// RefractivePower (0046,0251) FL
+	RefractivePower uint32 = 0x00460251
+	// RelativeElevation (0046,0252) FL
+	RelativeElevation uint32 = 0x00460252
+	// CornealWavefront (0046,0253) FL
+	CornealWavefront uint32 = 0x00460253
+	// ImagedVolumeWidth (0048,0001) FL
+	ImagedVolumeWidth uint32 = 0x00480001
+	// ImagedVolumeHeight (0048,0002) FL
+	ImagedVolumeHeight uint32 = 0x00480002
+	// ImagedVolumeDepth (0048,0003) FL
+	ImagedVolumeDepth uint32 = 0x00480003
+	// TotalPixelMatrixColumns (0048,0006) UL
+	TotalPixelMatrixColumns uint32 = 0x00480006
+	// TotalPixelMatrixRows (0048,0007) UL
+	TotalPixelMatrixRows uint32 = 0x00480007
+	// TotalPixelMatrixOriginSequence (0048,0008) SQ
+	TotalPixelMatrixOriginSequence uint32 = 0x00480008
+	// SpecimenLabelInImage (0048,0010) CS
+	SpecimenLabelInImage uint32 = 0x00480010
+	// FocusMethod (0048,0011) CS
+	FocusMethod uint32 = 0x00480011
+	// ExtendedDepthOfField (0048,0012) CS
+	ExtendedDepthOfField uint32 = 0x00480012
+	// NumberOfFocalPlanes (0048,0013) US
+	NumberOfFocalPlanes uint32 = 0x00480013
+	// DistanceBetweenFocalPlanes (0048,0014) FL
+	DistanceBetweenFocalPlanes uint32 = 0x00480014
+	// RecommendedAbsentPixelCIELabValue (0048,0015) US
+	RecommendedAbsentPixelCIELabValue uint32 = 0x00480015
+	// IlluminatorTypeCodeSequence (0048,0100) SQ
+	IlluminatorTypeCodeSequence uint32 = 0x00480100
+	// ImageOrientationSlide (0048,0102) DS
+	ImageOrientationSlide uint32 = 0x00480102
+	// OpticalPathSequence (0048,0105) SQ
+	OpticalPathSequence uint32 = 0x00480105
+	// OpticalPathIdentifier (0048,0106) SH
+	OpticalPathIdentifier uint32 = 0x00480106
+	// OpticalPathDescription (0048,0107) ST
+	OpticalPathDescription uint32 = 0x00480107
+	// IlluminationColorCodeSequence (0048,0108) SQ
+	IlluminationColorCodeSequence uint32 = 0x00480108
+	// SpecimenReferenceSequence (0048,0110) SQ
+	SpecimenReferenceSequence uint32 = 0x00480110
+	// CondenserLensPower (0048,0111) DS
+	CondenserLensPower uint32 = 0x00480111
+	// ObjectiveLensPower (0048,0112) DS
+	ObjectiveLensPower uint32 = 0x00480112
+	// ObjectiveLensNumericalAperture (0048,0113) DS
+	ObjectiveLensNumericalAperture uint32 = 0x00480113
+	// PaletteColorLookupTableSequence (0048,0120) SQ
+	PaletteColorLookupTableSequence uint32 = 0x00480120
+	// ReferencedImageNavigationSequence (0048,0200) SQ
+	ReferencedImageNavigationSequence uint32 = 0x00480200
+	// TopLeftHandCornerOfLocalizerArea (0048,0201) US
+	TopLeftHandCornerOfLocalizerArea uint32 = 0x00480201
+	// BottomRightHandCornerOfLocalizerArea (0048,0202) US
+	BottomRightHandCornerOfLocalizerArea uint32 = 0x00480202
+	// OpticalPathIdentificationSequence (0048,0207) SQ
+	OpticalPathIdentificationSequence uint32 = 0x00480207
+	// PlanePositionSlideSequence (0048,021A) SQ
+	PlanePositionSlideSequence uint32 = 0x0048021A
+	// ColumnPositionInTotalImagePixelMatrix (0048,021E) SL
+	ColumnPositionInTotalImagePixelMatrix uint32 = 0x0048021E
+	// RowPositionInTotalImagePixelMatrix (0048,021F) SL
+	RowPositionInTotalImagePixelMatrix uint32 = 0x0048021F
+	// PixelOriginInterpretation (0048,0301) CS
+	PixelOriginInterpretation uint32 = 0x00480301
+	// CalibrationImage (0050,0004) CS
+	CalibrationImage uint32 = 0x00500004
+	// DeviceSequence (0050,0010) SQ
+	DeviceSequence uint32 = 0x00500010
+	// ContainerComponentTypeCodeSequence (0050,0012) SQ
+	ContainerComponentTypeCodeSequence uint32 = 0x00500012
+	// ContainerComponentThickness (0050,0013) FD
+	ContainerComponentThickness uint32 = 0x00500013
+	// DeviceLength (0050,0014) DS
+	DeviceLength uint32 = 0x00500014
+	// ContainerComponentWidth (0050,0015) FD
+	ContainerComponentWidth uint32 = 0x00500015
+	// DeviceDiameter (0050,0016) DS
+	DeviceDiameter uint32 = 0x00500016
+	// DeviceDiameterUnits (0050,0017) CS
+	DeviceDiameterUnits uint32 = 0x00500017
+	// DeviceVolume (0050,0018) DS
+	DeviceVolume uint32 = 0x00500018
+	// InterMarkerDistance (0050,0019) DS
+	InterMarkerDistance uint32 = 0x00500019
+	// ContainerComponentMaterial (0050,001A) CS
+	ContainerComponentMaterial uint32 = 0x0050001A
+	// ContainerComponentID (0050,001B) LO
+	ContainerComponentID uint32 = 0x0050001B
+	// ContainerComponentLength (0050,001C) FD
+	ContainerComponentLength uint32 = 0x0050001C
+	// ContainerComponentDiameter (0050,001D) FD
+	ContainerComponentDiameter uint32 = 0x0050001D
+	// ContainerComponentDescription (0050,001E) LO
+	ContainerComponentDescription uint32 = 0x0050001E
+	// DeviceDescription (0050,0020) LO
+	DeviceDescription uint32 = 0x00500020
+	// ContrastBolusIngredientPercentByVolume (0052,0001) FL
+	ContrastBolusIngredientPercentByVolume uint32 = 0x00520001
+	// OCTFocalDistance (0052,0002) FD
+	OCTFocalDistance uint32 = 0x00520002
+	// BeamSpotSize (0052,0003) FD
+	BeamSpotSize uint32 = 0x00520003
+	// EffectiveRefractiveIndex (0052,0004) FD
+	EffectiveRefractiveIndex uint32 = 0x00520004
+	// OCTAcquisitionDomain (0052,0006) CS
+	OCTAcquisitionDomain uint32 = 0x00520006
+	// OCTOpticalCenterWavelength (0052,0007) FD
+	OCTOpticalCenterWavelength uint32 = 0x00520007
+	// AxialResolution (0052,0008) FD
+	AxialResolution uint32 = 0x00520008
+	// RangingDepth (0052,0009) FD
+	RangingDepth uint32 = 0x00520009
+	// ALineRate (0052,0011) FD
+	ALineRate uint32 = 0x00520011
+	// ALinesPerFrame (0052,0012) US
+	ALinesPerFrame uint32 = 0x00520012
+	// CatheterRotationalRate (0052,0013) FD
+	CatheterRotationalRate uint32 = 0x00520013
+	// ALinePixelSpacing (0052,0014) FD
+	ALinePixelSpacing uint32 = 0x00520014
+	// ModeOfPercutaneousAccessSequence (0052,0016) SQ
+	ModeOfPercutaneousAccessSequence uint32 = 0x00520016
+	// IntravascularOCTFrameTypeSequence (0052,0025) SQ
+	IntravascularOCTFrameTypeSequence uint32 = 0x00520025
+	// OCTZOffsetApplied (0052,0026) CS
+	OCTZOffsetApplied uint32 = 0x00520026
+	// IntravascularFrameContentSequence (0052,0027) SQ
+	IntravascularFrameContentSequence uint32 = 0x00520027
+	// IntravascularLongitudinalDistance (0052,0028) FD
+	IntravascularLongitudinalDistance uint32 = 0x00520028
+	// IntravascularOCTFrameContentSequence (0052,0029) SQ
+	IntravascularOCTFrameContentSequence uint32 = 0x00520029
+	// OCTZOffsetCorrection (0052,0030) SS
+	OCTZOffsetCorrection uint32 = 0x00520030
+	// CatheterDirectionOfRotation (0052,0031) CS
+	CatheterDirectionOfRotation uint32 = 0x00520031
+	// SeamLineLocation (0052,0033) FD
+	SeamLineLocation uint32 = 0x00520033
+	// FirstALineLocation (0052,0034) FD
+	FirstALineLocation uint32 = 0x00520034
+	// SeamLineIndex (0052,0036) US
+	SeamLineIndex uint32 = 0x00520036
+	// NumberOfPaddedALines (0052,0038) US
+	NumberOfPaddedALines uint32 = 0x00520038
+	// InterpolationType (0052,0039) CS
+	InterpolationType uint32 = 0x00520039
+	// RefractiveIndexApplied (0052,003A) CS
+	RefractiveIndexApplied uint32 = 0x0052003A
+	// EnergyWindowVector (0054,0010) US
+	EnergyWindowVector uint32 = 0x00540010
+	// NumberOfEnergyWindows (0054,0011) US
+	NumberOfEnergyWindows uint32 = 0x00540011
+	// EnergyWindowInformationSequence (0054,0012) SQ
+	EnergyWindowInformationSequence uint32 = 0x00540012
+	// EnergyWindowRangeSequence (0054,0013) SQ
+	EnergyWindowRangeSequence uint32 = 0x00540013
+	// EnergyWindowLowerLimit (0054,0014) DS
+	EnergyWindowLowerLimit uint32 = 0x00540014
+	// EnergyWindowUpperLimit (0054,0015) DS
+	EnergyWindowUpperLimit uint32 = 0x00540015
+	// RadiopharmaceuticalInformationSequence (0054,0016) SQ
+	RadiopharmaceuticalInformationSequence uint32 = 0x00540016
+	// ResidualSyringeCounts (0054,0017) IS
+	ResidualSyringeCounts uint32 = 0x00540017
+	// EnergyWindowName (0054,0018) SH
+	EnergyWindowName uint32 = 0x00540018
+	// DetectorVector (0054,0020) US
+	DetectorVector uint32 = 0x00540020
+	// NumberOfDetectors (0054,0021) US
+	NumberOfDetectors uint32 = 0x00540021
+	// DetectorInformationSequence (0054,0022) SQ
+	DetectorInformationSequence uint32 = 0x00540022
+	// PhaseVector (0054,0030) US
+	PhaseVector uint32 = 0x00540030
+	// NumberOfPhases (0054,0031) US
+	NumberOfPhases uint32 = 0x00540031
+	// PhaseInformationSequence (0054,0032) SQ
+	PhaseInformationSequence uint32 = 0x00540032
+	// NumberOfFramesInPhase (0054,0033) US
+	NumberOfFramesInPhase uint32 = 0x00540033
+	// PhaseDelay (0054,0036) IS
+	PhaseDelay uint32 = 0x00540036
+	// PauseBetweenFrames (0054,0038) IS
+	PauseBetweenFrames uint32 = 0x00540038
+	// PhaseDescription (0054,0039) CS
+	PhaseDescription uint32 = 0x00540039
+	// RotationVector (0054,0050) US
+	RotationVector uint32 = 0x00540050
+	// NumberOfRotations (0054,0051) US
+	NumberOfRotations uint32 = 0x00540051
+	// RotationInformationSequence (0054,0052) SQ
+	RotationInformationSequence uint32 = 0x00540052
+	// NumberOfFramesInRotation (0054,0053) US
+	NumberOfFramesInRotation uint32 = 0x00540053
+	// RRIntervalVector (0054,0060) US
+	RRIntervalVector uint32 = 0x00540060
+	// NumberOfRRIntervals (0054,0061) US
+	NumberOfRRIntervals uint32 = 0x00540061
+	// GatedInformationSequence (0054,0062) SQ
+	GatedInformationSequence uint32 = 0x00540062
+	// DataInformationSequence (0054,0063) SQ
+	DataInformationSequence uint32 = 0x00540063
+	// TimeSlotVector (0054,0070) US
+	TimeSlotVector uint32 = 0x00540070
+	// NumberOfTimeSlots (0054,0071) US
+	NumberOfTimeSlots uint32 = 0x00540071
+	// TimeSlotInformationSequence (0054,0072) SQ
+	TimeSlotInformationSequence uint32 = 0x00540072
+	// TimeSlotTime (0054,0073) DS
+	TimeSlotTime uint32 = 0x00540073
+	// SliceVector (0054,0080) US
+	SliceVector uint32 = 0x00540080
+	// NumberOfSlices (0054,0081) US
+	NumberOfSlices uint32 = 0x00540081
+	// AngularViewVector (0054,0090) US
+	AngularViewVector uint32 = 0x00540090
+	// TimeSliceVector (0054,0100) US
+	TimeSliceVector uint32 = 0x00540100
+	// NumberOfTimeSlices (0054,0101) US
+	NumberOfTimeSlices uint32 = 0x00540101
+	// StartAngle (0054,0200) DS
+	StartAngle uint32 = 0x00540200
+	// TypeOfDetectorMotion (0054,0202) CS
+	TypeOfDetectorMotion uint32 = 0x00540202
+	// TriggerVector (0054,0210) IS
+	TriggerVector uint32 = 0x00540210
+	// NumberOfTriggersInPhase (0054,0211) US
+	NumberOfTriggersInPhase uint32 = 0x00540211
+	// ViewCodeSequence (0054,0220) SQ
+	ViewCodeSequence uint32 = 0x00540220
+	// ViewModifierCodeSequence (0054,0222) SQ
+	ViewModifierCodeSequence uint32 = 0x00540222
+	// RadionuclideCodeSequence (0054,0300) SQ
+	RadionuclideCodeSequence uint32 = 0x00540300
+	// AdministrationRouteCodeSequence (0054,0302) SQ
+	AdministrationRouteCodeSequence uint32 = 0x00540302
+	// RadiopharmaceuticalCodeSequence (0054,0304) SQ
+	RadiopharmaceuticalCodeSequence uint32 = 0x00540304
+	// CalibrationDataSequence (0054,0306) SQ
+	CalibrationDataSequence uint32 = 0x00540306
+	// EnergyWindowNumber (0054,0308) US
+	EnergyWindowNumber uint32 = 0x00540308
+	// ImageID (0054,0400) SH
+	ImageID uint32 = 0x00540400
+	// PatientOrientationCodeSequence (0054,0410) SQ
+	PatientOrientationCodeSequence uint32 = 0x00540410
+	// PatientOrientationModifierCodeSequence (0054,0412) SQ
+	PatientOrientationModifierCodeSequence uint32 = 0x00540412
+	// PatientGantryRelationshipCodeSequence (0054,0414) SQ
+	PatientGantryRelationshipCodeSequence uint32 = 0x00540414
+	// SliceProgressionDirection (0054,0500) CS
+	SliceProgressionDirection uint32 = 0x00540500
+	// ScanProgressionDirection (0054,0501) CS
+	ScanProgressionDirection uint32 = 0x00540501
+	// SeriesType (0054,1000) CS
+	SeriesType uint32 = 0x00541000
+	// Units (0054,1001) CS
+	Units uint32 = 0x00541001
+	// CountsSource (0054,1002) CS
+	CountsSource uint32 = 0x00541002
+	// ReprojectionMethod (0054,1004) CS
+	ReprojectionMethod uint32 = 0x00541004
+	// SUVType (0054,1006) CS
+	SUVType uint32 = 0x00541006
+	// RandomsCorrectionMethod (0054,1100) CS
+	RandomsCorrectionMethod uint32 = 0x00541100
+	// AttenuationCorrectionMethod (0054,1101) LO
+	AttenuationCorrectionMethod uint32 = 0x00541101
+	// DecayCorrection (0054,1102) CS
+	DecayCorrection uint32 = 0x00541102
+	// ReconstructionMethod (0054,1103) LO
+	ReconstructionMethod uint32 = 0x00541103
+	// DetectorLinesOfResponseUsed (0054,1104) LO
+	DetectorLinesOfResponseUsed uint32 = 0x00541104
+	// ScatterCorrectionMethod (0054,1105) LO
+	ScatterCorrectionMethod uint32 = 0x00541105
+	// AxialAcceptance (0054,1200) DS
+	AxialAcceptance uint32 = 0x00541200
+	// AxialMash (0054,1201) IS
+	AxialMash uint32 = 0x00541201
+	// TransverseMash (0054,1202) IS
+	TransverseMash uint32 = 0x00541202
+	// DetectorElementSize (0054,1203) DS
+	DetectorElementSize uint32 = 0x00541203
+	// CoincidenceWindowWidth (0054,1210) DS
+	CoincidenceWindowWidth uint32 = 0x00541210
+	// SecondaryCountsType (0054,1220) CS
+	SecondaryCountsType uint32 = 0x00541220
+	// FrameReferenceTime (0054,1300) DS
+	FrameReferenceTime uint32 = 0x00541300
+	// PrimaryPromptsCountsAccumulated (0054,1310) IS
+	PrimaryPromptsCountsAccumulated uint32 = 0x00541310
+	// SecondaryCountsAccumulated (0054,1311) IS
+	SecondaryCountsAccumulated uint32 = 0x00541311
+	// SliceSensitivityFactor (0054,1320) DS
+	SliceSensitivityFactor uint32 = 0x00541320
+	// DecayFactor (0054,1321) DS
+	DecayFactor uint32 = 0x00541321
+	// DoseCalibrationFactor (0054,1322) DS
+	DoseCalibrationFactor uint32 = 0x00541322
+	// ScatterFractionFactor (0054,1323) DS
+	ScatterFractionFactor uint32 = 0x00541323
+	// DeadTimeFactor (0054,1324) DS
+	DeadTimeFactor uint32 = 0x00541324
+	// ImageIndex (0054,1330) US
+	ImageIndex uint32 = 0x00541330
+	// CountsIncluded (0054,1400) CS, retired
+	CountsIncluded uint32 = 0x00541400
+	// DeadTimeCorrectionFlag (0054,1401) CS, retired
+	DeadTimeCorrectionFlag uint32 = 0x00541401
+	// HistogramSequence (0060,3000) SQ
+	HistogramSequence uint32 = 0x00603000
+	// HistogramNumberOfBins (0060,3002) US
+	HistogramNumberOfBins uint32 = 0x00603002
+	// HistogramFirstBinValue (0060,3004) US
+	HistogramFirstBinValue uint32 = 0x00603004
+	// HistogramLastBinValue (0060,3006) US
+	HistogramLastBinValue uint32 = 0x00603006
+	// HistogramBinWidth (0060,3008) US
+	HistogramBinWidth uint32 = 0x00603008
+	// HistogramExplanation (0060,3010) LO
+	HistogramExplanation uint32 = 0x00603010
+	// HistogramData (0060,3020) UL
+	HistogramData uint32 = 0x00603020
+	// SegmentationType (0062,0001) CS
+	SegmentationType uint32 = 0x00620001
+	// SegmentSequence (0062,0002) SQ
+	SegmentSequence uint32 = 0x00620002
+	// SegmentedPropertyCategoryCodeSequence (0062,0003) SQ
+	SegmentedPropertyCategoryCodeSequence uint32 = 0x00620003
+	// SegmentNumber (0062,0004) US
+	SegmentNumber uint32 = 0x00620004
+	// SegmentLabel (0062,0005) LO
+	SegmentLabel uint32 = 0x00620005
+	// SegmentDescription (0062,0006) ST
+	SegmentDescription uint32 = 0x00620006
+	// SegmentationAlgorithmIdentificationSequence (0062,0007) SQ
+	SegmentationAlgorithmIdentificationSequence uint32 = 0x00620007
+	// SegmentAlgorithmType (0062,0008) CS
+	SegmentAlgorithmType uint32 = 0x00620008
+	// SegmentAlgorithmName (0062,0009) LO
+	SegmentAlgorithmName uint32 = 0x00620009
+	// SegmentIdentificationSequence (0062,000A) SQ
+	SegmentIdentificationSequence uint32 = 0x0062000A
+	// ReferencedSegmentNumber (0062,000B) US
+	ReferencedSegmentNumber uint32 = 0x0062000B
+	// RecommendedDisplayGrayscaleValue (0062,000C) US
+	RecommendedDisplayGrayscaleValue uint32 = 0x0062000C
+	// RecommendedDisplayCIELabValue (0062,000D) US
+	RecommendedDisplayCIELabValue uint32 = 0x0062000D
+	// MaximumFractionalValue (0062,000E) US
+	MaximumFractionalValue uint32 = 0x0062000E
+	// SegmentedPropertyTypeCodeSequence (0062,000F) SQ
+	SegmentedPropertyTypeCodeSequence uint32 = 0x0062000F
+	// SegmentationFractionalType (0062,0010) CS
+	SegmentationFractionalType uint32 = 0x00620010
+	// SegmentedPropertyTypeModifierCodeSequence (0062,0011) SQ
+	SegmentedPropertyTypeModifierCodeSequence uint32 = 0x00620011
+	// UsedSegmentsSequence (0062,0012) SQ
+	UsedSegmentsSequence uint32 = 0x00620012
+	// TrackingID (0062,0020) UT
+	TrackingID uint32 = 0x00620020
+	// TrackingUID (0062,0021) UI
+	TrackingUID uint32 = 0x00620021
+	// DeformableRegistrationSequence (0064,0002) SQ
+	DeformableRegistrationSequence uint32 = 0x00640002
+	// SourceFrameOfReferenceUID (0064,0003) UI
+	SourceFrameOfReferenceUID uint32 = 0x00640003
+	// DeformableRegistrationGridSequence (0064,0005) SQ
+	DeformableRegistrationGridSequence uint32 = 0x00640005
+	// GridDimensions (0064,0007) UL
+	GridDimensions uint32 = 0x00640007
+	// GridResolution (0064,0008) FD
+	GridResolution uint32 = 0x00640008
+	// VectorGridData (0064,0009) OF
+	VectorGridData uint32 = 0x00640009
+	// PreDeformationMatrixRegistrationSequence (0064,000F) SQ
+	PreDeformationMatrixRegistrationSequence uint32 = 0x0064000F
+	// PostDeformationMatrixRegistrationSequence (0064,0010) SQ
+	PostDeformationMatrixRegistrationSequence uint32 = 0x00640010
+	// NumberOfSurfaces (0066,0001) UL
+	NumberOfSurfaces uint32 = 0x00660001
+	// SurfaceSequence (0066,0002) SQ
+	SurfaceSequence uint32 = 0x00660002
+	// SurfaceNumber (0066,0003) UL
+	SurfaceNumber uint32 = 0x00660003
+	// SurfaceComments (0066,0004) LT
+	SurfaceComments uint32 = 0x00660004
+	// SurfaceProcessing (0066,0009) CS
+	SurfaceProcessing uint32 = 0x00660009
+	// SurfaceProcessingRatio (0066,000A) FL
+	SurfaceProcessingRatio uint32 = 0x0066000A
+	// SurfaceProcessingDescription (0066,000B) LO
+	SurfaceProcessingDescription uint32 = 0x0066000B
+	// RecommendedPresentationOpacity (0066,000C) FL
+	RecommendedPresentationOpacity uint32 = 0x0066000C
+	// RecommendedPresentationType (0066,000D) CS
+	RecommendedPresentationType uint32 = 0x0066000D
+	// FiniteVolume (0066,000E) CS
+	FiniteVolume uint32 = 0x0066000E
+	// Manifold (0066,0010) CS
+	Manifold uint32 = 0x00660010
+	// SurfacePointsSequence (0066,0011) SQ
+	SurfacePointsSequence uint32 = 0x00660011
+	// SurfacePointsNormalsSequence (0066,0012) SQ
+	SurfacePointsNormalsSequence uint32 = 0x00660012
+	// SurfaceMeshPrimitivesSequence (0066,0013) SQ
+	SurfaceMeshPrimitivesSequence uint32 = 0x00660013
+	// NumberOfSurfacePoints (0066,0015) UL
+	NumberOfSurfacePoints uint32 = 0x00660015
+	// PointCoordinatesData (0066,0016) OF
+	PointCoordinatesData uint32 = 0x00660016
+	// PointPositionAccuracy (0066,0017) FL
+	PointPositionAccuracy uint32 = 0x00660017
+	// MeanPointDistance (0066,0018) FL
+	MeanPointDistance uint32 = 0x00660018
+	// MaximumPointDistance (0066,0019) FL
+	MaximumPointDistance uint32 = 0x00660019
+	// PointsBoundingBoxCoordinates (0066,001A) FL
+	PointsBoundingBoxCoordinates uint32 = 0x0066001A
+	// AxisOfRotation (0066,001B) FL
+	AxisOfRotation uint32 = 0x0066001B
+	// CenterOfRotation (0066,001C) FL
+	CenterOfRotation uint32 = 0x0066001C
+	// NumberOfVectors (0066,001E) UL
+	NumberOfVectors uint32 = 0x0066001E
+	// VectorDimensionality (0066,001F) US
+	VectorDimensionality uint32 = 0x0066001F
+	// VectorAccuracy (0066,0020) FL
+	VectorAccuracy uint32 = 0x00660020
+	// VectorCoordinateData (0066,0021) OF
+	VectorCoordinateData uint32 = 0x00660021
+	// TrianglePointIndexList (0066,0023) OW, retired
+	TrianglePointIndexList uint32 = 0x00660023
+	// EdgePointIndexList (0066,0024) OW, retired
+	EdgePointIndexList uint32 = 0x00660024
+	// VertexPointIndexList (0066,0025) OW, retired
+	VertexPointIndexList uint32 = 0x00660025
+	// TriangleStripSequence (0066,0026) SQ
+	TriangleStripSequence uint32 = 0x00660026
+	// TriangleFanSequence (0066,0027) SQ
+	TriangleFanSequence uint32 = 0x00660027
+	// LineSequence (0066,0028) SQ
+	LineSequence uint32 = 0x00660028
+	// PrimitivePointIndexList (0066,0029) OW, retired
+	PrimitivePointIndexList uint32 = 0x00660029
+	// SurfaceCount (0066,002A) UL
+	SurfaceCount uint32 = 0x0066002A
+	// ReferencedSurfaceSequence (0066,002B) SQ
+	ReferencedSurfaceSequence uint32 = 0x0066002B
+	// ReferencedSurfaceNumber (0066,002C) UL
+	ReferencedSurfaceNumber uint32 = 0x0066002C
+	// SegmentSurfaceGenerationAlgorithmIdentificationSequence (0066,002D) SQ
+	SegmentSurfaceGenerationAlgorithmIdentificationSequence uint32 = 0x0066002D
+	// SegmentSurfaceSourceInstanceSequence (0066,002E) SQ
+	SegmentSurfaceSourceInstanceSequence uint32 = 0x0066002E
+	// AlgorithmFamilyCodeSequence (0066,002F) SQ
+	AlgorithmFamilyCodeSequence uint32 = 0x0066002F
+	// AlgorithmNameCodeSequence (0066,0030) SQ
+	AlgorithmNameCodeSequence uint32 = 0x00660030
+	// AlgorithmVersion (0066,0031) LO
+	AlgorithmVersion uint32 = 0x00660031
+	// AlgorithmParameters (0066,0032) LT
+	AlgorithmParameters uint32 = 0x00660032
+	// FacetSequence (0066,0034) SQ
+	FacetSequence uint32 = 0x00660034
+	// SurfaceProcessingAlgorithmIdentificationSequence (0066,0035) SQ
+	SurfaceProcessingAlgorithmIdentificationSequence uint32 = 0x00660035
+	// AlgorithmName (0066,0036) LO
+	AlgorithmName uint32 = 0x00660036
+	// RecommendedPointRadius (0066,0037) FL
+	RecommendedPointRadius uint32 = 0x00660037
+	// RecommendedLineThickness (0066,0038) FL
+	RecommendedLineThickness uint32 = 0x00660038
+	// LongPrimitivePointIndexList (0066,0040) OL
+	LongPrimitivePointIndexList uint32 = 0x00660040
+	// LongTrianglePointIndexList (0066,0041) OL
+	LongTrianglePointIndexList uint32 = 0x00660041
+	// LongEdgePointIndexList (0066,0042) OL
+	LongEdgePointIndexList uint32 = 0x00660042
+	// LongVertexPointIndexList (0066,0043) OL
+	LongVertexPointIndexList uint32 = 0x00660043
+	// TrackSetSequence (0066,0101) SQ
+	TrackSetSequence uint32 = 0x00660101
+	// TrackSequence (0066,0102) SQ
+	TrackSequence uint32 = 0x00660102
+	// RecommendedDisplayCIELabValueList (0066,0103) OW
+	RecommendedDisplayCIELabValueList uint32 = 0x00660103
+	// TrackingAlgorithmIdentificationSequence (0066,0104) SQ
+	TrackingAlgorithmIdentificationSequence uint32 = 0x00660104
+	// TrackSetNumber (0066,0105) UL
+	TrackSetNumber uint32 = 0x00660105
+	// TrackSetLabel (0066,0106) LO
+	TrackSetLabel uint32 = 0x00660106
+	// TrackSetDescription (0066,0107) UT
+	TrackSetDescription uint32 = 0x00660107
+	// TrackSetAnatomicalTypeCodeSequence (0066,0108) SQ
+	TrackSetAnatomicalTypeCodeSequence uint32 = 0x00660108
+	// MeasurementsSequence (0066,0121) SQ
+	MeasurementsSequence uint32 = 0x00660121
+	// TrackSetStatisticsSequence (0066,0124) SQ
+	TrackSetStatisticsSequence uint32 = 0x00660124
+	// FloatingPointValues (0066,0125) OF
+	FloatingPointValues uint32 = 0x00660125
+	// TrackPointIndexList (0066,0129) OL
+	TrackPointIndexList uint32 = 0x00660129
+	// TrackStatisticsSequence (0066,0130) SQ
+	TrackStatisticsSequence uint32 = 0x00660130
+	// MeasurementValuesSequence (0066,0132) SQ
+	MeasurementValuesSequence uint32 = 0x00660132
+	// DiffusionAcquisitionCodeSequence (0066,0133) SQ
+	DiffusionAcquisitionCodeSequence uint32 = 0x00660133
+	// DiffusionModelCodeSequence (0066,0134) SQ
+	DiffusionModelCodeSequence uint32 = 0x00660134
+	// ImplantSize (0068,6210) LO
+	ImplantSize uint32 = 0x00686210
+	// ImplantTemplateVersion (0068,6221) LO
+	ImplantTemplateVersion uint32 = 0x00686221
+	// ReplacedImplantTemplateSequence (0068,6222) SQ
+	ReplacedImplantTemplateSequence uint32 = 0x00686222
+	// ImplantType (0068,6223) CS
+	ImplantType uint32 = 0x00686223
+	// DerivationImplantTemplateSequence (0068,6224) SQ
+	DerivationImplantTemplateSequence uint32 = 0x00686224
+	// OriginalImplantTemplateSequence (0068,6225) SQ
+	OriginalImplantTemplateSequence uint32 = 0x00686225
+	// EffectiveDateTime (0068,6226) DT
+	EffectiveDateTime uint32 = 0x00686226
+	// ImplantTargetAnatomySequence (0068,6230) SQ
+	ImplantTargetAnatomySequence uint32 = 0x00686230
+	// InformationFromManufacturerSequence (0068,6260) SQ
+	InformationFromManufacturerSequence uint32 = 0x00686260
+	// NotificationFromManufacturerSequence (0068,6265) SQ
+	NotificationFromManufacturerSequence uint32 = 0x00686265
+	// InformationIssueDateTime (0068,6270) DT
+	InformationIssueDateTime uint32 = 0x00686270
+	// InformationSummary (0068,6280) ST
+	InformationSummary uint32 = 0x00686280
+	// ImplantRegulatoryDisapprovalCodeSequence (0068,62A0) SQ
+	ImplantRegulatoryDisapprovalCodeSequence uint32 = 0x006862A0
+	// OverallTemplateSpatialTolerance (0068,62A5) FD
+	OverallTemplateSpatialTolerance uint32 = 0x006862A5
+	// HPGLDocumentSequence (0068,62C0) SQ
+	HPGLDocumentSequence uint32 = 0x006862C0
+	// HPGLDocumentID (0068,62D0) US
+	HPGLDocumentID uint32 = 0x006862D0
+	// HPGLDocumentLabel (0068,62D5) LO
+	HPGLDocumentLabel uint32 = 0x006862D5
+	// ViewOrientationCodeSequence (0068,62E0) SQ
+	ViewOrientationCodeSequence uint32 = 0x006862E0
+	// ViewOrientationModifierCodeSequence (0068,62F0) SQ
+	ViewOrientationModifierCodeSequence uint32 = 0x006862F0
+	// HPGLDocumentScaling (0068,62F2) FD
+	HPGLDocumentScaling uint32 = 0x006862F2
+	// HPGLDocument (0068,6300) OB
+	HPGLDocument uint32 = 0x00686300
+	// HPGLContourPenNumber (0068,6310) US
+	HPGLContourPenNumber uint32 = 0x00686310
+	// HPGLPenSequence (0068,6320) SQ
+	HPGLPenSequence uint32 = 0x00686320
+	// HPGLPenNumber (0068,6330) US
+	HPGLPenNumber uint32 = 0x00686330
+	// HPGLPenLabel (0068,6340) LO
+	HPGLPenLabel uint32 = 0x00686340
+	// HPGLPenDescription (0068,6345) ST
+	HPGLPenDescription uint32 = 0x00686345
+	// RecommendedRotationPoint (0068,6346) FD
+	RecommendedRotationPoint uint32 = 0x00686346
+	// BoundingRectangle (0068,6347) FD
+	BoundingRectangle uint32 = 0x00686347
+	// ImplantTemplate3DModelSurfaceNumber (0068,6350) US
+	ImplantTemplate3DModelSurfaceNumber uint32 = 0x00686350
+	// SurfaceModelDescriptionSequence (0068,6360) SQ
+	SurfaceModelDescriptionSequence uint32 = 0x00686360
+	// SurfaceModelLabel (0068,6380) LO
+	SurfaceModelLabel uint32 = 0x00686380
+	// SurfaceModelScalingFactor (0068,6390) FD
+	SurfaceModelScalingFactor uint32 = 0x00686390
+	// MaterialsCodeSequence (0068,63A0) SQ
+	MaterialsCodeSequence uint32 = 0x006863A0
+	// CoatingMaterialsCodeSequence (0068,63A4) SQ
+	CoatingMaterialsCodeSequence uint32 = 0x006863A4
+	// ImplantTypeCodeSequence (0068,63A8) SQ
+	ImplantTypeCodeSequence uint32 = 0x006863A8
+	// FixationMethodCodeSequence (0068,63AC) SQ
+	FixationMethodCodeSequence uint32 = 0x006863AC
+	// MatingFeatureSetsSequence (0068,63B0) SQ
+	MatingFeatureSetsSequence uint32 = 0x006863B0
+	// MatingFeatureSetID (0068,63C0) US
+	MatingFeatureSetID uint32 = 0x006863C0
+	// MatingFeatureSetLabel (0068,63D0) LO
+	MatingFeatureSetLabel uint32 = 0x006863D0
+	// MatingFeatureSequence (0068,63E0) SQ
+	MatingFeatureSequence uint32 = 0x006863E0
+	// MatingFeatureID (0068,63F0) US
+	MatingFeatureID uint32 = 0x006863F0
+	// MatingFeatureDegreeOfFreedomSequence (0068,6400) SQ
+	MatingFeatureDegreeOfFreedomSequence uint32 = 0x00686400
+	// DegreeOfFreedomID (0068,6410) US
+	DegreeOfFreedomID uint32 = 0x00686410
+	// DegreeOfFreedomType (0068,6420) CS
+	DegreeOfFreedomType uint32 = 0x00686420
+	// TwoDMatingFeatureCoordinatesSequence (0068,6430) SQ
+	TwoDMatingFeatureCoordinatesSequence uint32 = 0x00686430
+	// ReferencedHPGLDocumentID (0068,6440) US
+	ReferencedHPGLDocumentID uint32 = 0x00686440
+	// TwoDMatingPoint (0068,6450) FD
+	TwoDMatingPoint uint32 = 0x00686450
+	// TwoDMatingAxes (0068,6460) FD
+	TwoDMatingAxes uint32 = 0x00686460
+	// TwoDDegreeOfFreedomSequence (0068,6470) SQ
+	TwoDDegreeOfFreedomSequence uint32 = 0x00686470
+	// ThreeDDegreeOfFreedomAxis (0068,6490) FD
+	ThreeDDegreeOfFreedomAxis uint32 = 0x00686490
+	// RangeOfFreedom (0068,64A0) FD
+	RangeOfFreedom uint32 = 0x006864A0
+	// ThreeDMatingPoint (0068,64C0) FD
+	ThreeDMatingPoint uint32 = 0x006864C0
+	// ThreeDMatingAxes (0068,64D0) FD
+	ThreeDMatingAxes uint32 = 0x006864D0
+	// TwoDDegreeOfFreedomAxis (0068,64F0) FD
+	TwoDDegreeOfFreedomAxis uint32 = 0x006864F0
+	// PlanningLandmarkPointSequence (0068,6500) SQ
+	PlanningLandmarkPointSequence uint32 = 0x00686500
+	// PlanningLandmarkLineSequence (0068,6510) SQ
+	PlanningLandmarkLineSequence uint32 = 0x00686510
+	// PlanningLandmarkPlaneSequence (0068,6520) SQ
+	PlanningLandmarkPlaneSequence uint32 = 0x00686520
+	// PlanningLandmarkID (0068,6530) US
+	PlanningLandmarkID uint32 = 0x00686530
+	// PlanningLandmarkDescription (0068,6540) LO
+	PlanningLandmarkDescription uint32 = 0x00686540
+	// PlanningLandmarkIdentificationCodeSequence (0068,6545) SQ
+	PlanningLandmarkIdentificationCodeSequence uint32 = 0x00686545
+	// TwoDPointCoordinatesSequence (0068,6550) SQ
+	TwoDPointCoordinatesSequence uint32 = 0x00686550
+	// TwoDPointCoordinates (0068,6560) FD
+	TwoDPointCoordinates uint32 = 0x00686560
+	// ThreeDPointCoordinates (0068,6590) FD
+	ThreeDPointCoordinates uint32 = 0x00686590
+	// TwoDLineCoordinatesSequence (0068,65A0) SQ
+	TwoDLineCoordinatesSequence uint32 = 0x006865A0
+	// TwoDLineCoordinates (0068,65B0) FD
+	TwoDLineCoordinates uint32 = 0x006865B0
+	// ThreeDLineCoordinates (0068,65D0) FD
+	ThreeDLineCoordinates uint32 = 0x006865D0
+	// TwoDPlaneCoordinatesSequence (0068,65E0) SQ
+	TwoDPlaneCoordinatesSequence uint32 = 0x006865E0
+	// TwoDPlaneIntersection (0068,65F0) FD
+	TwoDPlaneIntersection uint32 = 0x006865F0
+	// ThreeDPlaneOrigin (0068,6610) FD
+	ThreeDPlaneOrigin uint32 = 0x00686610
+	// ThreeDPlaneNormal (0068,6620) FD
+	ThreeDPlaneNormal uint32 = 0x00686620
+	// GraphicAnnotationSequence (0070,0001) SQ
+	GraphicAnnotationSequence uint32 = 0x00700001
+	// GraphicLayer (0070,0002) CS
+	GraphicLayer uint32 = 0x00700002
+	// BoundingBoxAnnotationUnits (0070,0003) CS
+	BoundingBoxAnnotationUnits uint32 = 0x00700003
+	// AnchorPointAnnotationUnits (0070,0004) CS
+	AnchorPointAnnotationUnits uint32 = 0x00700004
+	// GraphicAnnotationUnits (0070,0005) CS
+	GraphicAnnotationUnits uint32 = 0x00700005
+	// UnformattedTextValue (0070,0006) ST
+	UnformattedTextValue uint32 = 0x00700006
+	// TextObjectSequence (0070,0008) SQ
+	TextObjectSequence uint32 = 0x00700008
+	// GraphicObjectSequence (0070,0009) SQ
+	GraphicObjectSequence uint32 = 0x00700009
+	// BoundingBoxTopLeftHandCorner (0070,0010) FL
+	BoundingBoxTopLeftHandCorner uint32 = 0x00700010
+	// BoundingBoxBottomRightHandCorner (0070,0011) FL
+	BoundingBoxBottomRightHandCorner uint32 = 0x00700011
+	// BoundingBoxTextHorizontalJustification (0070,0012) CS
+	BoundingBoxTextHorizontalJustification uint32 = 0x00700012
+	// AnchorPoint (0070,0014) FL
+	AnchorPoint uint32 = 0x00700014
+	// AnchorPointVisibility (0070,0015) CS
+	AnchorPointVisibility uint32 = 0x00700015
+	// GraphicDimensions (0070,0020) US
+	GraphicDimensions uint32 = 0x00700020
+	// NumberOfGraphicPoints (0070,0021) US
+	NumberOfGraphicPoints uint32 = 0x00700021
+	// GraphicData (0070,0022) FL
+	GraphicData uint32 = 0x00700022
+	// GraphicType (0070,0023) CS
+	GraphicType uint32 = 0x00700023
+	// GraphicFilled (0070,0024) CS
+	GraphicFilled uint32 = 0x00700024
+	// ImageRotationRetired (0070,0040) IS, retired
+	ImageRotationRetired uint32 = 0x00700040
+	// ImageHorizontalFlip (0070,0041) CS
+	ImageHorizontalFlip uint32 = 0x00700041
+	// ImageRotation (0070,0042) US
+	ImageRotation uint32 = 0x00700042
+	// DisplayedAreaTopLeftHandCornerTrial (0070,0050) US, retired
+	DisplayedAreaTopLeftHandCornerTrial uint32 = 0x00700050
+	// DisplayedAreaBottomRightHandCornerTrial (0070,0051) US, retired
+	DisplayedAreaBottomRightHandCornerTrial uint32 = 0x00700051
+	// DisplayedAreaTopLeftHandCorner (0070,0052) SL
+	DisplayedAreaTopLeftHandCorner uint32 = 0x00700052
+	// DisplayedAreaBottomRightHandCorner (0070,0053) SL
+	DisplayedAreaBottomRightHandCorner uint32 = 0x00700053
+	// DisplayedAreaSelectionSequence (0070,005A) SQ
+	DisplayedAreaSelectionSequence uint32 = 0x0070005A
+	// GraphicLayerSequence (0070,0060) SQ
+	GraphicLayerSequence uint32 = 0x00700060
+	// GraphicLayerOrder (0070,0062) IS
+	GraphicLayerOrder uint32 = 0x00700062
+	// GraphicLayerRecommendedDisplayGrayscaleValue (0070,0066) US
+	GraphicLayerRecommendedDisplayGrayscaleValue uint32 = 0x00700066
+	// GraphicLayerRecommendedDisplayRGBValue (0070,0067) US, retired
+	GraphicLayerRecommendedDisplayRGBValue uint32 = 0x00700067
+	// GraphicLayerDescription (0070,0068) LO
+	GraphicLayerDescription uint32 = 0x00700068
+	// ContentLabel (0070,0080) CS
+	ContentLabel uint32 = 0x00700080
+	// ContentDescription (0070,0081) LO
+	ContentDescription uint32 = 0x00700081
+	// PresentationCreationDate (0070,0082) DA
+	PresentationCreationDate uint32 = 0x00700082
+	// PresentationCreationTime (0070,0083) TM
+	PresentationCreationTime uint32 = 0x00700083
+	// ContentCreatorName (0070,0084) PN
+	ContentCreatorName uint32 = 0x00700084
+	// ContentCreatorIdentificationCodeSequence (0070,0086) SQ
+	ContentCreatorIdentificationCodeSequence uint32 = 0x00700086
+	// AlternateContentDescriptionSequence (0070,0087) SQ
+	AlternateContentDescriptionSequence uint32 = 0x00700087
+	// PresentationSizeMode (0070,0100) CS
+	PresentationSizeMode uint32 = 0x00700100
+	// PresentationPixelSpacing (0070,0101) DS
+	PresentationPixelSpacing uint32 = 0x00700101
+	// PresentationPixelAspectRatio (0070,0102) IS
+	PresentationPixelAspectRatio uint32 = 0x00700102
+	// PresentationPixelMagnificationRatio (0070,0103) FL
+	PresentationPixelMagnificationRatio uint32 = 0x00700103
+	// GraphicGroupLabel (0070,0207) LO
+	GraphicGroupLabel uint32 = 0x00700207
+	// GraphicGroupDescription (0070,0208) ST
+	GraphicGroupDescription uint32 = 0x00700208
+	// CompoundGraphicSequence (0070,0209) SQ
+	CompoundGraphicSequence uint32 = 0x00700209
+	// CompoundGraphicInstanceID (0070,0226) UL
+	CompoundGraphicInstanceID uint32 = 0x00700226
+	// FontName (0070,0227) LO
+	FontName uint32 = 0x00700227
+	// FontNameType (0070,0228) CS
+	FontNameType uint32 = 0x00700228
+	// CSSFontName (0070,0229) LO
+	CSSFontName uint32 = 0x00700229
+	// RotationAngle (0070,0230) FD
+	RotationAngle uint32 = 0x00700230
+	// TextStyleSequence (0070,0231) SQ
+	TextStyleSequence uint32 = 0x00700231
+	// LineStyleSequence (0070,0232) SQ
+	LineStyleSequence uint32 = 0x00700232
+	// FillStyleSequence (0070,0233) SQ
+	FillStyleSequence uint32 = 0x00700233
+	// GraphicGroupSequence (0070,0234) SQ
+	GraphicGroupSequence uint32 = 0x00700234
+	// TextColorCIELabValue (0070,0241) US
+	TextColorCIELabValue uint32 = 0x00700241
+	// HorizontalAlignment (0070,0242) CS
+	HorizontalAlignment uint32 = 0x00700242
+	// VerticalAlignment (0070,0243) CS
+	VerticalAlignment uint32 = 0x00700243
+	// ShadowStyle (0070,0244) CS
+	ShadowStyle uint32 = 0x00700244
+	// ShadowOffsetX (0070,0245) FL
+	ShadowOffsetX uint32 = 0x00700245
+	// ShadowOffsetY (0070,0246) FL
+	ShadowOffsetY uint32 = 0x00700246
+	// ShadowColorCIELabValue (0070,0247) US
+	ShadowColorCIELabValue uint32 = 0x00700247
+	// Underlined (0070,0248) CS
+	Underlined uint32 = 0x00700248
+	// Bold (0070,0249) CS
+	Bold uint32 = 0x00700249
+	// Italic (0070,0250) CS
+	Italic uint32 = 0x00700250
+	// PatternOnColorCIELabValue (0070,0251) US
+	PatternOnColorCIELabValue uint32 = 0x00700251
+	// PatternOffColorCIELabValue (0070,0252) US
+	PatternOffColorCIELabValue uint32 = 0x00700252
+	// LineThickness (0070,0253) FL
+	LineThickness uint32 = 0x00700253
+	// LineDashingStyle (0070,0254) CS
+	LineDashingStyle uint32 = 0x00700254
+	// LinePattern (0070,0255) UL
+	LinePattern uint32 = 0x00700255
+	// FillPattern (0070,0256) OB
+	FillPattern uint32 = 0x00700256
+	// FillMode (0070,0257) CS
+	FillMode uint32 = 0x00700257
+	// ShadowOpacity (0070,0258) FL
+	ShadowOpacity uint32 = 0x00700258
+	// GapLength (0070,0261) FL
+	GapLength uint32 = 0x00700261
+	// DiameterOfVisibility (0070,0262) FL
+	DiameterOfVisibility uint32 = 0x00700262
+	// RotationPoint (0070,0273) FL
+	RotationPoint uint32 = 0x00700273
+	// TickAlignment (0070,0274) CS
+	TickAlignment uint32 = 0x00700274
+	// ShowTickLabel (0070,0278) CS
+	ShowTickLabel uint32 = 0x00700278
+	// TickLabelAlignment (0070,0279) CS
+	TickLabelAlignment uint32 = 0x00700279
+	// CompoundGraphicUnits (0070,0282) CS
+	CompoundGraphicUnits uint32 = 0x00700282
+	// PatternOnOpacity (0070,0284) FL
+	PatternOnOpacity uint32 = 0x00700284
+	// PatternOffOpacity (0070,0285) FL
+	PatternOffOpacity uint32 = 0x00700285
+	// MajorTicksSequence (0070,0287) SQ
+	MajorTicksSequence uint32 = 0x00700287
+	// TickPosition (0070,0288) FL
+	TickPosition uint32 = 0x00700288
+	// TickLabel (0070,0289) SH
+	TickLabel uint32 = 0x00700289
+	// CompoundGraphicType (0070,0294) CS
+	CompoundGraphicType uint32 = 0x00700294
+	// GraphicGroupID (0070,0295) UL
+	GraphicGroupID uint32 = 0x00700295
+	// ShapeType (0070,0306) CS
+	ShapeType uint32 = 0x00700306
+	// RegistrationSequence (0070,0308) SQ
+	RegistrationSequence uint32 = 0x00700308
+	// MatrixRegistrationSequence (0070,0309) SQ
+	MatrixRegistrationSequence uint32 = 0x00700309
+	// MatrixSequence (0070,030A) SQ
+	MatrixSequence uint32 = 0x0070030A
+	// FrameOfReferenceToDisplayedCoordinateSystemTransformationMatrix (0070,030B) FD
+	FrameOfReferenceToDisplayedCoordinateSystemTransformationMatrix uint32 = 0x0070030B
+	// FrameOfReferenceTransformationMatrixType (0070,030C) CS
+	FrameOfReferenceTransformationMatrixType uint32 = 0x0070030C
+	// RegistrationTypeCodeSequence (0070,030D) SQ
+	RegistrationTypeCodeSequence uint32 = 0x0070030D
+	// FiducialDescription (0070,030F) ST
+	FiducialDescription uint32 = 0x0070030F
+	// FiducialIdentifier (0070,0310) SH
+	FiducialIdentifier uint32 = 0x00700310
+	// FiducialIdentifierCodeSequence (0070,0311) SQ
+	FiducialIdentifierCodeSequence uint32 = 0x00700311
+	// ContourUncertaintyRadius (0070,0312) FD
+	ContourUncertaintyRadius uint32 = 0x00700312
+	// UsedFiducialsSequence (0070,0314) SQ
+	UsedFiducialsSequence uint32 = 0x00700314
+	// GraphicCoordinatesDataSequence (0070,0318) SQ
+	GraphicCoordinatesDataSequence uint32 = 0x00700318
+	// FiducialUID (0070,031A) UI
+	FiducialUID uint32 = 0x0070031A
+	// ReferencedFiducialUID (0070,031B) UI
+	ReferencedFiducialUID uint32 = 0x0070031B
+	// FiducialSetSequence (0070,031C) SQ
+	FiducialSetSequence uint32 = 0x0070031C
+	// FiducialSequence (0070,031E) SQ
+	FiducialSequence uint32 = 0x0070031E
+	// FiducialsPropertyCategoryCodeSequence (0070,031F) SQ
+	FiducialsPropertyCategoryCodeSequence uint32 = 0x0070031F
+	// GraphicLayerRecommendedDisplayCIELabValue (0070,0401) US
+	GraphicLayerRecommendedDisplayCIELabValue uint32 = 0x00700401
+	// BlendingSequence (0070,0402) SQ
+	BlendingSequence uint32 = 0x00700402
+	// RelativeOpacity (0070,0403) FL
+	RelativeOpacity uint32 = 0x00700403
+	// ReferencedSpatialRegistrationSequence (0070,0404) SQ
+	ReferencedSpatialRegistrationSequence uint32 = 0x00700404
+	// BlendingPosition (0070,0405) CS
+	BlendingPosition uint32 = 0x00700405
+	// PresentationDisplayCollectionUID (0070,1101) UI
+	PresentationDisplayCollectionUID uint32 = 0x00701101
+	// PresentationSequenceCollectionUID (0070,1102) UI
+	PresentationSequenceCollectionUID uint32 = 0x00701102
+	// PresentationSequencePositionIndex (0070,1103) US
+	PresentationSequencePositionIndex uint32 = 0x00701103
+	// RenderedImageReferenceSequence (0070,1104) SQ
+	RenderedImageReferenceSequence uint32 = 0x00701104
+	// VolumetricPresentationStateInputSequence (0070,1201) SQ
+	VolumetricPresentationStateInputSequence uint32 = 0x00701201
+	// PresentationInputType (0070,1202) CS
+	PresentationInputType uint32 = 0x00701202
+	// InputSequencePositionIndex (0070,1203) US
+	InputSequencePositionIndex uint32 = 0x00701203
+	// Crop (0070,1204) CS
+	Crop uint32 = 0x00701204
+	// CroppingSpecificationIndex (0070,1205) US
+	CroppingSpecificationIndex uint32 = 0x00701205
+	// CompositingMethod (0070,1206) CS, retired
+	CompositingMethod uint32 = 0x00701206
+	// VolumetricPresentationInputNumber (0070,1207) US
+	VolumetricPresentationInputNumber uint32 = 0x00701207
+	// ImageVolumeGeometry (0070,1208) CS
+	ImageVolumeGeometry uint32 = 0x00701208
+	// VolumetricPresentationInputSetUID (0070,1209) UI
+	VolumetricPresentationInputSetUID uint32 = 0x00701209
+	// VolumetricPresentationInputSetSequence (0070,120A) SQ
+	VolumetricPresentationInputSetSequence uint32 = 0x0070120A
+	// GlobalCrop (0070,120B) CS
+	GlobalCrop uint32 = 0x0070120B
+	// GlobalCroppingSpecificationIndex (0070,120C) US
+	GlobalCroppingSpecificationIndex uint32 = 0x0070120C
+	// RenderingMethod (0070,120D) CS
+	RenderingMethod uint32 = 0x0070120D
+	// VolumeCroppingSequence (0070,1301) SQ
+	VolumeCroppingSequence uint32 = 0x00701301
+	// VolumeCroppingMethod (0070,1302) CS
+	VolumeCroppingMethod uint32 = 0x00701302
+	// BoundingBoxCrop (0070,1303) FD
+	BoundingBoxCrop uint32 = 0x00701303
+	// ObliqueCroppingPlaneSequence (0070,1304) SQ
+	ObliqueCroppingPlaneSequence uint32 = 0x00701304
+	// Plane (0070,1305) FD
+	Plane uint32 = 0x00701305
+	// PlaneNormal (0070,1306) FD
+	PlaneNormal uint32 = 0x00701306
+	// CroppingSpecificationNumber (0070,1309) US
+	CroppingSpecificationNumber uint32 = 0x00701309
+	// MultiPlanarReconstructionStyle (0070,1501) CS
+	MultiPlanarReconstructionStyle uint32 = 0x00701501
+	// MPRThicknessType (0070,1502) CS
+	MPRThicknessType uint32 = 0x00701502
+	// MPRSlabThickness (0070,1503) FD
+	MPRSlabThickness uint32 = 0x00701503
+	// MPRTopLeftHandCorner (0070,1505) FD
+	MPRTopLeftHandCorner uint32 = 0x00701505
+	// MPRViewWidthDirection (0070,1507) FD
+	MPRViewWidthDirection uint32 = 0x00701507
+	// MPRViewWidth (0070,1508) FD
+	MPRViewWidth uint32 = 0x00701508
+	// NumberOfVolumetricCurvePoints (0070,150C) UL
+	NumberOfVolumetricCurvePoints uint32 = 0x0070150C
+	// VolumetricCurvePoints (0070,150D) OD
+	VolumetricCurvePoints uint32 = 0x0070150D
+	// MPRViewHeightDirection (0070,1511) FD
+	MPRViewHeightDirection uint32 = 0x00701511
+	// MPRViewHeight (0070,1512) FD
+	MPRViewHeight uint32 = 0x00701512
+	// RenderProjection (0070,1602) CS
+	RenderProjection uint32 = 0x00701602
+	// ViewpointPosition (0070,1603) FD
+	ViewpointPosition uint32 = 0x00701603
+	// ViewpointLookAtPoint (0070,1604) FD
+	ViewpointLookAtPoint uint32 = 0x00701604
+	// ViewpointUpDirection (0070,1605) FD
+	ViewpointUpDirection uint32 = 0x00701605
+	// RenderFieldOfView (0070,1606) FD
+	RenderFieldOfView uint32 = 0x00701606
+	// SamplingStepSize (0070,1607) FD
+	SamplingStepSize uint32 = 0x00701607
+	// ShadingStyle (0070,1701) CS
+	ShadingStyle uint32 = 0x00701701
+	// AmbientReflectionIntensity (0070,1702) FD
+	AmbientReflectionIntensity uint32 = 0x00701702
+	// LightDirection (0070,1703) FD
+	LightDirection uint32 = 0x00701703
+	// DiffuseReflectionIntensity (0070,1704) FD
+	DiffuseReflectionIntensity uint32 = 0x00701704
+	// SpecularReflectionIntensity (0070,1705) FD
+	SpecularReflectionIntensity uint32 = 0x00701705
+	// Shininess (0070,1706) FD
+	Shininess uint32 = 0x00701706
+	// PresentationStateClassificationComponentSequence (0070,1801) SQ
+	PresentationStateClassificationComponentSequence uint32 = 0x00701801
+	// ComponentType (0070,1802) CS
+	ComponentType uint32 = 0x00701802
+	// ComponentInputSequence (0070,1803) SQ
+	ComponentInputSequence uint32 = 0x00701803
+	// VolumetricPresentationInputIndex (0070,1804) US
+	VolumetricPresentationInputIndex uint32 = 0x00701804
+	// PresentationStateCompositorComponentSequence (0070,1805) SQ
+	PresentationStateCompositorComponentSequence uint32 = 0x00701805
+	// WeightingTransferFunctionSequence (0070,1806) SQ
+	WeightingTransferFunctionSequence uint32 = 0x00701806
+	// WeightingLookupTableDescriptor (0070,1807) US
+	WeightingLookupTableDescriptor uint32 = 0x00701807
+	// WeightingLookupTableData (0070,1808) OB
+	WeightingLookupTableData uint32 = 0x00701808
+	// VolumetricAnnotationSequence (0070,1901) SQ
+	VolumetricAnnotationSequence uint32 = 0x00701901
+	// ReferencedStructuredContextSequence (0070,1903) SQ
+	ReferencedStructuredContextSequence uint32 = 0x00701903
+	// ReferencedContentItem (0070,1904) UI
+	ReferencedContentItem uint32 = 0x00701904
+	// VolumetricPresentationInputAnnotationSequence (0070,1905) SQ
+	VolumetricPresentationInputAnnotationSequence uint32 = 0x00701905
+	// AnnotationClipping (0070,1907) CS
+	AnnotationClipping uint32 = 0x00701907
+	// PresentationAnimationStyle (0070,1A01) CS
+	PresentationAnimationStyle uint32 = 0x00701A01
+	// RecommendedAnimationRate (0070,1A03) FD
+	RecommendedAnimationRate uint32 = 0x00701A03
+	// AnimationCurveSequence (0070,1A04) SQ
+	AnimationCurveSequence uint32 = 0x00701A04
+	// AnimationStepSize (0070,1A05) FD
+	AnimationStepSize uint32 = 0x00701A05
+	// SwivelRange (0070,1A06) FD
+	SwivelRange uint32 = 0x00701A06
+	// VolumetricCurveUpDirections (0070,1A07) OD
+	VolumetricCurveUpDirections uint32 = 0x00701A07
+	// VolumeStreamSequence (0070,1A08) SQ
+	VolumeStreamSequence uint32 = 0x00701A08
+	// RGBATransferFunctionDescription (0070,1A09) LO
+	RGBATransferFunctionDescription uint32 = 0x00701A09
+	// AdvancedBlendingSequence (0070,1B01) SQ
+	AdvancedBlendingSequence uint32 = 0x00701B01
+	// BlendingInputNumber (0070,1B02) US
+	BlendingInputNumber uint32 = 0x00701B02
+	// BlendingDisplayInputSequence (0070,1B03) SQ
+	BlendingDisplayInputSequence uint32 = 0x00701B03
+	// BlendingDisplaySequence (0070,1B04) SQ
+	BlendingDisplaySequence uint32 = 0x00701B04
+	// BlendingMode (0070,1B06) CS
+	BlendingMode uint32 = 0x00701B06
+	// TimeSeriesBlending (0070,1B07) CS
+	TimeSeriesBlending uint32 = 0x00701B07
+	// GeometryForDisplay (0070,1B08) CS
+	GeometryForDisplay uint32 = 0x00701B08
+	// ThresholdSequence (0070,1B11) SQ
+	ThresholdSequence uint32 = 0x00701B11
+	// ThresholdValueSequence (0070,1B12) SQ
+	ThresholdValueSequence uint32 = 0x00701B12
+	// ThresholdType (0070,1B13) CS
+	ThresholdType uint32 = 0x00701B13
+	// ThresholdValue (0070,1B14) FD
+	ThresholdValue uint32 = 0x00701B14
+	// HangingProtocolName (0072,0002) SH
+	HangingProtocolName uint32 = 0x00720002
+	// HangingProtocolDescription (0072,0004) LO
+	HangingProtocolDescription uint32 = 0x00720004
+	// HangingProtocolLevel (0072,0006) CS
+	HangingProtocolLevel uint32 = 0x00720006
+	// HangingProtocolCreator (0072,0008) LO
+	HangingProtocolCreator uint32 = 0x00720008
+	// HangingProtocolCreationDateTime (0072,000A) DT
+	HangingProtocolCreationDateTime uint32 = 0x0072000A
+	// HangingProtocolDefinitionSequence (0072,000C) SQ
+	HangingProtocolDefinitionSequence uint32 = 0x0072000C
+	// HangingProtocolUserIdentificationCodeSequence (0072,000E) SQ
+	HangingProtocolUserIdentificationCodeSequence uint32 = 0x0072000E
+	// HangingProtocolUserGroupName (0072,0010) LO
+	HangingProtocolUserGroupName uint32 = 0x00720010
+	// SourceHangingProtocolSequence (0072,0012) SQ
+	SourceHangingProtocolSequence uint32 = 0x00720012
+	// NumberOfPriorsReferenced (0072,0014) US
+	NumberOfPriorsReferenced uint32 = 0x00720014
+	// ImageSetsSequence (0072,0020) SQ
+	ImageSetsSequence uint32 = 0x00720020
+	// ImageSetSelectorSequence (0072,0022) SQ
+	ImageSetSelectorSequence uint32 = 0x00720022
+	// ImageSetSelectorUsageFlag (0072,0024) CS
+	ImageSetSelectorUsageFlag uint32 = 0x00720024
+	// SelectorAttribute (0072,0026) AT
+	SelectorAttribute uint32 = 0x00720026
+	// SelectorValueNumber (0072,0028) US
+	SelectorValueNumber uint32 = 0x00720028
+	// TimeBasedImageSetsSequence (0072,0030) SQ
+	TimeBasedImageSetsSequence uint32 = 0x00720030
+	// ImageSetNumber (0072,0032) US
+	ImageSetNumber uint32 = 0x00720032
+	// ImageSetSelectorCategory (0072,0034) CS
+	ImageSetSelectorCategory uint32 = 0x00720034
+	// RelativeTime (0072,0038) US
+	RelativeTime uint32 = 0x00720038
+	// RelativeTimeUnits (0072,003A) CS
+	RelativeTimeUnits uint32 = 0x0072003A
+	// AbstractPriorValue (0072,003C) SS
+	AbstractPriorValue uint32 = 0x0072003C
+	// AbstractPriorCodeSequence (0072,003E) SQ
+	AbstractPriorCodeSequence uint32 = 0x0072003E
+	// ImageSetLabel (0072,0040) LO
+	ImageSetLabel uint32 = 0x00720040
+	// SelectorAttributeVR (0072,0050) CS
+	SelectorAttributeVR uint32 = 0x00720050
+	// SelectorSequencePointer (0072,0052) AT
+	SelectorSequencePointer uint32 = 0x00720052
+	// SelectorSequencePointerPrivateCreator (0072,0054) LO
+	SelectorSequencePointerPrivateCreator uint32 = 0x00720054
+	// SelectorAttributePrivateCreator (0072,0056) LO
+	SelectorAttributePrivateCreator uint32 = 0x00720056
+	// SelectorAEValue (0072,005E) AE
+	SelectorAEValue uint32 = 0x0072005E
+	// SelectorASValue (0072,005F) AS
+	SelectorASValue uint32 = 0x0072005F
+	// SelectorATValue (0072,0060) AT
+	SelectorATValue uint32 = 0x00720060
+	// SelectorDAValue (0072,0061) DA
+	SelectorDAValue uint32 = 0x00720061
+	// SelectorCSValue (0072,0062) CS
+	SelectorCSValue uint32 = 0x00720062
+	// SelectorDTValue (0072,0063) DT
+	SelectorDTValue uint32 = 0x00720063
+	// SelectorISValue (0072,0064) IS
+	SelectorISValue uint32 = 0x00720064
+	// SelectorOBValue (0072,0065) OB
+	SelectorOBValue uint32 = 0x00720065
+	// SelectorLOValue (0072,0066) LO
+	SelectorLOValue uint32 = 0x00720066
+	// SelectorOFValue (0072,0067) OF
+	SelectorOFValue uint32 = 0x00720067
+	// SelectorLTValue (0072,0068) LT
+	SelectorLTValue uint32 = 0x00720068
+	// SelectorOWValue (0072,0069) OW
+	SelectorOWValue uint32 = 0x00720069
+	// SelectorPNValue (0072,006A) PN
+	SelectorPNValue uint32 = 0x0072006A
+	// SelectorTMValue (0072,006B) TM
+	SelectorTMValue uint32 = 0x0072006B
+	// SelectorSHValue (0072,006C) SH
+	SelectorSHValue uint32 = 0x0072006C
+	// SelectorUNValue (0072,006D) UN
+	SelectorUNValue uint32 = 0x0072006D
+	// SelectorSTValue (0072,006E) ST
+	SelectorSTValue uint32 = 0x0072006E
+	// SelectorUCValue (0072,006F) UC
+	SelectorUCValue uint32 = 0x0072006F
+	// SelectorUTValue (0072,0070) UT
+	SelectorUTValue uint32 = 0x00720070
+	// SelectorURValue (0072,0071) UR
+	SelectorURValue uint32 = 0x00720071
+	// SelectorDSValue (0072,0072) DS
+	SelectorDSValue uint32 = 0x00720072
+	// SelectorODValue (0072,0073) OD
+	SelectorODValue uint32 = 0x00720073
+	// SelectorFDValue (0072,0074) FD
+	SelectorFDValue uint32 = 0x00720074
+	// SelectorOLValue (0072,0075) OL
+	SelectorOLValue uint32 = 0x00720075
+	// SelectorFLValue (0072,0076) FL
+	SelectorFLValue uint32 = 0x00720076
+	// SelectorULValue (0072,0078) UL
+	SelectorULValue uint32 = 0x00720078
+	// SelectorUSValue (0072,007A) US
+	SelectorUSValue uint32 = 0x0072007A
+	// SelectorSLValue (0072,007C) SL
+	SelectorSLValue uint32 = 0x0072007C
+	// SelectorSSValue (0072,007E) SS
+	SelectorSSValue uint32 = 0x0072007E
+	// SelectorUIValue (0072,007F) UI
+	SelectorUIValue uint32 = 0x0072007F
+	// SelectorCodeSequenceValue (0072,0080) SQ
+	SelectorCodeSequenceValue uint32 = 0x00720080
+	// NumberOfScreens (0072,0100) US
+	NumberOfScreens uint32 = 0x00720100
+	// NominalScreenDefinitionSequence (0072,0102) SQ
+	NominalScreenDefinitionSequence uint32 = 0x00720102
+	// NumberOfVerticalPixels (0072,0104) US
+	NumberOfVerticalPixels uint32 = 0x00720104
+	// NumberOfHorizontalPixels (0072,0106) US
+	NumberOfHorizontalPixels uint32 = 0x00720106
+	// DisplayEnvironmentSpatialPosition (0072,0108) FD
+	DisplayEnvironmentSpatialPosition uint32 = 0x00720108
+	// ScreenMinimumGrayscaleBitDepth (0072,010A) US
+	ScreenMinimumGrayscaleBitDepth uint32 = 0x0072010A
+	// ScreenMinimumColorBitDepth (0072,010C) US
+	ScreenMinimumColorBitDepth uint32 = 0x0072010C
+	// ApplicationMaximumRepaintTime (0072,010E) US
+	ApplicationMaximumRepaintTime uint32 = 0x0072010E
+	// DisplaySetsSequence (0072,0200) SQ
+	DisplaySetsSequence uint32 = 0x00720200
+	// DisplaySetNumber (0072,0202) US
+	DisplaySetNumber uint32 = 0x00720202
+	// DisplaySetLabel (0072,0203) LO
+	DisplaySetLabel uint32 = 0x00720203
+	// DisplaySetPresentationGroup (0072,0204) US
+	DisplaySetPresentationGroup uint32 = 0x00720204
+	// DisplaySetPresentationGroupDescription (0072,0206) LO
+	DisplaySetPresentationGroupDescription uint32 = 0x00720206
+	// PartialDataDisplayHandling (0072,0208) CS
+	PartialDataDisplayHandling uint32 = 0x00720208
+	// SynchronizedScrollingSequence (0072,0210) SQ
+	SynchronizedScrollingSequence uint32 = 0x00720210
+	// DisplaySetScrollingGroup (0072,0212) US
+	DisplaySetScrollingGroup uint32 = 0x00720212
+	// NavigationIndicatorSequence (0072,0214) SQ
+	NavigationIndicatorSequence uint32 = 0x00720214
+	// NavigationDisplaySet (0072,0216) US
+	NavigationDisplaySet uint32 = 0x00720216
+	// ReferenceDisplaySets (0072,0218) US
+	ReferenceDisplaySets uint32 = 0x00720218
+	// ImageBoxesSequence (0072,0300) SQ
+	ImageBoxesSequence uint32 = 0x00720300
+	// ImageBoxNumber (0072,0302) US
+	ImageBoxNumber uint32 = 0x00720302
+	// ImageBoxLayoutType (0072,0304) CS
+	ImageBoxLayoutType uint32 = 0x00720304
+	// ImageBoxTileHorizontalDimension (0072,0306) US
+	ImageBoxTileHorizontalDimension uint32 = 0x00720306
+	// ImageBoxTileVerticalDimension (0072,0308) US
+	ImageBoxTileVerticalDimension uint32 = 0x00720308
+	// ImageBoxScrollDirection (0072,0310) CS
+	ImageBoxScrollDirection uint32 = 0x00720310
+	// ImageBoxSmallScrollType (0072,0312) CS
+	ImageBoxSmallScrollType uint32 = 0x00720312
+	// ImageBoxSmallScrollAmount (0072,0314) US
+	ImageBoxSmallScrollAmount uint32 = 0x00720314
+	// ImageBoxLargeScrollType (0072,0316) CS
+	ImageBoxLargeScrollType uint32 = 0x00720316
+	// ImageBoxLargeScrollAmount (0072,0318) US
+	ImageBoxLargeScrollAmount uint32 = 0x00720318
+	// ImageBoxOverlapPriority (0072,0320) US
+	ImageBoxOverlapPriority uint32 = 0x00720320
+	// CineRelativeToRealTime (0072,0330) FD
+	CineRelativeToRealTime uint32 = 0x00720330
+	// FilterOperationsSequence (0072,0400) SQ
+	FilterOperationsSequence uint32 = 0x00720400
+	// FilterByCategory (0072,0402) CS
+	FilterByCategory uint32 = 0x00720402
+	// FilterByAttributePresence (0072,0404) CS
+	FilterByAttributePresence uint32 = 0x00720404
+	// FilterByOperator (0072,0406) CS
+	FilterByOperator uint32 = 0x00720406
+	// StructuredDisplayBackgroundCIELabValue (0072,0420) US
+	StructuredDisplayBackgroundCIELabValue uint32 = 0x00720420
+	// EmptyImageBoxCIELabValue (0072,0421) US
+	EmptyImageBoxCIELabValue uint32 = 0x00720421
+	// StructuredDisplayImageBoxSequence (0072,0422) SQ
+	StructuredDisplayImageBoxSequence uint32 = 0x00720422
+	// StructuredDisplayTextBoxSequence (0072,0424) SQ
+	StructuredDisplayTextBoxSequence uint32 = 0x00720424
+	// ReferencedFirstFrameSequence (0072,0427) SQ
+	ReferencedFirstFrameSequence uint32 = 0x00720427
+	// ImageBoxSynchronizationSequence (0072,0430) SQ
+	ImageBoxSynchronizationSequence uint32 = 0x00720430
+	// SynchronizedImageBoxList (0072,0432) US
+	SynchronizedImageBoxList uint32 = 0x00720432
+	// TypeOfSynchronization (0072,0434) CS
+	TypeOfSynchronization uint32 = 0x00720434
+	// BlendingOperationType (0072,0500) CS
+	BlendingOperationType uint32 = 0x00720500
+	// ReformattingOperationType (0072,0510) CS
+	ReformattingOperationType uint32 = 0x00720510
+	// ReformattingThickness (0072,0512) FD
+	ReformattingThickness uint32 = 0x00720512
+	// ReformattingInterval (0072,0514) FD
+	ReformattingInterval uint32 = 0x00720514
+	// ReformattingOperationInitialViewDirection (0072,0516) CS
+	ReformattingOperationInitialViewDirection uint32 = 0x00720516
+	// ThreeDRenderingType (0072,0520) CS
+	ThreeDRenderingType uint32 = 0x00720520
+	// SortingOperationsSequence (0072,0600) SQ
+	SortingOperationsSequence uint32 = 0x00720600
+	// SortByCategory (0072,0602) CS
+	SortByCategory uint32 = 0x00720602
+	// SortingDirection (0072,0604) CS
+	SortingDirection uint32 = 0x00720604
+	// DisplaySetPatientOrientation (0072,0700) CS
+	DisplaySetPatientOrientation uint32 = 0x00720700
+	// VOIType (0072,0702) CS
+	VOIType uint32 = 0x00720702
+	// PseudoColorType (0072,0704) CS
+	PseudoColorType uint32 = 0x00720704
+	// PseudoColorPaletteInstanceReferenceSequence (0072,0705) SQ
+	PseudoColorPaletteInstanceReferenceSequence uint32 = 0x00720705
+	// ShowGrayscaleInverted (0072,0706) CS
+	ShowGrayscaleInverted uint32 = 0x00720706
+	// ShowImageTrueSizeFlag (0072,0710) CS
+	ShowImageTrueSizeFlag uint32 = 0x00720710
+	// ShowGraphicAnnotationFlag (0072,0712) CS
+	ShowGraphicAnnotationFlag uint32 = 0x00720712
+	// ShowPatientDemographicsFlag (0072,0714) CS
+	ShowPatientDemographicsFlag uint32 = 0x00720714
+	// ShowAcquisitionTechniquesFlag (0072,0716) CS
+	ShowAcquisitionTechniquesFlag uint32 = 0x00720716
+	// DisplaySetHorizontalJustification (0072,0717) CS
+	DisplaySetHorizontalJustification uint32 = 0x00720717
+	// DisplaySetVerticalJustification (0072,0718) CS
+	DisplaySetVerticalJustification uint32 = 0x00720718
+	// ContinuationStartMeterset (0074,0120) FD
+	ContinuationStartMeterset uint32 = 0x00740120
+	// ContinuationEndMeterset (0074,0121) FD
+	ContinuationEndMeterset uint32 = 0x00740121
+	// ProcedureStepState (0074,1000) CS
+	ProcedureStepState uint32 = 0x00741000
+	// ProcedureStepProgressInformationSequence (0074,1002) SQ
+	ProcedureStepProgressInformationSequence uint32 = 0x00741002
+	// ProcedureStepProgress (0074,1004) DS
+	ProcedureStepProgress uint32 = 0x00741004
+	// ProcedureStepProgressDescription (0074,1006) ST
+	ProcedureStepProgressDescription uint32 = 0x00741006
+	// ProcedureStepProgressParametersSequence (0074,1007) SQ
+	ProcedureStepProgressParametersSequence uint32 = 0x00741007
+	// ProcedureStepCommunicationsURISequence (0074,1008) SQ
+	ProcedureStepCommunicationsURISequence uint32 = 0x00741008
+	// ContactURI (0074,100A) UR
+	ContactURI uint32 = 0x0074100A
+	// ContactDisplayName (0074,100C) LO
+	ContactDisplayName uint32 = 0x0074100C
+	// ProcedureStepDiscontinuationReasonCodeSequence (0074,100E) SQ
+	ProcedureStepDiscontinuationReasonCodeSequence uint32 = 0x0074100E
+	// BeamTaskSequence (0074,1020) SQ
+	BeamTaskSequence uint32 = 0x00741020
+	// BeamTaskType (0074,1022) CS
+	BeamTaskType uint32 = 0x00741022
+	// BeamOrderIndexTrial (0074,1024) IS, retired
+	BeamOrderIndexTrial uint32 = 0x00741024
+	// AutosequenceFlag (0074,1025) CS
+	AutosequenceFlag uint32 = 0x00741025
+	// TableTopVerticalAdjustedPosition (0074,1026) FD
+	TableTopVerticalAdjustedPosition uint32 = 0x00741026
+	// TableTopLongitudinalAdjustedPosition (0074,1027) FD
+	TableTopLongitudinalAdjustedPosition uint32 = 0x00741027
+	// TableTopLateralAdjustedPosition (0074,1028) FD
+	TableTopLateralAdjustedPosition uint32 = 0x00741028
+	// PatientSupportAdjustedAngle (0074,102A) FD
+	PatientSupportAdjustedAngle uint32 = 0x0074102A
+	// TableTopEccentricAdjustedAngle (0074,102B) FD
+	TableTopEccentricAdjustedAngle uint32 = 0x0074102B
+	// TableTopPitchAdjustedAngle (0074,102C) FD
+	TableTopPitchAdjustedAngle uint32 = 0x0074102C
+	// TableTopRollAdjustedAngle (0074,102D) FD
+	TableTopRollAdjustedAngle uint32 = 0x0074102D
+	// DeliveryVerificationImageSequence (0074,1030) SQ
+	DeliveryVerificationImageSequence uint32 = 0x00741030
+	// VerificationImageTiming (0074,1032) CS
+	VerificationImageTiming uint32 = 0x00741032
+	// DoubleExposureFlag (0074,1034) CS
+	DoubleExposureFlag uint32 = 0x00741034
+	// DoubleExposureOrdering (0074,1036) CS
+	DoubleExposureOrdering uint32 = 0x00741036
+	// DoubleExposureMetersetTrial (0074,1038) DS, retired
+	DoubleExposureMetersetTrial uint32 = 0x00741038
+	// DoubleExposureFieldDeltaTrial (0074,103A) DS, retired
+	DoubleExposureFieldDeltaTrial uint32 = 0x0074103A
+	// RelatedReferenceRTImageSequence (0074,1040) SQ
+	RelatedReferenceRTImageSequence uint32 = 0x00741040
+	// GeneralMachineVerificationSequence (0074,1042) SQ
+	GeneralMachineVerificationSequence uint32 = 0x00741042
+	// ConventionalMachineVerificationSequence (0074,1044) SQ
+	ConventionalMachineVerificationSequence uint32 = 0x00741044
+	// IonMachineVerificationSequence (0074,1046) SQ
+	IonMachineVerificationSequence uint32 = 0x00741046
+	// FailedAttributesSequence (0074,1048) SQ
+	FailedAttributesSequence uint32 = 0x00741048
+	// OverriddenAttributesSequence (0074,104A) SQ
+	OverriddenAttributesSequence uint32 = 0x0074104A
+	// ConventionalControlPointVerificationSequence (0074,104C) SQ
+	ConventionalControlPointVerificationSequence uint32 = 0x0074104C
+	// IonControlPointVerificationSequence (0074,104E) SQ
+	IonControlPointVerificationSequence uint32 = 0x0074104E
+	// AttributeOccurrenceSequence (0074,1050) SQ
+	AttributeOccurrenceSequence uint32 = 0x00741050
+	// AttributeOccurrencePointer (0074,1052) AT
+	AttributeOccurrencePointer uint32 = 0x00741052
+	// AttributeItemSelector (0074,1054) UL
+	AttributeItemSelector uint32 = 0x00741054
+	// AttributeOccurrencePrivateCreator (0074,1056) LO
+	AttributeOccurrencePrivateCreator uint32 = 0x00741056
+	// SelectorSequencePointerItems (0074,1057) IS
+	SelectorSequencePointerItems uint32 = 0x00741057
+	// ScheduledProcedureStepPriority (0074,1200) CS
+	ScheduledProcedureStepPriority uint32 = 0x00741200
+	// WorklistLabel (0074,1202) LO
+	WorklistLabel uint32 = 0x00741202
+	// ProcedureStepLabel (0074,1204) LO
+	ProcedureStepLabel uint32 = 0x00741204
+	// ScheduledProcessingParametersSequence (0074,1210) SQ
+	ScheduledProcessingParametersSequence uint32 = 0x00741210
+	// PerformedProcessingParametersSequence (0074,1212) SQ
+	PerformedProcessingParametersSequence uint32 = 0x00741212
+	// UnifiedProcedureStepPerformedProcedureSequence (0074,1216) SQ
+	UnifiedProcedureStepPerformedProcedureSequence uint32 = 0x00741216
+	// RelatedProcedureStepSequence (0074,1220) SQ, retired
+	RelatedProcedureStepSequence uint32 = 0x00741220
+	// ProcedureStepRelationshipType (0074,1222) LO, retired
+	ProcedureStepRelationshipType uint32 = 0x00741222
+	// ReplacedProcedureStepSequence (0074,1224) SQ
+	ReplacedProcedureStepSequence uint32 = 0x00741224
+	// DeletionLock (0074,1230) LO
+	DeletionLock uint32 = 0x00741230
+	// ReceivingAE (0074,1234) AE
+	ReceivingAE uint32 = 0x00741234
+	// RequestingAE (0074,1236) AE
+	RequestingAE uint32 = 0x00741236
+	// ReasonForCancellation (0074,1238) LT
+	ReasonForCancellation uint32 = 0x00741238
+	// SCPStatus (0074,1242) CS
+	SCPStatus uint32 = 0x00741242
+	// SubscriptionListStatus (0074,1244) CS
+	SubscriptionListStatus uint32 = 0x00741244
+	// UnifiedProcedureStepListStatus (0074,1246) CS
+	UnifiedProcedureStepListStatus uint32 = 0x00741246
+	// BeamOrderIndex (0074,1324) UL
+	BeamOrderIndex uint32 = 0x00741324
+	// DoubleExposureMeterset (0074,1338) FD
+	DoubleExposureMeterset uint32 = 0x00741338
+	// DoubleExposureFieldDelta (0074,133A) FD
+	DoubleExposureFieldDelta uint32 = 0x0074133A
+	// BrachyTaskSequence (0074,1401) SQ
+	BrachyTaskSequence uint32 = 0x00741401
+	// ContinuationStartTotalReferenceAirKerma (0074,1402) DS
+	ContinuationStartTotalReferenceAirKerma uint32 = 0x00741402
+	// ContinuationEndTotalReferenceAirKerma (0074,1403) DS
+	ContinuationEndTotalReferenceAirKerma uint32 = 0x00741403
+	// ContinuationPulseNumber (0074,1404) IS
+	ContinuationPulseNumber uint32 = 0x00741404
+	// ChannelDeliveryOrderSequence (0074,1405) SQ
+	ChannelDeliveryOrderSequence uint32 = 0x00741405
+	// ReferencedChannelNumber (0074,1406) IS
+	ReferencedChannelNumber uint32 = 0x00741406
+	// StartCumulativeTimeWeight (0074,1407) DS
+	StartCumulativeTimeWeight uint32 = 0x00741407
+	// EndCumulativeTimeWeight (0074,1408) DS
+	EndCumulativeTimeWeight uint32 = 0x00741408
+	// OmittedChannelSequence (0074,1409) SQ
+	OmittedChannelSequence uint32 = 0x00741409
+	// ReasonForChannelOmission (0074,140A) CS
+	ReasonForChannelOmission uint32 = 0x0074140A
+	// ReasonForChannelOmissionDescription (0074,140B) LO
+	ReasonForChannelOmissionDescription uint32 = 0x0074140B
+	// ChannelDeliveryOrderIndex (0074,140C) IS
+	ChannelDeliveryOrderIndex uint32 = 0x0074140C
+	// ChannelDeliveryContinuationSequence (0074,140D) SQ
+	ChannelDeliveryContinuationSequence uint32 = 0x0074140D
+	// OmittedApplicationSetupSequence (0074,140E) SQ
+	OmittedApplicationSetupSequence uint32 = 0x0074140E
+	// ImplantAssemblyTemplateName (0076,0001) LO
+	ImplantAssemblyTemplateName uint32 = 0x00760001
+	// ImplantAssemblyTemplateIssuer (0076,0003) LO
+	ImplantAssemblyTemplateIssuer uint32 = 0x00760003
+	// ImplantAssemblyTemplateVersion (0076,0006) LO
+	ImplantAssemblyTemplateVersion uint32 = 0x00760006
+	// ReplacedImplantAssemblyTemplateSequence (0076,0008) SQ
+	ReplacedImplantAssemblyTemplateSequence uint32 = 0x00760008
+	// ImplantAssemblyTemplateType (0076,000A) CS
+	ImplantAssemblyTemplateType uint32 = 0x0076000A
+	// OriginalImplantAssemblyTemplateSequence (0076,000C) SQ
+	OriginalImplantAssemblyTemplateSequence uint32 = 0x0076000C
+	// DerivationImplantAssemblyTemplateSequence (0076,000E) SQ
+	DerivationImplantAssemblyTemplateSequence uint32 = 0x0076000E
+	// ImplantAssemblyTemplateTargetAnatomySequence (0076,0010) SQ
+	ImplantAssemblyTemplateTargetAnatomySequence uint32 = 0x00760010
+	// ProcedureTypeCodeSequence (0076,0020) SQ
+	ProcedureTypeCodeSequence uint32 = 0x00760020
+	// SurgicalTechnique (0076,0030) LO
+	SurgicalTechnique uint32 = 0x00760030
+	// ComponentTypesSequence (0076,0032) SQ
+	ComponentTypesSequence uint32 = 0x00760032
+	// ComponentTypeCodeSequence (0076,0034) SQ
+	ComponentTypeCodeSequence uint32 = 0x00760034
+	// ExclusiveComponentType (0076,0036) CS
+	ExclusiveComponentType uint32 = 0x00760036
+	// MandatoryComponentType (0076,0038) CS
+	MandatoryComponentType uint32 = 0x00760038
+	// ComponentSequence (0076,0040) SQ
+	ComponentSequence uint32 = 0x00760040
+	// ComponentID (0076,0055) US
+	ComponentID uint32 = 0x00760055
+	// ComponentAssemblySequence (0076,0060) SQ
+	ComponentAssemblySequence uint32 = 0x00760060
+	// Component1ReferencedID (0076,0070) US
+	Component1ReferencedID uint32 = 0x00760070
+	// Component1ReferencedMatingFeatureSetID (0076,0080) US
+	Component1ReferencedMatingFeatureSetID uint32 = 0x00760080
+	// Component1ReferencedMatingFeatureID (0076,0090) US
+	Component1ReferencedMatingFeatureID uint32 = 0x00760090
+	// Component2ReferencedID (0076,00A0) US
+	Component2ReferencedID uint32 = 0x007600A0
+	// Component2ReferencedMatingFeatureSetID (0076,00B0) US
+	Component2ReferencedMatingFeatureSetID uint32 = 0x007600B0
+	// Component2ReferencedMatingFeatureID (0076,00C0) US
+	Component2ReferencedMatingFeatureID uint32 = 0x007600C0
+	// ImplantTemplateGroupName (0078,0001) LO
+	ImplantTemplateGroupName uint32 = 0x00780001
+	// ImplantTemplateGroupDescription (0078,0010) ST
+	ImplantTemplateGroupDescription uint32 = 0x00780010
+	// ImplantTemplateGroupIssuer (0078,0020) LO
+	ImplantTemplateGroupIssuer uint32 = 0x00780020
+	// ImplantTemplateGroupVersion (0078,0024) LO
+	ImplantTemplateGroupVersion uint32 = 0x00780024
+	// ReplacedImplantTemplateGroupSequence (0078,0026) SQ
+	ReplacedImplantTemplateGroupSequence uint32 = 0x00780026
+	// ImplantTemplateGroupTargetAnatomySequence (0078,0028) SQ
+	ImplantTemplateGroupTargetAnatomySequence uint32 = 0x00780028
+	// ImplantTemplateGroupMembersSequence (0078,002A) SQ
+	ImplantTemplateGroupMembersSequence uint32 = 0x0078002A
+	// ImplantTemplateGroupMemberID (0078,002E) US
+	ImplantTemplateGroupMemberID uint32 = 0x0078002E
+	// ThreeDImplantTemplateGroupMemberMatchingPoint (0078,0050) FD
+	ThreeDImplantTemplateGroupMemberMatchingPoint uint32 = 0x00780050
+	// ThreeDImplantTemplateGroupMemberMatchingAxes (0078,0060) FD
+	ThreeDImplantTemplateGroupMemberMatchingAxes uint32 = 0x00780060
+	// ImplantTemplateGroupMemberMatching2DCoordinatesSequence (0078,0070) SQ
+	ImplantTemplateGroupMemberMatching2DCoordinatesSequence uint32 = 0x00780070
+	// TwoDImplantTemplateGroupMemberMatchingPoint (0078,0090) FD
+	TwoDImplantTemplateGroupMemberMatchingPoint uint32 = 0x00780090
+	// TwoDImplantTemplateGroupMemberMatchingAxes (0078,00A0) FD
+	TwoDImplantTemplateGroupMemberMatchingAxes uint32 = 0x007800A0
+	// ImplantTemplateGroupVariationDimensionSequence (0078,00B0) SQ
+	ImplantTemplateGroupVariationDimensionSequence uint32 = 0x007800B0
+	// ImplantTemplateGroupVariationDimensionName (0078,00B2) LO
+	ImplantTemplateGroupVariationDimensionName uint32 = 0x007800B2
+	// ImplantTemplateGroupVariationDimensionRankSequence (0078,00B4) SQ
+	ImplantTemplateGroupVariationDimensionRankSequence uint32 = 0x007800B4
+	// ReferencedImplantTemplateGroupMemberID (0078,00B6) US
+	ReferencedImplantTemplateGroupMemberID uint32 = 0x007800B6
+	// ImplantTemplateGroupVariationDimensionRank (0078,00B8) US
+	ImplantTemplateGroupVariationDimensionRank uint32 = 0x007800B8
+	// SurfaceScanAcquisitionTypeCodeSequence (0080,0001) SQ
+	SurfaceScanAcquisitionTypeCodeSequence uint32 = 0x00800001
+	// SurfaceScanModeCodeSequence (0080,0002) SQ
+	SurfaceScanModeCodeSequence uint32 = 0x00800002
+	// RegistrationMethodCodeSequence (0080,0003) SQ
+	RegistrationMethodCodeSequence uint32 = 0x00800003
+	// ShotDurationTime (0080,0004) FD
+	ShotDurationTime uint32 = 0x00800004
+	// ShotOffsetTime (0080,0005) FD
+	ShotOffsetTime uint32 = 0x00800005
+	// SurfacePointPresentationValueData (0080,0006) US
+	SurfacePointPresentationValueData uint32 = 0x00800006
+	// SurfacePointColorCIELabValueData (0080,0007) US
+	SurfacePointColorCIELabValueData uint32 = 0x00800007
+	// UVMappingSequence (0080,0008) SQ
+	UVMappingSequence uint32 = 0x00800008
+	// TextureLabel (0080,0009) SH
+	TextureLabel uint32 = 0x00800009
+	// UValueData (0080,0010) OF
+	UValueData uint32 = 0x00800010
+	// VValueData (0080,0011) OF
+	VValueData uint32 = 0x00800011
+	// ReferencedTextureSequence (0080,0012) SQ
+	ReferencedTextureSequence uint32 = 0x00800012
+	// ReferencedSurfaceDataSequence (0080,0013) SQ
+	ReferencedSurfaceDataSequence uint32 = 0x00800013
+	// AssessmentSummary (0082,0001) CS
+	AssessmentSummary uint32 = 0x00820001
+	// AssessmentSummaryDescription (0082,0003) UT
+	AssessmentSummaryDescription uint32 = 0x00820003
+	// AssessedSOPInstanceSequence (0082,0004) SQ
+	AssessedSOPInstanceSequence uint32 = 0x00820004
+	// ReferencedComparisonSOPInstanceSequence (0082,0005) SQ
+	ReferencedComparisonSOPInstanceSequence uint32 = 0x00820005
+	// NumberOfAssessmentObservations (0082,0006) UL
+	NumberOfAssessmentObservations uint32 = 0x00820006
+	// AssessmentObservationsSequence (0082,0007) SQ
+	AssessmentObservationsSequence uint32 = 0x00820007
+	// ObservationSignificance (0082,0008) CS
+	ObservationSignificance uint32 = 0x00820008
+	// ObservationDescription (0082,000A) UT
+	ObservationDescription uint32 = 0x0082000A
+	// StructuredConstraintObservationSequence (0082,000C) SQ
+	StructuredConstraintObservationSequence uint32 = 0x0082000C
+	// AssessedAttributeValueSequence (0082,0010) SQ
+	AssessedAttributeValueSequence uint32 = 0x00820010
+	// AssessmentSetID (0082,0016) LO
+	AssessmentSetID uint32 = 0x00820016
+	// AssessmentRequesterSequence (0082,0017) SQ
+	AssessmentRequesterSequence uint32 = 0x00820017
+	// SelectorAttributeName (0082,0018) LO
+	SelectorAttributeName uint32 = 0x00820018
+	// SelectorAttributeKeyword (0082,0019) LO
+	SelectorAttributeKeyword uint32 = 0x00820019
+	// AssessmentTypeCodeSequence (0082,0021) SQ
+	AssessmentTypeCodeSequence uint32 = 0x00820021
+	// ObservationBasisCodeSequence (0082,0022) SQ
+	ObservationBasisCodeSequence uint32 = 0x00820022
+	// AssessmentLabel (0082,0023) LO
+	AssessmentLabel uint32 = 0x00820023
+	// ConstraintType (0082,0032) CS
+	ConstraintType uint32 = 0x00820032
+	// SpecificationSelectionGuidance (0082,0033) UT
+	SpecificationSelectionGuidance uint32 = 0x00820033
+	// ConstraintValueSequence (0082,0034) SQ
+	ConstraintValueSequence uint32 = 0x00820034
+	// RecommendedDefaultValueSequence (0082,0035) SQ
+	RecommendedDefaultValueSequence uint32 = 0x00820035
+	// ConstraintViolationSignificance (0082,0036) CS
+	ConstraintViolationSignificance uint32 = 0x00820036
+	// ConstraintViolationCondition (0082,0037) UT
+	ConstraintViolationCondition uint32 = 0x00820037
+	// ModifiableConstraintFlag (0082,0038) CS
+	ModifiableConstraintFlag uint32 = 0x00820038
+	// StorageMediaFileSetID (0088,0130) SH
+	StorageMediaFileSetID uint32 = 0x00880130
+	// StorageMediaFileSetUID (0088,0140) UI
+	StorageMediaFileSetUID uint32 = 0x00880140
+	// IconImageSequence (0088,0200) SQ
+	IconImageSequence uint32 = 0x00880200
+	// TopicTitle (0088,0904) LO, retired
+	TopicTitle uint32 = 0x00880904
+	// TopicSubject (0088,0906) ST, retired
+	TopicSubject uint32 = 0x00880906
+	// TopicAuthor (0088,0910) LO, retired
+	TopicAuthor uint32 = 0x00880910
+	// TopicKeywords (0088,0912) LO, retired
+	TopicKeywords uint32 = 0x00880912
+	// SOPInstanceStatus (0100,0410) CS
+	SOPInstanceStatus uint32 = 0x01000410
+	// SOPAuthorizationDateTime (0100,0420) DT
+	SOPAuthorizationDateTime uint32 = 0x01000420
+	// SOPAuthorizationComment (0100,0424) LT
+	SOPAuthorizationComment uint32 = 0x01000424
+	// AuthorizationEquipmentCertificationNumber (0100,0426) LO
+	AuthorizationEquipmentCertificationNumber uint32 = 0x01000426
+	// MACIDNumber (0400,0005) US
+	MACIDNumber uint32 = 0x04000005
+	// MACCalculationTransferSyntaxUID (0400,0010) UI
+	MACCalculationTransferSyntaxUID uint32 = 0x04000010
+	// MACAlgorithm (0400,0015) CS
+	MACAlgorithm uint32 = 0x04000015
+	// DataElementsSigned (0400,0020) AT
+	DataElementsSigned uint32 = 0x04000020
+	// DigitalSignatureUID (0400,0100) UI
+	DigitalSignatureUID uint32 = 0x04000100
+	// DigitalSignatureDateTime (0400,0105) DT
+	DigitalSignatureDateTime uint32 = 0x04000105
+	// CertificateType (0400,0110) CS
+	CertificateType uint32 = 0x04000110
+	// CertificateOfSigner (0400,0115) OB
+	CertificateOfSigner uint32 = 0x04000115
+	// Signature (0400,0120) OB
+	Signature uint32 = 0x04000120
+	// CertifiedTimestampType (0400,0305) CS
+	CertifiedTimestampType uint32 = 0x04000305
+	// CertifiedTimestamp (0400,0310) OB
+	CertifiedTimestamp uint32 = 0x04000310
+	// DigitalSignaturePurposeCodeSequence (0400,0401) SQ
+	DigitalSignaturePurposeCodeSequence uint32 = 0x04000401
+	// ReferencedDigitalSignatureSequence (0400,0402) SQ
+	ReferencedDigitalSignatureSequence uint32 = 0x04000402
+	// ReferencedSOPInstanceMACSequence (0400,0403) SQ
+	ReferencedSOPInstanceMACSequence uint32 = 0x04000403
+	// MAC (0400,0404) OB
+	MAC uint32 = 0x04000404
+	// EncryptedAttributesSequence (0400,0500) SQ
+	EncryptedAttributesSequence uint32 = 0x04000500
+	// EncryptedContentTransferSyntaxUID (0400,0510) UI
+	EncryptedContentTransferSyntaxUID uint32 = 0x04000510
+	// EncryptedContent (0400,0520) OB
+	EncryptedContent uint32 = 0x04000520
+	// ModifiedAttributesSequence (0400,0550) SQ
+	ModifiedAttributesSequence uint32 = 0x04000550
+	// OriginalAttributesSequence (0400,0561) SQ
+	OriginalAttributesSequence uint32 = 0x04000561
+	// AttributeModificationDateTime (0400,0562) DT
+	AttributeModificationDateTime uint32 = 0x04000562
+	// ModifyingSystem (0400,0563) LO
+	ModifyingSystem uint32 = 0x04000563
+	// SourceOfPreviousValues (0400,0564) LO
+	SourceOfPreviousValues uint32 = 0x04000564
+	// ReasonForTheAttributeModification (0400,0565) CS
+	ReasonForTheAttributeModification uint32 = 0x04000565
+	// NumberOfCopies (2000,0010) IS
+	NumberOfCopies uint32 = 0x20000010
+	// PrinterConfigurationSequence (2000,001E) SQ
+	PrinterConfigurationSequence uint32 = 0x2000001E
+	// PrintPriority (2000,0020) CS
+	PrintPriority uint32 = 0x20000020
+	// MediumType (2000,0030) CS
+	MediumType uint32 = 0x20000030
+	// FilmDestination (2000,0040) CS
+	FilmDestination uint32 = 0x20000040
+	// FilmSessionLabel (2000,0050) LO
+	FilmSessionLabel uint32 = 0x20000050
+	// MemoryAllocation (2000,0060) IS
+	MemoryAllocation uint32 = 0x20000060
+	// MaximumMemoryAllocation (2000,0061) IS
+	MaximumMemoryAllocation uint32 = 0x20000061
+	// ColorImagePrintingFlag (2000,0062) CS, retired
+	ColorImagePrintingFlag uint32 = 0x20000062
+	// CollationFlag (2000,0063) CS, retired
+	CollationFlag uint32 = 0x20000063
+	// AnnotationFlag (2000,0065) CS, retired
+	AnnotationFlag uint32 = 0x20000065
+	// ImageOverlayFlag (2000,0067) CS, retired
+	ImageOverlayFlag uint32 = 0x20000067
+	// PresentationLUTFlag (2000,0069) CS, retired
+	PresentationLUTFlag uint32 = 0x20000069
+	// ImageBoxPresentationLUTFlag (2000,006A) CS, retired
+	ImageBoxPresentationLUTFlag uint32 = 0x2000006A
+	// MemoryBitDepth (2000,00A0) US
+	MemoryBitDepth uint32 = 0x200000A0
+	// PrintingBitDepth (2000,00A1) US
+	PrintingBitDepth uint32 = 0x200000A1
+	// MediaInstalledSequence (2000,00A2) SQ
+	MediaInstalledSequence uint32 = 0x200000A2
+	// OtherMediaAvailableSequence (2000,00A4) SQ
+	OtherMediaAvailableSequence uint32 = 0x200000A4
+	// SupportedImageDisplayFormatsSequence (2000,00A8) SQ
+	SupportedImageDisplayFormatsSequence uint32 = 0x200000A8
+	// ReferencedFilmBoxSequence (2000,0500) SQ
+	ReferencedFilmBoxSequence uint32 = 0x20000500
+	// ReferencedStoredPrintSequence (2000,0510) SQ, retired
+	ReferencedStoredPrintSequence uint32 = 0x20000510
+	// ImageDisplayFormat (2010,0010) ST
+	ImageDisplayFormat uint32 = 0x20100010
+	// AnnotationDisplayFormatID (2010,0030) CS
+	AnnotationDisplayFormatID uint32 = 0x20100030
+	// FilmOrientation (2010,0040) CS
+	FilmOrientation uint32 = 0x20100040
+	// FilmSizeID (2010,0050) CS
+	FilmSizeID uint32 = 0x20100050
+	// PrinterResolutionID (2010,0052) CS
+	PrinterResolutionID uint32 = 0x20100052
+	// DefaultPrinterResolutionID (2010,0054) CS
+	DefaultPrinterResolutionID uint32 = 0x20100054
+	// MagnificationType (2010,0060) CS
+	MagnificationType uint32 = 0x20100060
+	// SmoothingType (2010,0080) CS
+	SmoothingType uint32 = 0x20100080
+	// DefaultMagnificationType (2010,00A6) CS
+	DefaultMagnificationType uint32 = 0x201000A6
+	// OtherMagnificationTypesAvailable (2010,00A7) CS
+	OtherMagnificationTypesAvailable uint32 = 0x201000A7
+	// DefaultSmoothingType (2010,00A8) CS
+	DefaultSmoothingType uint32 = 0x201000A8
+	// OtherSmoothingTypesAvailable (2010,00A9) CS
+	OtherSmoothingTypesAvailable uint32 = 0x201000A9
+	// BorderDensity (2010,0100) CS
+	BorderDensity uint32 = 0x20100100
+	// EmptyImageDensity (2010,0110) CS
+	EmptyImageDensity uint32 = 0x20100110
+	// MinDensity (2010,0120) US
+	MinDensity uint32 = 0x20100120
+	// MaxDensity (2010,0130) US
+	MaxDensity uint32 = 0x20100130
+	// Trim (2010,0140) CS
+	Trim uint32 = 0x20100140
+	// ConfigurationInformation (2010,0150) ST
+	ConfigurationInformation uint32 = 0x20100150
+	// ConfigurationInformationDescription (2010,0152) LT
+	ConfigurationInformationDescription uint32 = 0x20100152
+	// MaximumCollatedFilms (2010,0154) IS
+	MaximumCollatedFilms uint32 = 0x20100154
+	// Illumination (2010,015E) US
+	Illumination uint32 = 0x2010015E
+	// ReflectedAmbientLight (2010,0160) US
+	ReflectedAmbientLight uint32 = 0x20100160
+	// PrinterPixelSpacing (2010,0376) DS
+	PrinterPixelSpacing uint32 = 0x20100376
+	// ReferencedFilmSessionSequence (2010,0500) SQ
+	ReferencedFilmSessionSequence uint32 = 0x20100500
+	// ReferencedImageBoxSequence (2010,0510) SQ
+	ReferencedImageBoxSequence uint32 = 0x20100510
+	// ReferencedBasicAnnotationBoxSequence (2010,0520) SQ
+	ReferencedBasicAnnotationBoxSequence uint32 = 0x20100520
+	// ImageBoxPosition (2020,0010) US
+	ImageBoxPosition uint32 = 0x20200010
+	// Polarity (2020,0020) CS
+	Polarity uint32 = 0x20200020
+	// RequestedImageSize (2020,0030) DS
+	RequestedImageSize uint32 = 0x20200030
+	// RequestedDecimateCropBehavior (2020,0040) CS
+	RequestedDecimateCropBehavior uint32 = 0x20200040
+	// RequestedResolutionID (2020,0050) CS
+	RequestedResolutionID uint32 = 0x20200050
+	// RequestedImageSizeFlag (2020,00A0) CS
+	RequestedImageSizeFlag uint32 = 0x202000A0
+	// DecimateCropResult (2020,00A2) CS
+	DecimateCropResult uint32 = 0x202000A2
+	// BasicGrayscaleImageSequence (2020,0110) SQ
+	BasicGrayscaleImageSequence uint32 = 0x20200110
+	// BasicColorImageSequence (2020,0111) SQ
+	BasicColorImageSequence uint32 = 0x20200111
+	// ReferencedImageOverlayBoxSequence (2020,0130) SQ, retired
+	ReferencedImageOverlayBoxSequence uint32 = 0x20200130
+	// ReferencedVOILUTBoxSequence (2020,0140) SQ, retired
+	ReferencedVOILUTBoxSequence uint32 = 0x20200140
+	// AnnotationPosition (2030,0010) US
+	AnnotationPosition uint32 = 0x20300010
+	// TextString (2030,0020) LO
+	TextString uint32 = 0x20300020
+	// ReferencedOverlayPlaneSequence (2040,0010) SQ, retired
+	ReferencedOverlayPlaneSequence uint32 = 0x20400010
+	// ReferencedOverlayPlaneGroups (2040,0011) US, retired
+	ReferencedOverlayPlaneGroups uint32 = 0x20400011
+	// OverlayPixelDataSequence (2040,0020) SQ, retired
+	OverlayPixelDataSequence uint32 = 0x20400020
+	// OverlayMagnificationType (2040,0060) CS, retired
+	OverlayMagnificationType uint32 = 0x20400060
+	// OverlaySmoothingType (2040,0070) CS, retired
+	OverlaySmoothingType uint32 = 0x20400070
+	// OverlayOrImageMagnification (2040,0072) CS, retired
+	OverlayOrImageMagnification uint32 = 0x20400072
+	// MagnifyToNumberOfColumns (2040,0074) US, retired
+	MagnifyToNumberOfColumns uint32 = 0x20400074
+	// OverlayForegroundDensity (2040,0080) CS, retired
+	OverlayForegroundDensity uint32 = 0x20400080
+	// OverlayBackgroundDensity (2040,0082) CS, retired
+	OverlayBackgroundDensity uint32 = 0x20400082
+	// OverlayMode (2040,0090) CS, retired
+	OverlayMode uint32 = 0x20400090
+	// ThresholdDensity (2040,0100) CS, retired
+	ThresholdDensity uint32 = 0x20400100
+	// ReferencedImageBoxSequenceRetired (2040,0500) SQ, retired
+	ReferencedImageBoxSequenceRetired uint32 = 0x20400500
+	// PresentationLUTSequence (2050,0010) SQ
+	PresentationLUTSequence uint32 = 0x20500010
+	// PresentationLUTShape (2050,0020) CS
+	PresentationLUTShape uint32 = 0x20500020
+	// ReferencedPresentationLUTSequence (2050,0500) SQ
+	ReferencedPresentationLUTSequence uint32 = 0x20500500
+	// PrintJobID (2100,0010) SH, retired
+	PrintJobID uint32 = 0x21000010
+	// ExecutionStatus (2100,0020) CS
+	ExecutionStatus uint32 = 0x21000020
+	// ExecutionStatusInfo (2100,0030) CS
+	ExecutionStatusInfo uint32 = 0x21000030
+	// CreationDate (2100,0040) DA
+	CreationDate uint32 = 0x21000040
+	// CreationTime (2100,0050) TM
+	CreationTime uint32 = 0x21000050
+	// Originator (2100,0070) AE
+	Originator uint32 = 0x21000070
+	// DestinationAE (2100,0140) AE
+	DestinationAE uint32 = 0x21000140
+	// OwnerID (2100,0160) SH
+	OwnerID uint32 = 0x21000160
+	// NumberOfFilms (2100,0170) IS
+	NumberOfFilms uint32 = 0x21000170
+	// ReferencedPrintJobSequencePullStoredPrint (2100,0500) SQ, retired
+	ReferencedPrintJobSequencePullStoredPrint uint32 = 0x21000500
+	// PrinterStatus (2110,0010) CS
+	PrinterStatus uint32 = 0x21100010
+	// PrinterStatusInfo (2110,0020) CS
+	PrinterStatusInfo uint32 = 0x21100020
+	// PrinterName (2110,0030) LO
+	PrinterName uint32 = 0x21100030
+	// PrintQueueID (2110,0099) SH, retired
+	PrintQueueID uint32 = 0x21100099
+	// QueueStatus (2120,0010) CS, retired
+	QueueStatus uint32 = 0x21200010
+	// PrintJobDescriptionSequence (2120,0050) SQ, retired
+	PrintJobDescriptionSequence uint32 = 0x21200050
+	// ReferencedPrintJobSequence (2120,0070) SQ, retired
+	ReferencedPrintJobSequence uint32 = 0x21200070
+	// PrintManagementCapabilitiesSequence (2130,0010) SQ, retired
+	PrintManagementCapabilitiesSequence uint32 = 0x21300010
+	// PrinterCharacteristicsSequence (2130,0015) SQ, retired
+	PrinterCharacteristicsSequence uint32 = 0x21300015
+	// FilmBoxContentSequence (2130,0030) SQ, retired
+	FilmBoxContentSequence uint32 = 0x21300030
+	// ImageBoxContentSequence (2130,0040) SQ, retired
+	ImageBoxContentSequence uint32 = 0x21300040
+	// AnnotationContentSequence (2130,0050) SQ, retired
+	AnnotationContentSequence uint32 = 0x21300050
+	// ImageOverlayBoxContentSequence (2130,0060) SQ, retired
+	ImageOverlayBoxContentSequence uint32 = 0x21300060
+	// PresentationLUTContentSequence (2130,0080) SQ, retired
+	PresentationLUTContentSequence uint32 = 0x21300080
+	// ProposedStudySequence (2130,00A0) SQ, retired
+	ProposedStudySequence uint32 = 0x213000A0
+	// OriginalImageSequence (2130,00C0) SQ, retired
+	OriginalImageSequence uint32 = 0x213000C0
+	// LabelUsingInformationExtractedFromInstances (2200,0001) CS
+	LabelUsingInformationExtractedFromInstances uint32 = 0x22000001
+	// LabelText (2200,0002) UT
+	LabelText uint32 = 0x22000002
+	// LabelStyleSelection (2200,0003) CS
+	LabelStyleSelection uint32 = 0x22000003
+	// MediaDisposition (2200,0004) LT
+	MediaDisposition uint32 = 0x22000004
+	// BarcodeValue (2200,0005) LT
+	BarcodeValue uint32 = 0x22000005
+	// BarcodeSymbology (2200,0006) CS
+	BarcodeSymbology uint32 = 0x22000006
+	// AllowMediaSplitting (2200,0007) CS
+	AllowMediaSplitting uint32 = 0x22000007
+	// IncludeNonDICOMObjects (2200,0008) CS
+	IncludeNonDICOMObjects uint32 = 0x22000008
+	// IncludeDisplayApplication (2200,0009) CS
+	IncludeDisplayApplication uint32 = 0x22000009
+	// PreserveCompositeInstancesAfterMediaCreation (2200,000A) CS
+	PreserveCompositeInstancesAfterMediaCreation uint32 = 0x2200000A
+	// TotalNumberOfPiecesOfMediaCreated (2200,000B) US
+	TotalNumberOfPiecesOfMediaCreated uint32 = 0x2200000B
+	// RequestedMediaApplicationProfile (2200,000C) LO
+	RequestedMediaApplicationProfile uint32 = 0x2200000C
+	// ReferencedStorageMediaSequence (2200,000D) SQ
+	ReferencedStorageMediaSequence uint32 = 0x2200000D
+	// FailureAttributes (2200,000E) AT
+	FailureAttributes uint32 = 0x2200000E
+	// AllowLossyCompression (2200,000F) CS
+	AllowLossyCompression uint32 = 0x2200000F
+	// RequestPriority (2200,0020) CS
+	RequestPriority uint32 = 0x22000020
+	// RTImageLabel (3002,0002) SH
+	RTImageLabel uint32 = 0x30020002
+	// RTImageName (3002,0003) LO
+	RTImageName uint32 = 0x30020003
+	// RTImageDescription (3002,0004) ST
+	RTImageDescription uint32 = 0x30020004
+	// ReportedValuesOrigin (3002,000A) CS
+	ReportedValuesOrigin uint32 = 0x3002000A
+	// RTImagePlane (3002,000C) CS
+	RTImagePlane uint32 = 0x3002000C
+	// XRayImageReceptorTranslation (3002,000D) DS
+	XRayImageReceptorTranslation uint32 = 0x3002000D
+	// XRayImageReceptorAngle (3002,000E) DS
+	XRayImageReceptorAngle uint32 = 0x3002000E
+	// RTImageOrientation (3002,0010) DS
+	RTImageOrientation uint32 = 0x30020010
+	// ImagePlanePixelSpacing (3002,0011) DS
+	ImagePlanePixelSpacing uint32 = 0x30020011
+	// RTImagePosition (3002,0012) DS
+	RTImagePosition uint32 = 0x30020012
+	// RadiationMachineName (3002,0020) SH
+	RadiationMachineName uint32 = 0x30020020
+	// RadiationMachineSAD (3002,0022) DS
+	RadiationMachineSAD uint32 = 0x30020022
+	// RadiationMachineSSD (3002,0024) DS
+	RadiationMachineSSD uint32 = 0x30020024
+	// RTImageSID (3002,0026) DS
+	RTImageSID uint32 = 0x30020026
+	// SourceToReferenceObjectDistance (3002,0028) DS
+	SourceToReferenceObjectDistance uint32 = 0x30020028
+	// FractionNumber (3002,0029) IS
+	FractionNumber uint32 = 0x30020029
+	// ExposureSequence (3002,0030) SQ
+	ExposureSequence uint32 = 0x30020030
+	// MetersetExposure (3002,0032) DS
+	MetersetExposure uint32 = 0x30020032
+	// DiaphragmPosition (3002,0034) DS
+	DiaphragmPosition uint32 = 0x30020034
+	// FluenceMapSequence (3002,0040) SQ
+	FluenceMapSequence uint32 = 0x30020040
+	// FluenceDataSource (3002,0041) CS
+	FluenceDataSource uint32 = 0x30020041
+	// FluenceDataScale (3002,0042) DS
+	FluenceDataScale uint32 = 0x30020042
+	// PrimaryFluenceModeSequence (3002,0050) SQ
+	PrimaryFluenceModeSequence uint32 = 0x30020050
+	// FluenceMode (3002,0051) CS
+	FluenceMode uint32 = 0x30020051
+	// FluenceModeID (3002,0052) SH
+	FluenceModeID uint32 = 0x30020052
+	// DVHType (3004,0001) CS
+	DVHType uint32 = 0x30040001
+	// DoseUnits (3004,0002) CS
+	DoseUnits uint32 = 0x30040002
+	// DoseType (3004,0004) CS
+	DoseType uint32 = 0x30040004
+	// SpatialTransformOfDose (3004,0005) CS
+	SpatialTransformOfDose uint32 = 0x30040005
+	// DoseComment (3004,0006) LO
+	DoseComment uint32 = 0x30040006
+	// NormalizationPoint (3004,0008) DS
+	NormalizationPoint uint32 = 0x30040008
+	// DoseSummationType (3004,000A) CS
+	DoseSummationType uint32 = 0x3004000A
+	// GridFrameOffsetVector (3004,000C) DS
+	GridFrameOffsetVector uint32 = 0x3004000C
+	// DoseGridScaling (3004,000E) DS
+	DoseGridScaling uint32 = 0x3004000E
+	// RTDoseROISequence (3004,0010) SQ
+	RTDoseROISequence uint32 = 0x30040010
+	// DoseValue (3004,0012) DS
+	DoseValue uint32 = 0x30040012
+	// TissueHeterogeneityCorrection (3004,0014) CS
+	TissueHeterogeneityCorrection uint32 = 0x30040014
+	// DVHNormalizationPoint (3004,0040) DS
+	DVHNormalizationPoint uint32 = 0x30040040
+	// DVHNormalizationDoseValue (3004,0042) DS
+	DVHNormalizationDoseValue uint32 = 0x30040042
+	// DVHSequence (3004,0050) SQ
+	DVHSequence uint32 = 0x30040050
+	// DVHDoseScaling (3004,0052) DS
+	DVHDoseScaling uint32 = 0x30040052
+	// DVHVolumeUnits (3004,0054) CS
+	DVHVolumeUnits uint32 = 0x30040054
+	// DVHNumberOfBins (3004,0056) IS
+	DVHNumberOfBins uint32 = 0x30040056
+	// DVHData (3004,0058) DS
+	DVHData uint32 = 0x30040058
+	// DVHReferencedROISequence (3004,0060) SQ
+	DVHReferencedROISequence uint32 = 0x30040060
+	// DVHROIContributionType (3004,0062) CS
+	DVHROIContributionType uint32 = 0x30040062
+	// DVHMinimumDose (3004,0070) DS
+	DVHMinimumDose uint32 = 0x30040070
+	// DVHMaximumDose (3004,0072) DS
+	DVHMaximumDose uint32 = 0x30040072
+	// DVHMeanDose (3004,0074) DS
+	DVHMeanDose uint32 = 0x30040074
+	// StructureSetLabel (3006,0002) SH
+	StructureSetLabel uint32 = 0x30060002
+	// StructureSetName (3006,0004) LO
+	StructureSetName uint32 = 0x30060004
+	// StructureSetDescription (3006,0006) ST
+	StructureSetDescription uint32 = 0x30060006
+	// StructureSetDate (3006,0008) DA
+	StructureSetDate uint32 = 0x30060008
+	// StructureSetTime (3006,0009) TM
+	StructureSetTime uint32 = 0x30060009
+	// ReferencedFrameOfReferenceSequence (3006,0010) SQ
+	ReferencedFrameOfReferenceSequence uint32 = 0x30060010
+	// RTReferencedStudySequence (3006,0012) SQ
+	RTReferencedStudySequence uint32 = 0x30060012
+	// RTReferencedSeriesSequence (3006,0014) SQ
+	RTReferencedSeriesSequence uint32 = 0x30060014
+	// ContourImageSequence (3006,0016) SQ
+	ContourImageSequence uint32 = 0x30060016
+	// PredecessorStructureSetSequence (3006,0018) SQ
+	PredecessorStructureSetSequence uint32 = 0x30060018
+	// StructureSetROISequence (3006,0020) SQ
+	StructureSetROISequence uint32 = 0x30060020
+	// ROINumber (3006,0022) IS
+	ROINumber uint32 = 0x30060022
+	// ReferencedFrameOfReferenceUID (3006,0024) UI
+	ReferencedFrameOfReferenceUID uint32 = 0x30060024
+	// ROIName (3006,0026) LO
+	ROIName uint32 = 0x30060026
+	// ROIDescription (3006,0028) ST
+	ROIDescription uint32 = 0x30060028
+	// ROIDisplayColor (3006,002A) IS
+	ROIDisplayColor uint32 = 0x3006002A
+	// ROIVolume (3006,002C) DS
+	ROIVolume uint32 = 0x3006002C
+	// RTRelatedROISequence (3006,0030) SQ
+	RTRelatedROISequence uint32 = 0x30060030
+	// RTROIRelationship (3006,0033) CS
+	RTROIRelationship uint32 = 0x30060033
+	// ROIGenerationAlgorithm (3006,0036) CS
+	ROIGenerationAlgorithm uint32 = 0x30060036
+	// ROIGenerationDescription (3006,0038) LO
+	ROIGenerationDescription uint32 = 0x30060038
+	// ROIContourSequence (3006,0039) SQ
+	ROIContourSequence uint32 = 0x30060039
+	// ContourSequence (3006,0040) SQ
+	ContourSequence uint32 = 0x30060040
+	// ContourGeometricType (3006,0042) CS
+	ContourGeometricType uint32 = 0x30060042
+	// ContourSlabThickness (3006,0044) DS
+	ContourSlabThickness uint32 = 0x30060044
+	// ContourOffsetVector (3006,0045) DS
+	ContourOffsetVector uint32 = 0x30060045
+	// NumberOfContourPoints (3006,0046) IS
+	NumberOfContourPoints uint32 = 0x30060046
+	// ContourNumber (3006,0048) IS
+	ContourNumber uint32 = 0x30060048
+	// AttachedContours (3006,0049) IS
+	AttachedContours uint32 = 0x30060049
+	// ContourData (3006,0050) DS
+	ContourData uint32 = 0x30060050
+	// RTROIObservationsSequence (3006,0080) SQ
+	RTROIObservationsSequence uint32 = 0x30060080
+	// ObservationNumber (3006,0082) IS
+	ObservationNumber uint32 = 0x30060082
+	// ReferencedROINumber (3006,0084) IS
+	ReferencedROINumber uint32 = 0x30060084
+	// ROIObservationLabel (3006,0085) SH
+	ROIObservationLabel uint32 = 0x30060085
+	// RTROIIdentificationCodeSequence (3006,0086) SQ
+	RTROIIdentificationCodeSequence uint32 = 0x30060086
+	// ROIObservationDescription (3006,0088) ST
+	ROIObservationDescription uint32 = 0x30060088
+	// RelatedRTROIObservationsSequence (3006,00A0) SQ
+	RelatedRTROIObservationsSequence uint32 = 0x300600A0
+	// RTROIInterpretedType (3006,00A4) CS
+	RTROIInterpretedType uint32 = 0x300600A4
+	// ROIInterpreter (3006,00A6) PN
+	ROIInterpreter uint32 = 0x300600A6
+	// ROIPhysicalPropertiesSequence (3006,00B0) SQ
+	ROIPhysicalPropertiesSequence uint32 = 0x300600B0
+	// ROIPhysicalProperty (3006,00B2) CS
+	ROIPhysicalProperty uint32 = 0x300600B2
+	// ROIPhysicalPropertyValue (3006,00B4) DS
+	ROIPhysicalPropertyValue uint32 = 0x300600B4
+	// ROIElementalCompositionSequence (3006,00B6) SQ
+	ROIElementalCompositionSequence uint32 = 0x300600B6
+	// ROIElementalCompositionAtomicNumber (3006,00B7) US
+	ROIElementalCompositionAtomicNumber uint32 = 0x300600B7
+	// ROIElementalCompositionAtomicMassFraction (3006,00B8) FL
+	ROIElementalCompositionAtomicMassFraction uint32 = 0x300600B8
+	// AdditionalRTROIIdentificationCodeSequence (3006,00B9) SQ, retired
+	AdditionalRTROIIdentificationCodeSequence uint32 = 0x300600B9
+	// FrameOfReferenceRelationshipSequence (3006,00C0) SQ, retired
+	FrameOfReferenceRelationshipSequence uint32 = 0x300600C0
+	// RelatedFrameOfReferenceUID (3006,00C2) UI, retired
+	RelatedFrameOfReferenceUID uint32 = 0x300600C2
+	// FrameOfReferenceTransformationType (3006,00C4) CS, retired
+	FrameOfReferenceTransformationType uint32 = 0x300600C4
+	// FrameOfReferenceTransformationMatrix (3006,00C6) DS
+	FrameOfReferenceTransformationMatrix uint32 = 0x300600C6
+	// FrameOfReferenceTransformationComment (3006,00C8) LO
+	FrameOfReferenceTransformationComment uint32 = 0x300600C8
+	// MeasuredDoseReferenceSequence (3008,0010) SQ
+	MeasuredDoseReferenceSequence uint32 = 0x30080010
+	// MeasuredDoseDescription (3008,0012) ST
+	MeasuredDoseDescription uint32 = 0x30080012
+	// MeasuredDoseType (3008,0014) CS
+	MeasuredDoseType uint32 = 0x30080014
+	// MeasuredDoseValue (3008,0016) DS
+	MeasuredDoseValue uint32 = 0x30080016
+	// TreatmentSessionBeamSequence (3008,0020) SQ
+	TreatmentSessionBeamSequence uint32 = 0x30080020
+	// TreatmentSessionIonBeamSequence (3008,0021) SQ
+	TreatmentSessionIonBeamSequence uint32 = 0x30080021
+	// CurrentFractionNumber (3008,0022) IS
+	CurrentFractionNumber uint32 = 0x30080022
+	// TreatmentControlPointDate (3008,0024) DA
+	TreatmentControlPointDate uint32 = 0x30080024
+	// TreatmentControlPointTime (3008,0025) TM
+	TreatmentControlPointTime uint32 = 0x30080025
+	// TreatmentTerminationStatus (3008,002A) CS
+	TreatmentTerminationStatus uint32 = 0x3008002A
+	// TreatmentTerminationCode (3008,002B) SH
+	TreatmentTerminationCode uint32 = 0x3008002B
+	// TreatmentVerificationStatus (3008,002C) CS
+	TreatmentVerificationStatus uint32 = 0x3008002C
+	// ReferencedTreatmentRecordSequence (3008,0030) SQ
+	ReferencedTreatmentRecordSequence uint32 = 0x30080030
+	// SpecifiedPrimaryMeterset (3008,0032) DS
+	SpecifiedPrimaryMeterset uint32 = 0x30080032
+	// SpecifiedSecondaryMeterset (3008,0033) DS
+	SpecifiedSecondaryMeterset uint32 = 0x30080033
+	// DeliveredPrimaryMeterset (3008,0036) DS
+	DeliveredPrimaryMeterset uint32 = 0x30080036
+	// DeliveredSecondaryMeterset (3008,0037) DS
+	DeliveredSecondaryMeterset uint32 = 0x30080037
+	// SpecifiedTreatmentTime (3008,003A) DS
+	SpecifiedTreatmentTime uint32 = 0x3008003A
+	// DeliveredTreatmentTime (3008,003B) DS
+	DeliveredTreatmentTime uint32 = 0x3008003B
+	// ControlPointDeliverySequence (3008,0040) SQ
+	ControlPointDeliverySequence uint32 = 0x30080040
+	// IonControlPointDeliverySequence (3008,0041) SQ
+	IonControlPointDeliverySequence uint32 = 0x30080041
+	// SpecifiedMeterset (3008,0042) DS
+	SpecifiedMeterset uint32 = 0x30080042
+	// DeliveredMeterset (3008,0044) DS
+	DeliveredMeterset uint32 = 0x30080044
+	// MetersetRateSet (3008,0045) FL
+	MetersetRateSet uint32 = 0x30080045
+	// MetersetRateDelivered (3008,0046) FL
+	MetersetRateDelivered uint32 = 0x30080046
+	// ScanSpotMetersetsDelivered (3008,0047) FL
+	ScanSpotMetersetsDelivered uint32 = 0x30080047
+	// DoseRateDelivered (3008,0048) DS
+	DoseRateDelivered uint32 = 0x30080048
+	// TreatmentSummaryCalculatedDoseReferenceSequence (3008,0050) SQ
+	TreatmentSummaryCalculatedDoseReferenceSequence uint32 = 0x30080050
+	// CumulativeDoseToDoseReference (3008,0052) DS
+	CumulativeDoseToDoseReference uint32 = 0x30080052
+	// FirstTreatmentDate (3008,0054) DA
+	FirstTreatmentDate uint32 = 0x30080054
+	// MostRecentTreatmentDate (3008,0056) DA
+	MostRecentTreatmentDate uint32 = 0x30080056
+	// NumberOfFractionsDelivered (3008,005A) IS
+	NumberOfFractionsDelivered uint32 = 0x3008005A
+	// OverrideSequence (3008,0060) SQ
+	OverrideSequence uint32 = 0x30080060
+	// ParameterSequencePointer (3008,0061) AT
+	ParameterSequencePointer uint32 = 0x30080061
+	// OverrideParameterPointer (3008,0062) AT
+	OverrideParameterPointer uint32 = 0x30080062
+	// ParameterItemIndex (3008,0063) IS
+	ParameterItemIndex uint32 = 0x30080063
+	// MeasuredDoseReferenceNumber (3008,0064) IS
+	MeasuredDoseReferenceNumber uint32 = 0x30080064
+	// ParameterPointer (3008,0065) AT
+	ParameterPointer uint32 = 0x30080065
+	// OverrideReason (3008,0066) ST
+	OverrideReason uint32 = 0x30080066
+	// ParameterValueNumber (3008,0067) US
+	ParameterValueNumber uint32 = 0x30080067
+	// CorrectedParameterSequence (3008,0068) SQ
+	CorrectedParameterSequence uint32 = 0x30080068
+	// CorrectionValue (3008,006A) FL
+	CorrectionValue uint32 = 0x3008006A
+	// CalculatedDoseReferenceSequence (3008,0070) SQ
+	CalculatedDoseReferenceSequence uint32 = 0x30080070
+	// CalculatedDoseReferenceNumber (3008,0072) IS
+	CalculatedDoseReferenceNumber uint32 = 0x30080072
+	// CalculatedDoseReferenceDescription (3008,0074) ST
+	CalculatedDoseReferenceDescription uint32 = 0x30080074
+	// CalculatedDoseReferenceDoseValue (3008,0076) DS
+	CalculatedDoseReferenceDoseValue uint32 = 0x30080076
+	// StartMeterset (3008,0078) DS
+	StartMeterset uint32 = 0x30080078
+	// EndMeterset (3008,007A) DS
+	EndMeterset uint32 = 0x3008007A
+	// ReferencedMeasuredDoseReferenceSequence (3008,0080) SQ
+	ReferencedMeasuredDoseReferenceSequence uint32 = 0x30080080
+	// ReferencedMeasuredDoseReferenceNumber (3008,0082) IS
+	ReferencedMeasuredDoseReferenceNumber uint32 = 0x30080082
+	// ReferencedCalculatedDoseReferenceSequence (3008,0090) SQ
+	ReferencedCalculatedDoseReferenceSequence uint32 = 0x30080090
+	// ReferencedCalculatedDoseReferenceNumber (3008,0092) IS
+	ReferencedCalculatedDoseReferenceNumber uint32 = 0x30080092
+	// BeamLimitingDeviceLeafPairsSequence (3008,00A0) SQ
+	BeamLimitingDeviceLeafPairsSequence uint32 = 0x300800A0
+	// RecordedWedgeSequence (3008,00B0) SQ
+	RecordedWedgeSequence uint32 = 0x300800B0
+	// RecordedCompensatorSequence (3008,00C0) SQ
+	RecordedCompensatorSequence uint32 = 0x300800C0
+	// RecordedBlockSequence (3008,00D0) SQ
+	RecordedBlockSequence uint32 = 0x300800D0
+	// TreatmentSummaryMeasuredDoseReferenceSequence (3008,00E0) SQ
+	TreatmentSummaryMeasuredDoseReferenceSequence uint32 = 0x300800E0
+	// RecordedSnoutSequence (3008,00F0) SQ
+	RecordedSnoutSequence uint32 = 0x300800F0
+	// RecordedRangeShifterSequence (3008,00F2) SQ
+	RecordedRangeShifterSequence uint32 = 0x300800F2
+	// RecordedLateralSpreadingDeviceSequence (3008,00F4) SQ
+	RecordedLateralSpreadingDeviceSequence uint32 = 0x300800F4
+	// RecordedRangeModulatorSequence (3008,00F6) SQ
+	RecordedRangeModulatorSequence uint32 = 0x300800F6
+	// RecordedSourceSequence (3008,0100) SQ
+	RecordedSourceSequence uint32 = 0x30080100
+	// SourceSerialNumber (3008,0105) LO
+	SourceSerialNumber uint32 = 0x30080105
+	// TreatmentSessionApplicationSetupSequence (3008,0110) SQ
+	TreatmentSessionApplicationSetupSequence uint32 = 0x30080110
+	// ApplicationSetupCheck (3008,0116) CS
+	ApplicationSetupCheck uint32 = 0x30080116
+	// RecordedBrachyAccessoryDeviceSequence (3008,0120) SQ
+	RecordedBrachyAccessoryDeviceSequence uint32 = 0x30080120
+	// ReferencedBrachyAccessoryDeviceNumber (3008,0122) IS
+	ReferencedBrachyAccessoryDeviceNumber uint32 = 0x30080122
+	// RecordedChannelSequence (3008,0130) SQ
+	RecordedChannelSequence uint32 = 0x30080130
+	// SpecifiedChannelTotalTime (3008,0132) DS
+	SpecifiedChannelTotalTime uint32 = 0x30080132
+	// DeliveredChannelTotalTime (3008,0134) DS
+	DeliveredChannelTotalTime uint32 = 0x30080134
+	// SpecifiedNumberOfPulses (3008,0136) IS
+	SpecifiedNumberOfPulses uint32 = 0x30080136
+	// DeliveredNumberOfPulses (3008,0138) IS
+	DeliveredNumberOfPulses uint32 = 0x30080138
+	// SpecifiedPulseRepetitionInterval (3008,013A) DS
+	SpecifiedPulseRepetitionInterval uint32 = 0x3008013A
+	// DeliveredPulseRepetitionInterval (3008,013C) DS
+	DeliveredPulseRepetitionInterval uint32 = 0x3008013C
+	// RecordedSourceApplicatorSequence (3008,0140) SQ
+	RecordedSourceApplicatorSequence uint32 = 0x30080140
+	// ReferencedSourceApplicatorNumber (3008,0142) IS
+	ReferencedSourceApplicatorNumber uint32 = 0x30080142
+	// RecordedChannelShieldSequence (3008,0150) SQ
+	RecordedChannelShieldSequence uint32 = 0x30080150
+	// ReferencedChannelShieldNumber (3008,0152) IS
+	ReferencedChannelShieldNumber uint32 = 0x30080152
+	// BrachyControlPointDeliveredSequence (3008,0160) SQ
+	BrachyControlPointDeliveredSequence uint32 = 0x30080160
+	// SafePositionExitDate (3008,0162) DA
+	SafePositionExitDate uint32 = 0x30080162
+	// SafePositionExitTime (3008,0164) TM
+	SafePositionExitTime uint32 = 0x30080164
+	// SafePositionReturnDate (3008,0166) DA
+	SafePositionReturnDate uint32 = 0x30080166
+	// SafePositionReturnTime (3008,0168) TM
+	SafePositionReturnTime uint32 = 0x30080168
+	// PulseSpecificBrachyControlPointDeliveredSequence (3008,0171) SQ
+	PulseSpecificBrachyControlPointDeliveredSequence uint32 = 0x30080171
+	// PulseNumber (3008,0172) US
+	PulseNumber uint32 = 0x30080172
+	// BrachyPulseControlPointDeliveredSequence (3008,0173) SQ
+	BrachyPulseControlPointDeliveredSequence uint32 = 0x30080173
+	// CurrentTreatmentStatus (3008,0200) CS
+	CurrentTreatmentStatus uint32 = 0x30080200
+	// TreatmentStatusComment (3008,0202) ST
+	TreatmentStatusComment uint32 = 0x30080202
+	// FractionGroupSummarySequence (3008,0220) SQ
+	FractionGroupSummarySequence uint32 = 0x30080220
+	// ReferencedFractionNumber (3008,0223) IS
+	ReferencedFractionNumber uint32 = 0x30080223
+	// FractionGroupType (3008,0224) CS
+	FractionGroupType uint32 = 0x30080224
+	// BeamStopperPosition (3008,0230) CS
+	BeamStopperPosition uint32 = 0x30080230
+	// FractionStatusSummarySequence (3008,0240) SQ
+	FractionStatusSummarySequence uint32 = 0x30080240
+	// TreatmentDate (3008,0250) DA
+	TreatmentDate uint32 = 0x30080250
+	// TreatmentTime (3008,0251) TM
+	TreatmentTime uint32 = 0x30080251
+	// RTPlanLabel (300A,0002) SH
+	RTPlanLabel uint32 = 0x300A0002
+	// RTPlanName (300A,0003) LO
+	RTPlanName uint32 = 0x300A0003
+	// RTPlanDescription (300A,0004) ST
+	RTPlanDescription uint32 = 0x300A0004
+	// RTPlanDate (300A,0006) DA
+	RTPlanDate uint32 = 0x300A0006
+	// RTPlanTime (300A,0007) TM
+	RTPlanTime uint32 = 0x300A0007
+	// TreatmentProtocols (300A,0009) LO
+	TreatmentProtocols uint32 = 0x300A0009
+	// PlanIntent (300A,000A) CS
+	PlanIntent uint32 = 0x300A000A
+	// TreatmentSites (300A,000B) LO
+	TreatmentSites uint32 = 0x300A000B
+	// RTPlanGeometry (300A,000C) CS
+	RTPlanGeometry uint32 = 0x300A000C
+	// PrescriptionDescription (300A,000E) ST
+	PrescriptionDescription uint32 = 0x300A000E
+	// DoseReferenceSequence (300A,0010) SQ
+	DoseReferenceSequence uint32 = 0x300A0010
+	// DoseReferenceNumber (300A,0012) IS
+	DoseReferenceNumber uint32 = 0x300A0012
+	// DoseReferenceUID (300A,0013) UI
+	DoseReferenceUID uint32 = 0x300A0013
+	// DoseReferenceStructureType (300A,0014) CS
+	DoseReferenceStructureType uint32 = 0x300A0014
+	// NominalBeamEnergyUnit (300A,0015) CS
+	NominalBeamEnergyUnit uint32 = 0x300A0015
+	// DoseReferenceDescription (300A,0016) LO
+	DoseReferenceDescription uint32 = 0x300A0016
+	// DoseReferencePointCoordinates (300A,0018) DS
+	DoseReferencePointCoordinates uint32 = 0x300A0018
+	// NominalPriorDose (300A,001A) DS
+	NominalPriorDose uint32 = 0x300A001A
+	// DoseReferenceType (300A,0020) CS
+	DoseReferenceType uint32 = 0x300A0020
+	// ConstraintWeight (300A,0021) DS
+	ConstraintWeight uint32 = 0x300A0021
+	// DeliveryWarningDose (300A,0022) DS
+	DeliveryWarningDose uint32 = 0x300A0022
+	// DeliveryMaximumDose (300A,0023) DS
+	DeliveryMaximumDose uint32 = 0x300A0023
+	// TargetMinimumDose (300A,0025) DS
+	TargetMinimumDose uint32 = 0x300A0025
+	// TargetPrescriptionDose (300A,0026) DS
+	TargetPrescriptionDose uint32 = 0x300A0026
+	// TargetMaximumDose (300A,0027) DS
+	TargetMaximumDose uint32 = 0x300A0027
+	// TargetUnderdoseVolumeFraction (300A,0028) DS
+	TargetUnderdoseVolumeFraction uint32 = 0x300A0028
+	// OrganAtRiskFullVolumeDose (300A,002A) DS
+	OrganAtRiskFullVolumeDose uint32 = 0x300A002A
+	// OrganAtRiskLimitDose (300A,002B) DS
+	OrganAtRiskLimitDose uint32 = 0x300A002B
+	// OrganAtRiskMaximumDose (300A,002C) DS
+	OrganAtRiskMaximumDose uint32 = 0x300A002C
+	// OrganAtRiskOverdoseVolumeFraction (300A,002D) DS
+	OrganAtRiskOverdoseVolumeFraction uint32 = 0x300A002D
+	// ToleranceTableSequence (300A,0040) SQ
+	ToleranceTableSequence uint32 = 0x300A0040
+	// ToleranceTableNumber (300A,0042) IS
+	ToleranceTableNumber uint32 = 0x300A0042
+	// ToleranceTableLabel (300A,0043) SH
+	ToleranceTableLabel uint32 = 0x300A0043
+	// GantryAngleTolerance (300A,0044) DS
+	GantryAngleTolerance uint32 = 0x300A0044
+	// BeamLimitingDeviceAngleTolerance (300A,0046) DS
+	BeamLimitingDeviceAngleTolerance uint32 = 0x300A0046
+	// BeamLimitingDeviceToleranceSequence (300A,0048) SQ
+	BeamLimitingDeviceToleranceSequence uint32 = 0x300A0048
+	// BeamLimitingDevicePositionTolerance (300A,004A) DS
+	BeamLimitingDevicePositionTolerance uint32 = 0x300A004A
+	// SnoutPositionTolerance (300A,004B) FL
+	SnoutPositionTolerance uint32 = 0x300A004B
+	// PatientSupportAngleTolerance (300A,004C) DS
+	PatientSupportAngleTolerance uint32 = 0x300A004C
+	// TableTopEccentricAngleTolerance (300A,004E) DS
+	TableTopEccentricAngleTolerance uint32 = 0x300A004E
+	// TableTopPitchAngleTolerance (300A,004F) FL
+	TableTopPitchAngleTolerance uint32 = 0x300A004F
+	// TableTopRollAngleTolerance (300A,0050) FL
+	TableTopRollAngleTolerance uint32 = 0x300A0050
+	// TableTopVerticalPositionTolerance (300A,0051) DS
+	TableTopVerticalPositionTolerance uint32 = 0x300A0051
+	// TableTopLongitudinalPositionTolerance (300A,0052) DS
+	TableTopLongitudinalPositionTolerance uint32 = 0x300A0052
+	// TableTopLateralPositionTolerance (300A,0053) DS
+	TableTopLateralPositionTolerance uint32 = 0x300A0053
+	// RTPlanRelationship (300A,0055) CS
+	RTPlanRelationship uint32 = 0x300A0055
+	// FractionGroupSequence (300A,0070) SQ
+	FractionGroupSequence uint32 = 0x300A0070
+	// FractionGroupNumber (300A,0071) IS
+	FractionGroupNumber uint32 = 0x300A0071
+	// FractionGroupDescription (300A,0072) LO
+	FractionGroupDescription uint32 = 0x300A0072
+	// NumberOfFractionsPlanned (300A,0078) IS
+	NumberOfFractionsPlanned uint32 = 0x300A0078
+	// NumberOfFractionPatternDigitsPerDay (300A,0079) IS
+	NumberOfFractionPatternDigitsPerDay uint32 = 0x300A0079
+	// RepeatFractionCycleLength (300A,007A) IS
+	RepeatFractionCycleLength uint32 = 0x300A007A
+	// FractionPattern (300A,007B) LT
+	FractionPattern uint32 = 0x300A007B
+	// NumberOfBeams (300A,0080) IS
+	NumberOfBeams uint32 = 0x300A0080
+	// BeamDoseSpecificationPoint (300A,0082) DS
+	BeamDoseSpecificationPoint uint32 = 0x300A0082
+	// ReferencedDoseReferenceUID (300A,0083) UI
+	ReferencedDoseReferenceUID uint32 = 0x300A0083
+	// BeamDose (300A,0084) DS
+	BeamDose uint32 = 0x300A0084
+	// BeamMeterset (300A,0086) DS
+	BeamMeterset uint32 = 0x300A0086
+	// BeamDosePointDepth (300A,0088) FL
+	BeamDosePointDepth uint32 = 0x300A0088
+	// BeamDosePointEquivalentDepth (300A,0089) FL
+	BeamDosePointEquivalentDepth uint32 = 0x300A0089
+	// BeamDosePointSSD (300A,008A) FL
+	BeamDosePointSSD uint32 = 0x300A008A
+	// BeamDoseMeaning (300A,008B) CS
+	BeamDoseMeaning uint32 = 0x300A008B
+	// BeamDoseVerificationControlPointSequence (300A,008C) SQ
+	BeamDoseVerificationControlPointSequence uint32 = 0x300A008C
+	// AverageBeamDosePointDepth (300A,008D) FL, retired
+	AverageBeamDosePointDepth uint32 = 0x300A008D
+	// AverageBeamDosePointEquivalentDepth (300A,008E) FL, retired
+	AverageBeamDosePointEquivalentDepth uint32 = 0x300A008E
+	// AverageBeamDosePointSSD (300A,008F) FL, retired
+	AverageBeamDosePointSSD uint32 = 0x300A008F
+	// BeamDoseType (300A,0090) CS
+	BeamDoseType uint32 = 0x300A0090
+	// AlternateBeamDose (300A,0091) DS
+	AlternateBeamDose uint32 = 0x300A0091
+	// AlternateBeamDoseType (300A,0092) CS
+	AlternateBeamDoseType uint32 = 0x300A0092
+	// DepthValueAveragingFlag (300A,0093) CS
+	DepthValueAveragingFlag uint32 = 0x300A0093
+	// NumberOfBrachyApplicationSetups (300A,00A0) IS
+	NumberOfBrachyApplicationSetups uint32 = 0x300A00A0
+	// BrachyApplicationSetupDoseSpecificationPoint (300A,00A2) DS
+	BrachyApplicationSetupDoseSpecificationPoint uint32 = 0x300A00A2
+	// BrachyApplicationSetupDose (300A,00A4) DS
+	BrachyApplicationSetupDose uint32 = 0x300A00A4
+	// BeamSequence (300A,00B0) SQ
+	BeamSequence uint32 = 0x300A00B0
+	// TreatmentMachineName (300A,00B2) SH
+	TreatmentMachineName uint32 = 0x300A00B2
+	// PrimaryDosimeterUnit (300A,00B3) CS
+	PrimaryDosimeterUnit uint32 = 0x300A00B3
+	// SourceAxisDistance (300A,00B4) DS
+	SourceAxisDistance uint32 = 0x300A00B4
+	// BeamLimitingDeviceSequence (300A,00B6) SQ
+	BeamLimitingDeviceSequence uint32 = 0x300A00B6
+	// RTBeamLimitingDeviceType (300A,00B8) CS
+	RTBeamLimitingDeviceType uint32 = 0x300A00B8
+	// SourceToBeamLimitingDeviceDistance (300A,00BA) DS
+	SourceToBeamLimitingDeviceDistance uint32 = 0x300A00BA
+	// IsocenterToBeamLimitingDeviceDistance (300A,00BB) FL
+	IsocenterToBeamLimitingDeviceDistance uint32 = 0x300A00BB
+	// NumberOfLeafJawPairs (300A,00BC) IS
+	NumberOfLeafJawPairs uint32 = 0x300A00BC
+	// LeafPositionBoundaries (300A,00BE) DS
+	LeafPositionBoundaries uint32 = 0x300A00BE
+	// BeamNumber (300A,00C0) IS
+	BeamNumber uint32 = 0x300A00C0
+	// BeamName (300A,00C2) LO
+	BeamName uint32 = 0x300A00C2
+	// BeamDescription (300A,00C3) ST
+	BeamDescription uint32 = 0x300A00C3
+	// BeamType (300A,00C4) CS
+	BeamType uint32 = 0x300A00C4
+	// BeamDeliveryDurationLimit (300A,00C5) FD
+	BeamDeliveryDurationLimit uint32 = 0x300A00C5
+	// RadiationType (300A,00C6) CS
+	RadiationType uint32 = 0x300A00C6
+	// HighDoseTechniqueType (300A,00C7) CS
+	HighDoseTechniqueType uint32 = 0x300A00C7
+	// ReferenceImageNumber (300A,00C8) IS
+	ReferenceImageNumber uint32 = 0x300A00C8
+	// PlannedVerificationImageSequence (300A,00CA) SQ
+	PlannedVerificationImageSequence uint32 = 0x300A00CA
+	// ImagingDeviceSpecificAcquisitionParameters (300A,00CC) LO
+	ImagingDeviceSpecificAcquisitionParameters uint32 = 0x300A00CC
+	// TreatmentDeliveryType (300A,00CE) CS
+	TreatmentDeliveryType uint32 = 0x300A00CE
+	// NumberOfWedges (300A,00D0) IS
+	NumberOfWedges uint32 = 0x300A00D0
+	// WedgeSequence (300A,00D1) SQ
+	WedgeSequence uint32 = 0x300A00D1
+	// WedgeNumber (300A,00D2) IS
+	WedgeNumber uint32 = 0x300A00D2
+	// WedgeType (300A,00D3) CS
+	WedgeType uint32 = 0x300A00D3
+	// WedgeID (300A,00D4) SH
+	WedgeID uint32 = 0x300A00D4
+	// WedgeAngle (300A,00D5) IS
+	WedgeAngle uint32 = 0x300A00D5
+	// WedgeFactor (300A,00D6) DS
+	WedgeFactor uint32 = 0x300A00D6
+	// TotalWedgeTrayWaterEquivalentThickness (300A,00D7) FL
+	TotalWedgeTrayWaterEquivalentThickness uint32 = 0x300A00D7
+	// WedgeOrientation (300A,00D8) DS
+	WedgeOrientation uint32 = 0x300A00D8
+	// IsocenterToWedgeTrayDistance (300A,00D9) FL
+	IsocenterToWedgeTrayDistance uint32 = 0x300A00D9
+	// SourceToWedgeTrayDistance (300A,00DA) DS
+	SourceToWedgeTrayDistance uint32 = 0x300A00DA
+	// WedgeThinEdgePosition (300A,00DB) FL
+	WedgeThinEdgePosition uint32 = 0x300A00DB
+	// BolusID (300A,00DC) SH
+	BolusID uint32 = 0x300A00DC
+	// BolusDescription (300A,00DD) ST
+	BolusDescription uint32 = 0x300A00DD
+	// EffectiveWedgeAngle (300A,00DE) DS
+	EffectiveWedgeAngle uint32 = 0x300A00DE
+	// NumberOfCompensators (300A,00E0) IS
+	NumberOfCompensators uint32 = 0x300A00E0
+	// MaterialID (300A,00E1) SH
+	MaterialID uint32 = 0x300A00E1
+	// TotalCompensatorTrayFactor (300A,00E2) DS
+	TotalCompensatorTrayFactor uint32 = 0x300A00E2
+	// CompensatorSequence (300A,00E3) SQ
+	CompensatorSequence uint32 = 0x300A00E3
+	// CompensatorNumber (300A,00E4) IS
+	CompensatorNumber uint32 = 0x300A00E4
+	// CompensatorID (300A,00E5) SH
+	CompensatorID uint32 = 0x300A00E5
+	// SourceToCompensatorTrayDistance (300A,00E6) DS
+	SourceToCompensatorTrayDistance uint32 = 0x300A00E6
+	// CompensatorRows (300A,00E7) IS
+	CompensatorRows uint32 = 0x300A00E7
+	// CompensatorColumns (300A,00E8) IS
+	CompensatorColumns uint32 = 0x300A00E8
+	// CompensatorPixelSpacing (300A,00E9) DS
+	CompensatorPixelSpacing uint32 = 0x300A00E9
+	// CompensatorPosition (300A,00EA) DS
+	CompensatorPosition uint32 = 0x300A00EA
+	// CompensatorTransmissionData (300A,00EB) DS
+	CompensatorTransmissionData uint32 = 0x300A00EB
+	// CompensatorThicknessData (300A,00EC) DS
+	CompensatorThicknessData uint32 = 0x300A00EC
+	// NumberOfBoli (300A,00ED) IS
+	NumberOfBoli uint32 = 0x300A00ED
+	// CompensatorType (300A,00EE) CS
+	CompensatorType uint32 = 0x300A00EE
+	// CompensatorTrayID (300A,00EF) SH
+	CompensatorTrayID uint32 = 0x300A00EF
+	// NumberOfBlocks (300A,00F0) IS
+	NumberOfBlocks uint32 = 0x300A00F0
+	// TotalBlockTrayFactor (300A,00F2) DS
+	TotalBlockTrayFactor uint32 = 0x300A00F2
+	// TotalBlockTrayWaterEquivalentThickness (300A,00F3) FL
+	TotalBlockTrayWaterEquivalentThickness uint32 = 0x300A00F3
+	// BlockSequence (300A,00F4) SQ
+	BlockSequence uint32 = 0x300A00F4
+	// BlockTrayID (300A,00F5) SH
+	BlockTrayID uint32 = 0x300A00F5
+	// SourceToBlockTrayDistance (300A,00F6) DS
+	SourceToBlockTrayDistance uint32 = 0x300A00F6
+	// IsocenterToBlockTrayDistance (300A,00F7) FL
+	IsocenterToBlockTrayDistance uint32 = 0x300A00F7
+	// BlockType (300A,00F8) CS
+	BlockType uint32 = 0x300A00F8
+	// AccessoryCode (300A,00F9) LO
+	AccessoryCode uint32 = 0x300A00F9
+	// BlockDivergence (300A,00FA) CS
+	BlockDivergence uint32 = 0x300A00FA
+	// BlockMountingPosition (300A,00FB) CS
+	BlockMountingPosition uint32 = 0x300A00FB
+	// BlockNumber (300A,00FC) IS
+	BlockNumber uint32 = 0x300A00FC
+	// BlockName (300A,00FE) LO
+	BlockName uint32 = 0x300A00FE
+	// BlockThickness (300A,0100) DS
+	BlockThickness uint32 = 0x300A0100
+	// BlockTransmission (300A,0102) DS
+	BlockTransmission uint32 = 0x300A0102
+	// BlockNumberOfPoints (300A,0104) IS
+	BlockNumberOfPoints uint32 = 0x300A0104
+	// BlockData (300A,0106) DS
+	BlockData uint32 = 0x300A0106
+	// ApplicatorSequence (300A,0107) SQ
+	ApplicatorSequence uint32 = 0x300A0107
+	// ApplicatorID (300A,0108) SH
+	ApplicatorID uint32 = 0x300A0108
+	// ApplicatorType (300A,0109) CS
+	ApplicatorType uint32 = 0x300A0109
+	// ApplicatorDescription (300A,010A) LO
+	ApplicatorDescription uint32 = 0x300A010A
+	// CumulativeDoseReferenceCoefficient (300A,010C) DS
+	CumulativeDoseReferenceCoefficient uint32 = 0x300A010C
+	// FinalCumulativeMetersetWeight (300A,010E) DS
+	FinalCumulativeMetersetWeight uint32 = 0x300A010E
+	// NumberOfControlPoints (300A,0110) IS
+	NumberOfControlPoints uint32 = 0x300A0110
+	// ControlPointSequence (300A,0111) SQ
+	ControlPointSequence uint32 = 0x300A0111
+	// ControlPointIndex (300A,0112) IS
+	ControlPointIndex uint32 = 0x300A0112
+	// NominalBeamEnergy (300A,0114) DS
+	NominalBeamEnergy uint32 = 0x300A0114
+	// DoseRateSet (300A,0115) DS
+	DoseRateSet uint32 = 0x300A0115
+	// WedgePositionSequence (300A,0116) SQ
+	WedgePositionSequence uint32 = 0x300A0116
+	// WedgePosition (300A,0118) CS
+	WedgePosition uint32 = 0x300A0118
+	// BeamLimitingDevicePositionSequence (300A,011A) SQ
+	BeamLimitingDevicePositionSequence uint32 = 0x300A011A
+	// LeafJawPositions (300A,011C) DS
+	LeafJawPositions uint32 = 0x300A011C
+	// GantryAngle (300A,011E) DS
+	GantryAngle uint32 = 0x300A011E
+	// GantryRotationDirection (300A,011F) CS
+	GantryRotationDirection uint32 = 0x300A011F
+	// BeamLimitingDeviceAngle (300A,0120) DS
+	BeamLimitingDeviceAngle uint32 = 0x300A0120
+	// BeamLimitingDeviceRotationDirection (300A,0121) CS
+	BeamLimitingDeviceRotationDirection uint32 = 0x300A0121
+	// PatientSupportAngle (300A,0122) DS
+	PatientSupportAngle uint32 = 0x300A0122
+	// PatientSupportRotationDirection (300A,0123) CS
+	PatientSupportRotationDirection uint32 = 0x300A0123
+	// TableTopEccentricAxisDistance (300A,0124) DS
+	TableTopEccentricAxisDistance uint32 = 0x300A0124
+	// TableTopEccentricAngle (300A,0125) DS
+	TableTopEccentricAngle uint32 = 0x300A0125
+	// TableTopEccentricRotationDirection (300A,0126) CS
+	TableTopEccentricRotationDirection uint32 = 0x300A0126
+	// TableTopVerticalPosition (300A,0128) DS
+	TableTopVerticalPosition uint32 = 0x300A0128
+	// TableTopLongitudinalPosition (300A,0129) DS
+	TableTopLongitudinalPosition uint32 = 0x300A0129
+	// TableTopLateralPosition (300A,012A) DS
+	TableTopLateralPosition uint32 = 0x300A012A
+	// IsocenterPosition (300A,012C) DS
+	IsocenterPosition uint32 = 0x300A012C
+	// SurfaceEntryPoint (300A,012E) DS
+	SurfaceEntryPoint uint32 = 0x300A012E
+	// SourceToSurfaceDistance (300A,0130) DS
+	SourceToSurfaceDistance uint32 = 0x300A0130
+	// AverageBeamDosePointSourceToExternalContourDistance (300A,0131) FL
+	AverageBeamDosePointSourceToExternalContourDistance uint32 = 0x300A0131
+	// SourceToExternalContourDistance (300A,0132) FL
+	SourceToExternalContourDistance uint32 = 0x300A0132
+	// ExternalContourEntryPoint (300A,0133) FL
+	ExternalContourEntryPoint uint32 = 0x300A0133
+	// CumulativeMetersetWeight (300A,0134) DS
+	CumulativeMetersetWeight uint32 = 0x300A0134
+	// TableTopPitchAngle (300A,0140) FL
+	TableTopPitchAngle uint32 = 0x300A0140
+	// TableTopPitchRotationDirection (300A,0142) CS
+	TableTopPitchRotationDirection uint32 = 0x300A0142
+	// TableTopRollAngle (300A,0144) FL
+	TableTopRollAngle uint32 = 0x300A0144
+	// TableTopRollRotationDirection (300A,0146) CS
+	TableTopRollRotationDirection uint32 = 0x300A0146
+	// HeadFixationAngle (300A,0148) FL
+	HeadFixationAngle uint32 = 0x300A0148
+	// GantryPitchAngle (300A,014A) FL
+	GantryPitchAngle uint32 = 0x300A014A
+	// GantryPitchRotationDirection (300A,014C) CS
+	GantryPitchRotationDirection uint32 = 0x300A014C
+	// GantryPitchAngleTolerance (300A,014E) FL
+	GantryPitchAngleTolerance uint32 = 0x300A014E
+	// FixationEye (300A,0150) CS
+	FixationEye uint32 = 0x300A0150
+	// ChairHeadFramePosition (300A,0151) DS
+	ChairHeadFramePosition uint32 = 0x300A0151
+	// HeadFixationAngleTolerance (300A,0152) DS
+	HeadFixationAngleTolerance uint32 = 0x300A0152
+	// ChairHeadFramePositionTolerance (300A,0153) DS
+	ChairHeadFramePositionTolerance uint32 = 0x300A0153
+	// FixationLightAzimuthalAngleTolerance (300A,0154) DS
+	FixationLightAzimuthalAngleTolerance uint32 = 0x300A0154
+	// FixationLightPolarAngleTolerance (300A,0155) DS
+	FixationLightPolarAngleTolerance uint32 = 0x300A0155
+	// PatientSetupSequence (300A,0180) SQ
+	PatientSetupSequence uint32 = 0x300A0180
+	// PatientSetupNumber (300A,0182) IS
+	PatientSetupNumber uint32 = 0x300A0182
+	// PatientSetupLabel (300A,0183) LO
+	PatientSetupLabel uint32 = 0x300A0183
+	// PatientAdditionalPosition (300A,0184) LO
+	PatientAdditionalPosition uint32 = 0x300A0184
+	// FixationDeviceSequence (300A,0190) SQ
+	FixationDeviceSequence uint32 = 0x300A0190
+	// FixationDeviceType (300A,0192) CS
+	FixationDeviceType uint32 = 0x300A0192
+	// FixationDeviceLabel (300A,0194) SH
+	FixationDeviceLabel uint32 = 0x300A0194
+	// FixationDeviceDescription (300A,0196) ST
+	FixationDeviceDescription uint32 = 0x300A0196
+	// FixationDevicePosition (300A,0198) SH
+	FixationDevicePosition uint32 = 0x300A0198
+	// FixationDevicePitchAngle (300A,0199) FL
+	FixationDevicePitchAngle uint32 = 0x300A0199
+	// FixationDeviceRollAngle (300A,019A) FL
+	FixationDeviceRollAngle uint32 = 0x300A019A
+	// ShieldingDeviceSequence (300A,01A0) SQ
+	ShieldingDeviceSequence uint32 = 0x300A01A0
+	// ShieldingDeviceType (300A,01A2) CS
+	ShieldingDeviceType uint32 = 0x300A01A2
+	// ShieldingDeviceLabel (300A,01A4) SH
+	ShieldingDeviceLabel uint32 = 0x300A01A4
+	// ShieldingDeviceDescription (300A,01A6) ST
+	ShieldingDeviceDescription uint32 = 0x300A01A6
+	// ShieldingDevicePosition (300A,01A8) SH
+	ShieldingDevicePosition uint32 = 0x300A01A8
+	// SetupTechnique (300A,01B0) CS
+	SetupTechnique uint32 = 0x300A01B0
+	// SetupTechniqueDescription (300A,01B2) ST
+	SetupTechniqueDescription uint32 = 0x300A01B2
+	// SetupDeviceSequence (300A,01B4) SQ
+	SetupDeviceSequence uint32 = 0x300A01B4
+	// SetupDeviceType (300A,01B6) CS
+	SetupDeviceType uint32 = 0x300A01B6
+	// SetupDeviceLabel (300A,01B8) SH
+	SetupDeviceLabel uint32 = 0x300A01B8
+	// SetupDeviceDescription (300A,01BA) ST
+	SetupDeviceDescription uint32 = 0x300A01BA
+	// SetupDeviceParameter (300A,01BC) DS
+	SetupDeviceParameter uint32 = 0x300A01BC
+	// SetupReferenceDescription (300A,01D0) ST
+	SetupReferenceDescription uint32 = 0x300A01D0
+	// TableTopVerticalSetupDisplacement (300A,01D2) DS
+	TableTopVerticalSetupDisplacement uint32 = 0x300A01D2
+	// TableTopLongitudinalSetupDisplacement (300A,01D4) DS
+	TableTopLongitudinalSetupDisplacement uint32 = 0x300A01D4
+	// TableTopLateralSetupDisplacement (300A,01D6) DS
+	TableTopLateralSetupDisplacement uint32 = 0x300A01D6
+	// BrachyTreatmentTechnique (300A,0200) CS
+	BrachyTreatmentTechnique uint32 = 0x300A0200
+	// BrachyTreatmentType (300A,0202) CS
+	BrachyTreatmentType uint32 = 0x300A0202
+	// TreatmentMachineSequence (300A,0206) SQ
+	TreatmentMachineSequence uint32 = 0x300A0206
+	// SourceSequence (300A,0210) SQ
+	SourceSequence uint32 = 0x300A0210
+	// SourceNumber (300A,0212) IS
+	SourceNumber uint32 = 0x300A0212
+	// SourceType (300A,0214) CS
+	SourceType uint32 = 0x300A0214
+	// SourceManufacturer (300A,0216) LO
+	SourceManufacturer uint32 = 0x300A0216
+	// ActiveSourceDiameter (300A,0218) DS
+	ActiveSourceDiameter uint32 = 0x300A0218
+	// ActiveSourceLength (300A,021A) DS
+	ActiveSourceLength uint32 = 0x300A021A
+	// SourceModelID (300A,021B) SH
+	SourceModelID uint32 = 0x300A021B
+	// SourceDescription (300A,021C) LO
+	SourceDescription uint32 = 0x300A021C
+	// SourceEncapsulationNominalThickness (300A,0222) DS
+	SourceEncapsulationNominalThickness uint32 = 0x300A0222
+	// SourceEncapsulationNominalTransmission (300A,0224) DS
+	SourceEncapsulationNominalTransmission uint32 = 0x300A0224
+	// SourceIsotopeName (300A,0226) LO
+	SourceIsotopeName uint32 = 0x300A0226
+	// SourceIsotopeHalfLife (300A,0228) DS
+	SourceIsotopeHalfLife uint32 = 0x300A0228
+	// SourceStrengthUnits (300A,0229) CS
+	SourceStrengthUnits uint32 = 0x300A0229
+	// ReferenceAirKermaRate (300A,022A) DS
+	ReferenceAirKermaRate uint32 = 0x300A022A
+	// SourceStrength (300A,022B) DS
+	SourceStrength uint32 = 0x300A022B
+	// SourceStrengthReferenceDate (300A,022C) DA
+	SourceStrengthReferenceDate uint32 = 0x300A022C
+	// SourceStrengthReferenceTime (300A,022E) TM
+	SourceStrengthReferenceTime uint32 = 0x300A022E
+	// ApplicationSetupSequence (300A,0230) SQ
+	ApplicationSetupSequence uint32 = 0x300A0230
+	// ApplicationSetupType (300A,0232) CS
+	ApplicationSetupType uint32 = 0x300A0232
+	// ApplicationSetupNumber (300A,0234) IS
+	ApplicationSetupNumber uint32 = 0x300A0234
+	// ApplicationSetupName (300A,0236) LO
+	ApplicationSetupName uint32 = 0x300A0236
+	// ApplicationSetupManufacturer (300A,0238) LO
+	ApplicationSetupManufacturer uint32 = 0x300A0238
+	// TemplateNumber (300A,0240) IS
+	TemplateNumber uint32 = 0x300A0240
+	// TemplateType (300A,0242) SH
+	TemplateType uint32 = 0x300A0242
+	// TemplateName (300A,0244) LO
+	TemplateName uint32 = 0x300A0244
+	// TotalReferenceAirKerma (300A,0250) DS
+	TotalReferenceAirKerma uint32 = 0x300A0250
+	// BrachyAccessoryDeviceSequence (300A,0260) SQ
+	BrachyAccessoryDeviceSequence uint32 = 0x300A0260
+	// BrachyAccessoryDeviceNumber (300A,0262) IS
+	BrachyAccessoryDeviceNumber uint32 = 0x300A0262
+	// BrachyAccessoryDeviceID (300A,0263) SH
+	BrachyAccessoryDeviceID uint32 = 0x300A0263
+	// BrachyAccessoryDeviceType (300A,0264) CS
+	BrachyAccessoryDeviceType uint32 = 0x300A0264
+	// BrachyAccessoryDeviceName (300A,0266) LO
+	BrachyAccessoryDeviceName uint32 = 0x300A0266
+	// BrachyAccessoryDeviceNominalThickness (300A,026A) DS
+	BrachyAccessoryDeviceNominalThickness uint32 = 0x300A026A
+	// BrachyAccessoryDeviceNominalTransmission (300A,026C) DS
+	BrachyAccessoryDeviceNominalTransmission uint32 = 0x300A026C
+	// ChannelEffectiveLength (300A,0271) DS
+	ChannelEffectiveLength uint32 = 0x300A0271
+	// ChannelInnerLength (300A,0272) DS
+	ChannelInnerLength uint32 = 0x300A0272
+	// AfterloaderChannelID (300A,0273) SH
+	AfterloaderChannelID uint32 = 0x300A0273
+	// SourceApplicatorTipLength (300A,0274) DS
+	SourceApplicatorTipLength uint32 = 0x300A0274
+	// ChannelSequence (300A,0280) SQ
+	ChannelSequence uint32 = 0x300A0280
+	// ChannelNumber (300A,0282) IS
+	ChannelNumber uint32 = 0x300A0282
+	// ChannelLength (300A,0284) DS
+	ChannelLength uint32 = 0x300A0284
+	// ChannelTotalTime (300A,0286) DS
+	ChannelTotalTime uint32 = 0x300A0286
+	// SourceMovementType (300A,0288) CS
+	SourceMovementType uint32 = 0x300A0288
+	// NumberOfPulses (300A,028A) IS
+	NumberOfPulses uint32 = 0x300A028A
+	// PulseRepetitionInterval (300A,028C) DS
+	PulseRepetitionInterval uint32 = 0x300A028C
+	// SourceApplicatorNumber (300A,0290) IS
+	SourceApplicatorNumber uint32 = 0x300A0290
+	// SourceApplicatorID (300A,0291) SH
+	SourceApplicatorID uint32 = 0x300A0291
+	// SourceApplicatorType (300A,0292) CS
+	SourceApplicatorType uint32 = 0x300A0292
+	// SourceApplicatorName (300A,0294) LO
+	SourceApplicatorName uint32 = 0x300A0294
+	// SourceApplicatorLength (300A,0296) DS
+	SourceApplicatorLength uint32 = 0x300A0296
+	// SourceApplicatorManufacturer (300A,0298) LO
+	SourceApplicatorManufacturer uint32 = 0x300A0298
+	// SourceApplicatorWallNominalThickness (300A,029C) DS
+	SourceApplicatorWallNominalThickness uint32 = 0x300A029C
+	// SourceApplicatorWallNominalTransmission (300A,029E) DS
+	SourceApplicatorWallNominalTransmission uint32 = 0x300A029E
+	// SourceApplicatorStepSize (300A,02A0) DS
+	SourceApplicatorStepSize uint32 = 0x300A02A0
+	// TransferTubeNumber (300A,02A2) IS
+	TransferTubeNumber uint32 = 0x300A02A2
+	// TransferTubeLength (300A,02A4) DS
+	TransferTubeLength uint32 = 0x300A02A4
+	// ChannelShieldSequence (300A,02B0) SQ
+	ChannelShieldSequence uint32 = 0x300A02B0
+	// ChannelShieldNumber (300A,02B2) IS
+	ChannelShieldNumber uint32 = 0x300A02B2
+	// ChannelShieldID (300A,02B3) SH
+	ChannelShieldID uint32 = 0x300A02B3
+	// ChannelShieldName (300A,02B4) LO
+	ChannelShieldName uint32 = 0x300A02B4
+	// ChannelShieldNominalThickness (300A,02B8) DS
+	ChannelShieldNominalThickness uint32 = 0x300A02B8
+	// ChannelShieldNominalTransmission (300A,02BA) DS
+	ChannelShieldNominalTransmission uint32 = 0x300A02BA
+	// FinalCumulativeTimeWeight (300A,02C8) DS
+	FinalCumulativeTimeWeight uint32 = 0x300A02C8
+	// BrachyControlPointSequence (300A,02D0) SQ
+	BrachyControlPointSequence uint32 = 0x300A02D0
+	// ControlPointRelativePosition (300A,02D2) DS
+	ControlPointRelativePosition uint32 = 0x300A02D2
+	// ControlPoint3DPosition (300A,02D4) DS
+	ControlPoint3DPosition uint32 = 0x300A02D4
+	// CumulativeTimeWeight (300A,02D6) DS
+	CumulativeTimeWeight uint32 = 0x300A02D6
+	// CompensatorDivergence (300A,02E0) CS
+	CompensatorDivergence uint32 = 0x300A02E0
+	// CompensatorMountingPosition (300A,02E1) CS
+	CompensatorMountingPosition uint32 = 0x300A02E1
+	// SourceToCompensatorDistance (300A,02E2) DS
+	SourceToCompensatorDistance uint32 = 0x300A02E2
+	// TotalCompensatorTrayWaterEquivalentThickness (300A,02E3) FL
+	TotalCompensatorTrayWaterEquivalentThickness uint32 = 0x300A02E3
+	// IsocenterToCompensatorTrayDistance (300A,02E4) FL
+	IsocenterToCompensatorTrayDistance uint32 = 0x300A02E4
+	// CompensatorColumnOffset (300A,02E5) FL
+	CompensatorColumnOffset uint32 = 0x300A02E5
+	// IsocenterToCompensatorDistances (300A,02E6) FL
+	IsocenterToCompensatorDistances uint32 = 0x300A02E6
+	// CompensatorRelativeStoppingPowerRatio (300A,02E7) FL
+	CompensatorRelativeStoppingPowerRatio uint32 = 0x300A02E7
+	// CompensatorMillingToolDiameter (300A,02E8) FL
+	CompensatorMillingToolDiameter uint32 = 0x300A02E8
+	// IonRangeCompensatorSequence (300A,02EA) SQ
+	IonRangeCompensatorSequence uint32 = 0x300A02EA
+	// CompensatorDescription (300A,02EB) LT
+	CompensatorDescription uint32 = 0x300A02EB
+	// RadiationMassNumber (300A,0302) IS
+	RadiationMassNumber uint32 = 0x300A0302
+	// RadiationAtomicNumber (300A,0304) IS
+	RadiationAtomicNumber uint32 = 0x300A0304
+	// RadiationChargeState (300A,0306) SS
+	RadiationChargeState uint32 = 0x300A0306
+	// ScanMode (300A,0308) CS
+	ScanMode uint32 = 0x300A0308
+	// ModulatedScanModeType (300A,0309) CS
+	ModulatedScanModeType uint32 = 0x300A0309
+	// VirtualSourceAxisDistances (300A,030A) FL
+	VirtualSourceAxisDistances uint32 = 0x300A030A
+	// SnoutSequence (300A,030C) SQ
+	SnoutSequence uint32 = 0x300A030C
+	// SnoutPosition (300A,030D) FL
+	SnoutPosition uint32 = 0x300A030D
+	// SnoutID (300A,030F) SH
+	SnoutID uint32 = 0x300A030F
+	// NumberOfRangeShifters (300A,0312) IS
+	NumberOfRangeShifters uint32 = 0x300A0312
+	// RangeShifterSequence (300A,0314) SQ
+	RangeShifterSequence uint32 = 0x300A0314
+	// RangeShifterNumber (300A,0316) IS
+	RangeShifterNumber uint32 = 0x300A0316
+	// RangeShifterID (300A,0318) SH
+	RangeShifterID uint32 = 0x300A0318
+	// RangeShifterType (300A,0320) CS
+	RangeShifterType uint32 = 0x300A0320
+	// RangeShifterDescription (300A,0322) LO
+	RangeShifterDescription uint32 = 0x300A0322
+	// NumberOfLateralSpreadingDevices (300A,0330) IS
+	NumberOfLateralSpreadingDevices uint32 = 0x300A0330
+	// LateralSpreadingDeviceSequence (300A,0332) SQ
+	LateralSpreadingDeviceSequence uint32 = 0x300A0332
+	// LateralSpreadingDeviceNumber (300A,0334) IS
+	LateralSpreadingDeviceNumber uint32 = 0x300A0334
+	// LateralSpreadingDeviceID (300A,0336) SH
+	LateralSpreadingDeviceID uint32 = 0x300A0336
+	// LateralSpreadingDeviceType (300A,0338) CS
+	LateralSpreadingDeviceType uint32 = 0x300A0338
+	// LateralSpreadingDeviceDescription (300A,033A) LO
+	LateralSpreadingDeviceDescription uint32 = 0x300A033A
+	// LateralSpreadingDeviceWaterEquivalentThickness (300A,033C) FL
+	LateralSpreadingDeviceWaterEquivalentThickness uint32 = 0x300A033C
+	// NumberOfRangeModulators (300A,0340) IS
+	NumberOfRangeModulators uint32 = 0x300A0340
+	// RangeModulatorSequence (300A,0342) SQ
+	RangeModulatorSequence uint32 = 0x300A0342
+	// RangeModulatorNumber (300A,0344) IS
+	RangeModulatorNumber uint32 = 0x300A0344
+	// RangeModulatorID (300A,0346) SH
+	RangeModulatorID uint32 = 0x300A0346
+	// RangeModulatorType (300A,0348) CS
+	RangeModulatorType uint32 = 0x300A0348
+	// RangeModulatorDescription (300A,034A) LO
+	RangeModulatorDescription uint32 = 0x300A034A
+	// BeamCurrentModulationID (300A,034C) SH
+	BeamCurrentModulationID uint32 = 0x300A034C
+	// PatientSupportType (300A,0350) CS
+	PatientSupportType uint32 = 0x300A0350
+	// PatientSupportID (300A,0352) SH
+	PatientSupportID uint32 = 0x300A0352
+	// PatientSupportAccessoryCode (300A,0354) LO
+	PatientSupportAccessoryCode uint32 = 0x300A0354
+	// TrayAccessoryCode (300A,0355) LO
+	TrayAccessoryCode uint32 = 0x300A0355
+	// FixationLightAzimuthalAngle (300A,0356) FL
+	FixationLightAzimuthalAngle uint32 = 0x300A0356
+	// FixationLightPolarAngle (300A,0358) FL
+	FixationLightPolarAngle uint32 = 0x300A0358
+	// MetersetRate (300A,035A) FL
+	MetersetRate uint32 = 0x300A035A
+	// RangeShifterSettingsSequence (300A,0360) SQ
+	RangeShifterSettingsSequence uint32 = 0x300A0360
+	// RangeShifterSetting (300A,0362) LO
+	RangeShifterSetting uint32 = 0x300A0362
+	// IsocenterToRangeShifterDistance (300A,0364) FL
+	IsocenterToRangeShifterDistance uint32 = 0x300A0364
+	// RangeShifterWaterEquivalentThickness (300A,0366) FL
+	RangeShifterWaterEquivalentThickness uint32 = 0x300A0366
+	// LateralSpreadingDeviceSettingsSequence (300A,0370) SQ
+	LateralSpreadingDeviceSettingsSequence uint32 = 0x300A0370
+	// LateralSpreadingDeviceSetting (300A,0372) LO
+	LateralSpreadingDeviceSetting uint32 = 0x300A0372
+	// IsocenterToLateralSpreadingDeviceDistance (300A,0374) FL
+	IsocenterToLateralSpreadingDeviceDistance uint32 = 0x300A0374
+	// RangeModulatorSettingsSequence (300A,0380) SQ
+	RangeModulatorSettingsSequence uint32 = 0x300A0380
+	// RangeModulatorGatingStartValue (300A,0382) FL
+	RangeModulatorGatingStartValue uint32 = 0x300A0382
+	// RangeModulatorGatingStopValue (300A,0384) FL
+	RangeModulatorGatingStopValue uint32 = 0x300A0384
+	// RangeModulatorGatingStartWaterEquivalentThickness (300A,0386) FL
+	RangeModulatorGatingStartWaterEquivalentThickness uint32 = 0x300A0386
+	// RangeModulatorGatingStopWaterEquivalentThickness (300A,0388) FL
+	RangeModulatorGatingStopWaterEquivalentThickness uint32 = 0x300A0388
+	// IsocenterToRangeModulatorDistance (300A,038A) FL
+	IsocenterToRangeModulatorDistance uint32 = 0x300A038A
+	// ScanSpotTimeOffset (300A,038F) FL
+	ScanSpotTimeOffset uint32 = 0x300A038F
+	// ScanSpotTuneID (300A,0390) SH
+	ScanSpotTuneID uint32 = 0x300A0390
+	// ScanSpotPrescribedIndices (300A,0391) IS
+	ScanSpotPrescribedIndices uint32 = 0x300A0391
+	// NumberOfScanSpotPositions (300A,0392) IS
+	NumberOfScanSpotPositions uint32 = 0x300A0392
+	// ScanSpotReordered (300A,0393) CS
+	ScanSpotReordered uint32 = 0x300A0393
+	// ScanSpotPositionMap (300A,0394) FL
+	ScanSpotPositionMap uint32 = 0x300A0394
+	// ScanSpotReorderingAllowed (300A,0395) CS
+	ScanSpotReorderingAllowed uint32 = 0x300A0395
+	// ScanSpotMetersetWeights (300A,0396) FL
+	ScanSpotMetersetWeights uint32 = 0x300A0396
+	// ScanningSpotSize (300A,0398) FL
+	ScanningSpotSize uint32 = 0x300A0398
+	// NumberOfPaintings (300A,039A) IS
+	NumberOfPaintings uint32 = 0x300A039A
+	// IonToleranceTableSequence (300A,03A0) SQ
+	IonToleranceTableSequence uint32 = 0x300A03A0
+	// IonBeamSequence (300A,03A2) SQ
+	IonBeamSequence uint32 = 0x300A03A2
+	// IonBeamLimitingDeviceSequence (300A,03A4) SQ
+	IonBeamLimitingDeviceSequence uint32 = 0x300A03A4
+	// IonBlockSequence (300A,03A6) SQ
+	IonBlockSequence uint32 = 0x300A03A6
+	// IonControlPointSequence (300A,03A8) SQ
+	IonControlPointSequence uint32 = 0x300A03A8
+	// IonWedgeSequence (300A,03AA) SQ
+	IonWedgeSequence uint32 = 0x300A03AA
+	// IonWedgePositionSequence (300A,03AC) SQ
+	IonWedgePositionSequence uint32 = 0x300A03AC
+	// ReferencedSetupImageSequence (300A,0401) SQ
+	ReferencedSetupImageSequence uint32 = 0x300A0401
+	// SetupImageComment (300A,0402) ST
+	SetupImageComment uint32 = 0x300A0402
+	// MotionSynchronizationSequence (300A,0410) SQ
+	MotionSynchronizationSequence uint32 = 0x300A0410
+	// ControlPointOrientation (300A,0412) FL
+	ControlPointOrientation uint32 = 0x300A0412
+	// GeneralAccessorySequence (300A,0420) SQ
+	GeneralAccessorySequence uint32 = 0x300A0420
+	// GeneralAccessoryID (300A,0421) SH
+	GeneralAccessoryID uint32 = 0x300A0421
+	// GeneralAccessoryDescription (300A,0422) ST
+	GeneralAccessoryDescription uint32 = 0x300A0422
+	// GeneralAccessoryType (300A,0423) CS
+	GeneralAccessoryType uint32 = 0x300A0423
+	// GeneralAccessoryNumber (300A,0424) IS
+	GeneralAccessoryNumber uint32 = 0x300A0424
+	// SourceToGeneralAccessoryDistance (300A,0425) FL
+	SourceToGeneralAccessoryDistance uint32 = 0x300A0425
+	// ApplicatorGeometrySequence (300A,0431) SQ
+	ApplicatorGeometrySequence uint32 = 0x300A0431
+	// ApplicatorApertureShape (300A,0432) CS
+	ApplicatorApertureShape uint32 = 0x300A0432
+	// ApplicatorOpening (300A,0433) FL
+	ApplicatorOpening uint32 = 0x300A0433
+	// ApplicatorOpeningX (300A,0434) FL
+	ApplicatorOpeningX uint32 = 0x300A0434
+	// ApplicatorOpeningY (300A,0435) FL
+	ApplicatorOpeningY uint32 = 0x300A0435
+	// SourceToApplicatorMountingPositionDistance (300A,0436) FL
+	SourceToApplicatorMountingPositionDistance uint32 = 0x300A0436
+	// NumberOfBlockSlabItems (300A,0440) IS
+	NumberOfBlockSlabItems uint32 = 0x300A0440
+	// BlockSlabSequence (300A,0441) SQ
+	BlockSlabSequence uint32 = 0x300A0441
+	// BlockSlabThickness (300A,0442) DS
+	BlockSlabThickness uint32 = 0x300A0442
+	// BlockSlabNumber (300A,0443) US
+	BlockSlabNumber uint32 = 0x300A0443
+	// DeviceMotionControlSequence (300A,0450) SQ
+	DeviceMotionControlSequence uint32 = 0x300A0450
+	// DeviceMotionExecutionMode (300A,0451) CS
+	DeviceMotionExecutionMode uint32 = 0x300A0451
+	// DeviceMotionObservationMode (300A,0452) CS
+	DeviceMotionObservationMode uint32 = 0x300A0452
+	// DeviceMotionParameterCodeSequence (300A,0453) SQ
+	DeviceMotionParameterCodeSequence uint32 = 0x300A0453
+	// DistalDepthFraction (300A,0501) FL
+	DistalDepthFraction uint32 = 0x300A0501
+	// DistalDepth (300A,0502) FL
+	DistalDepth uint32 = 0x300A0502
+	// NominalRangeModulationFractions (300A,0503) FL
+	NominalRangeModulationFractions uint32 = 0x300A0503
+	// NominalRangeModulatedRegionDepths (300A,0504) FL
+	NominalRangeModulatedRegionDepths uint32 = 0x300A0504
+	// DepthDoseParametersSequence (300A,0505) SQ
+	DepthDoseParametersSequence uint32 = 0x300A0505
+	// DeliveredDepthDoseParametersSequence (300A,0506) SQ
+	DeliveredDepthDoseParametersSequence uint32 = 0x300A0506
+	// DeliveredDistalDepthFraction (300A,0507) FL
+	DeliveredDistalDepthFraction uint32 = 0x300A0507
+	// DeliveredDistalDepth (300A,0508) FL
+	DeliveredDistalDepth uint32 = 0x300A0508
+	// DeliveredNominalRangeModulationFractions (300A,0509) FL
+	DeliveredNominalRangeModulationFractions uint32 = 0x300A0509
+	// DeliveredNominalRangeModulatedRegionDepths (300A,0510) FL
+	DeliveredNominalRangeModulatedRegionDepths uint32 = 0x300A0510
+	// DeliveredReferenceDoseDefinition (300A,0511) CS
+	DeliveredReferenceDoseDefinition uint32 = 0x300A0511
+	// ReferenceDoseDefinition (300A,0512) CS
+	ReferenceDoseDefinition uint32 = 0x300A0512
+	// ReferencedRTPlanSequence (300C,0002) SQ
+	ReferencedRTPlanSequence uint32 = 0x300C0002
+	// ReferencedBeamSequence (300C,0004) SQ
+	ReferencedBeamSequence uint32 = 0x300C0004
+	// ReferencedBeamNumber (300C,0006) IS
+	ReferencedBeamNumber uint32 = 0x300C0006
+	// ReferencedReferenceImageNumber (300C,0007) IS
+	ReferencedReferenceImageNumber uint32 = 0x300C0007
+	// StartCumulativeMetersetWeight (300C,0008) DS
+	StartCumulativeMetersetWeight uint32 = 0x300C0008
+	// EndCumulativeMetersetWeight (300C,0009) DS
+	EndCumulativeMetersetWeight uint32 = 0x300C0009
+	// ReferencedBrachyApplicationSetupSequence (300C,000A) SQ
+	ReferencedBrachyApplicationSetupSequence uint32 = 0x300C000A
+	// ReferencedBrachyApplicationSetupNumber (300C,000C) IS
+	ReferencedBrachyApplicationSetupNumber uint32 = 0x300C000C
+	// ReferencedSourceNumber (300C,000E) IS
+	ReferencedSourceNumber uint32 = 0x300C000E
+	// ReferencedFractionGroupSequence (300C,0020) SQ
+	ReferencedFractionGroupSequence uint32 = 0x300C0020
+	// ReferencedFractionGroupNumber (300C,0022) IS
+	ReferencedFractionGroupNumber uint32 = 0x300C0022
+	// ReferencedVerificationImageSequence (300C,0040) SQ
+	ReferencedVerificationImageSequence uint32 = 0x300C0040
+	// ReferencedReferenceImageSequence (300C,0042) SQ
+	ReferencedReferenceImageSequence uint32 = 0x300C0042
+	// ReferencedDoseReferenceSequence (300C,0050) SQ
+	ReferencedDoseReferenceSequence uint32 = 0x300C0050
+	// ReferencedDoseReferenceNumber (300C,0051) IS
+	ReferencedDoseReferenceNumber uint32 = 0x300C0051
+	// BrachyReferencedDoseReferenceSequence (300C,0055) SQ
+	BrachyReferencedDoseReferenceSequence uint32 = 0x300C0055
+	// ReferencedStructureSetSequence (300C,0060) SQ
+	ReferencedStructureSetSequence uint32 = 0x300C0060
+	// ReferencedPatientSetupNumber (300C,006A) IS
+	ReferencedPatientSetupNumber uint32 = 0x300C006A
+	// ReferencedDoseSequence (300C,0080) SQ
+	ReferencedDoseSequence uint32 = 0x300C0080
+	// ReferencedToleranceTableNumber (300C,00A0) IS
+	ReferencedToleranceTableNumber uint32 = 0x300C00A0
+	// ReferencedBolusSequence (300C,00B0) SQ
+	ReferencedBolusSequence uint32 = 0x300C00B0
+	// ReferencedWedgeNumber (300C,00C0) IS
+	ReferencedWedgeNumber uint32 = 0x300C00C0
+	// ReferencedCompensatorNumber (300C,00D0) IS
+	ReferencedCompensatorNumber uint32 = 0x300C00D0
+	// ReferencedBlockNumber (300C,00E0) IS
+	ReferencedBlockNumber uint32 = 0x300C00E0
+	// ReferencedControlPointIndex (300C,00F0) IS
+	ReferencedControlPointIndex uint32 = 0x300C00F0
+	// ReferencedControlPointSequence (300C,00F2) SQ
+	ReferencedControlPointSequence uint32 = 0x300C00F2
+	// ReferencedStartControlPointIndex (300C,00F4) IS
+	ReferencedStartControlPointIndex uint32 = 0x300C00F4
+	// ReferencedStopControlPointIndex (300C,00F6) IS
+	ReferencedStopControlPointIndex uint32 = 0x300C00F6
+	// ReferencedRangeShifterNumber (300C,0100) IS
+	ReferencedRangeShifterNumber uint32 = 0x300C0100
+	// ReferencedLateralSpreadingDeviceNumber (300C,0102) IS
+	ReferencedLateralSpreadingDeviceNumber uint32 = 0x300C0102
+	// ReferencedRangeModulatorNumber (300C,0104) IS
+	ReferencedRangeModulatorNumber uint32 = 0x300C0104
+	// OmittedBeamTaskSequence (300C,0111) SQ
+	OmittedBeamTaskSequence uint32 = 0x300C0111
+	// ReasonForOmission (300C,0112) CS
+	ReasonForOmission uint32 = 0x300C0112
+	// ReasonForOmissionDescription (300C,0113) LO
+	ReasonForOmissionDescription uint32 = 0x300C0113
+	// ApprovalStatus (300E,0002) CS
+	ApprovalStatus uint32 = 0x300E0002
+	// ReviewDate (300E,0004) DA
+	ReviewDate uint32 = 0x300E0004
+	// ReviewTime (300E,0005) TM
+	ReviewTime uint32 = 0x300E0005
+	// ReviewerName (300E,0008) PN
+	ReviewerName uint32 = 0x300E0008
+	// Arbitrary (4000,0010) LT, retired
+	Arbitrary uint32 = 0x40000010
+	// TextComments (4000,4000) LT, retired
+	TextComments uint32 = 0x40004000
+	// ResultsID (4008,0040) SH, retired
+	ResultsID uint32 = 0x40080040
+	// ResultsIDIssuer (4008,0042) LO, retired
+	ResultsIDIssuer uint32 = 0x40080042
+	// ReferencedInterpretationSequence (4008,0050) SQ, retired
+	ReferencedInterpretationSequence uint32 = 0x40080050
+	// ReportProductionStatusTrial (4008,00FF) CS, retired
+	ReportProductionStatusTrial uint32 = 0x400800FF
+	// InterpretationRecordedDate (4008,0100) DA, retired
+	InterpretationRecordedDate uint32 = 0x40080100
+	// InterpretationRecordedTime (4008,0101) TM, retired
+	InterpretationRecordedTime uint32 = 0x40080101
+	// InterpretationRecorder (4008,0102) PN, retired
+	InterpretationRecorder uint32 = 0x40080102
+	// ReferenceToRecordedSound (4008,0103) LO, retired
+	ReferenceToRecordedSound uint32 = 0x40080103
+	// InterpretationTranscriptionDate (4008,0108) DA, retired
+	InterpretationTranscriptionDate uint32 = 0x40080108
+	// InterpretationTranscriptionTime (4008,0109) TM, retired
+	InterpretationTranscriptionTime uint32 = 0x40080109
+	// InterpretationTranscriber (4008,010A) PN, retired
+	InterpretationTranscriber uint32 = 0x4008010A
+	// InterpretationText (4008,010B) ST, retired
+	InterpretationText uint32 = 0x4008010B
+	// InterpretationAuthor (4008,010C) PN, retired
+	InterpretationAuthor uint32 = 0x4008010C
+	// InterpretationApproverSequence (4008,0111) SQ, retired
+	InterpretationApproverSequence uint32 = 0x40080111
+	// InterpretationApprovalDate (4008,0112) DA, retired
+	InterpretationApprovalDate uint32 = 0x40080112
+	// InterpretationApprovalTime (4008,0113) TM, retired
+	InterpretationApprovalTime uint32 = 0x40080113
+	// PhysicianApprovingInterpretation (4008,0114) PN, retired
+	PhysicianApprovingInterpretation uint32 = 0x40080114
+	// InterpretationDiagnosisDescription (4008,0115) LT, retired
+	InterpretationDiagnosisDescription uint32 = 0x40080115
+	// InterpretationDiagnosisCodeSequence (4008,0117) SQ, retired
+	InterpretationDiagnosisCodeSequence uint32 = 0x40080117
+	// ResultsDistributionListSequence (4008,0118) SQ, retired
+	ResultsDistributionListSequence uint32 = 0x40080118
+	// DistributionName (4008,0119) PN, retired
+	DistributionName uint32 = 0x40080119
+	// DistributionAddress (4008,011A) LO, retired
+	DistributionAddress uint32 = 0x4008011A
+	// InterpretationID (4008,0200) SH, retired
+	InterpretationID uint32 = 0x40080200
+	// InterpretationIDIssuer (4008,0202) LO, retired
+	InterpretationIDIssuer uint32 = 0x40080202
+	// InterpretationTypeID (4008,0210) CS, retired
+	InterpretationTypeID uint32 = 0x40080210
+	// InterpretationStatusID (4008,0212) CS, retired
+	InterpretationStatusID uint32 = 0x40080212
+	// Impressions (4008,0300) ST, retired
+	Impressions uint32 = 0x40080300
+	// ResultsComments (4008,4000) ST, retired
+	ResultsComments uint32 = 0x40084000
+	// LowEnergyDetectors (4010,0001) CS
+	LowEnergyDetectors uint32 = 0x40100001
+	// HighEnergyDetectors (4010,0002) CS
+	HighEnergyDetectors uint32 = 0x40100002
+	// DetectorGeometrySequence (4010,0004) SQ
+	DetectorGeometrySequence uint32 = 0x40100004
+	// ThreatROIVoxelSequence (4010,1001) SQ
+	ThreatROIVoxelSequence uint32 = 0x40101001
+	// ThreatROIBase (4010,1004) FL
+	ThreatROIBase uint32 = 0x40101004
+	// ThreatROIExtents (4010,1005) FL
+	ThreatROIExtents uint32 = 0x40101005
+	// ThreatROIBitmap (4010,1006) OB
+	ThreatROIBitmap uint32 = 0x40101006
+	// RouteSegmentID (4010,1007) SH
+	RouteSegmentID uint32 = 0x40101007
+	// GantryType (4010,1008) CS
+	GantryType uint32 = 0x40101008
+	// OOIOwnerType (4010,1009) CS
+	OOIOwnerType uint32 = 0x40101009
+	// RouteSegmentSequence (4010,100A) SQ
+	RouteSegmentSequence uint32 = 0x4010100A
+	// PotentialThreatObjectID (4010,1010) US
+	PotentialThreatObjectID uint32 = 0x40101010
+	// ThreatSequence (4010,1011) SQ
+	ThreatSequence uint32 = 0x40101011
+	// ThreatCategory (4010,1012) CS
+	ThreatCategory uint32 = 0x40101012
+	// ThreatCategoryDescription (4010,1013) LT
+	ThreatCategoryDescription uint32 = 0x40101013
+	// ATDAbilityAssessment (4010,1014) CS
+	ATDAbilityAssessment uint32 = 0x40101014
+	// ATDAssessmentFlag (4010,1015) CS
+	ATDAssessmentFlag uint32 = 0x40101015
+	// ATDAssessmentProbability (4010,1016) FL
+	ATDAssessmentProbability uint32 = 0x40101016
+	// Mass (4010,1017) FL
+	Mass uint32 = 0x40101017
+	// Density (4010,1018) FL
+	Density uint32 = 0x40101018
+	// ZEffective (4010,1019) FL
+	ZEffective uint32 = 0x40101019
+	// BoardingPassID (4010,101A) SH
+	BoardingPassID uint32 = 0x4010101A
+	// CenterOfMass (4010,101B) FL
+	CenterOfMass uint32 = 0x4010101B
+	// CenterOfPTO (4010,101C) FL
+	CenterOfPTO uint32 = 0x4010101C
+	// BoundingPolygon (4010,101D) FL
+	BoundingPolygon uint32 = 0x4010101D
+	// RouteSegmentStartLocationID (4010,101E) SH
+	RouteSegmentStartLocationID uint32 = 0x4010101E
+	// RouteSegmentEndLocationID (4010,101F) SH
+	RouteSegmentEndLocationID uint32 = 0x4010101F
+	// RouteSegmentLocationIDType (4010,1020) CS
+	RouteSegmentLocationIDType uint32 = 0x40101020
+	// AbortReason (4010,1021) CS
+	AbortReason uint32 = 0x40101021
+	// VolumeOfPTO (4010,1023) FL
+	VolumeOfPTO uint32 = 0x40101023
+	// AbortFlag (4010,1024) CS
+	AbortFlag uint32 = 0x40101024
+	// RouteSegmentStartTime (4010,1025) DT
+	RouteSegmentStartTime uint32 = 0x40101025
+	// RouteSegmentEndTime (4010,1026) DT
+	RouteSegmentEndTime uint32 = 0x40101026
+	// TDRType (4010,1027) CS
+	TDRType uint32 = 0x40101027
+	// InternationalRouteSegment (4010,1028) CS
+	InternationalRouteSegment uint32 = 0x40101028
+	// ThreatDetectionAlgorithmandVersion (4010,1029) LO
+	ThreatDetectionAlgorithmandVersion uint32 = 0x40101029
+	// AssignedLocation (4010,102A) SH
+	AssignedLocation uint32 = 0x4010102A
+	// AlarmDecisionTime (4010,102B) DT
+	AlarmDecisionTime uint32 = 0x4010102B
+	// AlarmDecision (4010,1031) CS
+	AlarmDecision uint32 = 0x40101031
+	// NumberOfTotalObjects (4010,1033) US
+	NumberOfTotalObjects uint32 = 0x40101033
+	// NumberOfAlarmObjects (4010,1034) US
+	NumberOfAlarmObjects uint32 = 0x40101034
+	// PTORepresentationSequence (4010,1037) SQ
+	PTORepresentationSequence uint32 = 0x40101037
+	// ATDAssessmentSequence (4010,1038) SQ
+	ATDAssessmentSequence uint32 = 0x40101038
+	// TIPType (4010,1039) CS
+	TIPType uint32 = 0x40101039
+	// DICOSVersion (4010,103A) CS
+	DICOSVersion uint32 = 0x4010103A
+	// OOIOwnerCreationTime (4010,1041) DT
+	OOIOwnerCreationTime uint32 = 0x40101041
+	// OOIType (4010,1042) CS
+	OOIType uint32 = 0x40101042
+	// OOISize (4010,1043) FL
+	OOISize uint32 = 0x40101043
+	// AcquisitionStatus (4010,1044) CS
+	AcquisitionStatus uint32 = 0x40101044
+	// BasisMaterialsCodeSequence (4010,1045) SQ
+	BasisMaterialsCodeSequence uint32 = 0x40101045
+	// PhantomType (4010,1046) CS
+	PhantomType uint32 = 0x40101046
+	// OOIOwnerSequence (4010,1047) SQ
+	OOIOwnerSequence uint32 = 0x40101047
+	// ScanType (4010,1048) CS
+	ScanType uint32 = 0x40101048
+	// ItineraryID (4010,1051) LO
+	ItineraryID uint32 = 0x40101051
+	// ItineraryIDType (4010,1052) SH
+	ItineraryIDType uint32 = 0x40101052
+	// ItineraryIDAssigningAuthority (4010,1053) LO
+	ItineraryIDAssigningAuthority uint32 = 0x40101053
+	// RouteID (4010,1054) SH
+	RouteID uint32 = 0x40101054
+	// RouteIDAssigningAuthority (4010,1055) SH
+	RouteIDAssigningAuthority uint32 = 0x40101055
+	// InboundArrivalType (4010,1056) CS
+	InboundArrivalType uint32 = 0x40101056
+	// CarrierID (4010,1058) SH
+	CarrierID uint32 = 0x40101058
+	// CarrierIDAssigningAuthority (4010,1059) CS
+	CarrierIDAssigningAuthority uint32 = 0x40101059
+	// SourceOrientation (4010,1060) FL
+	SourceOrientation uint32 = 0x40101060
+	// SourcePosition (4010,1061) FL
+	SourcePosition uint32 = 0x40101061
+	// BeltHeight (4010,1062) FL
+	BeltHeight uint32 = 0x40101062
+	// AlgorithmRoutingCodeSequence (4010,1064) SQ
+	AlgorithmRoutingCodeSequence uint32 = 0x40101064
+	// TransportClassification (4010,1067) CS
+	TransportClassification uint32 = 0x40101067
+	// OOITypeDescriptor (4010,1068) LT
+	OOITypeDescriptor uint32 = 0x40101068
+	// TotalProcessingTime (4010,1069) FL
+	TotalProcessingTime uint32 = 0x40101069
+	// DetectorCalibrationData (4010,106C) OB
+	DetectorCalibrationData uint32 = 0x4010106C
+	// AdditionalScreeningPerformed (4010,106D) CS
+	AdditionalScreeningPerformed uint32 = 0x4010106D
+	// AdditionalInspectionSelectionCriteria (4010,106E) CS
+	AdditionalInspectionSelectionCriteria uint32 = 0x4010106E
+	// AdditionalInspectionMethodSequence (4010,106F) SQ
+	AdditionalInspectionMethodSequence uint32 = 0x4010106F
+	// AITDeviceType (4010,1070) CS
+	AITDeviceType uint32 = 0x40101070
+	// QRMeasurementsSequence (4010,1071) SQ
+	QRMeasurementsSequence uint32 = 0x40101071
+	// TargetMaterialSequence (4010,1072) SQ
+	TargetMaterialSequence uint32 = 0x40101072
+	// SNRThreshold (4010,1073) FD
+	SNRThreshold uint32 = 0x40101073
+	// ImageScaleRepresentation (4010,1075) DS
+	ImageScaleRepresentation uint32 = 0x40101075
+	// ReferencedPTOSequence (4010,1076) SQ
+	ReferencedPTOSequence uint32 = 0x40101076
+	// ReferencedTDRInstanceSequence (4010,1077) SQ
+	ReferencedTDRInstanceSequence uint32 = 0x40101077
+	// PTOLocationDescription (4010,1078) ST
+	PTOLocationDescription uint32 = 0x40101078
+	// AnomalyLocatorIndicatorSequence (4010,1079) SQ
+	AnomalyLocatorIndicatorSequence uint32 = 0x40101079
+	// AnomalyLocatorIndicator (4010,107A) FL
+	AnomalyLocatorIndicator uint32 = 0x4010107A
+	// PTORegionSequence (4010,107B) SQ
+	PTORegionSequence uint32 = 0x4010107B
+	// InspectionSelectionCriteria (4010,107C) CS
+	InspectionSelectionCriteria uint32 = 0x4010107C
+	// SecondaryInspectionMethodSequence (4010,107D) SQ
+	SecondaryInspectionMethodSequence uint32 = 0x4010107D
+	// PRCSToRCSOrientation (4010,107E) DS
+	PRCSToRCSOrientation uint32 = 0x4010107E
+	// MACParametersSequence (4FFE,0001) SQ
+	MACParametersSequence uint32 = 0x4FFE0001
+	// SharedFunctionalGroupsSequence (5200,9229) SQ
+	SharedFunctionalGroupsSequence uint32 = 0x52009229
+	// PerFrameFunctionalGroupsSequence (5200,9230) SQ
+	PerFrameFunctionalGroupsSequence uint32 = 0x52009230
+	// WaveformSequence (5400,0100) SQ
+	WaveformSequence uint32 = 0x54000100
+	// ChannelMinimumValue (5400,0110) OB
+	ChannelMinimumValue uint32 = 0x54000110
+	// ChannelMaximumValue (5400,0112) OB
+	ChannelMaximumValue uint32 = 0x54000112
+	// WaveformBitsAllocated (5400,1004) US
+	WaveformBitsAllocated uint32 = 0x54001004
+	// WaveformSampleInterpretation (5400,1006) CS
+	WaveformSampleInterpretation uint32 = 0x54001006
+	// WaveformPaddingValue (5400,100A) OB
+	WaveformPaddingValue uint32 = 0x5400100A
+	// WaveformData (5400,1010) OB
+	WaveformData uint32 = 0x54001010
+	// FirstOrderPhaseCorrectionAngle (5600,0010) OF
+	FirstOrderPhaseCorrectionAngle uint32 = 0x56000010
+	// SpectroscopyData (5600,0020) OF
+	SpectroscopyData uint32 = 0x56000020
+	// FloatPixelData (7FE0,0008) OF
+	FloatPixelData uint32 = 0x7FE00008
+	// DoubleFloatPixelData (7FE0,0009) OD
+	DoubleFloatPixelData uint32 = 0x7FE00009
+	// PixelData (7FE0,0010) OB
+	PixelData uint32 = 0x7FE00010
+	// CoefficientsSDVN (7FE0,0020) OW, retired
+	CoefficientsSDVN uint32 = 0x7FE00020
+	// CoefficientsSDHN (7FE0,0030) OW, retired
+	CoefficientsSDHN uint32 = 0x7FE00030
+	// CoefficientsSDDN (7FE0,0040) OW, retired
+	CoefficientsSDDN uint32 = 0x7FE00040
+	// DigitalSignaturesSequence (FFFA,FFFA) SQ
+	DigitalSignaturesSequence uint32 = 0xFFFAFFFA
+	// DataSetTrailingPadding (FFFC,FFFC) OB
+	DataSetTrailingPadding uint32 = 0xFFFCFFFC
+	// Item (FFFE,E000) UN
+	Item uint32 = 0xFFFEE000
+	// ItemDelimitationItem (FFFE,E00D) UN
+	ItemDelimitationItem uint32 = 0xFFFEE00D
+	// SequenceDelimitationItem (FFFE,E0DD) UN
+	SequenceDelimitationItem uint32 = 0xFFFEE0DD
+)
@@ -0,0 +1,802 @@
+// Code generated using util:gendatadict. DO NOT EDIT.
+
+// Package uid provides a constant for each unique identifier of the data
+// dictionary, such as SOP classes and transfer syntaxes. For example: `uid.ExplicitVRLittleEndian`
+package uid
+
+const (
+	// VerificationSOPClass is the SOP Class "Verification SOP Class"
+	VerificationSOPClass = "1.2.840.10008.1.1"
+	// ImplicitVRLittleEndian is the Transfer Syntax "Implicit VR Little Endian: Default Transfer Syntax for DICOM"
+	ImplicitVRLittleEndian = "1.2.840.10008.1.2"
+	// ExplicitVRLittleEndian is the Transfer Syntax "Explicit VR Little Endian"
+	ExplicitVRLittleEndian = "1.2.840.10008.1.2.1"
+	// DeflatedExplicitVRLittleEndian is the Transfer Syntax "Deflated Explicit VR Little Endian"
+	DeflatedExplicitVRLittleEndian = "1.2.840.10008.1.2.1.99"
+	// ExplicitVRBigEndian is the Transfer Syntax "Explicit VR Big Endian (Retired)"
+	ExplicitVRBigEndian = "1.2.840.10008.1.2.2"
+	// JPEGBaselineProcess1 is the Transfer Syntax "JPEG Baseline (Process 1): Default Transfer Syntax for Lossy JPEG 8 Bit Image Compression"
+	JPEGBaselineProcess1 = "1.2.840.10008.1.2.4.50"
+	// JPEGExtendedProcess2And4 is the Transfer Syntax "JPEG Extended (Process 2 & 4): Default Transfer Syntax for Lossy JPEG 12 Bit Image Compression (Process 4 only)"
+	JPEGExtendedProcess2And4 = "1.2.840.10008.1.2.4.51"
+	// JPEGExtendedProcess3And5 is the Transfer Syntax "JPEG Extended (Process 3 & 5) (Retired)"
+	JPEGExtendedProcess3And5 = "1.2.840.10008.1.2.4.52"
+	// JPEGSpectralSelectionNonHierarchicalProcess6And8 is the Transfer Syntax "JPEG Spectral Selection, Non-Hierarchical (Process 6 & 8) (Retired)"
+	JPEGSpectralSelectionNonHierarchicalProcess6And8 = "1.2.840.10008.1.2.4.53"
+	// JPEGSpectralSelectionNonHierarchicalProcess7And9 is the Transfer Syntax "JPEG Spectral Selection, Non-Hierarchical (Process 7 & 9) (Retired)"
+	JPEGSpectralSelectionNonHierarchicalProcess7And9 = "1.2.840.10008.1.2.4.54"
+	// JPEGFullProgressionNonHierarchicalProcess10And12 is the Transfer Syntax "JPEG Full Progression, Non-Hierarchical (Process 10 & 12) (Retired)"
+	JPEGFullProgressionNonHierarchicalProcess10And12 = "1.2.840.10008.1.2.4.55"
+	// JPEGFullProgressionNonHierarchicalProcess11And13 is the Transfer Syntax "JPEG Full Progression, Non-Hierarchical (Process 11 & 13) (Retired)"
+	JPEGFullProgressionNonHierarchicalProcess11And13 = "1.2.840.10008.1.2.4.56"
+	// JPEGLosslessNonHierarchicalProcess14 is the Transfer Syntax "JPEG Lossless, Non-Hierarchical (Process 14)"
+	JPEGLosslessNonHierarchicalProcess14 = "1.2.840.10008.1.2.4.57"
+	// JPEGLosslessNonHierarchicalProcess15 is the Transfer Syntax "JPEG Lossless, Non-Hierarchical (Process 15) (Retired)"
+	JPEGLosslessNonHierarchicalProcess15 = "1.2.840.10008.1.2.4.58"
+	// JPEGExtendedHierarchicalProcess16And18 is the Transfer Syntax "JPEG Extended, Hierarchical (Process 16 & 18) (Retired)"
+	JPEGExtendedHierarchicalProcess16And18 = "1.2.840.10008.1.2.4.59"
+	// JPEGExtendedHierarchicalProcess17And19 is the Transfer Syntax "JPEG Extended, Hierarchical (Process 17 & 19) (Retired)"
+	JPEGExtendedHierarchicalProcess17And19 = "1.2.840.10008.1.2.4.60"
+	// JPEGSpectralSelectionHierarchicalProcess20And22 is the Transfer Syntax "JPEG Spectral Selection, Hierarchical (Process 20 & 22) (Retired)"
+	JPEGSpectralSelectionHierarchicalProcess20And22 = "1.2.840.10008.1.2.4.61"
+	// JPEGSpectralSelectionHierarchicalProcess21And23 is the Transfer Syntax "JPEG Spectral Selection, Hierarchical (Process 21 & 23) (Retired)"
+	JPEGSpectralSelectionHierarchicalProcess21And23 = "1.2.840.10008.1.2.4.62"
+	// JPEGFullProgressionHierarchicalProcess24And26 is the Transfer Syntax "JPEG Full Progression, Hierarchical (Process 24 & 26) (Retired)"
+	JPEGFullProgressionHierarchicalProcess24And26 = "1.2.840.10008.1.2.4.63"
+	// JPEGFullProgressionHierarchicalProcess25And27 is the Transfer Syntax "JPEG Full Progression, Hierarchical (Process 25 & 27) (Retired)"
+	JPEGFullProgressionHierarchicalProcess25And27 = "1.2.840.10008.1.2.4.64"
+	// JPEGLosslessHierarchicalProcess28 is the Transfer Syntax "JPEG Lossless, Hierarchical (Process 28) (Retired)"
+	JPEGLosslessHierarchicalProcess28 = "1.2.840.10008.1.2.4.65"
+	// JPEGLosslessHierarchicalProcess29 is the Transfer Syntax "JPEG Lossless, Hierarchical (Process 29) (Retired)"
+	JPEGLosslessHierarchicalProcess29 = "1.2.840.10008.1.2.4.66"
+	// JPEGLosslessNonHierarchicalFirstOrderPredictionProcess14SelectionValue1 is the Transfer Syntax "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1]): Default Transfer Syntax for Lossless JPEG Image Compression"
+	JPEGLosslessNonHierarchicalFirstOrderPredictionProcess14SelectionValue1 = "1.2.840.10008.1.2.4.70"
+	// JPEGLSLosslessImageCompression is the Transfer Syntax "JPEG-LS Lossless Image Compression"
+	JPEGLSLosslessImageCompression = "1.2.840.10008.1.2.4.80"
+	// JPEGLSLossyNearLosslessImageCompression is the Transfer Syntax "JPEG-LS Lossy (Near-Lossless) Image Compression"
+	JPEGLSLossyNearLosslessImageCompression = "1.2.840.10008.1.2.4.81"
+	// JPEG2000ImageCompressionLosslessOnly is the Transfer Syntax "JPEG 2000 Image Compression (Lossless Only)"
+	JPEG2000ImageCompressionLosslessOnly = "1.2.840.10008.1.2.4.90"
+	// JPEG2000ImageCompression is the Transfer Syntax "JPEG 2000 Image Compression"
+	JPEG2000ImageCompression = "1.2.840.10008.1.2.4.91"
+	// JPEG2000Part2MultiComponentImageCompressionLosslessOnly is the Transfer Syntax "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)"
+	JPEG2000Part2MultiComponentImageCompressionLosslessOnly = "1.2.840.10008.1.2.4.92"
+	// JPEG2000Part2MultiComponentImageCompression is the Transfer Syntax "JPEG 2000 Part 2 Multi-component Image Compression"
+	JPEG2000Part2MultiComponentImageCompression = "1.2.840.10008.1.2.4.93"
+	// JPIPReferenced is the Transfer Syntax "JPIP Referenced"
+	JPIPReferenced = "1.2.840.10008.1.2.4.94"
+	// JPIPReferencedDeflate is the Transfer Syntax "JPIP Referenced Deflate"
+	JPIPReferencedDeflate = "1.2.840.10008.1.2.4.95"
+	// MPEG2MainProfileMainLevel is the Transfer Syntax "MPEG2 Main Profile / Main Level"
+	MPEG2MainProfileMainLevel = "1.2.840.10008.1.2.4.100"
+	// MPEG2MainProfileHighLevel is the Transfer Syntax "MPEG2 Main Profile / High Level"
+	MPEG2MainProfileHighLevel = "1.2.840.10008.1.2.4.101"
+	// MPEG4AVCH264HighProfileLevel41 is the Transfer Syntax "MPEG-4 AVC/H.264 High Profile / Level 4.1"
+	MPEG4AVCH264HighProfileLevel41 = "1.2.840.10008.1.2.4.102"
+	// MPEG4AVCH264BDCompatibleHighProfileLevel41 is the Transfer Syntax "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1"
+	MPEG4AVCH264BDCompatibleHighProfileLevel41 = "1.2.840.10008.1.2.4.103"
+	// MPEG4AVCH264HighProfileLevel42For2DVideo is the Transfer Syntax "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video"
+	MPEG4AVCH264HighProfileLevel42For2DVideo = "1.2.840.10008.1.2.4.104"
+	// MPEG4AVCH264HighProfileLevel42For3DVideo is the Transfer Syntax "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video"
+	MPEG4AVCH264HighProfileLevel42For3DVideo = "1.2.840.10008.1.2.4.105"
+	// MPEG4AVCH264StereoHighProfileLevel42 is the Transfer Syntax "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2"
+	MPEG4AVCH264StereoHighProfileLevel42 = "1.2.840.10008.1.2.4.106"
+	// HEVCH265MainProfileLevel51 is the Transfer Syntax "HEVC/H.265 Main Profile / Level 5.1"
+	HEVCH265MainProfileLevel51 = "1.2.840.10008.1.2.4.107"
+	// HEVCH265Main10ProfileLevel51 is the Transfer Syntax "HEVC/H.265 Main 10 Profile / Level 5.1"
+	HEVCH265Main10ProfileLevel51 = "1.2.840.10008.1.2.4.108"
+	// RLELossless is the Transfer Syntax "RLE Lossless"
+	RLELossless = "1.2.840.10008.1.2.5"
+	// RFC2557MIMEEncapsulation is the Transfer Syntax "RFC 2557 MIME encapsulation"
+	RFC2557MIMEEncapsulation = "1.2.840.10008.1.2.6.1"
+	// XMLEncoding is the Transfer Syntax "XML Encoding"
+	XMLEncoding = "1.2.840.10008.1.2.6.2"
+	// MediaStorageDirectoryStorage is the SOP Class "Media Storage Directory Storage"
+	MediaStorageDirectoryStorage = "1.2.840.10008.1.3.10"
+	// TalairachBrainAtlasFrameOfReference is the Well-known frame of reference "Talairach Brain Atlas Frame of Reference"
+	TalairachBrainAtlasFrameOfReference = "1.2.840.10008.1.4.1.1"
+	// SPM2T1FrameOfReference is the Well-known frame of reference "SPM2 T1 Frame of Reference"
+	SPM2T1FrameOfReference = "1.2.840.10008.1.4.1.2"
+	// SPM2T2FrameOfReference is the Well-known frame of reference "SPM2 T2 Frame of Reference"
+	SPM2T2FrameOfReference = "1.2.840.10008.1.4.1.3"
+	// SPM2PDFrameOfReference is the Well-known frame of reference "SPM2 PD Frame of Reference"
+	SPM2PDFrameOfReference = "1.2.840.10008.1.4.1.4"
+	// SPM2EPIFrameOfReference is the Well-known frame of reference "SPM2 EPI Frame of Reference"
+	SPM2EPIFrameOfReference = "1.2.840.10008.1.4.1.5"
+	// SPM2FILT1FrameOfReference is the Well-known frame of reference "SPM2 FIL T1 Frame of Reference"
+	SPM2FILT1FrameOfReference = "1.2.840.10008.1.4.1.6"
+	// SPM2PETFrameOfReference is the Well-known frame of reference "SPM2 PET Frame of Reference"
+	SPM2PETFrameOfReference = "1.2.840.10008.1.4.1.7"
+	// SPM2TRANSMFrameOfReference is the Well-known frame of reference "SPM2 TRANSM Frame of Reference"
+	SPM2TRANSMFrameOfReference = "1.2.840.10008.1.4.1.8"
+	// SPM2SPECTFrameOfReference is the Well-known frame of reference "SPM2 SPECT Frame of Reference"
+	SPM2SPECTFrameOfReference = "1.2.840.10008.1.4.1.9"
+	// SPM2GRAYFrameOfReference is the Well-known frame of reference "SPM2 GRAY Frame of Reference"
+	SPM2GRAYFrameOfReference = "1.2.840.10008.1.4.1.10"
+	// SPM2WHITEFrameOfReference is the Well-known frame of reference "SPM2 WHITE Frame of Reference"
+	SPM2WHITEFrameOfReference = "1.2.840.10008.1.4.1.11"
+	// SPM2CSFFrameOfReference is the Well-known frame of reference "SPM2 CSF Frame of Reference"
+	SPM2CSFFrameOfReference = "1.2.840.10008.1.4.1.12"
+	// SPM2BRAINMASKFrameOfReference is the Well-known frame of reference "SPM2 BRAINMASK Frame of Reference"
+	SPM2BRAINMASKFrameOfReference = "1.2.840.10008.1.4.1.13"
+	// SPM2AVG305T1FrameOfReference is the Well-known frame of reference "SPM2 AVG305T1 Frame of Reference"
+	SPM2AVG305T1FrameOfReference = "1.2.840.10008.1.4.1.14"
+	// SPM2AVG152T1FrameOfReference is the Well-known frame of reference "SPM2 AVG152T1 Frame of Reference"
+	SPM2AVG152T1FrameOfReference = "1.2.840.10008.1.4.1.15"
+	// SPM2AVG152T2FrameOfReference is the Well-known frame of reference "SPM2 AVG152T2 Frame of Reference"
+	SPM2AVG152T2FrameOfReference = "1.2.840.10008.1.4.1.16"
+	// SPM2AVG152PDFrameOfReference is the Well-known frame of reference "SPM2 AVG152PD Frame of Reference"
+	SPM2AVG152PDFrameOfReference = "1.2.840.10008.1.4.1.17"
+	// SPM2SINGLESUBJT1FrameOfReference is the Well-known frame of reference "SPM2 SINGLESUBJT1 Frame of Reference"
+	SPM2SINGLESUBJT1FrameOfReference = "1.2.840.10008.1.4.1.18"
+	// ICBM452T1FrameOfReference is the Well-known frame of reference "ICBM 452 T1 Frame of Reference"
+	ICBM452T1FrameOfReference = "1.2.840.10008.1.4.2.1"
+	// ICBMSingleSubjectMRIFrameOfReference is the Well-known frame of reference "ICBM Single Subject MRI Frame of Reference"
+	ICBMSingleSubjectMRIFrameOfReference = "1.2.840.10008.1.4.2.2"
+	// HotIronColorPaletteSOPInstance is the Well-known SOP Instance "Hot Iron Color Palette SOP Instance"
+	HotIronColorPaletteSOPInstance = "1.2.840.10008.1.5.1"
+	// PETColorPaletteSOPInstance is the Well-known SOP Instance "PET Color Palette SOP Instance"
+	PETColorPaletteSOPInstance = "1.2.840.10008.1.5.2"
+	// HotMetalBlueColorPaletteSOPInstance is the Well-known SOP Instance "Hot Metal Blue Color Palette SOP Instance"
+	HotMetalBlueColorPaletteSOPInstance = "1.2.840.10008.1.5.3"
+	// PET20StepColorPaletteSOPInstance is the Well-known SOP Instance "PET 20 Step Color Palette SOP Instance"
+	PET20StepColorPaletteSOPInstance = "1.2.840.10008.1.5.4"
+	// SpringColorPaletteSOPInstance is the Well-known SOP Instance "Spring Color Palette SOP Instance"
+	SpringColorPaletteSOPInstance = "1.2.840.10008.1.5.5"
+	// SummerColorPaletteSOPInstance is the Well-known SOP Instance "Summer Color Palette SOP Instance"
+	SummerColorPaletteSOPInstance = "1.2.840.10008.1.5.6"
+	// FallColorPaletteSOPInstance is the Well-known SOP Instance "Fall Color Palette SOP Instance"
+	FallColorPaletteSOPInstance = "1.2.840.10008.1.5.7"
+	// WinterColorPaletteSOPInstance is the Well-known SOP Instance "Winter Color Palette SOP Instance"
+	WinterColorPaletteSOPInstance = "1.2.840.10008.1.5.8"
+	// BasicStudyContentNotificationSOPClass is the SOP Class "Basic Study Content Notification SOP Class (Retired)"
+	BasicStudyContentNotificationSOPClass = "1.2.840.10008.1.9"
+	// Papyrus3ImplicitVRLittleEndian is the Transfer Syntax "Papyrus 3 Implicit VR Little Endian (Retired)"
+	Papyrus3ImplicitVRLittleEndian = "1.2.840.10008.1.20"
+	// StorageCommitmentPushModelSOPClass is the SOP Class "Storage Commitment Push Model SOP Class"
+	StorageCommitmentPushModelSOPClass = "1.2.840.10008.1.20.1"
+	// StorageCommitmentPushModelSOPInstance is the Well-known SOP Instance "Storage Commitment Push Model SOP Instance"
+	StorageCommitmentPushModelSOPInstance = "1.2.840.10008.1.20.1.1"
+	// StorageCommitmentPullModelSOPClass is the SOP Class "Storage Commitment Pull Model SOP Class (Retired)"
+	StorageCommitmentPullModelSOPClass = "1.2.840.10008.1.20.2"
+	// StorageCommitmentPullModelSOPInstance is the Well-known SOP Instance "Storage Commitment Pull Model SOP Instance (Retired)"
+	StorageCommitmentPullModelSOPInstance = "1.2.840.10008.1.20.2.1"
+	// ProceduralEventLoggingSOPClass is the SOP Class "Procedural Event Logging SOP Class"
+	ProceduralEventLoggingSOPClass = "1.2.840.10008.1.40"
+	// ProceduralEventLoggingSOPInstance is the Well-known SOP Instance "Procedural Event Logging SOP Instance"
+	ProceduralEventLoggingSOPInstance = "1.2.840.10008.1.40.1"
+	// SubstanceAdministrationLoggingSOPClass is the SOP Class "Substance Administration Logging SOP Class"
+	SubstanceAdministrationLoggingSOPClass = "1.2.840.10008.1.42"
+	// SubstanceAdministrationLoggingSOPInstance is the Well-known SOP Instance "Substance Administration Logging SOP Instance"
+	SubstanceAdministrationLoggingSOPInstance = "1.2.840.10008.1.42.1"
+	// DICOMUIDRegistry is the DICOM UIDs as a Coding Scheme "DICOM UID Registry"
+	DICOMUIDRegistry = "1.2.840.10008.2.6.1"
+	// DICOMControlledTerminology is the Coding Scheme "DICOM Controlled Terminology"
+	DICOMControlledTerminology = "1.2.840.10008.2.16.4"
+	// AdultMouseAnatomyOntology is the Coding Scheme "Adult Mouse Anatomy Ontology"
+	AdultMouseAnatomyOntology = "1.2.840.10008.2.16.5"
+	// UberonOntology is the Coding Scheme "Uberon Ontology"
+	UberonOntology = "1.2.840.10008.2.16.6"
+	// IntegratedTaxonomicInformationSystemITISTaxonomicSerialNumberTSN is the Coding Scheme "Integrated Taxonomic Information System (ITIS) Taxonomic Serial Number (TSN)"
+	IntegratedTaxonomicInformationSystemITISTaxonomicSerialNumberTSN = "1.2.840.10008.2.16.7"
+	// MouseGenomeInitiativeMGI is the Coding Scheme "Mouse Genome Initiative (MGI)"
+	MouseGenomeInitiativeMGI = "1.2.840.10008.2.16.8"
+	// PubChemCompoundCID is the Coding Scheme "PubChem Compound CID"
+	PubChemCompoundCID = "1.2.840.10008.2.16.9"
+	// DICOMApplicationContextName is the Application Context Name "DICOM Application Context Name"
+	DICOMApplicationContextName = "1.2.840.10008.3.1.1.1"
+	// DetachedPatientManagementSOPClass is the SOP Class "Detached Patient Management SOP Class (Retired)"
+	DetachedPatientManagementSOPClass = "1.2.840.10008.3.1.2.1.1"
+	// DetachedPatientManagementMetaSOPClass is the Meta SOP Class "Detached Patient Management Meta SOP Class (Retired)"
+	DetachedPatientManagementMetaSOPClass = "1.2.840.10008.3.1.2.1.4"
+	// DetachedVisitManagementSOPClass is the SOP Class "Detached Visit Management SOP Class (Retired)"
+	DetachedVisitManagementSOPClass = "1.2.840.10008.3.1.2.2.1"
+	// DetachedStudyManagementSOPClass is the SOP Class "Detached Study Management SOP Class (Retired)"
+	DetachedStudyManagementSOPClass = "1.2.840.10008.3.1.2.3.1"
+	// StudyComponentManagementSOPClass is the SOP Class "Study Component Management SOP Class (Retired)"
+	StudyComponentManagementSOPClass = "1.2.840.10008.3.1.2.3.2"
+	// ModalityPerformedProcedureStepSOPClass is the SOP Class "Modality Performed Procedure Step SOP Class"
+	ModalityPerformedProcedureStepSOPClass = "1.2.840.10008.3.1.2.3.3"
+	// ModalityPerformedProcedureStepRetrieveSOPClass is the SOP Class "Modality Performed Procedure Step Retrieve SOP Class"
+	ModalityPerformedProcedureStepRetrieveSOPClass = "1.2.840.10008.3.1.2.3.4"
+	// ModalityPerformedProcedureStepNotificationSOPClass is the SOP Class "Modality Performed Procedure Step Notification SOP Class"
+	ModalityPerformedProcedureStepNotificationSOPClass = "1.2.840.10008.3.1.2.3.5"
+	// DetachedResultsManagementSOPClass is the SOP Class "Detached Results Management SOP Class (Retired)"
+	DetachedResultsManagementSOPClass = "1.2.840.10008.3.1.2.5.1"
+	// DetachedResultsManagementMetaSOPClass is the Meta SOP Class "Detached Results Management Meta SOP Class (Retired)"
+	DetachedResultsManagementMetaSOPClass = "1.2.840.10008.3.1.2.5.4"
+	// DetachedStudyManagementMetaSOPClass is the Meta SOP Class "Detached Study Management Meta SOP Class (Retired)"
+	DetachedStudyManagementMetaSOPClass = "1.2.840.10008.3.1.2.5.5"
+	// DetachedInterpretationManagementSOPClass is the SOP Class "Detached Interpretation Management SOP Class (Retired)"
+	DetachedInterpretationManagementSOPClass = "1.2.840.10008.3.1.2.6.1"
+	// StorageServiceClass is the Service Class "Storage Service Class"
+	StorageServiceClass = "1.2.840.10008.4.2"
+	// BasicFilmSessionSOPClass is the SOP Class "Basic Film Session SOP Class"
+	BasicFilmSessionSOPClass = "1.2.840.10008.5.1.1.1"
+	// BasicFilmBoxSOPClass is the SOP Class "Basic Film Box SOP Class"
+	BasicFilmBoxSOPClass = "1.2.840.10008.5.1.1.2"
+	// BasicGrayscaleImageBoxSOPClass is the SOP Class "Basic Grayscale Image Box SOP Class"
+	BasicGrayscaleImageBoxSOPClass = "1.2.840.10008.5.1.1.4"
+	// BasicColorImageBoxSOPClass is the SOP Class "Basic Color Image Box SOP Class"
+	BasicColorImageBoxSOPClass = "1.2.840.10008.5.1.1.4.1"
+	// ReferencedImageBoxSOPClass is the SOP Class "Referenced Image Box SOP Class (Retired)"
+	ReferencedImageBoxSOPClass = "1.2.840.10008.5.1.1.4.2"
+	// BasicGrayscalePrintManagementMetaSOPClass is the Meta SOP Class "Basic Grayscale Print Management Meta SOP Class"
+	BasicGrayscalePrintManagementMetaSOPClass = "1.2.840.10008.5.1.1.9"
+	// ReferencedGrayscalePrintManagementMetaSOPClass is the Meta SOP Class "Referenced Grayscale Print Management Meta SOP Class (Retired)"
+	ReferencedGrayscalePrintManagementMetaSOPClass = "1.2.840.10008.5.1.1.9.1"
+	// PrintJobSOPClass is the SOP Class "Print Job SOP Class"
+	PrintJobSOPClass = "1.2.840.10008.5.1.1.14"
+	// BasicAnnotationBoxSOPClass is the SOP Class "Basic Annotation Box SOP Class"
+	BasicAnnotationBoxSOPClass = "1.2.840.10008.5.1.1.15"
+	// PrinterSOPClass is the SOP Class "Printer SOP Class"
+	PrinterSOPClass = "1.2.840.10008.5.1.1.16"
+	// PrinterConfigurationRetrievalSOPClass is the SOP Class "Printer Configuration Retrieval SOP Class"
+	PrinterConfigurationRetrievalSOPClass = "1.2.840.10008.5.1.1.16.376"
+	// PrinterSOPInstance is the Well-known Printer SOP Instance "Printer SOP Instance"
+	PrinterSOPInstance = "1.2.840.10008.5.1.1.17"
+	// PrinterConfigurationRetrievalSOPInstance is the Well-known Printer SOP Instance "Printer Configuration Retrieval SOP Instance"
+	PrinterConfigurationRetrievalSOPInstance = "1.2.840.10008.5.1.1.17.376"
+	// BasicColorPrintManagementMetaSOPClass is the Meta SOP Class "Basic Color Print Management Meta SOP Class"
+	BasicColorPrintManagementMetaSOPClass = "1.2.840.10008.5.1.1.18"
+	// ReferencedColorPrintManagementMetaSOPClass is the Meta SOP Class "Referenced Color Print Management Meta SOP Class (Retired)"
+	ReferencedColorPrintManagementMetaSOPClass = "1.2.840.10008.5.1.1.18.1"
+	// VOILUTBoxSOPClass is the SOP Class "VOI LUT Box SOP Class"
+	VOILUTBoxSOPClass = "1.2.840.10008.5.1.1.22"
+	// PresentationLUTSOPClass is the SOP Class "Presentation LUT SOP Class"
+	PresentationLUTSOPClass = "1.2.840.10008.5.1.1.23"
+	// ImageOverlayBoxSOPClass is the SOP Class "Image Overlay Box SOP Class (Retired)"
+	ImageOverlayBoxSOPClass = "1.2.840.10008.5.1.1.24"
+	// BasicPrintImageOverlayBoxSOPClass is the SOP Class "Basic Print Image Overlay Box SOP Class (Retired)"
+	BasicPrintImageOverlayBoxSOPClass = "1.2.840.10008.5.1.1.24.1"
+	// PrintQueueSOPInstance is the Well-known Print Queue SOP Instance "Print Queue SOP Instance (Retired)"
+	PrintQueueSOPInstance = "1.2.840.10008.5.1.1.25"
+	// PrintQueueManagementSOPClass is the SOP Class "Print Queue Management SOP Class (Retired)"
+	PrintQueueManagementSOPClass = "1.2.840.10008.5.1.1.26"
+	// StoredPrintStorageSOPClass is the SOP Class "Stored Print Storage SOP Class (Retired)"
+	StoredPrintStorageSOPClass = "1.2.840.10008.5.1.1.27"
+	// HardcopyGrayscaleImageStorageSOPClass is the SOP Class "Hardcopy Grayscale Image Storage SOP Class (Retired)"
+	HardcopyGrayscaleImageStorageSOPClass = "1.2.840.10008.5.1.1.29"
+	// HardcopyColorImageStorageSOPClass is the SOP Class "Hardcopy Color Image Storage SOP Class (Retired)"
+	HardcopyColorImageStorageSOPClass = "1.2.840.10008.5.1.1.30"
+	// PullPrintRequestSOPClass is the SOP Class "Pull Print Request SOP Class (Retired)"
+	PullPrintRequestSOPClass = "1.2.840.10008.5.1.1.31"
+	// PullStoredPrintManagementMetaSOPClass is the Meta SOP Class "Pull Stored Print Management Meta SOP Class (Retired)"
+	PullStoredPrintManagementMetaSOPClass = "1.2.840.10008.5.1.1.32"
+	// MediaCreationManagementSOPClassUID is the SOP Class "Media Creation Management SOP Class UID"
+	MediaCreationManagementSOPClassUID = "1.2.840.10008.5.1.1.33"
+	// DisplaySystemSOPClass is the SOP Class "Display System SOP Class"
+	DisplaySystemSOPClass = "1.2.840.10008.5.1.1.40"
+	// DisplaySystemSOPInstance is the Well-known SOP Instance "Display System SOP Instance"
+	DisplaySystemSOPInstance = "1.2.840.10008.5.1.1.40.1"
+	// ComputedRadiographyImageStorage is the SOP Class "Computed Radiography Image Storage"
+	ComputedRadiographyImageStorage = "1.2.840.10008.5.1.4.1.1.1"
+	// DigitalXRayImageStorageForPresentation is the SOP Class "Digital X-Ray Image Storage - For Presentation"
+	DigitalXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.1"
+	// DigitalXRayImageStorageForProcessing is the SOP Class "Digital X-Ray Image Storage - For Processing"
+	DigitalXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.1.1"
+	// DigitalMammographyXRayImageStorageForPresentation is the SOP Class "Digital Mammography X-Ray Image Storage - For Presentation"
+	DigitalMammographyXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.2"
+	// DigitalMammographyXRayImageStorageForProcessing is the SOP Class "Digital Mammography X-Ray Image Storage - For Processing"
+	DigitalMammographyXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.2.1"
+	// DigitalIntraOralXRayImageStorageForPresentation is the SOP Class "Digital Intra-Oral X-Ray Image Storage - For Presentation"
+	DigitalIntraOralXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.3"
+	// DigitalIntraOralXRayImageStorageForProcessing is the SOP Class "Digital Intra-Oral X-Ray Image Storage - For Processing"
+	DigitalIntraOralXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.3.1"
+	// CTImageStorage is the SOP Class "CT Image Storage"
+	CTImageStorage = "1.2.840.10008.5.1.4.1.1.2"
+	// EnhancedCTImageStorage is the SOP Class "Enhanced CT Image Storage"
+	EnhancedCTImageStorage = "1.2.840.10008.5.1.4.1.1.2.1"
+	// LegacyConvertedEnhancedCTImageStorage is the SOP Class "Legacy Converted Enhanced CT Image Storage"
+	LegacyConvertedEnhancedCTImageStorage = "1.2.840.10008.5.1.4.1.1.2.2"
+	// UltrasoundMultiFrameImageStorageRetired is the SOP Class "Ultrasound Multi-frame Image Storage (Retired)"
+	UltrasoundMultiFrameImageStorageRetired = "1.2.840.10008.5.1.4.1.1.3"
+	// UltrasoundMultiFrameImageStorage is the SOP Class "Ultrasound Multi-frame Image Storage"
+	UltrasoundMultiFrameImageStorage = "1.2.840.10008.5.1.4.1.1.3.1"
+	// MRImageStorage is the SOP Class "MR Image Storage"
+	MRImageStorage = "1.2.840.10008.5.1.4.1.1.4"
+	// EnhancedMRImageStorage is the SOP Class "Enhanced MR Image Storage"
+	EnhancedMRImageStorage = "1.2.840.10008.5.1.4.1.1.4.1"
+	// MRSpectroscopyStorage is the SOP Class "MR Spectroscopy Storage"
+	MRSpectroscopyStorage = "1.2.840.10008.5.1.4.1.1.4.2"
+	// EnhancedMRColorImageStorage is the SOP Class "Enhanced MR Color Image Storage"
+	EnhancedMRColorImageStorage = "1.2.840.10008.5.1.4.1.1.4.3"
+	// LegacyConvertedEnhancedMRImageStorage is the SOP Class "Legacy Converted Enhanced MR Image Storage"
+	LegacyConvertedEnhancedMRImageStorage = "1.2.840.10008.5.1.4.1.1.4.4"
+	// NuclearMedicineImageStorageRetired is the SOP Class "Nuclear Medicine Image Storage (Retired)"
+	NuclearMedicineImageStorageRetired = "1.2.840.10008.5.1.4.1.1.5"
+	// UltrasoundImageStorageRetired is the SOP Class "Ultrasound Image Storage (Retired)"
+	UltrasoundImageStorageRetired = "1.2.840.10008.5.1.4.1.1.6"
+	// UltrasoundImageStorage is the SOP Class "Ultrasound Image Storage"
+	UltrasoundImageStorage = "1.2.840.10008.5.1.4.1.1.6.1"
+	// EnhancedUSVolumeStorage is the SOP Class "Enhanced US Volume Storage"
+	EnhancedUSVolumeStorage = "1.2.840.10008.5.1.4.1.1.6.2"
+	// SecondaryCaptureImageStorage is the SOP Class "Secondary Capture Image Storage"
+	SecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7"
+	// MultiFrameSingleBitSecondaryCaptureImageStorage is the SOP Class "Multi-frame Single Bit Secondary Capture Image Storage"
+	MultiFrameSingleBitSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.1"
+	// MultiFrameGrayscaleByteSecondaryCaptureImageStorage is the SOP Class "Multi-frame Grayscale Byte Secondary Capture Image Storage"
+	MultiFrameGrayscaleByteSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.2"
+	// MultiFrameGrayscaleWordSecondaryCaptureImageStorage is the SOP Class "Multi-frame Grayscale Word Secondary Capture Image Storage"
+	MultiFrameGrayscaleWordSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.3"
+	// MultiFrameTrueColorSecondaryCaptureImageStorage is the SOP Class "Multi-frame True Color Secondary Capture Image Storage"
+	MultiFrameTrueColorSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.4"
+	// StandaloneOverlayStorage is the SOP Class "Standalone Overlay Storage (Retired)"
+	StandaloneOverlayStorage = "1.2.840.10008.5.1.4.1.1.8"
+	// StandaloneCurveStorage is the SOP Class "Standalone Curve Storage (Retired)"
+	StandaloneCurveStorage = "1.2.840.10008.5.1.4.1.1.9"
+	// WaveformStorageTrial is the SOP Class "Waveform Storage - Trial (Retired)"
+	WaveformStorageTrial = "1.2.840.10008.5.1.4.1.1.9.1"
+	// UID12LeadECGWaveformStorage is the SOP Class "12-lead ECG Waveform Storage"
+	UID12LeadECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.1"
+	// GeneralECGWaveformStorage is the SOP Class "General ECG Waveform Storage"
+	GeneralECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.2"
+	// AmbulatoryECGWaveformStorage is the SOP Class "Ambulatory ECG Waveform Storage"
+	AmbulatoryECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.3"
+	// HemodynamicWaveformStorage is the SOP Class "Hemodynamic Waveform Storage"
+	HemodynamicWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.2.1"
+	// CardiacElectrophysiologyWaveformStorage is the SOP Class "Cardiac Electrophysiology Waveform Storage"
+	CardiacElectrophysiologyWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.3.1"
+	// BasicVoiceAudioWaveformStorage is the SOP Class "Basic Voice Audio Waveform Storage"
+	BasicVoiceAudioWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.4.1"
+	// GeneralAudioWaveformStorage is the SOP Class "General Audio Waveform Storage"
+	GeneralAudioWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.4.2"
+	// ArterialPulseWaveformStorage is the SOP Class "Arterial Pulse Waveform Storage"
+	ArterialPulseWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.5.1"
+	// RespiratoryWaveformStorage is the SOP Class "Respiratory Waveform Storage"
+	RespiratoryWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.6.1"
+	// StandaloneModalityLUTStorage is the SOP Class "Standalone Modality LUT Storage (Retired)"
+	StandaloneModalityLUTStorage = "1.2.840.10008.5.1.4.1.1.10"
+	// StandaloneVOILUTStorage is the SOP Class "Standalone VOI LUT Storage (Retired)"
+	StandaloneVOILUTStorage = "1.2.840.10008.5.1.4.1.1.11"
+	// GrayscaleSoftcopyPresentationStateStorage is the SOP Class "Grayscale Softcopy Presentation State Storage"
+	GrayscaleSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.1"
+	// ColorSoftcopyPresentationStateStorage is the SOP Class "Color Softcopy Presentation State Storage"
+	ColorSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.2"
+	// PseudoColorSoftcopyPresentationStateStorage is the SOP Class "Pseudo-Color Softcopy Presentation State Storage"
+	PseudoColorSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.3"
+	// BlendingSoftcopyPresentationStateStorage is the SOP Class "Blending Softcopy Presentation State Storage"
+	BlendingSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.4"
+	// XAXRFGrayscaleSoftcopyPresentationStateStorage is the SOP Class "XA/XRF Grayscale Softcopy Presentation State Storage"
+	XAXRFGrayscaleSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.5"
+	// GrayscalePlanarMPRVolumetricPresentationStateStorage is the SOP Class "Grayscale Planar MPR Volumetric Presentation State Storage"
+	GrayscalePlanarMPRVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.6"
+	// CompositingPlanarMPRVolumetricPresentationStateStorage is the SOP Class "Compositing Planar MPR Volumetric Presentation State Storage"
+	CompositingPlanarMPRVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.7"
+	// AdvancedBlendingPresentationStateStorage is the SOP Class "Advanced Blending Presentation State Storage"
+	AdvancedBlendingPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.8"
+	// VolumeRenderingVolumetricPresentationStateStorage is the SOP Class "Volume Rendering Volumetric Presentation State Storage"
+	VolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.9"
+	// SegmentedVolumeRenderingVolumetricPresentationStateStorage is the SOP Class "Segmented Volume Rendering Volumetric Presentation State Storage"
+	SegmentedVolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.10"
+	// MultipleVolumeRenderingVolumetricPresentationStateStorage is the SOP Class "Multiple Volume Rendering Volumetric Presentation State Storage"
+	MultipleVolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.11"
+	// XRayAngiographicImageStorage is the SOP Class "X-Ray Angiographic Image Storage"
+	XRayAngiographicImageStorage = "1.2.840.10008.5.1.4.1.1.12.1"
+	// EnhancedXAImageStorage is the SOP Class "Enhanced XA Image Storage"
+	EnhancedXAImageStorage = "1.2.840.10008.5.1.4.1.1.12.1.1"
+	// XRayRadiofluoroscopicImageStorage is the SOP Class "X-Ray Radiofluoroscopic Image Storage"
+	XRayRadiofluoroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.12.2"
+	// EnhancedXRFImageStorage is the SOP Class "Enhanced XRF Image Storage"
+	EnhancedXRFImageStorage = "1.2.840.10008.5.1.4.1.1.12.2.1"
+	// XRayAngiographicBiPlaneImageStorage is the SOP Class "X-Ray Angiographic Bi-Plane Image Storage (Retired)"
+	XRayAngiographicBiPlaneImageStorage = "1.2.840.10008.5.1.4.1.1.12.3"
+	// XRay3DAngiographicImageStorage is the SOP Class "X-Ray 3D Angiographic Image Storage"
+	XRay3DAngiographicImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.1"
+	// XRay3DCraniofacialImageStorage is the SOP Class "X-Ray 3D Craniofacial Image Storage"
+	XRay3DCraniofacialImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.2"
+	// BreastTomosynthesisImageStorage is the SOP Class "Breast Tomosynthesis Image Storage"
+	BreastTomosynthesisImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.3"
+	// BreastProjectionXRayImageStorageForPresentation is the SOP Class "Breast Projection X-Ray Image Storage - For Presentation"
+	BreastProjectionXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.13.1.4"
+	// BreastProjectionXRayImageStorageForProcessing is the SOP Class "Breast Projection X-Ray Image Storage - For Processing"
+	BreastProjectionXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.13.1.5"
+	// IntravascularOpticalCoherenceTomographyImageStorageForPresentation is the SOP Class "Intravascular Optical Coherence Tomography Image Storage - For Presentation"
+	IntravascularOpticalCoherenceTomographyImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.14.1"
+	// IntravascularOpticalCoherenceTomographyImageStorageForProcessing is the SOP Class "Intravascular Optical Coherence Tomography Image Storage - For Processing"
+	IntravascularOpticalCoherenceTomographyImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.14.2"
+	// NuclearMedicineImageStorage is the SOP Class "Nuclear Medicine Image Storage"
+	NuclearMedicineImageStorage = "1.2.840.10008.5.1.4.1.1.20"
+	// ParametricMapStorage is the SOP Class "Parametric Map Storage"
+	ParametricMapStorage = "1.2.840.10008.5.1.4.1.1.30"
+	// RawDataStorage is the SOP Class "Raw Data Storage"
+	RawDataStorage = "1.2.840.10008.5.1.4.1.1.66"
+	// SpatialRegistrationStorage is the SOP Class "Spatial Registration Storage"
+	SpatialRegistrationStorage = "1.2.840.10008.5.1.4.1.1.66.1"
+	// SpatialFiducialsStorage is the SOP Class "Spatial Fiducials Storage"
+	SpatialFiducialsStorage = "1.2.840.10008.5.1.4.1.1.66.2"
+	// DeformableSpatialRegistrationStorage is the SOP Class "Deformable Spatial Registration Storage"
+	DeformableSpatialRegistrationStorage = "1.2.840.10008.5.1.4.1.1.66.3"
+	// SegmentationStorage is the SOP Class "Segmentation Storage"
+	SegmentationStorage = "1.2.840.10008.5.1.4.1.1.66.4"
+	// SurfaceSegmentationStorage is the SOP Class "Surface Segmentation Storage"
+	SurfaceSegmentationStorage = "1.2.840.10008.5.1.4.1.1.66.5"
+	// TractographyResultsStorage is the SOP Class "Tractography Results Storage"
+	TractographyResultsStorage = "1.2.840.10008.5.1.4.1.1.66.6"
+	// RealWorldValueMappingStorage is the SOP Class "Real World Value Mapping Storage"
+	RealWorldValueMappingStorage = "1.2.840.10008.5.1.4.1.1.67"
+	// SurfaceScanMeshStorage is the SOP Class "Surface Scan Mesh Storage"
+	SurfaceScanMeshStorage = "1.2.840.10008.5.1.4.1.1.68.1"
+	// SurfaceScanPointCloudStorage is the SOP Class "Surface Scan Point Cloud Storage"
+	SurfaceScanPointCloudStorage = "1.2.840.10008.5.1.4.1.1.68.2"
+	// VLImageStorageTrial is the SOP Class "VL Image Storage - Trial (Retired)"
+	VLImageStorageTrial = "1.2.840.10008.5.1.4.1.1.77.1"
+	// VLEndoscopicImageStorage is the SOP Class "VL Endoscopic Image Storage"
+	VLEndoscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.1"
+	// VideoEndoscopicImageStorage is the SOP Class "Video Endoscopic Image Storage"
+	VideoEndoscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.1.1"
+	// VLMicroscopicImageStorage is the SOP Class "VL Microscopic Image Storage"
+	VLMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.2"
+	// VideoMicroscopicImageStorage is the SOP Class "Video Microscopic Image Storage"
+	VideoMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.2.1"
+	// VLSlideCoordinatesMicroscopicImageStorage is the SOP Class "VL Slide-Coordinates Microscopic Image Storage"
+	VLSlideCoordinatesMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.3"
+	// VLPhotographicImageStorage is the SOP Class "VL Photographic Image Storage"
+	VLPhotographicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.4"
+	// VideoPhotographicImageStorage is the SOP Class "Video Photographic Image Storage"
+	VideoPhotographicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.4.1"
+	// OphthalmicPhotography8BitImageStorage is the SOP Class "Ophthalmic Photography 8 Bit Image Storage"
+	OphthalmicPhotography8BitImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.1"
+	// OphthalmicPhotography16BitImageStorage is the SOP Class "Ophthalmic Photography 16 Bit Image Storage"
+	OphthalmicPhotography16BitImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.2"
+	// StereometricRelationshipStorage is the SOP Class "Stereometric Relationship Storage"
+	StereometricRelationshipStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.3"
+	// OphthalmicTomographyImageStorage is the SOP Class "Ophthalmic Tomography Image Storage"
+	OphthalmicTomographyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.4"
+	// WideFieldOphthalmicPhotographyStereographicProjectionImageStorage is the SOP Class "Wide Field Ophthalmic Photography Stereographic Projection Image Storage"
+	WideFieldOphthalmicPhotographyStereographicProjectionImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.5"
+	// WideFieldOphthalmicPhotography3DCoordinatesImageStorage is the SOP Class "Wide Field Ophthalmic Photography 3D Coordinates Image Storage"
+	WideFieldOphthalmicPhotography3DCoordinatesImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.6"
+	// OphthalmicOpticalCoherenceTomographyEnFaceImageStorage is the SOP Class "Ophthalmic Optical Coherence Tomography En Face Image Storage"
+	OphthalmicOpticalCoherenceTomographyEnFaceImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.7"
+	// OphthalmicOpticalCoherenceTomographyBScanVolumeAnalysisStorage is the SOP Class "Ophthalmic Optical Coherence Tomography B-scan Volume Analysis Storage"
+	OphthalmicOpticalCoherenceTomographyBScanVolumeAnalysisStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.8"
+	// VLWholeSlideMicroscopyImageStorage is the SOP Class "VL Whole Slide Microscopy Image Storage"
+	VLWholeSlideMicroscopyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.6"
+	// VLMultiFrameImageStorageTrial is the SOP Class "VL Multi-frame Image Storage - Trial (Retired)"
+	VLMultiFrameImageStorageTrial = "1.2.840.10008.5.1.4.1.1.77.2"
+	// LensometryMeasurementsStorage is the SOP Class "Lensometry Measurements Storage"
+	LensometryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.1"
+	// AutorefractionMeasurementsStorage is the SOP Class "Autorefraction Measurements Storage"
+	AutorefractionMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.2"
+	// KeratometryMeasurementsStorage is the SOP Class "Keratometry Measurements Storage"
+	KeratometryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.3"
+	// SubjectiveRefractionMeasurementsStorage is the SOP Class "Subjective Refraction Measurements Storage"
+	SubjectiveRefractionMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.4"
+	// VisualAcuityMeasurementsStorage is the SOP Class "Visual Acuity Measurements Storage"
+	VisualAcuityMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.5"
+	// SpectaclePrescriptionReportStorage is the SOP Class "Spectacle Prescription Report Storage"
+	SpectaclePrescriptionReportStorage = "1.2.840.10008.5.1.4.1.1.78.6"
+	// OphthalmicAxialMeasurementsStorage is the SOP Class "Ophthalmic Axial Measurements Storage"
+	OphthalmicAxialMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.7"
+	// IntraocularLensCalculationsStorage is the SOP Class "Intraocular Lens Calculations Storage"
+	IntraocularLensCalculationsStorage = "1.2.840.10008.5.1.4.1.1.78.8"
+	// MacularGridThicknessAndVolumeReportStorage is the SOP Class "Macular Grid Thickness and Volume Report Storage"
+	MacularGridThicknessAndVolumeReportStorage = "1.2.840.10008.5.1.4.1.1.79.1"
+	// OphthalmicVisualFieldStaticPerimetryMeasurementsStorage is the SOP Class "Ophthalmic Visual Field Static Perimetry Measurements Storage"
+	OphthalmicVisualFieldStaticPerimetryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.80.1"
+	// OphthalmicThicknessMapStorage is the SOP Class "Ophthalmic Thickness Map Storage"
+	OphthalmicThicknessMapStorage = "1.2.840.10008.5.1.4.1.1.81.1"
+	// CornealTopographyMapStorage is the SOP Class "Corneal Topography Map Storage"
+	CornealTopographyMapStorage = "1.2.840.10008.5.1.4.1.1.82.1"
+	// TextSRStorageTrial is the SOP Class "Text SR Storage - Trial (Retired)"
+	TextSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.1"
+	// AudioSRStorageTrial is the SOP Class "Audio SR Storage - Trial (Retired)"
+	AudioSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.2"
+	// DetailSRStorageTrial is the SOP Class "Detail SR Storage - Trial (Retired)"
+	DetailSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.3"
+	// ComprehensiveSRStorageTrial is the SOP Class "Comprehensive SR Storage - Trial (Retired)"
+	ComprehensiveSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.4"
+	// BasicTextSRStorage is the SOP Class "Basic Text SR Storage"
+	BasicTextSRStorage = "1.2.840.10008.5.1.4.1.1.88.11"
+	// EnhancedSRStorage is the SOP Class "Enhanced SR Storage"
+	EnhancedSRStorage = "1.2.840.10008.5.1.4.1.1.88.22"
+	// ComprehensiveSRStorage is the SOP Class "Comprehensive SR Storage"
+	ComprehensiveSRStorage = "1.2.840.10008.5.1.4.1.1.88.33"
+	// Comprehensive3DSRStorage is the SOP Class "Comprehensive 3D SR Storage"
+	Comprehensive3DSRStorage = "1.2.840.10008.5.1.4.1.1.88.34"
+	// ExtensibleSRStorage is the SOP Class "Extensible SR Storage"
+	ExtensibleSRStorage = "1.2.840.10008.5.1.4.1.1.88.35"
+	// ProcedureLogStorage is the SOP Class "Procedure Log Storage"
+	ProcedureLogStorage = "1.2.840.10008.5.1.4.1.1.88.40"
+	// MammographyCADSRStorage is the SOP Class "Mammography CAD SR Storage"
+	MammographyCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.50"
+	// KeyObjectSelectionDocumentStorage is the SOP Class "Key Object Selection Document Storage"
+	KeyObjectSelectionDocumentStorage = "1.2.840.10008.5.1.4.1.1.88.59"
+	// ChestCADSRStorage is the SOP Class "Chest CAD SR Storage"
+	ChestCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.65"
+	// XRayRadiationDoseSRStorage is the SOP Class "X-Ray Radiation Dose SR Storage"
+	XRayRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.67"
+	// RadiopharmaceuticalRadiationDoseSRStorage is the SOP Class "Radiopharmaceutical Radiation Dose SR Storage"
+	RadiopharmaceuticalRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.68"
+	// ColonCADSRStorage is the SOP Class "Colon CAD SR Storage"
+	ColonCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.69"
+	// ImplantationPlanSRStorage is the SOP Class "Implantation Plan SR Storage"
+	ImplantationPlanSRStorage = "1.2.840.10008.5.1.4.1.1.88.70"
+	// AcquisitionContextSRStorage is the SOP Class "Acquisition Context SR Storage"
+	AcquisitionContextSRStorage = "1.2.840.10008.5.1.4.1.1.88.71"
+	// SimplifiedAdultEchoSRStorage is the SOP Class "Simplified Adult Echo SR Storage"
+	SimplifiedAdultEchoSRStorage = "1.2.840.10008.5.1.4.1.1.88.72"
+	// PatientRadiationDoseSRStorage is the SOP Class "Patient Radiation Dose SR Storage"
+	PatientRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.73"
+	// ContentAssessmentResultsStorage is the SOP Class "Content Assessment Results Storage"
+	ContentAssessmentResultsStorage = "1.2.840.10008.5.1.4.1.1.90.1"
+	// EncapsulatedPDFStorage is the SOP Class "Encapsulated PDF Storage"
+	EncapsulatedPDFStorage = "1.2.840.10008.5.1.4.1.1.104.1"
+	// EncapsulatedCDAStorage is the SOP Class "Encapsulated CDA Storage"
+	EncapsulatedCDAStorage = "1.2.840.10008.5.1.4.1.1.104.2"
+	// PositronEmissionTomographyImageStorage is the SOP Class "Positron Emission Tomography Image Storage"
+	PositronEmissionTomographyImageStorage = "1.2.840.10008.5.1.4.1.1.128"
+	// LegacyConvertedEnhancedPETImageStorage is the SOP Class "Legacy Converted Enhanced PET Image Storage"
+	LegacyConvertedEnhancedPETImageStorage = "1.2.840.10008.5.1.4.1.1.128.1"
+	// StandalonePETCurveStorage is the SOP Class "Standalone PET Curve Storage (Retired)"
+	StandalonePETCurveStorage = "1.2.840.10008.5.1.4.1.1.129"
+	// EnhancedPETImageStorage is the SOP Class "Enhanced PET Image Storage"
+	EnhancedPETImageStorage = "1.2.840.10008.5.1.4.1.1.130"
+	// BasicStructuredDisplayStorage is the SOP Class "Basic Structured Display Storage"
+	BasicStructuredDisplayStorage = "1.2.840.10008.5.1.4.1.1.131"
+	// CTDefinedProcedureProtocolStorage is the SOP Class "CT Defined Procedure Protocol Storage"
+	CTDefinedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.1"
+	// CTPerformedProcedureProtocolStorage is the SOP Class "CT Performed Procedure Protocol Storage"
+	CTPerformedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.2"
+	// ProtocolApprovalStorage is the SOP Class "Protocol Approval Storage"
+	ProtocolApprovalStorage = "1.2.840.10008.5.1.4.1.1.200.3"
+	// ProtocolApprovalInformationModelFIND is the SOP Class "Protocol Approval Information Model - FIND"
+	ProtocolApprovalInformationModelFIND = "1.2.840.10008.5.1.4.1.1.200.4"
+	// ProtocolApprovalInformationModelMOVE is the SOP Class "Protocol Approval Information Model - MOVE"
+	ProtocolApprovalInformationModelMOVE = "1.2.840.10008.5.1.4.1.1.200.5"
+	// ProtocolApprovalInformationModelGET is the SOP Class "Protocol Approval Information Model - GET"
+	ProtocolApprovalInformationModelGET = "1.2.840.10008.5.1.4.1.1.200.6"
+	// RTImageStorage is the SOP Class "RT Image Storage"
+	RTImageStorage = "1.2.840.10008.5.1.4.1.1.481.1"
+	// RTDoseStorage is the SOP Class "RT Dose Storage"
+	RTDoseStorage = "1.2.840.10008.5.1.4.1.1.481.2"
+	// RTStructureSetStorage is the SOP Class "RT Structure Set Storage"
+	RTStructureSetStorage = "1.2.840.10008.5.1.4.1.1.481.3"
+	// RTBeamsTreatmentRecordStorage is the SOP Class "RT Beams Treatment Record Storage"
+	RTBeamsTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.4"
+	// RTPlanStorage is the SOP Class "RT Plan Storage"
+	RTPlanStorage = "1.2.840.10008.5.1.4.1.1.481.5"
+	// RTBrachyTreatmentRecordStorage is the SOP Class "RT Brachy Treatment Record Storage"
+	RTBrachyTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.6"
+	// RTTreatmentSummaryRecordStorage is the SOP Class "RT Treatment Summary Record Storage"
+	RTTreatmentSummaryRecordStorage = "1.2.840.10008.5.1.4.1.1.481.7"
+	// RTIonPlanStorage is the SOP Class "RT Ion Plan Storage"
+	RTIonPlanStorage = "1.2.840.10008.5.1.4.1.1.481.8"
+	// RTIonBeamsTreatmentRecordStorage is the SOP Class "RT Ion Beams Treatment Record Storage"
+	RTIonBeamsTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.9"
+	// DICOSCTImageStorage is the SOP Class "DICOS CT Image Storage"
+	DICOSCTImageStorage = "1.2.840.10008.5.1.4.1.1.501.1"
+	// DICOSDigitalXRayImageStorageForPresentation is the SOP Class "DICOS Digital X-Ray Image Storage - For Presentation"
+	DICOSDigitalXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.501.2.1"
+	// DICOSDigitalXRayImageStorageForProcessing is the SOP Class "DICOS Digital X-Ray Image Storage - For Processing"
+	DICOSDigitalXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.501.2.2"
+	// DICOSThreatDetectionReportStorage is the SOP Class "DICOS Threat Detection Report Storage"
+	DICOSThreatDetectionReportStorage = "1.2.840.10008.5.1.4.1.1.501.3"
+	// DICOS2DAITStorage is the SOP Class "DICOS 2D AIT Storage"
+	DICOS2DAITStorage = "1.2.840.10008.5.1.4.1.1.501.4"
+	// DICOS3DAITStorage is the SOP Class "DICOS 3D AIT Storage"
+	DICOS3DAITStorage = "1.2.840.10008.5.1.4.1.1.501.5"
+	// DICOSQuadrupoleResonanceQRStorage is the SOP Class "DICOS Quadrupole Resonance (QR) Storage"
+	DICOSQuadrupoleResonanceQRStorage = "1.2.840.10008.5.1.4.1.1.501.6"
+	// EddyCurrentImageStorage is the SOP Class "Eddy Current Image Storage"
+	EddyCurrentImageStorage = "1.2.840.10008.5.1.4.1.1.601.1"
+	// EddyCurrentMultiFrameImageStorage is the SOP Class "Eddy Current Multi-frame Image Storage"
+	EddyCurrentMultiFrameImageStorage = "1.2.840.10008.5.1.4.1.1.601.2"
+	// PatientRootQueryRetrieveInformationModelFIND is the SOP Class "Patient Root Query/Retrieve Information Model - FIND"
+	PatientRootQueryRetrieveInformationModelFIND = "1.2.840.10008.5.1.4.1.2.1.1"
+	// PatientRootQueryRetrieveInformationModelMOVE is the SOP Class "Patient Root Query/Retrieve Information Model - MOVE"
+	PatientRootQueryRetrieveInformationModelMOVE = "1.2.840.10008.5.1.4.1.2.1.2"
+	// PatientRootQueryRetrieveInformationModelGET is the SOP Class "Patient Root Query/Retrieve Information Model - GET"
+	PatientRootQueryRetrieveInformationModelGET = "1.2.840.10008.5.1.4.1.2.1.3"
+	// StudyRootQueryRetrieveInformationModelFIND is the SOP Class "Study Root Query/Retrieve Information Model - FIND"
+	StudyRootQueryRetrieveInformationModelFIND = "1.2.840.10008.5.1.4.1.2.2.1"
+	// StudyRootQueryRetrieveInformationModelMOVE is the SOP Class "Study Root Query/Retrieve Information Model - MOVE"
+	StudyRootQueryRetrieveInformationModelMOVE = "1.2.840.10008.5.1.4.1.2.2.2"
+	// StudyRootQueryRetrieveInformationModelGET is the SOP Class "Study Root Query/Retrieve Information Model - GET"
+	StudyRootQueryRetrieveInformationModelGET = "1.2.840.10008.5.1.4.1.2.2.3"
+	// PatientStudyOnlyQueryRetrieveInformationModelFIND is the SOP Class "Patient/Study Only Query/Retrieve Information Model - FIND (Retired)"
+	PatientStudyOnlyQueryRetrieveInformationModelFIND = "1.2.840.10008.5.1.4.1.2.3.1"
+	// PatientStudyOnlyQueryRetrieveInformationModelMOVE is the SOP Class "Patient/Study Only Query/Retrieve Information Model - MOVE (Retired)"
+	PatientStudyOnlyQueryRetrieveInformationModelMOVE = "1.2.840.10008.5.1.4.1.2.3.2"
+	// PatientStudyOnlyQueryRetrieveInformationModelGET is the SOP Class "Patient/Study Only Query/Retrieve Information Model - GET (Retired)"
+	PatientStudyOnlyQueryRetrieveInformationModelGET = "1.2.840.10008.5.1.4.1.2.3.3"
+	// CompositeInstanceRootRetrieveMOVE is the SOP Class "Composite Instance Root Retrieve - MOVE"
+	CompositeInstanceRootRetrieveMOVE = "1.2.840.10008.5.1.4.1.2.4.2"
+	// CompositeInstanceRootRetrieveGET is the SOP Class "Composite Instance Root Retrieve - GET"
+	CompositeInstanceRootRetrieveGET = "1.2.840.10008.5.1.4.1.2.4.3"
+	// CompositeInstanceRetrieveWithoutBulkDataGET is the SOP Class "Composite Instance Retrieve Without Bulk Data - GET"
+	CompositeInstanceRetrieveWithoutBulkDataGET = "1.2.840.10008.5.1.4.1.2.5.3"
+	// DefinedProcedureProtocolInformationModelFIND is the SOP Class "Defined Procedure Protocol Information Model - FIND"
+	DefinedProcedureProtocolInformationModelFIND = "1.2.840.10008.5.1.4.20.1"
+	// DefinedProcedureProtocolInformationModelMOVE is the SOP Class "Defined Procedure Protocol Information Model - MOVE"
+	DefinedProcedureProtocolInformationModelMOVE = "1.2.840.10008.5.1.4.20.2"
+	// DefinedProcedureProtocolInformationModelGET is the SOP Class "Defined Procedure Protocol Information Model - GET"
+	DefinedProcedureProtocolInformationModelGET = "1.2.840.10008.5.1.4.20.3"
+	// ModalityWorklistInformationModelFIND is the SOP Class "Modality Worklist Information Model - FIND"
+	ModalityWorklistInformationModelFIND = "1.2.840.10008.5.1.4.31"
+	// GeneralPurposeWorklistManagementMetaSOPClass is the Meta SOP Class "General Purpose Worklist Management Meta SOP Class (Retired)"
+	GeneralPurposeWorklistManagementMetaSOPClass = "1.2.840.10008.5.1.4.32"
+	// GeneralPurposeWorklistInformationModelFIND is the SOP Class "General Purpose Worklist Information Model - FIND (Retired)"
+	GeneralPurposeWorklistInformationModelFIND = "1.2.840.10008.5.1.4.32.1"
+	// GeneralPurposeScheduledProcedureStepSOPClass is the SOP Class "General Purpose Scheduled Procedure Step SOP Class (Retired)"
+	GeneralPurposeScheduledProcedureStepSOPClass = "1.2.840.10008.5.1.4.32.2"
+	// GeneralPurposePerformedProcedureStepSOPClass is the SOP Class "General Purpose Performed Procedure Step SOP Class (Retired)"
+	GeneralPurposePerformedProcedureStepSOPClass = "1.2.840.10008.5.1.4.32.3"
+	// InstanceAvailabilityNotificationSOPClass is the SOP Class "Instance Availability Notification SOP Class"
+	InstanceAvailabilityNotificationSOPClass = "1.2.840.10008.5.1.4.33"
+	// RTBeamsDeliveryInstructionStorageTrial is the SOP Class "RT Beams Delivery Instruction Storage - Trial (Retired)"
+	RTBeamsDeliveryInstructionStorageTrial = "1.2.840.10008.5.1.4.34.1"
+	// RTConventionalMachineVerificationTrial is the SOP Class "RT Conventional Machine Verification - Trial (Retired)"
+	RTConventionalMachineVerificationTrial = "1.2.840.10008.5.1.4.34.2"
+	// RTIonMachineVerificationTrial is the SOP Class "RT Ion Machine Verification - Trial (Retired)"
+	RTIonMachineVerificationTrial = "1.2.840.10008.5.1.4.34.3"
+	// UnifiedWorklistAndProcedureStepServiceClassTrial is the Service Class "Unified Worklist and Procedure Step Service Class - Trial (Retired)"
+	UnifiedWorklistAndProcedureStepServiceClassTrial = "1.2.840.10008.5.1.4.34.4"
+	// UnifiedProcedureStepPushSOPClassTrial is the SOP Class "Unified Procedure Step - Push SOP Class - Trial (Retired)"
+	UnifiedProcedureStepPushSOPClassTrial = "1.2.840.10008.5.1.4.34.4.1"
+	// UnifiedProcedureStepWatchSOPClassTrial is the SOP Class "Unified Procedure Step - Watch SOP Class - Trial (Retired)"
+	UnifiedProcedureStepWatchSOPClassTrial = "1.2.840.10008.5.1.4.34.4.2"
+	// UnifiedProcedureStepPullSOPClassTrial is the SOP Class "Unified Procedure Step - Pull SOP Class - Trial (Retired)"
+	UnifiedProcedureStepPullSOPClassTrial = "1.2.840.10008.5.1.4.34.4.3"
+	// UnifiedProcedureStepEventSOPClassTrial is the SOP Class "Unified Procedure Step - Event SOP Class - Trial (Retired)"
+	UnifiedProcedureStepEventSOPClassTrial = "1.2.840.10008.5.1.4.34.4.4"
+	// UPSGlobalSubscriptionSOPInstance is the Well-known SOP Instance "UPS Global Subscription SOP Instance"
+	UPSGlobalSubscriptionSOPInstance = "1.2.840.10008.5.1.4.34.5"
+	// UPSFilteredGlobalSubscriptionSOPInstance is the Well-known SOP Instance "UPS Filtered Global Subscription SOP Instance"
+	UPSFilteredGlobalSubscriptionSOPInstance = "1.2.840.10008.5.1.4.34.5.1"
+	// UnifiedWorklistAndProcedureStepServiceClass is the Service Class "Unified Worklist and Procedure Step Service Class"
+	UnifiedWorklistAndProcedureStepServiceClass = "1.2.840.10008.5.1.4.34.6"
+	// UnifiedProcedureStepPushSOPClass is the SOP Class "Unified Procedure Step - Push SOP Class"
+	UnifiedProcedureStepPushSOPClass = "1.2.840.10008.5.1.4.34.6.1"
+	// UnifiedProcedureStepWatchSOPClass is the SOP Class "Unified Procedure Step - Watch SOP Class"
+	UnifiedProcedureStepWatchSOPClass = "1.2.840.10008.5.1.4.34.6.2"
+	// UnifiedProcedureStepPullSOPClass is the SOP Class "Unified Procedure Step - Pull SOP Class"
+	UnifiedProcedureStepPullSOPClass = "1.2.840.10008.5.1.4.34.6.3"
+	// UnifiedProcedureStepEventSOPClass is the SOP Class "Unified Procedure Step - Event SOP Class"
+	UnifiedProcedureStepEventSOPClass = "1.2.840.10008.5.1.4.34.6.4"
+	// RTBeamsDeliveryInstructionStorage is the SOP Class "RT Beams Delivery Instruction Storage"
+	RTBeamsDeliveryInstructionStorage = "1.2.840.10008.5.1.4.34.7"
+	// RTConventionalMachineVerification is the SOP Class "RT Conventional Machine Verification"
+	RTConventionalMachineVerification = "1.2.840.10008.5.1.4.34.8"
+	// RTIonMachineVerification is the SOP Class "RT Ion Machine Verification"
+	RTIonMachineVerification = "1.2.840.10008.5.1.4.34.9"
+	// RTBrachyApplicationSetupDeliveryInstructionStorage is the SOP Class "RT Brachy Application Setup Delivery Instruction Storage"
+	RTBrachyApplicationSetupDeliveryInstructionStorage = "1.2.840.10008.5.1.4.34.10"
+	// GeneralRelevantPatientInformationQuery is the SOP Class "General Relevant Patient Information Query"
+	GeneralRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.1"
+	// BreastImagingRelevantPatientInformationQuery is the SOP Class "Breast Imaging Relevant Patient Information Query"
+	BreastImagingRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.2"
+	// CardiacRelevantPatientInformationQuery is the SOP Class "Cardiac Relevant Patient Information Query"
+	CardiacRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.3"
+	// HangingProtocolStorage is the SOP Class "Hanging Protocol Storage"
+	HangingProtocolStorage = "1.2.840.10008.5.1.4.38.1"
+	// HangingProtocolInformationModelFIND is the SOP Class "Hanging Protocol Information Model - FIND"
+	HangingProtocolInformationModelFIND = "1.2.840.10008.5.1.4.38.2"
+	// HangingProtocolInformationModelMOVE is the SOP Class "Hanging Protocol Information Model - MOVE"
+	HangingProtocolInformationModelMOVE = "1.2.840.10008.5.1.4.38.3"
+	// HangingProtocolInformationModelGET is the SOP Class "Hanging Protocol Information Model - GET"
+	HangingProtocolInformationModelGET = "1.2.840.10008.5.1.4.38.4"
+	// ColorPaletteStorage is the SOP Class "Color Palette Storage"
+	ColorPaletteStorage = "1.2.840.10008.5.1.4.39.1"
+	// ColorPaletteQueryRetrieveInformationModelFIND is the SOP Class "Color Palette Query/Retrieve Information Model - FIND"
+	ColorPaletteQueryRetrieveInformationModelFIND = "1.2.840.10008.5.1.4.39.2"
+	// ColorPaletteQueryRetrieveInformationModelMOVE is the SOP Class "Color Palette Query/Retrieve Information Model - MOVE"
+	ColorPaletteQueryRetrieveInformationModelMOVE = "1.2.840.10008.5.1.4.39.3"
+	// ColorPaletteQueryRetrieveInformationModelGET is the SOP Class "Color Palette Query/Retrieve Information Model - GET"
+	ColorPaletteQueryRetrieveInformationModelGET = "1.2.840.10008.5.1.4.39.4"
+	// ProductCharacteristicsQuerySOPClass is the SOP Class "Product Characteristics Query SOP Class"
+	ProductCharacteristicsQuerySOPClass = "1.2.840.10008.5.1.4.41"
+	// SubstanceApprovalQuerySOPClass is the SOP Class "Substance Approval Query SOP Class"
+	SubstanceApprovalQuerySOPClass = "1.2.840.10008.5.1.4.42"
+	// GenericImplantTemplateStorage is the SOP Class "Generic Implant Template Storage"
+	GenericImplantTemplateStorage = "1.2.840.10008.5.1.4.43.1"
+	// GenericImplantTemplateInformationModelFIND is the SOP Class "Generic Implant Template Information Model - FIND"
+	GenericImplantTemplateInformationModelFIND = "1.2.840.10008.5.1.4.43.2"
+	// GenericImplantTemplateInformationModelMOVE is the SOP Class "Generic Implant Template Information Model - MOVE"
+	GenericImplantTemplateInformationModelMOVE = "1.2.840.10008.5.1.4.43.3"
+	// GenericImplantTemplateInformationModelGET is the SOP Class "Generic Implant Template Information Model - GET"
+	GenericImplantTemplateInformationModelGET = "1.2.840.10008.5.1.4.43.4"
+	// ImplantAssemblyTemplateStorage is the SOP Class "Implant Assembly Template Storage"
+	ImplantAssemblyTemplateStorage = "1.2.840.10008.5.1.4.44.1"
+	// ImplantAssemblyTemplateInformationModelFIND is the SOP Class "Implant Assembly Template Information Model - FIND"
+	ImplantAssemblyTemplateInformationModelFIND = "1.2.840.10008.5.1.4.44.2"
+	// ImplantAssemblyTemplateInformationModelMOVE is the SOP Class "Implant Assembly Template Information Model - MOVE"
+	ImplantAssemblyTemplateInformationModelMOVE = "1.2.840.10008.5.1.4.44.3"
+	// ImplantAssemblyTemplateInformationModelGET is the SOP Class "Implant Assembly Template Information Model - GET"
+	ImplantAssemblyTemplateInformationModelGET = "1.2.840.10008.5.1.4.44.4"
+	// ImplantTemplateGroupStorage is the SOP Class "Implant Template Group Storage"
+	ImplantTemplateGroupStorage = "1.2.840.10008.5.1.4.45.1"
+	// ImplantTemplateGroupInformationModelFIND is the SOP Class "Implant Template Group Information Model - FIND"
+	ImplantTemplateGroupInformationModelFIND = "1.2.840.10008.5.1.4.45.2"
+	// ImplantTemplateGroupInformationModelMOVE is the SOP Class "Implant Template Group Information Model - MOVE"
+	ImplantTemplateGroupInformationModelMOVE = "1.2.840.10008.5.1.4.45.3"
+	// ImplantTemplateGroupInformationModelGET is the SOP Class "Implant Template Group Information Model - GET"
+	ImplantTemplateGroupInformationModelGET = "1.2.840.10008.5.1.4.45.4"
+	// NativeDICOMModel is the Application Hosting Model "Native DICOM Model"
+	NativeDICOMModel = "1.2.840.10008.7.1.1"
+	// AbstractMultiDimensionalImageModel is the Application Hosting Model "Abstract Multi-Dimensional Image Model"
+	AbstractMultiDimensionalImageModel = "1.2.840.10008.7.1.2"
+	// DICOMContentMappingResource is the Mapping Resource "DICOM Content Mapping Resource"
+	DICOMContentMappingResource = "1.2.840.10008.8.1.1"
+	// DicomDeviceName is the LDAP OID "dicom Device Name"
+	DicomDeviceName = "1.2.840.10008.15.0.3.1"
+	// DicomDescription is the LDAP OID "dicom Description"
+	DicomDescription = "1.2.840.10008.15.0.3.2"
+	// DicomManufacturer is the LDAP OID "dicom Manufacturer"
+	DicomManufacturer = "1.2.840.10008.15.0.3.3"
+	// DicomManufacturerModelName is the LDAP OID "dicom Manufacturer Model Name"
+	DicomManufacturerModelName = "1.2.840.10008.15.0.3.4"
+	// DicomSoftwareVersion is the LDAP OID "dicom Software Version"
+	DicomSoftwareVersion = "1.2.840.10008.15.0.3.5"
+	// DicomVendorData is the LDAP OID "dicom Vendor Data"
+	DicomVendorData = "1.2.840.10008.15.0.3.6"
+	// DicomAETitle is the LDAP OID "dicomAE Title"
+	DicomAETitle = "1.2.840.10008.15.0.3.7"
+	// DicomNetworkConnectionReference is the LDAP OID "dicom Network Connection Reference"
+	DicomNetworkConnectionReference = "1.2.840.10008.15.0.3.8"
+	// DicomApplicationCluster is the LDAP OID "dicom Application Cluster"
+	DicomApplicationCluster = "1.2.840.10008.15.0.3.9"
+	// DicomAssociationInitiator is the LDAP OID "dicom Association Initiator"
+	DicomAssociationInitiator = "1.2.840.10008.15.0.3.10"
+	// DicomAssociationAcceptor is the LDAP OID "dicom Association Acceptor"
+	DicomAssociationAcceptor = "1.2.840.10008.15.0.3.11"
+	// DicomHostname is the LDAP OID "dicom Hostname"
+	DicomHostname = "1.2.840.10008.15.0.3.12"
+	// DicomPort is the LDAP OID "dicom Port"
+	DicomPort = "1.2.840.10008.15.0.3.13"
+	// DicomSOPClass is the LDAP OID "dicomSOP Class"
+	DicomSOPClass = "1.2.840.10008.15.0.3.14"
+	// DicomTransferRole is the LDAP OID "dicom Transfer Role"
+	DicomTransferRole = "1.2.840.10008.15.0.3.15"
+	// DicomTransferSyntax is the LDAP OID "dicom Transfer Syntax"
+	DicomTransferSyntax = "1.2.840.10008.15.0.3.16"
+	// DicomPrimaryDeviceType is the LDAP OID "dicom Primary Device Type"
+	DicomPrimaryDeviceType = "1.2.840.10008.15.0.3.17"
+	// DicomRelatedDeviceReference is the LDAP OID "dicom Related Device Reference"
+	DicomRelatedDeviceReference = "1.2.840.10008.15.0.3.18"
+	// DicomPreferredCalledAETitle is the LDAP OID "dicom Preferred CalledAE Title"
+	DicomPreferredCalledAETitle = "1.2.840.10008.15.0.3.19"
+	// DicomTLSCyphersuite is the LDAP OID "dicomTLS Cyphersuite"
+	DicomTLSCyphersuite = "1.2.840.10008.15.0.3.20"
+	// DicomAuthorizedNodeCertificateReference is the LDAP OID "dicom Authorized Node Certificate Reference"
+	DicomAuthorizedNodeCertificateReference = "1.2.840.10008.15.0.3.21"
+	// DicomThisNodeCertificateReference is the LDAP OID "dicom This Node Certificate Reference"
+	DicomThisNodeCertificateReference = "1.2.840.10008.15.0.3.22"
+	// DicomInstalled is the LDAP OID "dicom Installed"
+	DicomInstalled = "1.2.840.10008.15.0.3.23"
+	// DicomStationName is the LDAP OID "dicom Station Name"
+	DicomStationName = "1.2.840.10008.15.0.3.24"
+	// DicomDeviceSerialNumber is the LDAP OID "dicom Device Serial Number"
+	DicomDeviceSerialNumber = "1.2.840.10008.15.0.3.25"
+	// DicomInstitutionName is the LDAP OID "dicom Institution Name"
+	DicomInstitutionName = "1.2.840.10008.15.0.3.26"
+	// DicomInstitutionAddress is the LDAP OID "dicom Institution Address"
+	DicomInstitutionAddress = "1.2.840.10008.15.0.3.27"
+	// DicomInstitutionDepartmentName is the LDAP OID "dicom Institution Department Name"
+	DicomInstitutionDepartmentName = "1.2.840.10008.15.0.3.28"
+	// DicomIssuerOfPatientID is the LDAP OID "dicom Issuer OfPatientID"
+	DicomIssuerOfPatientID = "1.2.840.10008.15.0.3.29"
+	// DicomPreferredCallingAETitle is the LDAP OID "dicom Preferred CallingAE Title"
+	DicomPreferredCallingAETitle = "1.2.840.10008.15.0.3.30"
+	// DicomSupportedCharacterSet is the LDAP OID "dicom Supported Character Set"
+	DicomSupportedCharacterSet = "1.2.840.10008.15.0.3.31"
+	// DicomConfigurationRoot is the LDAP OID "dicom Configuration Root"
+	DicomConfigurationRoot = "1.2.840.10008.15.0.4.1"
+	// DicomDevicesRoot is the LDAP OID "dicom Devices Root"
+	DicomDevicesRoot = "1.2.840.10008.15.0.4.2"
+	// DicomUniqueAETitlesRegistryRoot is the LDAP OID "dicom UniqueAE Titles Registry Root"
+	DicomUniqueAETitlesRegistryRoot = "1.2.840.10008.15.0.4.3"
+	// DicomDevice is the LDAP OID "dicom Device"
+	DicomDevice = "1.2.840.10008.15.0.4.4"
+	// DicomNetworkAE is the LDAP OID "dicom NetworkAE"
+	DicomNetworkAE = "1.2.840.10008.15.0.4.5"
+	// DicomNetworkConnection is the LDAP OID "dicom Network Connection"
+	DicomNetworkConnection = "1.2.840.10008.15.0.4.6"
+	// DicomUniqueAETitle is the LDAP OID "dicom UniqueAE Title"
+	DicomUniqueAETitle = "1.2.840.10008.15.0.4.7"
+	// DicomTransferCapability is the LDAP OID "dicom Transfer Capability"
+	DicomTransferCapability = "1.2.840.10008.15.0.4.8"
+	// UniversalCoordinatedTime is the Synchronization Frame of Reference "Universal Coordinated Time"
+	UniversalCoordinatedTime = "1.2.840.10008.15.1.1"
+)
@@ -0,0 +1,371 @@
+package opendcm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"math"
+	"strings"
+)
+
+/*
+===============================================================================
+	Display
+	---
+	Provides the grayscale display pipeline: stored values are transformed by
+	the Modality LUT into modality values (such as Hounsfield units), by the
+	VOI LUT into a range of interest, and by the Presentation LUT into
+	displayable values.
+===============================================================================
+*/
+
+// ModalityLUT transforms stored values into modality values, as per the
+// Modality LUT module.
+// See http://dicom.nema.org/medical/dicom/current/output/chtml/part03/sect_C.11.html#sect_C.11.1
+type ModalityLUT struct {
+	RescaleSlope     float64
+	RescaleIntercept float64
+	// Type describes the units of the modality values, such as "HU" (Hounsfield units).
+	// It is taken from the Modality LUT Type, or otherwise the Rescale Type.
+	Type string
+	// LUT, if not nil, is used in place of RescaleSlope and RescaleIntercept
+	LUT *LookupTable
+}
+
+// Apply returns the modality value of stored value `v`.
+func (m *ModalityLUT) Apply(v int64) float64 {
+	if m.LUT != nil {
+		return float64(m.LUT.Lookup(int(v)))
+	}
+	return float64(v)*m.RescaleSlope + m.RescaleIntercept
+}
+
+// VOILUT transforms modality values into a range of interest, as per the
+// VOI LUT module. It is described either by a window, or by a lookup table.
+// See http://dicom.nema.org/medical/dicom/current/output/chtml/part03/sect_C.11.2.html
+type VOILUT struct {
+	WindowCenter float64
+	WindowWidth  float64
+	// Function is one of "LINEAR", "LINEAR_EXACT" or "SIGMOID"
+	Function    string
+	Explanation string
+	// LUT, if not nil, is used in place of the window
+	LUT *LookupTable
+}
+
+// Apply returns the value of modality value `x` within the range of interest,
+// from 0 to 1.
+func (voi *VOILUT) Apply(x float64) float64 {
+	if voi.LUT != nil {
+		bits := voi.LUT.BitsPerEntry
+		if bits == 0 || bits > 16 {
+			bits = 16
+		}
+		return float64(voi.LUT.Lookup(int(math.Floor(x)))) / float64(uint32(1)<<bits-1)
+	}
+	c, w := voi.WindowCenter, voi.WindowWidth
+	switch voi.Function {
+	case "SIGMOID":
+		if w <= 0 {
+			break
+		}
+		return 1 / (1 + math.Exp(-4*(x-c)/w))
+	case "LINEAR_EXACT":
+		if w <= 0 {
+			break
+		}
+		switch {
+		case x <= c-w/2:
+			return 0
+		case x > c+w/2:
+			return 1
+		}
+		return (x-c)/w + 0.5
+	default:
+		// LINEAR windows have a width of at least one
+		w = math.Max(w, 1)
+		switch {
+		case x <= c-0.5-(w-1)/2:
+			return 0
+		case x > c-0.5+(w-1)/2:
+			return 1
+		}
+		if w == 1 {
+			return 1
+		}
+		return (x-(c-0.5))/(w-1) + 0.5
+	}
+	// windows without width are a threshold at their center
+	if x < c {
+		return 0
+	}
+	return 1
+}
+
+// DisplayPipeline transforms the stored values of monochrome frames into
+// modality values, and into eight bit displayable values.
+type DisplayPipeline struct {
+	Params   ImageParameters
+	Modality ModalityLUT
+	// VOIs holds each window described by the dicom, followed by each VOI LUT
+	VOIs []VOILUT
+	// VOI is the transform applied by Render. If nil, a window is chosen
+	// which spans the modality values of the frame.
+	VOI *VOILUT
+	// Inverse is whether minimum values are displayed as white, as given by a
+	// Presentation LUT Shape of "INVERSE", or a PhotometricInterpretation of
+	// "MONOCHROME1" without a Presentation LUT Shape.
+	Inverse bool
+}
+
+// GetDisplayPipeline returns the display pipeline described by the Modality LUT,
+// VOI LUT and Presentation LUT modules. The first window or VOI LUT is selected.
+func (dcm *Dicom) GetDisplayPipeline() (*DisplayPipeline, error) {
+	params, err := dcm.GetImageParameters()
+	if err != nil {
+		return nil, err
+	}
+	if err = params.validateMonochrome(); err != nil {
+		return nil, fmt.Errorf("GetDisplayPipeline: %v", err)
+	}
+	p := DisplayPipeline{Params: params}
+	if dcm.err = dcm.getModalityLUT(&p); dcm.err != nil {
+		return nil, fmt.Errorf("GetDisplayPipeline: %v", dcm.err)
+	}
+	if dcm.err = dcm.getVOILUTs(&p); dcm.err != nil {
+		return nil, fmt.Errorf("GetDisplayPipeline: %v", dcm.err)
+	}
+	if len(p.VOIs) > 0 {
+		p.VOI = &p.VOIs[0]
+	}
+
+	shape := ""
+	if _, dcm.err = dcm.GetElementValue(0x20500020, &shape); dcm.err != nil {
+		return nil, dcm.err
+	}
+	switch strings.TrimSpace(shape) {
+	case "INVERSE":
+		p.Inverse = true
+	case "IDENTITY":
+	case "":
+		p.Inverse = params.PhotometricInterpretation == "MONOCHROME1"
+	default:
+		return nil, fmt.Errorf(`GetDisplayPipeline: Presentation LUT Shape "%s" is not supported`, shape)
+	}
+	return &p, nil
+}
+
+// getModalityLUT reads the Modality LUT Sequence, or otherwise the Rescale
+// Slope and Intercept, into `p`.
+func (dcm *Dicom) getModalityLUT(p *DisplayPipeline) error {
+	sq := NewElement()
+	if dcm.GetElement(0x00283000, &sq) && sq.HasItems() {
+		item := sq.GetItems()[0]
+		lut, err := item.dataset.lookupTable(0x00283002, 0x00283006, p.Params.PixelRepresentation == 1)
+		if err != nil {
+			return err
+		}
+		if lut == nil {
+			return errors.New("Modality LUT Sequence has no LUT Data")
+		}
+		p.Modality.LUT = lut
+		_, err = item.dataset.GetElementValue(0x00283004, &p.Modality.Type)
+		p.Modality.Type = strings.TrimSpace(p.Modality.Type)
+		return err
+	}
+
+	p.Modality.RescaleSlope = 1
+	for tag, dst := range map[uint32]*float64{
+		0x00281053: &p.Modality.RescaleSlope,
+		0x00281052: &p.Modality.RescaleIntercept,
+	} {
+		values := []float64{}
+		if _, err := dcm.GetElementValue(tag, &values); err != nil {
+			return err
+		}
+		if len(values) > 0 {
+			*dst = values[0]
+		}
+	}
+	_, err := dcm.GetElementValue(0x00281054, &p.Modality.Type)
+	p.Modality.Type = strings.TrimSpace(p.Modality.Type)
+	return err
+}
+
+// getVOILUTs reads each window, followed by each item of the VOI LUT Sequence, into `p`.
+func (dcm *Dicom) getVOILUTs(p *DisplayPipeline) error {
+	centers, widths := []float64{}, []float64{}
+	if _, err := dcm.GetElementValue(0x00281050, &centers); err != nil {
+		return err
+	}
+	if _, err := dcm.GetElementValue(0x00281051, &widths); err != nil {
+		return err
+	}
+	if len(centers) != len(widths) {
+		return fmt.Errorf("there are %d window centers, but %d window widths", len(centers), len(widths))
+	}
+	explanations := []string{}
+	if _, err := dcm.GetElementValue(0x00281055, &explanations); err != nil {
+		return err
+	}
+	function := ""
+	if _, err := dcm.GetElementValue(0x00281056, &function); err != nil {
+		return err
+	}
+	switch function = strings.TrimSpace(function); function {
+	case "":
+		function = "LINEAR"
+	case "LINEAR", "LINEAR_EXACT", "SIGMOID":
+	default:
+		return fmt.Errorf(`VOI LUT Function "%s" is not supported`, function)
+	}
+	for i := range centers {
+		voi := VOILUT{WindowCenter: centers[i], WindowWidth: widths[i], Function: function}
+		if i < len(explanations) {
+			voi.Explanation = strings.TrimSpace(explanations[i])
+		}
+		p.VOIs = append(p.VOIs, voi)
+	}
+
+	sq := NewElement()
+	if !dcm.GetElement(0x00283010, &sq) {
+		return nil
+	}
+	// the first value mapped is signed if modality values may be negative
+	signed := p.Params.PixelRepresentation == 1 || (p.Modality.LUT == nil && p.Modality.RescaleIntercept < 0)
+	for _, item := range sq.GetItems() {
+		lut, err := item.dataset.lookupTable(0x00283002, 0x00283006, signed)
+		if err != nil {
+			return err
+		}
+		if lut == nil {
+			return errors.New("VOI LUT Sequence item has no LUT Data")
+		}
+		voi := VOILUT{LUT: lut}
+		if _, err = item.dataset.GetElementValue(0x00283003, &voi.Explanation); err != nil {
+			return err
+		}
+		voi.Explanation = strings.TrimSpace(voi.Explanation)
+		p.VOIs = append(p.VOIs, voi)
+	}
+	return nil
+}
+
+// validateMonochrome returns an error if the parameters do not describe a
+// monochrome image with a single sample per pixel.
+func (params *ImageParameters) validateMonochrome() error {
+	switch params.PhotometricInterpretation {
+	case "MONOCHROME1", "MONOCHROME2":
+	default:
+		return fmt.Errorf(`PhotometricInterpretation "%s" is not supported`, params.PhotometricInterpretation)
+	}
+	if params.SamplesPerPixel != 1 {
+		return fmt.Errorf("%s requires one sample per pixel, not %d", params.PhotometricInterpretation, params.SamplesPerPixel)
+	}
+	return nil
+}
+
+// ModalityValues returns the modality value of each pixel of a frame of native
+// pixel data. For CT images, these are Hounsfield units.
+func (p *DisplayPipeline) ModalityValues(frame []byte) ([]float64, error) {
+	if err := p.Params.validateMonochrome(); err != nil {
+		return nil, err
+	}
+	if err := p.Params.validate(frame); err != nil {
+		return nil, err
+	}
+	values := make([]float64, int(p.Params.Rows)*int(p.Params.Columns))
+	for i := range values {
+		values[i] = p.Modality.Apply(p.Params.sample(frame, i))
+	}
+	return values, nil
+}
+
+// Render transforms a frame of native pixel data into eight bit displayable values.
+func (p *DisplayPipeline) Render(frame []byte) (*image.Gray, error) {
+	img := image.NewGray(image.Rect(0, 0, int(p.Params.Columns), int(p.Params.Rows)))
+	err := p.render(frame, func(i int, y float64) {
+		img.Pix[i] = uint8(math.Round(y * 0xFF))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// Render16 transforms a frame of native pixel data into sixteen bit displayable values.
+func (p *DisplayPipeline) Render16(frame []byte) (*image.Gray16, error) {
+	img := image.NewGray16(image.Rect(0, 0, int(p.Params.Columns), int(p.Params.Rows)))
+	err := p.render(frame, func(i int, y float64) {
+		binary.BigEndian.PutUint16(img.Pix[i*2:], uint16(math.Round(y*0xFFFF)))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// render passes the displayable value of each pixel of `frame`, from 0 to 1, to `set`.
+func (p *DisplayPipeline) render(frame []byte, set func(i int, y float64)) error {
+	values, err := p.ModalityValues(frame)
+	if err != nil {
+		return err
+	}
+	voi := p.VOI
+	if voi == nil {
+		voi = spanningWindow(values)
+	}
+	for i, v := range values {
+		y := voi.Apply(v)
+		if p.Inverse {
+			y = 1 - y
+		}
+		set(i, y)
+	}
+	return nil
+}
+
+// spanningWindow returns a window which spans from the minimum to the maximum of `values`.
+func spanningWindow(values []float64) *VOILUT {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		lo, hi = math.Min(lo, v), math.Max(hi, v)
+	}
+	if len(values) == 0 {
+		lo, hi = 0, 0
+	}
+	return &VOILUT{WindowCenter: (lo + hi) / 2, WindowWidth: hi - lo, Function: "LINEAR_EXACT"}
+}
+
+// GetModalityValues returns the modality value of each pixel of the frame at
+// `index`, such as Hounsfield units for CT images.
+// See: DisplayPipeline.ModalityValues
+func (dcm *Dicom) GetModalityValues(index int) ([]float64, error) {
+	p, frame, err := dcm.displayFrame(index)
+	if err != nil {
+		return nil, fmt.Errorf("GetModalityValues: %v", err)
+	}
+	return p.ModalityValues(frame)
+}
+
+// GetDisplayImage transforms the frame at `index` into eight bit displayable
+// values, through the dicom's display pipeline.
+// See: DisplayPipeline.Render
+func (dcm *Dicom) GetDisplayImage(index int) (*image.Gray, error) {
+	p, frame, err := dcm.displayFrame(index)
+	if err != nil {
+		return nil, fmt.Errorf("GetDisplayImage: %v", err)
+	}
+	return p.Render(frame)
+}
+
+// displayFrame returns the display pipeline, and the frame at `index` as native pixel data.
+func (dcm *Dicom) displayFrame(index int) (*DisplayPipeline, []byte, error) {
+	p, err := dcm.GetDisplayPipeline()
+	if err != nil {
+		return nil, nil, err
+	}
+	frame, err := dcm.GetNativeFrame(index, &p.Params)
+	return p, frame, err
+}
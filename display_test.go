@@ -0,0 +1,226 @@
+package opendcm
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newMonochromeDicom returns a single frame, 16 bit MONOCHROME2 dicom holding
+// `pixels`, with the additional elements of `elements`.
+func newMonochromeDicom(pixels []uint16, elements map[uint32][]byte) Dicom {
+	dcm := newNativeDicom(1, uint16(len(pixels)), 1, 16, "", uint16Bytes(pixels...))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("MONOCHROME2 ")
+	dcm.addElement(photometric)
+	for tag, value := range elements {
+		e := NewElementWithTag(tag)
+		e.data = value
+		dcm.addElement(e)
+	}
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	return dcm
+}
+
+// newLUTSequence returns a sequence `tag`, holding an item for each lookup table
+// of `items`, each described by its descriptor and data elements.
+func newLUTSequence(tag uint32, items ...map[uint32][]byte) Element {
+	sq := NewElementWithTag(tag)
+	for _, elements := range items {
+		item := NewItem()
+		for itemTag, value := range elements {
+			e := NewElementWithTag(itemTag)
+			e.data = value
+			item.dataset.addElement(e)
+		}
+		sq.items = append(sq.items, item)
+	}
+	return sq
+}
+
+func TestModalityLUT(t *testing.T) {
+	// ensures that stored values are rescaled, or mapped through the lookup table.
+	t.Parallel()
+	m := ModalityLUT{RescaleSlope: 2, RescaleIntercept: -1024}
+	assert.Equal(t, -1024.0, m.Apply(0))
+	assert.Equal(t, 976.0, m.Apply(1000))
+	m.LUT = &LookupTable{FirstMapped: -1, BitsPerEntry: 16, Data: []uint16{10, 20, 30}}
+	assert.Equal(t, 10.0, m.Apply(-5))
+	assert.Equal(t, 20.0, m.Apply(0))
+	assert.Equal(t, 30.0, m.Apply(5))
+}
+
+func TestVOILUT(t *testing.T) {
+	// ensures that modality values are windowed according to the VOI LUT Function,
+	// or mapped through the lookup table.
+	t.Parallel()
+	for _, testCase := range []struct {
+		voi      VOILUT
+		x        float64
+		expected float64
+	}{
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR"}, -160, 0},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR"}, -159, 1.0 / 399},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR"}, 39.5, 0.5},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR"}, 239, 1},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR"}, 240, 1},
+		// LINEAR is the default function
+		{VOILUT{WindowCenter: 40, WindowWidth: 400}, 39.5, 0.5},
+		// LINEAR windows of less than one are a threshold
+		{VOILUT{WindowCenter: 10, WindowWidth: 0.5}, 9.5, 0},
+		{VOILUT{WindowCenter: 10, WindowWidth: 0.5}, 9.6, 1},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR_EXACT"}, -160, 0},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR_EXACT"}, 40, 0.5},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR_EXACT"}, 140, 0.75},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR_EXACT"}, 240, 1},
+		{VOILUT{WindowCenter: 40, WindowWidth: 0, Function: "LINEAR_EXACT"}, 39, 0},
+		{VOILUT{WindowCenter: 40, WindowWidth: 0, Function: "LINEAR_EXACT"}, 40, 1},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "SIGMOID"}, 40, 0.5},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "SIGMOID"}, 440, 1 / (1 + math.Exp(-4))},
+		{VOILUT{WindowCenter: 40, WindowWidth: 400, Function: "SIGMOID"}, -360, 1 / (1 + math.Exp(4))},
+		{VOILUT{LUT: &LookupTable{FirstMapped: -2, BitsPerEntry: 8, Data: []uint16{0, 51, 255}}}, -5, 0},
+		{VOILUT{LUT: &LookupTable{FirstMapped: -2, BitsPerEntry: 8, Data: []uint16{0, 51, 255}}}, -0.5, 0.2},
+		{VOILUT{LUT: &LookupTable{FirstMapped: -2, BitsPerEntry: 8, Data: []uint16{0, 51, 255}}}, 100, 1},
+	} {
+		assert.InDelta(t, testCase.expected, testCase.voi.Apply(testCase.x), 1e-9, "%+v(%v)", testCase.voi, testCase.x)
+	}
+}
+
+func TestGetDisplayPipeline(t *testing.T) {
+	// ensures that the display pipeline is read from the Modality LUT, VOI LUT
+	// and Presentation LUT modules, and that frames are rendered through it.
+	t.Parallel()
+	dcm := newMonochromeDicom([]uint16{0, 1024, 1064, 2048}, map[uint32][]byte{
+		0x00281052: []byte("-1024 "),
+		0x00281053: []byte("1 "),
+		0x00281054: []byte("HU"),
+		0x00281050: []byte("40\\-600 "),
+		0x00281051: []byte("400 \\1500"),
+		0x00281055: []byte("BRAIN\\LUNG "),
+	})
+	p, err := dcm.GetDisplayPipeline()
+	assert.NoError(t, err)
+	assert.Equal(t, ModalityLUT{RescaleSlope: 1, RescaleIntercept: -1024, Type: "HU"}, p.Modality)
+	assert.Equal(t, []VOILUT{
+		{WindowCenter: 40, WindowWidth: 400, Function: "LINEAR", Explanation: "BRAIN"},
+		{WindowCenter: -600, WindowWidth: 1500, Function: "LINEAR", Explanation: "LUNG"},
+	}, p.VOIs)
+	assert.Equal(t, &p.VOIs[0], p.VOI)
+	assert.False(t, p.Inverse)
+
+	values, err := dcm.GetModalityValues(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{-1024, 0, 40, 1024}, values)
+	img, err := dcm.GetDisplayImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 4, 1), img.Bounds())
+	assert.Equal(t, []uint8{0, 102, 128, 255}, img.Pix)
+
+	// another window may be selected
+	p.VOI = &p.VOIs[1]
+	img, err = p.Render(uint16Bytes(0, 1024, 1064, 2048))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{55, 230, 236, 255}, img.Pix)
+
+	// without a window, the window spans the modality values of the frame
+	p.VOI = nil
+	img, err = p.Render(uint16Bytes(0, 1024, 1536, 2048))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 128, 191, 255}, img.Pix)
+	img16, err := p.Render16(uint16Bytes(0, 1024, 1536, 2048))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0x00, 0x00, 0x80, 0x00, 0xBF, 0xFF, 0xFF, 0xFF}, img16.Pix)
+	img, err = p.Render(uint16Bytes(5, 5, 5, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{255, 255, 255, 255}, img.Pix)
+
+	_, err = dcm.GetDisplayImage(1)
+	assert.Error(t, err)
+	_, err = p.Render(uint16Bytes(0))
+	assert.Error(t, err)
+	_, err = p.Render16(uint16Bytes(0))
+	assert.Error(t, err)
+}
+
+func TestGetDisplayPipelineLUTs(t *testing.T) {
+	// ensures that the Modality LUT and VOI LUT sequences are read, and that
+	// MONOCHROME1 and the Presentation LUT Shape invert the displayed values.
+	t.Parallel()
+	dcm := newMonochromeDicom([]uint16{0, 1, 2, 3}, map[uint32][]byte{
+		0x00280103: uint16Bytes(1),
+		0x00281052: []byte("-1024 "),
+	})
+	dcm.addElement(newLUTSequence(0x00283000, map[uint32][]byte{
+		0x00283002: uint16Bytes(3, 0, 16),
+		0x00283004: []byte("OD"),
+		0x00283006: uint16Bytes(100, 200, 300),
+	}))
+	dcm.addElement(newLUTSequence(0x00283010, map[uint32][]byte{
+		0x00283002: uint16Bytes(2, 150, 8),
+		0x00283003: []byte("FIRST "),
+		0x00283006: uint16Bytes(0, 255),
+	}, map[uint32][]byte{
+		0x00283002: uint16Bytes(0, 0, 16),
+		0x00283006: uint16Bytes(0xFFFF),
+	}))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("MONOCHROME1 ")
+	dcm.addElement(photometric)
+
+	p, err := dcm.GetDisplayPipeline()
+	assert.NoError(t, err)
+	// the Modality LUT is used in place of the Rescale Intercept
+	assert.Equal(t, ModalityLUT{Type: "OD", LUT: &LookupTable{FirstMapped: 0, BitsPerEntry: 16, Data: []uint16{100, 200, 300}}}, p.Modality)
+	assert.Equal(t, 2, len(p.VOIs))
+	assert.Equal(t, VOILUT{Explanation: "FIRST", LUT: &LookupTable{FirstMapped: 150, BitsPerEntry: 8, Data: []uint16{0, 255}}}, p.VOIs[0])
+	assert.Equal(t, 0x10000, cap(p.VOIs[1].LUT.Data))
+	assert.True(t, p.Inverse)
+
+	values, err := dcm.GetModalityValues(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{100, 200, 300, 300}, values)
+	img, err := dcm.GetDisplayImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{255, 0, 0, 0}, img.Pix)
+
+	for shape, expected := range map[string][]uint8{
+		"IDENTITY": {0, 255, 255, 255},
+		"INVERSE ": {255, 0, 0, 0},
+	} {
+		e := NewElementWithTag(0x20500020)
+		e.data = []byte(shape)
+		dcm.addElement(e)
+		img, err = dcm.GetDisplayImage(0)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, img.Pix, shape)
+	}
+}
+
+func TestGetDisplayPipelineError(t *testing.T) {
+	// ensures that unsupported or invalid display pipelines result in an error.
+	t.Parallel()
+	for _, elements := range []map[uint32][]byte{
+		{0x00280004: []byte("RGB ")},
+		{0x00280002: uint16Bytes(3)},
+		{0x00281053: []byte("one ")},
+		{0x00281050: []byte("40\\50 "), 0x00281051: []byte("400 ")},
+		{0x00281050: []byte("40"), 0x00281051: []byte("400 "), 0x00281056: []byte("CUBIC ")},
+		{0x20500020: []byte("SIGMOID ")},
+	} {
+		dcm := newMonochromeDicom([]uint16{0, 1}, elements)
+		_, err := dcm.GetDisplayPipeline()
+		assert.Error(t, err, "%v", elements)
+		_, err = dcm.GetDisplayImage(0)
+		assert.Error(t, err, "%v", elements)
+	}
+
+	// sequences without LUT Data
+	for _, tag := range []uint32{0x00283000, 0x00283010} {
+		dcm := newMonochromeDicom([]uint16{0, 1}, nil)
+		dcm.addElement(newLUTSequence(tag, map[uint32][]byte{0x00283002: uint16Bytes(2, 0, 16)}))
+		_, err := dcm.GetDisplayPipeline()
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,270 @@
+package opendcm
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+===============================================================================
+	Dump
+	---
+	Provides a human-readable listing of the elements of a data set, in the
+	manner of dcmdump.
+===============================================================================
+*/
+
+// DumpOptions specifies the output of `Dump`.
+type DumpOptions struct {
+	// MaxValueWidth is the number of characters beyond which each value is
+	// truncated. If zero, values are not truncated.
+	MaxValueWidth int
+	// HidePrivate omits private elements, being those of an odd group.
+	HidePrivate bool
+}
+
+// dumpColumn is the width of the tag, VR and value of each line, after which
+// the length, multiplicity and name of the element follow.
+const dumpColumn = 56
+
+// Dump writes a listing of the elements of the data set to `w`, one per line, in
+// ascending order of tag. Each line consists of the element's tag, VR, value,
+// length, multiplicity and name, such as:
+//
+//	(0010,0010) PN [Doe^Jane]                              #    8, 1 PatientName
+//
+// The items of sequences and the fragments of encapsulated PixelData are listed
+// beneath their element, indented.
+func (ds *DataSet) Dump(w io.Writer, opts DumpOptions) error {
+	d := dumper{w: w, opts: opts}
+	d.dumpDataSet(ds, 0)
+	return d.err
+}
+
+// dumper writes the lines of `Dump`, retaining the first error encountered.
+type dumper struct {
+	w    io.Writer
+	opts DumpOptions
+	err  error
+}
+
+// dumpDataSet writes a line for each element of `ds`, indented by `depth`.
+func (d *dumper) dumpDataSet(ds *DataSet, depth int) {
+	for _, tag := range ds.Tags() {
+		if d.opts.HidePrivate && isPrivateTag(tag) {
+			continue
+		}
+		e := (*ds)[tag]
+		d.dumpElement(&e, depth)
+	}
+}
+
+// dumpElement writes the line of `e`, followed by its items, indented by `depth`.
+func (d *dumper) dumpElement(e *Element, depth int) {
+	length := strconv.Itoa(e.Len())
+	if e.datalen == 0xFFFFFFFF && !e.IsDeferred() {
+		length = "u/l"
+	}
+	name := e.GetName()
+	if isPrivateTag(e.GetTag()) && uint16(e.GetTag()) >= 0x0010 && uint16(e.GetTag()) <= 0x00FF {
+		name = "PrivateCreator"
+	}
+
+	switch {
+	case e.GetTag() == pixelDataTag && e.HasItems():
+		// encapsulated pixel data: a series of fragments
+		d.writeLine(depth, e.GetTag(), e.GetVR(), fmt.Sprintf("(PixelSequence #=%d)", len(e.items)), length, 1, name)
+		for _, item := range e.items {
+			fragmentLength := strconv.Itoa(len(item.fragment))
+			d.writeLine(depth+1, itemTag, "pi", fmt.Sprintf("(Fragment of %d bytes)", len(item.fragment)), fragmentLength, 1, "Item")
+		}
+	case e.GetVR() == "SQ" || e.HasItems():
+		d.writeLine(depth, e.GetTag(), "SQ", fmt.Sprintf("(Sequence #=%d)", len(e.items)), length, 1, name)
+		for i := range e.items {
+			item := &e.items[i]
+			d.writeLine(depth+1, itemTag, "na", fmt.Sprintf("(Item #=%d)", item.dataset.Len()), "-", 1, "Item")
+			d.dumpDataSet(&item.dataset, depth+2)
+		}
+	default:
+		value, vm := d.formatValue(e)
+		d.writeLine(depth, e.GetTag(), e.GetVR(), value, length, vm, name)
+	}
+}
+
+// writeLine writes a single line of the listing.
+func (d *dumper) writeLine(depth int, tag uint32, vr string, value string, length string, vm int, name string) {
+	if d.err != nil {
+		return
+	}
+	left := fmt.Sprintf("%s(%04X,%04X) %s %s", strings.Repeat("  ", depth), uint16(tag>>16), uint16(tag), vr, value)
+	padding := maxInt(dumpColumn-utf8.RuneCountInString(left), 1)
+	_, d.err = fmt.Fprintf(d.w, "%s%s# %4s, %d %s\n", left, strings.Repeat(" ", padding), length, vm, name)
+}
+
+// formatValue returns the value of `e` as listed by `Dump`, truncated to the
+// maximum width, along with its multiplicity.
+func (d *dumper) formatValue(e *Element) (string, int) {
+	if e.IsDeferred() {
+		return "(not loaded)", 1
+	}
+	if len(e.data) == 0 {
+		return "(no value available)", 0
+	}
+	// binary values are of a fixed size, and each is listed as at least one
+	// character: only those which could be listed within the maximum width are
+	// formatted, as the value may be as large as native PixelData
+	size, length := binaryValueSize(e.GetVR()), len(e.data)
+	if limit := (d.opts.MaxValueWidth + 1) * size; d.opts.MaxValueWidth > 0 && size > 0 && len(e.data) > limit {
+		prefix := *e
+		prefix.data = e.data[:limit]
+		e = &prefix
+	}
+	values := []string{}
+	var err error
+	switch vr := e.GetVR(); vr {
+	case "LT", "ST", "UT", "UR":
+		values = append(values, string(e.data))
+	case "AT":
+		tags := []uint32{}
+		err = e.GetValue(&tags)
+		for _, tag := range tags {
+			values = append(values, fmt.Sprintf("(%04X,%04X)", uint16(tag>>16), uint16(tag)))
+		}
+	case "FL", "OF":
+		floats := []float32{}
+		err = e.GetValue(&floats)
+		for _, f := range floats {
+			values = append(values, strconv.FormatFloat(float64(f), 'g', -1, 32))
+		}
+	case "FD", "OD":
+		floats := []float64{}
+		err = e.GetValue(&floats)
+		for _, f := range floats {
+			values = append(values, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case "SS":
+		ints := []int16{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatInt(int64(i), 10))
+		}
+	case "SL":
+		ints := []int32{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatInt(int64(i), 10))
+		}
+	case "SV":
+		ints := []int64{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatInt(i, 10))
+		}
+	case "US":
+		ints := []uint16{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatUint(uint64(i), 10))
+		}
+	case "UL":
+		ints := []uint32{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatUint(uint64(i), 10))
+		}
+	case "UV":
+		ints := []uint64{}
+		err = e.GetValue(&ints)
+		for _, i := range ints {
+			values = append(values, strconv.FormatUint(i, 10))
+		}
+	case "OW":
+		words := []uint16{}
+		err = e.GetValue(&words)
+		for _, w := range words {
+			values = append(values, fmt.Sprintf("%04x", w))
+		}
+	case "OL":
+		words := []uint32{}
+		err = e.GetValue(&words)
+		for _, w := range words {
+			values = append(values, fmt.Sprintf("%08x", w))
+		}
+	case "OV":
+		words := []uint64{}
+		err = e.GetValue(&words)
+		for _, w := range words {
+			values = append(values, fmt.Sprintf("%016x", w))
+		}
+	default:
+		if isTextualVR(vr) {
+			for _, v := range splitCharacterStringVM(e.data) {
+				values = append(values, string(v))
+			}
+			break
+		}
+		// OB, UN and any other VR are listed as bytes
+		for _, b := range e.data {
+			values = append(values, fmt.Sprintf("%02x", b))
+		}
+	}
+	if err != nil {
+		return fmt.Sprintf("(%v)", err), 0
+	}
+
+	vm := len(values)
+	if size > 0 {
+		vm = length / size
+	}
+	switch e.GetVR() {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "UN":
+		// other VRs are of a single value
+		vm = 1
+	}
+	value := d.truncate(strings.Map(printable, strings.Join(values, `\`)))
+	if isTextualVR(e.GetVR()) {
+		value = "[" + value + "]"
+	}
+	return value, vm
+}
+
+// binaryValueSize returns the size of each value of the given binary VR, or 0
+// if the VR is not binary.
+func binaryValueSize(vr string) int {
+	switch vr {
+	case "OB", "UN":
+		return 1
+	case "OW", "SS", "US":
+		return 2
+	case "AT", "FL", "OF", "OL", "SL", "UL":
+		return 4
+	case "FD", "OD", "OV", "SV", "UV":
+		return 8
+	}
+	return 0
+}
+
+// truncate returns `s` limited to the maximum value width, marking truncation with "...".
+func (d *dumper) truncate(s string) string {
+	if d.opts.MaxValueWidth <= 0 || utf8.RuneCountInString(s) <= d.opts.MaxValueWidth {
+		return s
+	}
+	return string([]rune(s)[:d.opts.MaxValueWidth]) + "..."
+}
+
+// printable replaces control characters, such as line breaks, such that each
+// value occupies a single line.
+func printable(r rune) rune {
+	if r < 0x20 || r == 0x7F {
+		return '.'
+	}
+	return r
+}
+
+// isPrivateTag returns whether `tag` is of a private group, being one of an odd number.
+func isPrivateTag(tag uint32) bool {
+	return (tag>>16)%2 == 1
+}
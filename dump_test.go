@@ -0,0 +1,108 @@
+package opendcm
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDump(t *testing.T) {
+	// ensures that `Dump` lists each element with its tag, VR, value, length,
+	// multiplicity and name, with items indented beneath their sequence.
+	t.Parallel()
+	ds := make(DataSet, 0)
+	item := make(DataSet, 0)
+	for _, ds := range []*DataSet{&ds, &item} {
+		e, err := NewElementWithValue(0x0020000E, "1.2.3")
+		assert.NoError(t, err)
+		ds.Put(e)
+	}
+	for tag, value := range map[uint32]interface{}{
+		0x00100010: "Müller^Hans",
+		0x00080008: []string{"ORIGINAL", "PRIMARY"},
+		0x00280010: 512,
+		0x00281050: []float64{40, 400},
+		0x00720060: uint32(0x00280010),
+		0x00284000: "Line 1\r\nLine 2",
+		0x00081115: []DataSet{item},
+		0x00091010: []byte{0x01, 0x02},
+		0x00100020: "",
+	} {
+		e, err := NewElementWithValue(tag, value)
+		assert.NoError(t, err)
+		ds.Put(e)
+	}
+	creator := NewElementWithTag(0x00090010)
+	creator.setVR("LO")
+	assert.NoError(t, creator.SetValue("PRIVATE CREATOR"))
+	ds.Put(creator)
+
+	buf := bytes.Buffer{}
+	assert.NoError(t, ds.Dump(&buf, DumpOptions{}))
+	assert.Equal(t, strings.Join([]string{
+		`(0008,0008) CS [ORIGINAL\PRIMARY]                       #   16, 2 ImageType`,
+		`(0008,1115) SQ (Sequence #=1)                           #  u/l, 1 ReferencedSeriesSequence`,
+		`  (FFFE,E000) na (Item #=1)                             #    -, 1 Item`,
+		`    (0020,000E) UI [1.2.3]                              #    5, 1 SeriesInstanceUID`,
+		`(0009,0010) LO [PRIVATE CREATOR]                        #   15, 1 PrivateCreator`,
+		`(0009,1010) UN 01\02                                    #    2, 1 Unknown(0009,1010)`,
+		`(0010,0010) PN [Müller^Hans]                            #   12, 1 PatientName`,
+		`(0010,0020) LO (no value available)                     #    0, 0 PatientID`,
+		`(0020,000E) UI [1.2.3]                                  #    5, 1 SeriesInstanceUID`,
+		`(0028,0010) US 512                                      #    2, 1 Rows`,
+		`(0028,1050) DS [40\400]                                 #    6, 2 WindowCenter`,
+		`(0028,4000) LT [Line 1..Line 2]                         #   14, 1 ImagePresentationComments`,
+		`(0072,0060) AT (0028,0010)                              #    4, 1 SelectorATValue`,
+		``,
+	}, "\n"), buf.String())
+
+	// truncated values, without private elements
+	buf.Reset()
+	assert.NoError(t, ds.Dump(&buf, DumpOptions{MaxValueWidth: 8, HidePrivate: true}))
+	assert.Contains(t, buf.String(), "(0008,0008) CS [ORIGINAL...]")
+	assert.NotContains(t, buf.String(), "(0009,")
+
+	// only the binary values which could be listed are formatted, while the
+	// multiplicity and length remain those of the whole value
+	matrix, err := NewElementWithValue(0x00181310, []uint16{256, 256, 0, 0})
+	assert.NoError(t, err)
+	ds.Put(matrix)
+	pd := newNativeDicom(1024, 1024, 1, 16, "", bytes.Repeat([]byte{0x01}, 1024*1024*2)).DataSet[pixelDataTag]
+	pd.datalen = uint32(len(pd.data))
+	ds.Put(pd)
+	buf.Reset()
+	assert.NoError(t, ds.Dump(&buf, DumpOptions{MaxValueWidth: 3}))
+	assert.Contains(t, buf.String(), "(0018,1310) US 256...                                   #    8, 4 AcquisitionMatrix")
+	assert.Contains(t, buf.String(), "(7FE0,0010) OW 010...                                   # 2097152, 1 PixelData")
+
+	// encapsulated pixel data is listed as its fragments
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	buf.Reset()
+	assert.NoError(t, dcm.Dump(&buf, DumpOptions{MaxValueWidth: 64}))
+	assert.Contains(t, buf.String(), "(7FE0,0010) OB (PixelSequence #=1)")
+	assert.Contains(t, buf.String(), "  (FFFE,E000) pi (Fragment of 4 bytes)")
+	assert.Equal(t, dcm.Len()+strings.Count(buf.String(), "(FFFE,E000)")+countNested(&dcm.DataSet), strings.Count(buf.String(), "\n"))
+}
+
+// countNested returns the number of elements within the items of `ds`, recursively.
+func countNested(ds *DataSet) int {
+	n := 0
+	for _, e := range *ds {
+		for i := range e.items {
+			n += e.items[i].dataset.Len() + countNested(&e.items[i].dataset)
+		}
+	}
+	return n
+}
+
+func TestDumpError(t *testing.T) {
+	// ensures that errors of the writer are returned.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	assert.Error(t, dcm.Dump(&failAfterN{failAfter: 100}, DumpOptions{}))
+}
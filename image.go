@@ -0,0 +1,415 @@
+package opendcm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+)
+
+/*
+===============================================================================
+	Image
+	---
+	Provides decoding of frames into an `image.Image`, according to the
+	Image Pixel module of the data set.
+===============================================================================
+*/
+
+// ImageParameters describes how the pixel data of each frame is encoded,
+// as per the Image Pixel module.
+// See http://dicom.nema.org/medical/dicom/current/output/chtml/part03/sect_C.7.6.3.html
+type ImageParameters struct {
+	Rows                      uint16
+	Columns                   uint16
+	SamplesPerPixel           uint16
+	PhotometricInterpretation string
+	// PlanarConfiguration is 0 if samples are interleaved (RGBRGB...), and 1
+	// if each sample is stored in its own plane (RR...GG...BB...)
+	PlanarConfiguration uint16
+	BitsAllocated       uint16
+	BitsStored          uint16
+	HighBit             uint16
+	// PixelRepresentation is 0 if samples are unsigned, and 1 if samples are
+	// stored in two's complement
+	PixelRepresentation uint16
+	// LittleEndian is the byte order of samples larger than one byte
+	LittleEndian bool
+	// Palette holds the red, green and blue lookup tables of "PALETTE COLOR" images
+	Palette [3]*LookupTable
+}
+
+// LookupTable maps stored values onto output values, as described by a
+// lookup table descriptor and its data.
+type LookupTable struct {
+	// FirstMapped is the stored value which maps onto the first entry of Data
+	FirstMapped int
+	// BitsPerEntry is the number of significant bits of each entry of Data
+	BitsPerEntry uint16
+	Data         []uint16
+}
+
+// Lookup returns the entry of the lookup table for stored value `v`.
+// Values outside of the range of the table are clamped to its first or last entry.
+func (lut *LookupTable) Lookup(v int) uint16 {
+	i := v - lut.FirstMapped
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(lut.Data):
+		i = len(lut.Data) - 1
+	}
+	return lut.Data[i]
+}
+
+// lookup8 returns the entry of the lookup table for stored value `v`, scaled to eight bits.
+func (lut *LookupTable) lookup8(v int) uint8 {
+	if lut.BitsPerEntry > 8 {
+		return uint8(lut.Lookup(v) >> (lut.BitsPerEntry - 8))
+	}
+	return uint8(lut.Lookup(v))
+}
+
+// GetImageParameters returns the parameters of the Image Pixel module.
+//
+// Missing attributes are left as zero, except for BitsStored and HighBit,
+// which default to BitsAllocated and BitsStored - 1 respectively.
+func (dcm *Dicom) GetImageParameters() (ImageParameters, error) {
+	params := ImageParameters{LittleEndian: true}
+	if ts := dcm.GetTransferSyntax(); ts != nil {
+		params.LittleEndian = ts.LittleEndian
+	}
+	for tag, dst := range map[uint32]*uint16{
+		0x00280010: &params.Rows,
+		0x00280011: &params.Columns,
+		0x00280002: &params.SamplesPerPixel,
+		0x00280006: &params.PlanarConfiguration,
+		0x00280100: &params.BitsAllocated,
+		0x00280101: &params.BitsStored,
+		0x00280102: &params.HighBit,
+		0x00280103: &params.PixelRepresentation,
+	} {
+		if _, dcm.err = dcm.GetElementValue(tag, dst); dcm.err != nil {
+			return params, dcm.err
+		}
+	}
+	if _, dcm.err = dcm.GetElementValue(0x00280004, &params.PhotometricInterpretation); dcm.err != nil {
+		return params, dcm.err
+	}
+	params.PhotometricInterpretation = strings.TrimSpace(params.PhotometricInterpretation)
+	// SamplesPerPixel is Type 1, but is commonly missing from monochrome images
+	if params.SamplesPerPixel == 0 {
+		params.SamplesPerPixel = 1
+	}
+	if !dcm.HasElement(0x00280101) {
+		params.BitsStored = params.BitsAllocated
+	}
+	if !dcm.HasElement(0x00280102) && params.BitsStored > 0 {
+		params.HighBit = params.BitsStored - 1
+	}
+
+	if params.PhotometricInterpretation == "PALETTE COLOR" {
+		for i, tags := range [3][2]uint32{
+			{0x00281101, 0x00281201},
+			{0x00281102, 0x00281202},
+			{0x00281103, 0x00281203},
+		} {
+			if params.Palette[i], dcm.err = dcm.lookupTable(tags[0], tags[1], params.PixelRepresentation == 1); dcm.err != nil {
+				return params, dcm.err
+			}
+		}
+	}
+	return params, nil
+}
+
+// lookupTable returns the lookup table described by the elements `descriptorTag`
+// and `dataTag`. Returns nil if the lookup table is not present.
+func (ds *DataSet) lookupTable(descriptorTag uint32, dataTag uint32, signed bool) (*LookupTable, error) {
+	descriptor := []uint16{}
+	data := NewElement()
+	if !ds.GetElement(dataTag, &data) {
+		return nil, nil
+	}
+	if _, err := ds.GetElementValue(descriptorTag, &descriptor); err != nil {
+		return nil, err
+	}
+	if len(descriptor) != 3 {
+		return nil, fmt.Errorf("lookup table descriptor %s should have three values", data.dictEntry)
+	}
+	if err := data.LoadValue(); err != nil {
+		return nil, err
+	}
+	// the number of entries is given by the first value, where 0 is 2^16
+	numEntries := int(descriptor[0])
+	if numEntries == 0 {
+		numEntries = 0x10000
+	}
+	lut := LookupTable{FirstMapped: int(descriptor[1]), BitsPerEntry: descriptor[2]}
+	// the first stored value mapped has the same representation as the pixel data
+	if signed {
+		lut.FirstMapped = int(int16(descriptor[1]))
+	}
+	if len(data.data) == numEntries {
+		// eight bit entries, packed as bytes
+		lut.Data = make([]uint16, numEntries)
+		for i, v := range data.data {
+			lut.Data[i] = uint16(v)
+		}
+	} else {
+		lut.Data = make([]uint16, 0, numEntries)
+		for _, v := range splitBinaryVM(data.data, 2) {
+			lut.Data = append(lut.Data, data.byteOrder().Uint16(v))
+		}
+	}
+	if len(lut.Data) == 0 {
+		return nil, fmt.Errorf("lookup table %s is empty", data.dictEntry)
+	}
+	return &lut, nil
+}
+
+// frameBits returns the number of bits occupied by each frame of native pixel data.
+func (params *ImageParameters) frameBits() int {
+	samplesPerPixel := int(params.SamplesPerPixel)
+	if params.PhotometricInterpretation == "YBR_FULL_422" {
+		// chrominance is sampled once for every two pixels
+		samplesPerPixel = 2
+	}
+	return int(params.Rows) * int(params.Columns) * samplesPerPixel * int(params.BitsAllocated)
+}
+
+// validate returns an error if the parameters are not supported, or if
+// `frame` is too short to hold the image that they describe.
+func (params *ImageParameters) validate(frame []byte) error {
+	if params.Rows == 0 || params.Columns == 0 {
+		return errors.New("image has no rows or columns")
+	}
+	switch params.BitsAllocated {
+	case 1, 8, 16, 32:
+	default:
+		return fmt.Errorf("BitsAllocated of %d is not supported", params.BitsAllocated)
+	}
+	if params.BitsStored == 0 || params.BitsStored > params.BitsAllocated {
+		return fmt.Errorf("BitsStored of %d is invalid for BitsAllocated of %d", params.BitsStored, params.BitsAllocated)
+	}
+	if params.HighBit < params.BitsStored-1 || params.HighBit >= params.BitsAllocated {
+		return fmt.Errorf("HighBit of %d is invalid for BitsStored of %d", params.HighBit, params.BitsStored)
+	}
+	if params.PhotometricInterpretation == "YBR_FULL_422" && params.Columns%2 != 0 {
+		// chrominance is shared by each pair of pixels of a row
+		return fmt.Errorf("YBR_FULL_422 requires an even number of columns, but Columns is %d", params.Columns)
+	}
+	if len(frame)*8 < params.frameBits() {
+		return fmt.Errorf("frame holds %d bytes, but %d are required", len(frame), (params.frameBits()+7)/8)
+	}
+	return nil
+}
+
+// sample returns the `i`th sample of `frame`, masked according to BitsStored and
+// HighBit, and sign-extended if PixelRepresentation is signed.
+func (params *ImageParameters) sample(frame []byte, i int) int64 {
+	order := binary.ByteOrder(binary.LittleEndian)
+	if !params.LittleEndian {
+		order = binary.BigEndian
+	}
+	var raw uint64
+	switch params.BitsAllocated {
+	case 1:
+		raw = uint64(frame[i/8]>>uint(i%8)) & 1
+	case 8:
+		raw = uint64(frame[i])
+	case 16:
+		raw = uint64(order.Uint16(frame[i*2:]))
+	case 32:
+		raw = uint64(order.Uint32(frame[i*4:]))
+	}
+	raw = (raw >> (params.HighBit + 1 - params.BitsStored)) & (1<<params.BitsStored - 1)
+	if params.PixelRepresentation == 1 && raw&(1<<(params.BitsStored-1)) != 0 {
+		return int64(raw) - 1<<params.BitsStored
+	}
+	return int64(raw)
+}
+
+// GetImage decodes the frame at `index` into an `image.Image`.
+// See: DecodeFrame for more information
+func (dcm *Dicom) GetImage(index int) (image.Image, error) {
+	params, err := dcm.GetImageParameters()
+	if err != nil {
+		return nil, err
+	}
+	frame, err := dcm.GetNativeFrame(index, &params)
+	if err != nil {
+		return nil, fmt.Errorf("GetImage: %v", err)
+	}
+	return DecodeFrame(frame, params)
+}
+
+// GetNativeFrame returns the frame at `index` as native pixel data, decompressing
+// it if the pixel data is encapsulated, and updating `params` to describe it.
+// `params` should initially be those returned by `GetImageParameters`.
+func (dcm *Dicom) GetNativeFrame(index int, params *ImageParameters) ([]byte, error) {
+	if index < 0 || index >= dcm.pixelData.NumFrames() {
+		return nil, fmt.Errorf("frame %d does not exist; dicom has %d frames", index, dcm.pixelData.NumFrames())
+	}
+	frame := dcm.pixelData.GetFrame(index)
+	if ts := dcm.GetTransferSyntax(); ts != nil && ts.Encapsulated {
+		return decompressFrame(ts, frame, params)
+	}
+	return frame, nil
+}
+
+// decompressFrame decompresses an encapsulated frame into native pixel data
+// with the codec registered for `ts`, updating `params` to describe the
+// decompressed frame.
+func decompressFrame(ts *TransferSyntax, frame []byte, params *ImageParameters) ([]byte, error) {
+	codec, err := LookupCodec(ts.UID)
+	if err != nil {
+		return nil, fmt.Errorf("decoding %s is not supported", ts.Name)
+	}
+	return codec.Decode(frame, params)
+}
+
+// DecodeFrame decodes a frame of native (uncompressed) pixel data into an `image.Image`.
+//
+// Monochrome images with BitsAllocated of 1 or 8 are decoded into an `*image.Gray`,
+// and otherwise into an `*image.Gray16`. Stored values are not rescaled, other than
+// the following: signed values are offset such that the minimum value is zero,
+// MONOCHROME1 values are inverted such that zero is black, bit-packed values are
+// scaled to 0 and 255, and values of more than 16 bits are truncated to 16 bits.
+//
+// RGB, YBR_FULL, YBR_FULL_422 and PALETTE COLOR images are decoded into an
+// `*image.RGBA`, except for RGB images with BitsAllocated of 16, which are
+// scaled into an `*image.RGBA64`.
+func DecodeFrame(frame []byte, params ImageParameters) (image.Image, error) {
+	if err := params.validate(frame); err != nil {
+		return nil, err
+	}
+	switch params.PhotometricInterpretation {
+	case "MONOCHROME1", "MONOCHROME2":
+		return decodeMonochrome(frame, &params), nil
+	case "RGB", "YBR_FULL":
+		return decodeColor(frame, &params)
+	case "YBR_FULL_422":
+		return decodeYBRFull422(frame, &params)
+	case "PALETTE COLOR":
+		return decodePaletteColor(frame, &params)
+	}
+	return nil, fmt.Errorf(`PhotometricInterpretation "%s" is not supported`, params.PhotometricInterpretation)
+}
+
+// decodeMonochrome decodes a MONOCHROME1 or MONOCHROME2 frame.
+func decodeMonochrome(frame []byte, params *ImageParameters) image.Image {
+	rect := image.Rect(0, 0, int(params.Columns), int(params.Rows))
+	maxValue := int64(1)<<params.BitsStored - 1
+	// signed values are offset such that the minimum value is zero
+	offset := int64(0)
+	if params.PixelRepresentation == 1 {
+		offset = 1 << (params.BitsStored - 1)
+	}
+	value := func(i int) int64 {
+		v := params.sample(frame, i) + offset
+		if params.PhotometricInterpretation == "MONOCHROME1" {
+			v = maxValue - v
+		}
+		return v
+	}
+
+	if params.BitsAllocated <= 8 {
+		img := image.NewGray(rect)
+		for i := range img.Pix {
+			if params.BitsAllocated == 1 {
+				img.Pix[i] = uint8(value(i) * 0xFF)
+			} else {
+				img.Pix[i] = uint8(value(i))
+			}
+		}
+		return img
+	}
+	img := image.NewGray16(rect)
+	shift := uint(0)
+	if params.BitsStored > 16 {
+		shift = uint(params.BitsStored - 16)
+	}
+	for i := 0; i < len(img.Pix)/2; i++ {
+		binary.BigEndian.PutUint16(img.Pix[i*2:], uint16(value(i)>>shift))
+	}
+	return img
+}
+
+// decodeColor decodes an RGB or YBR_FULL frame, with either planar configuration.
+func decodeColor(frame []byte, params *ImageParameters) (image.Image, error) {
+	if params.SamplesPerPixel != 3 {
+		return nil, fmt.Errorf("%s requires three samples per pixel, not %d", params.PhotometricInterpretation, params.SamplesPerPixel)
+	}
+	nPixels := int(params.Rows) * int(params.Columns)
+	samples := func(i int) (int64, int64, int64) {
+		if params.PlanarConfiguration == 1 {
+			return params.sample(frame, i), params.sample(frame, nPixels+i), params.sample(frame, 2*nPixels+i)
+		}
+		return params.sample(frame, i*3), params.sample(frame, i*3+1), params.sample(frame, i*3+2)
+	}
+	rect := image.Rect(0, 0, int(params.Columns), int(params.Rows))
+
+	switch {
+	case params.BitsAllocated == 8:
+		img := image.NewRGBA(rect)
+		for i := 0; i < nPixels; i++ {
+			a, b, c := samples(i)
+			r, g, bl := uint8(a), uint8(b), uint8(c)
+			if params.PhotometricInterpretation == "YBR_FULL" {
+				r, g, bl = color.YCbCrToRGB(uint8(a), uint8(b), uint8(c))
+			}
+			img.Pix[i*4], img.Pix[i*4+1], img.Pix[i*4+2], img.Pix[i*4+3] = r, g, bl, 0xFF
+		}
+		return img, nil
+	case params.BitsAllocated == 16 && params.PhotometricInterpretation == "RGB":
+		img := image.NewRGBA64(rect)
+		shift := 16 - params.BitsStored
+		for i := 0; i < nPixels; i++ {
+			r, g, b := samples(i)
+			img.SetRGBA64(i%int(params.Columns), i/int(params.Columns), color.RGBA64{
+				R: uint16(r) << shift, G: uint16(g) << shift, B: uint16(b) << shift, A: 0xFFFF,
+			})
+		}
+		return img, nil
+	}
+	return nil, fmt.Errorf("%s with BitsAllocated of %d is not supported", params.PhotometricInterpretation, params.BitsAllocated)
+}
+
+// decodeYBRFull422 decodes a YBR_FULL_422 frame, in which each pair of pixels
+// is stored as Y1 Y2 Cb Cr.
+func decodeYBRFull422(frame []byte, params *ImageParameters) (image.Image, error) {
+	if params.BitsAllocated != 8 {
+		return nil, fmt.Errorf("YBR_FULL_422 with BitsAllocated of %d is not supported", params.BitsAllocated)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(params.Columns), int(params.Rows)))
+	nPixels := int(params.Rows) * int(params.Columns)
+	for i := 0; i+1 < nPixels; i += 2 {
+		cb, cr := uint8(params.sample(frame, i*2+2)), uint8(params.sample(frame, i*2+3))
+		for j := 0; j < 2; j++ {
+			r, g, b := color.YCbCrToRGB(uint8(params.sample(frame, i*2+j)), cb, cr)
+			img.Pix[(i+j)*4], img.Pix[(i+j)*4+1], img.Pix[(i+j)*4+2], img.Pix[(i+j)*4+3] = r, g, b, 0xFF
+		}
+	}
+	return img, nil
+}
+
+// decodePaletteColor decodes a PALETTE COLOR frame, mapping each stored value
+// through the red, green and blue lookup tables.
+func decodePaletteColor(frame []byte, params *ImageParameters) (image.Image, error) {
+	for _, lut := range params.Palette {
+		if lut == nil {
+			return nil, errors.New("PALETTE COLOR requires red, green and blue lookup tables")
+		}
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(params.Columns), int(params.Rows)))
+	for i := 0; i < len(img.Pix)/4; i++ {
+		v := int(params.sample(frame, i))
+		img.Pix[i*4] = params.Palette[0].lookup8(v)
+		img.Pix[i*4+1] = params.Palette[1].lookup8(v)
+		img.Pix[i*4+2] = params.Palette[2].lookup8(v)
+		img.Pix[i*4+3] = 0xFF
+	}
+	return img, nil
+}
@@ -0,0 +1,282 @@
+package opendcm
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    Image
+===============================================================================
+*/
+
+// uint16Bytes returns `values` encoded as little endian uint16s.
+func uint16Bytes(values ...uint16) []byte {
+	b := make([]byte, len(values)*2)
+	for i, v := range values {
+		binary.LittleEndian.PutUint16(b[i*2:], v)
+	}
+	return b
+}
+
+// newCompressedDicom returns a dicom with the Image Pixel module described by
+// `params`, holding `frames` encapsulated with transfer syntax `transferSyntaxUID`.
+func newCompressedDicom(t *testing.T, transferSyntaxUID string, params ImageParameters, frames ...[]byte) Dicom {
+	dcm := newImagePixelDicom(params, strconv.Itoa(len(frames)))
+	assert.NoError(t, dcm.setEncapsulatedPixelData(transferSyntaxUID, frames))
+	return dcm
+}
+
+func TestDecodeFrameMonochrome(t *testing.T) {
+	// ensures that monochrome frames are decoded according to BitsStored,
+	// HighBit, PixelRepresentation and PhotometricInterpretation.
+	t.Parallel()
+	params := ImageParameters{
+		Rows: 1, Columns: 3, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	img, err := DecodeFrame([]byte{0, 127, 255}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 127, 255}, img.(*image.Gray).Pix)
+
+	// MONOCHROME1 is inverted
+	params.PhotometricInterpretation = "MONOCHROME1"
+	img, err = DecodeFrame([]byte{0, 127, 255}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{255, 128, 0}, img.(*image.Gray).Pix)
+
+	// bits outside of BitsStored are masked
+	params = ImageParameters{
+		Rows: 1, Columns: 2, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 16, BitsStored: 12, HighBit: 11, LittleEndian: true,
+	}
+	img, err = DecodeFrame(uint16Bytes(0xF123, 0x0FFF), params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0x0123}, img.At(0, 0))
+	assert.Equal(t, color.Gray16{Y: 0x0FFF}, img.At(1, 0))
+
+	// HighBit below the most significant bit
+	params.BitsStored, params.HighBit = 8, 11
+	img, err = DecodeFrame(uint16Bytes(0xFAB0, 0), params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0xAB}, img.At(0, 0))
+
+	// signed values are offset such that the minimum is zero
+	params.BitsStored, params.HighBit, params.PixelRepresentation = 16, 15, 1
+	img, err = DecodeFrame(uint16Bytes(0x8000, 0xFFFF), params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0}, img.At(0, 0))
+	assert.Equal(t, color.Gray16{Y: 0x7FFF}, img.At(1, 0))
+
+	// signed, with BitsStored less than BitsAllocated
+	params.BitsStored, params.HighBit = 12, 11
+	img, err = DecodeFrame(uint16Bytes(0x0800, 0x07FF), params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0}, img.At(0, 0))
+	assert.Equal(t, color.Gray16{Y: 0x0FFF}, img.At(1, 0))
+
+	// big endian
+	params = ImageParameters{
+		Rows: 1, Columns: 1, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 16, BitsStored: 16, HighBit: 15, LittleEndian: false,
+	}
+	img, err = DecodeFrame([]byte{0x12, 0x34}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0x1234}, img.At(0, 0))
+
+	// 32 bit values are truncated to 16 bits
+	params.BitsAllocated, params.BitsStored, params.HighBit, params.LittleEndian = 32, 32, 31, true
+	img, err = DecodeFrame([]byte{0x00, 0x00, 0x34, 0x12}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 0x1234}, img.At(0, 0))
+
+	// bit-packed
+	params = ImageParameters{
+		Rows: 2, Columns: 5, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 1, BitsStored: 1, HighBit: 0, LittleEndian: true,
+	}
+	img, err = DecodeFrame([]byte{0x21, 0x02}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{255, 0, 0, 0, 0, 255, 0, 0, 0, 255}, img.(*image.Gray).Pix)
+}
+
+func TestDecodeFrameColor(t *testing.T) {
+	// ensures that colour frames are decoded according to
+	// PhotometricInterpretation and PlanarConfiguration.
+	t.Parallel()
+	params := ImageParameters{
+		Rows: 1, Columns: 2, SamplesPerPixel: 3, PhotometricInterpretation: "RGB",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	img, err := DecodeFrame([]byte{1, 2, 3, 4, 5, 6}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 3, 255, 4, 5, 6, 255}, img.(*image.RGBA).Pix)
+
+	params.PlanarConfiguration = 1
+	img, err = DecodeFrame([]byte{1, 4, 2, 5, 3, 6}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{1, 2, 3, 255, 4, 5, 6, 255}, img.(*image.RGBA).Pix)
+
+	// 16 bit values are scaled to the full range
+	params = ImageParameters{
+		Rows: 1, Columns: 1, SamplesPerPixel: 3, PhotometricInterpretation: "RGB",
+		BitsAllocated: 16, BitsStored: 12, HighBit: 11, LittleEndian: true,
+	}
+	img, err = DecodeFrame(uint16Bytes(0x0FFF, 0x0800, 0), params)
+	assert.NoError(t, err)
+	assert.Equal(t, color.RGBA64{R: 0xFFF0, G: 0x8000, B: 0, A: 0xFFFF}, img.At(0, 0))
+
+	// YBR_FULL
+	params = ImageParameters{
+		Rows: 1, Columns: 2, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_FULL",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	img, err = DecodeFrame([]byte{128, 128, 128, 255, 128, 128}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{128, 128, 128, 255, 255, 255, 255, 255}, img.(*image.RGBA).Pix)
+
+	// YBR_FULL_422: each pair of pixels shares chrominance
+	params.PhotometricInterpretation = "YBR_FULL_422"
+	img, err = DecodeFrame([]byte{0, 255, 128, 128}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0, 0, 0, 255, 255, 255, 255, 255}, img.(*image.RGBA).Pix)
+	r, g, b := color.YCbCrToRGB(100, 50, 200)
+	img, err = DecodeFrame([]byte{100, 100, 50, 200}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{r, g, b, 255, r, g, b, 255}, img.(*image.RGBA).Pix)
+	// pixels are paired within each row, and so the number of columns is even
+	params.Columns = 3
+	_, err = DecodeFrame([]byte{100, 100, 50, 200, 100, 100, 50, 200}, params)
+	assert.Error(t, err)
+}
+
+func TestDecodeFramePaletteColor(t *testing.T) {
+	// ensures that PALETTE COLOR frames are mapped through their lookup tables.
+	t.Parallel()
+	params := ImageParameters{
+		Rows: 1, Columns: 4, SamplesPerPixel: 1, PhotometricInterpretation: "PALETTE COLOR",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	_, err := DecodeFrame([]byte{0, 1, 2, 3}, params)
+	assert.Error(t, err)
+
+	params.Palette = [3]*LookupTable{
+		{FirstMapped: 1, BitsPerEntry: 16, Data: []uint16{0x1000, 0x2000}},
+		{FirstMapped: 1, BitsPerEntry: 8, Data: []uint16{0x30, 0x40}},
+		{FirstMapped: 1, BitsPerEntry: 16, Data: []uint16{0xFFFF, 0}},
+	}
+	img, err := DecodeFrame([]byte{0, 1, 2, 3}, params)
+	assert.NoError(t, err)
+	// values outside of the lookup tables are clamped
+	assert.Equal(t, []uint8{
+		0x10, 0x30, 0xFF, 0xFF,
+		0x10, 0x30, 0xFF, 0xFF,
+		0x20, 0x40, 0x00, 0xFF,
+		0x20, 0x40, 0x00, 0xFF,
+	}, img.(*image.RGBA).Pix)
+}
+
+func TestDecodeFrameError(t *testing.T) {
+	// ensures that unsupported or invalid parameters result in an error.
+	t.Parallel()
+	valid := ImageParameters{
+		Rows: 2, Columns: 2, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	_, err := DecodeFrame(make([]byte, 4), valid)
+	assert.NoError(t, err)
+	for _, modify := range []func(p *ImageParameters){
+		func(p *ImageParameters) { p.Rows = 0 },
+		func(p *ImageParameters) { p.BitsAllocated = 12 },
+		func(p *ImageParameters) { p.BitsStored = 9 },
+		func(p *ImageParameters) { p.BitsStored = 0 },
+		func(p *ImageParameters) { p.HighBit = 8 },
+		func(p *ImageParameters) { p.HighBit = 6 },
+		func(p *ImageParameters) { p.Columns = 3 },
+		func(p *ImageParameters) { p.PhotometricInterpretation = "HSV" },
+		func(p *ImageParameters) { p.PhotometricInterpretation = "RGB" },
+	} {
+		params := valid
+		modify(&params)
+		_, err = DecodeFrame(make([]byte, 4), params)
+		assert.Error(t, err)
+	}
+}
+
+func TestGetImage(t *testing.T) {
+	// ensures that frames of a dicom are decoded according to its Image Pixel module.
+	t.Parallel()
+	dcm := newNativeDicom(2, 2, 3, 8, "2", sequentialBytes(16))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("YBR_FULL_422")
+	dcm.addElement(photometric)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+
+	params, err := dcm.GetImageParameters()
+	assert.NoError(t, err)
+	assert.Equal(t, ImageParameters{
+		Rows: 2, Columns: 2, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_FULL_422",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}, params)
+
+	// YBR_FULL_422 frames hold two samples per pixel
+	assert.Equal(t, 2, dcm.pixelData.NumFrames())
+	for i := 0; i < 2; i++ {
+		img, err := dcm.GetImage(i)
+		assert.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 2, 2), img.Bounds())
+	}
+	_, err = dcm.GetImage(2)
+	assert.Error(t, err)
+
+	// transfer syntax without a decoder
+	dcm = newCompressedDicom(t, "1.2.840.10008.1.2.4.100", params, []byte{0x00, 0x00, 0x01, 0xB3})
+	_, err = dcm.GetImage(0)
+	assert.Error(t, err)
+}
+
+func TestGetImageParametersPalette(t *testing.T) {
+	// ensures that palette lookup tables are read from the data set.
+	t.Parallel()
+	dcm := newNativeDicom(1, 2, 1, 16, "", uint16Bytes(0xFFFE, 0))
+	for tag, value := range map[uint32][]byte{
+		0x00280004: []byte("PALETTE COLOR "),
+		0x00280103: uint16Bytes(1),
+		// red: 16 bit entries, first mapped value of -2
+		0x00281101: uint16Bytes(2, 0xFFFE, 16),
+		0x00281201: uint16Bytes(0x1234, 0x5678),
+		// green: 8 bit entries, packed as bytes
+		0x00281102: uint16Bytes(2, 0xFFFE, 8),
+		0x00281202: []byte{0x12, 0x34},
+		// blue: 8 bit entries, stored as words
+		0x00281103: uint16Bytes(3, 0xFFFE, 8),
+		0x00281203: uint16Bytes(0x12, 0x34, 0x56),
+	} {
+		e := NewElementWithTag(tag)
+		e.data = value
+		dcm.addElement(e)
+	}
+	params, err := dcm.GetImageParameters()
+	assert.NoError(t, err)
+	assert.Equal(t, &LookupTable{FirstMapped: -2, BitsPerEntry: 16, Data: []uint16{0x1234, 0x5678}}, params.Palette[0])
+	assert.Equal(t, &LookupTable{FirstMapped: -2, BitsPerEntry: 8, Data: []uint16{0x12, 0x34}}, params.Palette[1])
+	assert.Equal(t, &LookupTable{FirstMapped: -2, BitsPerEntry: 8, Data: []uint16{0x12, 0x34, 0x56}}, params.Palette[2])
+
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint8{0x12, 0x12, 0x12, 0xFF, 0x56, 0x34, 0x56, 0xFF}, img.(*image.RGBA).Pix)
+
+	// invalid descriptor
+	e := NewElementWithTag(0x00281101)
+	e.data = uint16Bytes(2)
+	dcm.addElement(e)
+	_, err = dcm.GetImageParameters()
+	assert.Error(t, err)
+}
@@ -0,0 +1,902 @@
+package opendcm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"strings"
+)
+
+/*
+===============================================================================
+	JPEG
+	---
+	Provides decoding of frames compressed with JPEG Baseline (Process 1),
+	JPEG Extended (Process 2 & 4) and JPEG Lossless (Process 14). Eight bit
+	lossy frames are decoded by `image/jpeg`; all others are decoded by
+	`jpegDecoder`.
+===============================================================================
+*/
+
+// JPEG markers, as per "Table B.1" of ITU-T T.81
+const (
+	jpegMarkerSOF0 = 0xC0 // baseline DCT
+	jpegMarkerSOF1 = 0xC1 // extended sequential DCT, Huffman coding
+	jpegMarkerSOF2 = 0xC2 // progressive DCT, Huffman coding
+	jpegMarkerSOF3 = 0xC3 // lossless (sequential), Huffman coding
+	jpegMarkerDHT  = 0xC4
+	jpegMarkerJPG  = 0xC8
+	jpegMarkerDAC  = 0xCC
+	jpegMarkerRST0 = 0xD0
+	jpegMarkerRST7 = 0xD7
+	jpegMarkerSOI  = 0xD8
+	jpegMarkerEOI  = 0xD9
+	jpegMarkerSOS  = 0xDA
+	jpegMarkerDQT  = 0xDB
+	jpegMarkerDRI  = 0xDD
+)
+
+// jpegZigzag maps the zig-zag order of coefficients onto their natural order.
+var jpegZigzag = [64]int{
+	0, 1, 8, 16, 9, 2, 3, 10,
+	17, 24, 32, 25, 18, 11, 4, 5,
+	12, 19, 26, 33, 40, 48, 41, 34,
+	27, 20, 13, 6, 7, 14, 21, 28,
+	35, 42, 49, 56, 57, 50, 43, 36,
+	29, 22, 15, 23, 30, 37, 44, 51,
+	58, 59, 52, 45, 38, 31, 39, 46,
+	53, 60, 61, 54, 47, 55, 62, 63,
+}
+
+// jpegIDCTTable holds the basis of the inverse DCT, such that
+// jpegIDCTTable[x][u] = C(u)/2 * cos((2x+1)uπ/16)
+var jpegIDCTTable = func() (table [8][8]float64) {
+	for x := 0; x < 8; x++ {
+		for u := 0; u < 8; u++ {
+			c := 1.0
+			if u == 0 {
+				c = 1 / math.Sqrt2
+			}
+			table[x][u] = c / 2 * math.Cos(float64(2*x+1)*float64(u)*math.Pi/16)
+		}
+	}
+	return
+}()
+
+// huffmanTable is a Huffman table as defined by a DHT segment, in the form
+// described by "F.2.2.3" of ITU-T T.81.
+type huffmanTable struct {
+	minCode [17]int32
+	maxCode [17]int32
+	valPtr  [17]int32
+	values  []byte
+}
+
+// newHuffmanTable returns the Huffman table described by the number of codes
+// of each length (1-16), and the values of each code in order.
+func newHuffmanTable(counts [16]byte, values []byte) (*huffmanTable, error) {
+	h := huffmanTable{values: values}
+	code, k := int32(0), int32(0)
+	for l := 1; l <= 16; l++ {
+		n := int32(counts[l-1])
+		h.valPtr[l] = k
+		h.minCode[l] = code
+		h.maxCode[l] = -1
+		if n > 0 {
+			h.maxCode[l] = code + n - 1
+		}
+		code += n
+		k += n
+		if code > 1<<uint(l) {
+			return nil, errors.New("invalid Huffman table")
+		}
+		code <<= 1
+	}
+	if int(k) != len(values) {
+		return nil, errors.New("invalid Huffman table")
+	}
+	return &h, nil
+}
+
+// jpegBitReader reads the bits of an entropy-coded segment, removing stuffed
+// bytes. Once a marker is reached, zero bits are returned.
+type jpegBitReader struct {
+	src []byte
+	pos int
+	acc uint32
+	n   uint
+}
+
+// fill ensures that at least 25 bits are available.
+func (br *jpegBitReader) fill() {
+	for br.n <= 24 {
+		b := byte(0)
+		if br.pos < len(br.src) {
+			b = br.src[br.pos]
+			if b != 0xFF {
+				br.pos++
+			} else if br.pos+1 < len(br.src) && br.src[br.pos+1] == 0x00 {
+				// stuffed byte
+				br.pos += 2
+			} else {
+				// reached a marker
+				b = 0
+			}
+		}
+		br.acc |= uint32(b) << (24 - br.n)
+		br.n += 8
+	}
+}
+
+// receive returns the next `n` bits, where `n` is at most 16.
+func (br *jpegBitReader) receive(n uint) int32 {
+	if n == 0 {
+		return 0
+	}
+	br.fill()
+	v := br.acc >> (32 - n)
+	br.acc <<= n
+	br.n -= n
+	return int32(v)
+}
+
+// receiveExtend returns the next `n` bits, as a signed value of magnitude category `n`.
+func (br *jpegBitReader) receiveExtend(n uint) int32 {
+	v := br.receive(n)
+	if n > 0 && v < 1<<(n-1) {
+		v += -1<<n + 1
+	}
+	return v
+}
+
+// decodeHuffman returns the next value coded with Huffman table `h`.
+func (br *jpegBitReader) decodeHuffman(h *huffmanTable) (byte, error) {
+	code := int32(0)
+	for l := 1; l <= 16; l++ {
+		code = code<<1 | br.receive(1)
+		if code <= h.maxCode[l] {
+			return h.values[h.valPtr[l]+code-h.minCode[l]], nil
+		}
+	}
+	return 0, errors.New("invalid Huffman code")
+}
+
+// restart discards remaining bits, and skips the RST marker expected at the
+// current position, if present.
+func (br *jpegBitReader) restart() {
+	br.acc, br.n = 0, 0
+	if br.pos+1 < len(br.src) && br.src[br.pos] == 0xFF &&
+		br.src[br.pos+1] >= jpegMarkerRST0 && br.src[br.pos+1] <= jpegMarkerRST7 {
+		br.pos += 2
+	}
+}
+
+// jpegComponent is a component of a frame, as defined by a SOF segment.
+type jpegComponent struct {
+	id byte
+	// h and v are the horizontal and vertical sampling factors
+	h, v int
+	tq   byte
+	// td and ta are the DC and AC Huffman tables of the current scan
+	td, ta byte
+	pred   int32
+	// firstRow is the first row of the current restart interval, and reset
+	// indicates that the next sample begins a restart interval (lossless only)
+	firstRow int
+	reset    bool
+	// plane holds the decoded samples, which may extend beyond the frame
+	plane  []int32
+	stride int
+}
+
+// jpegStream is a stream of marker segments and entropy-coded data, which is
+// common to JPEG and JPEG-LS.
+type jpegStream struct {
+	src []byte
+	pos int
+}
+
+// jpegDecoder decodes JPEG frames of any precision which are not supported by
+// `image/jpeg`.
+type jpegDecoder struct {
+	jpegStream
+	sof             byte
+	precision       int
+	width, height   int
+	hmax, vmax      int
+	components      []jpegComponent
+	quant           [4][64]int32
+	dcTables        [4]*huffmanTable
+	acTables        [4]*huffmanTable
+	restartInterval int
+	br              jpegBitReader
+	// params, if not nil, describe the Image Pixel module which the frame
+	// must match. See: `checkFrameSize`
+	params *ImageParameters
+}
+
+// isJPEGSOF returns whether `marker` is a start of frame marker, of any process.
+func isJPEGSOF(marker byte) bool {
+	return marker >= jpegMarkerSOF0 && marker <= 0xCF && marker != jpegMarkerDHT && marker != jpegMarkerJPG && marker != jpegMarkerDAC
+}
+
+// readSegment returns the content of the marker segment at the current position.
+func (d *jpegStream) readSegment() ([]byte, error) {
+	if d.pos+2 > len(d.src) {
+		return nil, errors.New("unexpected end of JPEG stream")
+	}
+	length := int(d.src[d.pos])<<8 | int(d.src[d.pos+1])
+	if length < 2 || d.pos+length > len(d.src) {
+		return nil, errors.New("invalid JPEG segment length")
+	}
+	segment := d.src[d.pos+2 : d.pos+length]
+	d.pos += length
+	return segment, nil
+}
+
+// nextMarker returns the next marker, skipping any fill bytes and entropy-coded
+// data. Returns false if the end of the stream is reached.
+func (d *jpegStream) nextMarker() (byte, bool) {
+	for d.pos+1 < len(d.src) {
+		// within entropy-coded data, 0xFF is followed by a stuffed byte below
+		// 0x80: 0x00 in JPEG, or any byte with a zero high bit in JPEG-LS
+		if d.src[d.pos] != 0xFF || d.src[d.pos+1] < 0x80 || d.src[d.pos+1] == 0xFF {
+			d.pos++
+			continue
+		}
+		marker := d.src[d.pos+1]
+		d.pos += 2
+		return marker, true
+	}
+	return 0, false
+}
+
+// decode decodes the frame into the planes of each component.
+func (d *jpegDecoder) decode() error {
+	if !bytes.HasPrefix(d.src, jpegSOI) {
+		return errors.New("JPEG stream does not begin with SOI marker")
+	}
+	d.pos = 2
+	scans := 0
+	for {
+		marker, found := d.nextMarker()
+		if !found || marker == jpegMarkerEOI {
+			if scans == 0 {
+				return errors.New("JPEG stream holds no scans")
+			}
+			return nil
+		}
+		if marker >= jpegMarkerRST0 && marker <= jpegMarkerRST7 {
+			continue
+		}
+		segment, err := d.readSegment()
+		if err != nil {
+			return err
+		}
+		switch marker {
+		case jpegMarkerSOF0, jpegMarkerSOF1, jpegMarkerSOF3:
+			err = d.parseSOF(marker, segment)
+		case jpegMarkerDHT:
+			err = d.parseDHT(segment)
+		case jpegMarkerDQT:
+			err = d.parseDQT(segment)
+		case jpegMarkerDRI:
+			if len(segment) < 2 {
+				return errors.New("invalid DRI segment")
+			}
+			d.restartInterval = int(segment[0])<<8 | int(segment[1])
+		case jpegMarkerSOS:
+			err = d.parseSOS(segment)
+			scans++
+		default:
+			if isJPEGSOF(marker) {
+				return fmt.Errorf("JPEG process of marker 0x%02X is not supported", marker)
+			}
+			// APPn, COM, and others which do not affect decoding
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseSOF parses a start of frame segment.
+func (d *jpegDecoder) parseSOF(marker byte, segment []byte) error {
+	if d.components != nil {
+		return errors.New("JPEG stream holds multiple frames")
+	}
+	if len(segment) < 6 || len(segment) < 6+3*int(segment[5]) || segment[5] == 0 {
+		return errors.New("invalid SOF segment")
+	}
+	d.sof = marker
+	d.precision = int(segment[0])
+	d.height = int(segment[1])<<8 | int(segment[2])
+	d.width = int(segment[3])<<8 | int(segment[4])
+	if d.precision < 2 || d.precision > 16 || d.width == 0 || d.height == 0 {
+		return errors.New("invalid SOF segment")
+	}
+	if d.params != nil {
+		if err := checkFrameSize(d.width, d.height, int(segment[5]), d.params); err != nil {
+			return err
+		}
+	}
+	d.components = make([]jpegComponent, segment[5])
+	d.hmax, d.vmax = 1, 1
+	for i := range d.components {
+		c := &d.components[i]
+		c.id = segment[6+i*3]
+		c.h, c.v = int(segment[7+i*3]>>4), int(segment[7+i*3]&0x0F)
+		c.tq = segment[8+i*3] & 0x03
+		if c.h < 1 || c.h > 4 || c.v < 1 || c.v > 4 {
+			return errors.New("invalid sampling factors")
+		}
+		if c.h > d.hmax {
+			d.hmax = c.h
+		}
+		if c.v > d.vmax {
+			d.vmax = c.v
+		}
+	}
+	// planes are allocated to hold every block of every MCU
+	mcusX, mcusY := d.mcus()
+	for i := range d.components {
+		c := &d.components[i]
+		c.stride = mcusX * c.h * d.dataUnitSize()
+		c.plane = make([]int32, c.stride*mcusY*c.v*d.dataUnitSize())
+	}
+	return nil
+}
+
+// dataUnitSize returns the width and height of each data unit: an 8x8 block
+// for DCT processes, and a single sample for the lossless process.
+func (d *jpegDecoder) dataUnitSize() int {
+	if d.sof == jpegMarkerSOF3 {
+		return 1
+	}
+	return 8
+}
+
+// mcus returns the number of MCUs in each row and column of an interleaved scan.
+func (d *jpegDecoder) mcus() (int, int) {
+	size := d.dataUnitSize()
+	return (d.width + size*d.hmax - 1) / (size * d.hmax), (d.height + size*d.vmax - 1) / (size * d.vmax)
+}
+
+// parseDHT parses a Huffman table segment, which may define several tables.
+func (d *jpegDecoder) parseDHT(segment []byte) error {
+	for len(segment) > 0 {
+		if len(segment) < 17 {
+			return errors.New("invalid DHT segment")
+		}
+		class, id := segment[0]>>4, segment[0]&0x03
+		var counts [16]byte
+		copy(counts[:], segment[1:17])
+		total := 0
+		for _, n := range counts {
+			total += int(n)
+		}
+		if len(segment) < 17+total {
+			return errors.New("invalid DHT segment")
+		}
+		h, err := newHuffmanTable(counts, segment[17:17+total])
+		if err != nil {
+			return err
+		}
+		if class == 0 {
+			d.dcTables[id] = h
+		} else {
+			d.acTables[id] = h
+		}
+		segment = segment[17+total:]
+	}
+	return nil
+}
+
+// parseDQT parses a quantization table segment, which may define several tables.
+func (d *jpegDecoder) parseDQT(segment []byte) error {
+	for len(segment) > 0 {
+		precision, id := segment[0]>>4, segment[0]&0x03
+		segment = segment[1:]
+		size := 64 * (int(precision) + 1)
+		if len(segment) < size {
+			return errors.New("invalid DQT segment")
+		}
+		for k := 0; k < 64; k++ {
+			if precision == 0 {
+				d.quant[id][k] = int32(segment[k])
+			} else {
+				d.quant[id][k] = int32(segment[k*2])<<8 | int32(segment[k*2+1])
+			}
+		}
+		segment = segment[size:]
+	}
+	return nil
+}
+
+// parseSOS parses a start of scan segment, and decodes the scan that follows it.
+func (d *jpegDecoder) parseSOS(segment []byte) error {
+	if d.components == nil {
+		return errors.New("JPEG scan precedes SOF segment")
+	}
+	if len(segment) < 1 || len(segment) < 4+2*int(segment[0]) || segment[0] == 0 {
+		return errors.New("invalid SOS segment")
+	}
+	scan := make([]*jpegComponent, segment[0])
+	for i := range scan {
+		id := segment[1+i*2]
+		for j := range d.components {
+			if d.components[j].id == id {
+				scan[i] = &d.components[j]
+			}
+		}
+		if scan[i] == nil {
+			return fmt.Errorf("JPEG scan references unknown component %d", id)
+		}
+		scan[i].td, scan[i].ta = segment[2+i*2]>>4&0x03, segment[2+i*2]&0x03
+		scan[i].pred = 0
+	}
+	d.br = jpegBitReader{src: d.src, pos: d.pos}
+	var err error
+	if d.sof == jpegMarkerSOF3 {
+		// the start of spectral selection holds the predictor, and the
+		// successive approximation bit position low holds the point transform
+		tail := segment[1+2*len(scan):]
+		err = d.decodeLosslessScan(scan, int(tail[0]), uint(tail[2]&0x0F))
+	} else {
+		err = d.decodeDCTScan(scan)
+	}
+	d.pos = d.br.pos
+	return err
+}
+
+// decodeDCTScan decodes the entropy-coded segment of a sequential DCT scan.
+func (d *jpegDecoder) decodeDCTScan(scan []*jpegComponent) error {
+	for _, c := range scan {
+		if d.dcTables[c.td] == nil || d.acTables[c.ta] == nil {
+			return errors.New("JPEG scan references undefined Huffman table")
+		}
+	}
+	var coefficients [64]int32
+	decodeBlock := func(c *jpegComponent, bx, by int) error {
+		if err := d.decodeBlock(c, &coefficients); err != nil {
+			return err
+		}
+		d.inverseDCT(c, &coefficients, bx, by)
+		return nil
+	}
+
+	mcusX, mcusY := d.mcus()
+	if len(scan) == 1 {
+		// a non-interleaved scan holds only the blocks within the component
+		c := scan[0]
+		mcusX = ((d.width*c.h+d.hmax-1)/d.hmax + 7) / 8
+		mcusY = ((d.height*c.v+d.vmax-1)/d.vmax + 7) / 8
+	}
+	for mcu := 0; mcu < mcusX*mcusY; mcu++ {
+		if d.restartInterval > 0 && mcu > 0 && mcu%d.restartInterval == 0 {
+			d.br.restart()
+			for _, c := range scan {
+				c.pred = 0
+			}
+		}
+		mx, my := mcu%mcusX, mcu/mcusX
+		if len(scan) == 1 {
+			if err := decodeBlock(scan[0], mx, my); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, c := range scan {
+			for y := 0; y < c.v; y++ {
+				for x := 0; x < c.h; x++ {
+					if err := decodeBlock(c, mx*c.h+x, my*c.v+y); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// decodeBlock decodes the dequantized coefficients of a block, in natural order.
+func (d *jpegDecoder) decodeBlock(c *jpegComponent, dst *[64]int32) error {
+	*dst = [64]int32{}
+	quant := &d.quant[c.tq]
+	s, err := d.br.decodeHuffman(d.dcTables[c.td])
+	if err != nil {
+		return err
+	}
+	c.pred += d.br.receiveExtend(uint(s))
+	dst[0] = c.pred * quant[0]
+	for k := 1; k < 64; k++ {
+		rs, err := d.br.decodeHuffman(d.acTables[c.ta])
+		if err != nil {
+			return err
+		}
+		r, s := int(rs>>4), uint(rs&0x0F)
+		if s == 0 {
+			if r != 15 {
+				break // end of block
+			}
+			k += 15
+			continue
+		}
+		k += r
+		if k > 63 {
+			return errors.New("invalid JPEG coefficient index")
+		}
+		dst[jpegZigzag[k]] = d.br.receiveExtend(s) * quant[k]
+	}
+	return nil
+}
+
+// decodeLosslessScan decodes the entropy-coded segment of a lossless scan, as per
+// "Annex H" of ITU-T T.81, with predictor `predictor` and point transform `pointTransform`.
+func (d *jpegDecoder) decodeLosslessScan(scan []*jpegComponent, predictor int, pointTransform uint) error {
+	if predictor < 1 || predictor > 7 {
+		return fmt.Errorf("JPEG lossless predictor %d is not supported", predictor)
+	}
+	if int(pointTransform) >= d.precision {
+		return fmt.Errorf("JPEG lossless point transform %d is invalid", pointTransform)
+	}
+	for _, c := range scan {
+		if d.dcTables[c.td] == nil {
+			return errors.New("JPEG scan references undefined Huffman table")
+		}
+		c.reset = true
+	}
+	initial := int32(1) << (uint(d.precision) - pointTransform - 1)
+
+	mcusX, mcusY := d.mcus()
+	if len(scan) == 1 {
+		// a non-interleaved scan holds only the samples within the component
+		c := scan[0]
+		mcusX = (d.width*c.h + d.hmax - 1) / d.hmax
+		mcusY = (d.height*c.v + d.vmax - 1) / d.vmax
+	}
+	for mcu := 0; mcu < mcusX*mcusY; mcu++ {
+		if d.restartInterval > 0 && mcu > 0 && mcu%d.restartInterval == 0 {
+			d.br.restart()
+			for _, c := range scan {
+				c.reset = true
+			}
+		}
+		mx, my := mcu%mcusX, mcu/mcusX
+		if len(scan) == 1 {
+			if err := d.decodeSample(scan[0], mx, my, predictor, initial); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, c := range scan {
+			for y := 0; y < c.v; y++ {
+				for x := 0; x < c.h; x++ {
+					if err := d.decodeSample(c, mx*c.h+x, my*c.v+y, predictor, initial); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	if pointTransform > 0 {
+		for _, c := range scan {
+			for i := range c.plane {
+				c.plane[i] <<= pointTransform
+			}
+		}
+	}
+	return nil
+}
+
+// decodeSample decodes the sample of component `c` at (`x`, `y`), which is
+// predicted from its neighbours: Ra to the left, Rb above, and Rc above left.
+func (d *jpegDecoder) decodeSample(c *jpegComponent, x, y int, predictor int, initial int32) error {
+	s, err := d.br.decodeHuffman(d.dcTables[c.td])
+	if err != nil {
+		return err
+	}
+	var diff int32
+	switch {
+	case s == 16:
+		// no additional bits follow
+		diff = 32768
+	case s > 16:
+		return fmt.Errorf("invalid JPEG lossless difference category %d", s)
+	default:
+		diff = d.br.receiveExtend(uint(s))
+	}
+
+	row := c.plane[y*c.stride:]
+	var prediction int32
+	switch {
+	case c.reset:
+		// the first sample of each restart interval
+		prediction = initial
+		c.reset = false
+		c.firstRow = y
+	case y == c.firstRow:
+		// the first row of each restart interval
+		prediction = row[x-1]
+	case x == 0:
+		prediction = c.plane[(y-1)*c.stride]
+	default:
+		ra, rb, rc := row[x-1], c.plane[(y-1)*c.stride+x], c.plane[(y-1)*c.stride+x-1]
+		switch predictor {
+		case 1:
+			prediction = ra
+		case 2:
+			prediction = rb
+		case 3:
+			prediction = rc
+		case 4:
+			prediction = ra + rb - rc
+		case 5:
+			prediction = ra + (rb-rc)>>1
+		case 6:
+			prediction = rb + (ra-rc)>>1
+		case 7:
+			prediction = (ra + rb) / 2
+		}
+	}
+	// differences are modulo 2^16
+	row[x] = (prediction + diff) & 0xFFFF
+	return nil
+}
+
+// inverseDCT writes the samples of a block with coefficients `src` into the
+// plane of component `c`, at block position (`bx`, `by`).
+func (d *jpegDecoder) inverseDCT(c *jpegComponent, src *[64]int32, bx, by int) {
+	var tmp [8][8]float64
+	for v := 0; v < 8; v++ {
+		for x := 0; x < 8; x++ {
+			sum := 0.0
+			for u := 0; u < 8; u++ {
+				sum += jpegIDCTTable[x][u] * float64(src[v*8+u])
+			}
+			tmp[v][x] = sum
+		}
+	}
+	shift := float64(int32(1) << uint(d.precision-1))
+	maxValue := int32(1)<<uint(d.precision) - 1
+	for y := 0; y < 8; y++ {
+		row := c.plane[(by*8+y)*c.stride+bx*8:]
+		for x := 0; x < 8; x++ {
+			sum := 0.0
+			for v := 0; v < 8; v++ {
+				sum += jpegIDCTTable[y][v] * tmp[v][x]
+			}
+			sample := int32(math.Floor(sum + shift + 0.5))
+			if sample < 0 {
+				sample = 0
+			} else if sample > maxValue {
+				sample = maxValue
+			}
+			row[x] = sample
+		}
+	}
+}
+
+// planes returns the samples of each component at the full resolution of the frame.
+func (d *jpegDecoder) planes() [][]int32 {
+	planes := make([][]int32, len(d.components))
+	for i := range d.components {
+		c := &d.components[i]
+		planes[i] = make([]int32, d.width*d.height)
+		for y := 0; y < d.height; y++ {
+			row := c.plane[(y*c.v/d.vmax)*c.stride:]
+			for x := 0; x < d.width; x++ {
+				planes[i][y*d.width+x] = row[x*c.h/d.hmax]
+			}
+		}
+	}
+	return planes
+}
+
+// jpegFrameHeader returns the SOF marker of `frame`, and the sample precision
+// declared by its segment.
+func jpegFrameHeader(frame []byte) (byte, int, error) {
+	d := jpegStream{src: frame, pos: 2}
+	for {
+		marker, found := d.nextMarker()
+		if !found || marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			return 0, 0, errors.New("JPEG stream holds no SOF segment")
+		}
+		if marker >= jpegMarkerRST0 && marker <= jpegMarkerRST7 {
+			continue
+		}
+		segment, err := d.readSegment()
+		if err != nil {
+			return 0, 0, err
+		}
+		if isJPEGSOF(marker) {
+			if len(segment) < 1 {
+				return 0, 0, errors.New("invalid SOF segment")
+			}
+			return marker, int(segment[0]), nil
+		}
+	}
+}
+
+// imagePlanes returns the samples of each component of an image decoded by
+// `image/jpeg`, and whether the samples are YCbCr.
+func imagePlanes(img image.Image) ([][]int32, bool, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	switch img := img.(type) {
+	case *image.Gray:
+		plane := make([]int32, width*height)
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				plane[y*width+x] = int32(img.Pix[y*img.Stride+x])
+			}
+		}
+		return [][]int32{plane}, false, nil
+	case *image.YCbCr:
+		planes := [][]int32{make([]int32, width*height), make([]int32, width*height), make([]int32, width*height)}
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				c := img.YCbCrAt(bounds.Min.X+x, bounds.Min.Y+y)
+				planes[0][y*width+x], planes[1][y*width+x], planes[2][y*width+x] = int32(c.Y), int32(c.Cb), int32(c.Cr)
+			}
+		}
+		return planes, true, nil
+	case *image.RGBA:
+		planes := [][]int32{make([]int32, width*height), make([]int32, width*height), make([]int32, width*height)}
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				c := img.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+				planes[0][y*width+x], planes[1][y*width+x], planes[2][y*width+x] = int32(c.R), int32(c.G), int32(c.B)
+			}
+		}
+		return planes, false, nil
+	}
+	return nil, false, fmt.Errorf("JPEG image of type %T is not supported", img)
+}
+
+// DecodeJPEG decompresses a JPEG Baseline, Extended or Lossless frame into
+// native pixel data, updating `params` to describe the decompressed frame.
+//
+// Samples of YBR photometric interpretations are converted to RGB, in which
+// case PhotometricInterpretation becomes "RGB". Decompressed samples are little
+// endian, and interleaved (PlanarConfiguration 0).
+//
+// An error is returned, before the frame is decoded, if its dimensions or
+// components do not match `params`.
+func DecodeJPEG(frame []byte, params *ImageParameters) ([]byte, error) {
+	marker, precision, err := jpegFrameHeader(frame)
+	if err != nil {
+		return nil, err
+	}
+	var planes [][]int32
+	// whether the samples are YCbCr, as opposed to RGB
+	isYCbCr := strings.HasPrefix(params.PhotometricInterpretation, "YBR")
+	width, height := 0, 0
+	if precision == 8 && marker != jpegMarkerSOF3 {
+		config, err := jpeg.DecodeConfig(bytes.NewReader(frame))
+		if err != nil {
+			return nil, err
+		}
+		components := 3
+		switch config.ColorModel {
+		case color.GrayModel:
+			components = 1
+		case color.CMYKModel:
+			components = 4
+		}
+		if err = checkFrameSize(config.Width, config.Height, components, params); err != nil {
+			return nil, err
+		}
+		img, err := jpeg.Decode(bytes.NewReader(frame))
+		if err != nil {
+			return nil, err
+		}
+		if planes, isYCbCr, err = imagePlanes(img); err != nil {
+			return nil, err
+		}
+		// `image/jpeg` does not convert YCbCr, however an RGB photometric
+		// interpretation indicates that the components are not YCbCr
+		isYCbCr = isYCbCr && params.PhotometricInterpretation != "RGB"
+		width, height = img.Bounds().Dx(), img.Bounds().Dy()
+	} else {
+		d := jpegDecoder{jpegStream: jpegStream{src: frame}, params: params}
+		if err = d.decode(); err != nil {
+			return nil, err
+		}
+		planes = d.planes()
+		width, height = d.width, d.height
+	}
+	if len(planes) == 3 && isYCbCr {
+		ycbcrToRGB(planes, precision)
+	}
+	dst, err := nativeFromPlanes(planes, width, height, precision, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(planes) == 3 {
+		params.PhotometricInterpretation = "RGB"
+	}
+	return dst, nil
+}
+
+// checkFrameSize returns an error if a frame of `width` x `height` samples of
+// `components` components does not match the Image Pixel module described by
+// `params`. Decoders check this before allocating for the frame, as a corrupt
+// frame header could otherwise demand any amount of memory.
+func checkFrameSize(width, height, components int, params *ImageParameters) error {
+	if width != int(params.Columns) || height != int(params.Rows) {
+		return fmt.Errorf("frame is %dx%d, but Columns and Rows are %dx%d", width, height, params.Columns, params.Rows)
+	}
+	if components != int(params.SamplesPerPixel) {
+		return fmt.Errorf("frame has %d components, but SamplesPerPixel is %d", components, params.SamplesPerPixel)
+	}
+	return nil
+}
+
+// nativeFromPlanes returns native pixel data holding the decompressed `planes`
+// of a `width` x `height` frame with `precision` bits, updating `params` to
+// describe it. PhotometricInterpretation is left to the caller.
+func nativeFromPlanes(planes [][]int32, width, height, precision int, params *ImageParameters) ([]byte, error) {
+	if width != int(params.Columns) || height != int(params.Rows) {
+		return nil, fmt.Errorf("frame is %dx%d, but Columns and Rows are %dx%d", width, height, params.Columns, params.Rows)
+	}
+	if len(planes) != 1 && len(planes) != 3 {
+		return nil, fmt.Errorf("frame with %d components is not supported", len(planes))
+	}
+	if precision < 1 || precision > 16 {
+		return nil, fmt.Errorf("frame with precision of %d bits is not supported", precision)
+	}
+	params.SamplesPerPixel = uint16(len(planes))
+	params.PlanarConfiguration = 0
+	params.LittleEndian = true
+	params.BitsStored = uint16(precision)
+	params.HighBit = uint16(precision - 1)
+	params.BitsAllocated = 8
+	if precision > 8 {
+		params.BitsAllocated = 16
+	}
+	return interleavePlanes(planes, int(params.BitsAllocated)), nil
+}
+
+// ycbcrToRGB converts planes of YCbCr samples with `precision` bits into RGB, in place.
+func ycbcrToRGB(planes [][]int32, precision int) {
+	if precision == 8 {
+		for i := range planes[0] {
+			r, g, b := color.YCbCrToRGB(uint8(planes[0][i]), uint8(planes[1][i]), uint8(planes[2][i]))
+			planes[0][i], planes[1][i], planes[2][i] = int32(r), int32(g), int32(b)
+		}
+		return
+	}
+	shift := float64(int32(1) << uint(precision-1))
+	maxValue := float64(int32(1)<<uint(precision) - 1)
+	clamp := func(v float64) int32 {
+		return int32(math.Max(0, math.Min(maxValue, math.Floor(v+0.5))))
+	}
+	for i := range planes[0] {
+		y, cb, cr := float64(planes[0][i]), float64(planes[1][i])-shift, float64(planes[2][i])-shift
+		planes[0][i] = clamp(y + 1.402*cr)
+		planes[1][i] = clamp(y - 0.344136*cb - 0.714136*cr)
+		planes[2][i] = clamp(y + 1.772*cb)
+	}
+}
+
+// interleavePlanes returns native pixel data holding interleaved samples of
+// `planes`, each occupying `bitsAllocated` bits, in little endian.
+func interleavePlanes(planes [][]int32, bitsAllocated int) []byte {
+	bytesPerSample := bitsAllocated / 8
+	dst := make([]byte, len(planes[0])*len(planes)*bytesPerSample)
+	for i := range planes[0] {
+		for s, plane := range planes {
+			pos := (i*len(planes) + s) * bytesPerSample
+			dst[pos] = byte(plane[i])
+			if bytesPerSample == 2 {
+				dst[pos+1] = byte(plane[i] >> 8)
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,1864 @@
+package opendcm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+/*
+===============================================================================
+	JPEG 2000
+	---
+	Provides decoding of frames compressed with JPEG 2000 Part 1 (ITU-T T.800),
+	with either the reversible 5-3 or irreversible 9-7 wavelet transform. Frames
+	may be raw codestreams, or JP2 files holding a codestream.
+===============================================================================
+*/
+
+// JPEG 2000 markers, as per "Table A.2" of ITU-T T.800
+const (
+	j2kMarkerSOC = 0x4F
+	j2kMarkerSIZ = 0x51
+	j2kMarkerCOD = 0x52
+	j2kMarkerCOC = 0x53
+	j2kMarkerQCD = 0x5C
+	j2kMarkerQCC = 0x5D
+	j2kMarkerRGN = 0x5E
+	j2kMarkerPOC = 0x5F
+	j2kMarkerPPM = 0x60
+	j2kMarkerPPT = 0x61
+	j2kMarkerSOT = 0x90
+	j2kMarkerSOP = 0x91
+	j2kMarkerEPH = 0x92
+	j2kMarkerSOD = 0x93
+	j2kMarkerEOC = 0xD9
+)
+
+// progression orders, as per "Table A.16" of ITU-T T.800
+const (
+	j2kLRCP = iota
+	j2kRLCP
+	j2kRPCL
+	j2kPCRL
+	j2kCPRL
+)
+
+// code-block styles, as per "Table A.19" of ITU-T T.800
+const (
+	j2kStyleBypass  = 0x01
+	j2kStyleReset   = 0x02
+	j2kStyleTermAll = 0x04
+	j2kStyleCausal  = 0x08
+	j2kStyleSegMark = 0x20
+	j2kStyleHT      = 0x40
+)
+
+// subband orientations
+const (
+	j2kLL = iota
+	j2kHL
+	j2kLH
+	j2kHH
+)
+
+// coefficient states used by the coefficient bit modeling of tier-1 decoding
+const (
+	j2kSignificant = 1 << iota
+	j2kNegative
+	j2kRefined
+	j2kVisited
+)
+
+// contexts of tier-1 decoding: 0-8 are for zero coding, 9-13 for sign coding
+// and 14-16 for magnitude refinement
+const (
+	j2kContextRunLength = 17
+	j2kContextUniform   = 18
+	j2kContexts         = 19
+)
+
+// j2kSOC is the marker which begins a codestream
+var j2kSOC = []byte{0xFF, j2kMarkerSOC}
+
+// j2kJP2Signature is the signature box which begins a JP2 file
+var j2kJP2Signature = []byte{0x00, 0x00, 0x00, 0x0C, 'j', 'P', ' ', ' ', 0x0D, 0x0A, 0x87, 0x0A}
+
+// j2kMQStates holds the probability estimation states of the MQ coder, as per
+// "Table C.2" of ITU-T T.800: Qe, the next state after an MPS, the next state
+// after an LPS, and whether the MPS switches after an LPS.
+var j2kMQStates = [47]struct {
+	qe         uint32
+	nmps, nlps uint8
+	switchMPS  bool
+}{
+	{0x5601, 1, 1, true}, {0x3401, 2, 6, false}, {0x1801, 3, 9, false}, {0x0AC1, 4, 12, false},
+	{0x0521, 5, 29, false}, {0x0221, 38, 33, false}, {0x5601, 7, 6, true}, {0x5401, 8, 14, false},
+	{0x4801, 9, 14, false}, {0x3801, 10, 14, false}, {0x3001, 11, 17, false}, {0x2401, 12, 18, false},
+	{0x1C01, 13, 20, false}, {0x1601, 29, 21, false}, {0x5601, 15, 14, true}, {0x5401, 16, 14, false},
+	{0x5101, 17, 15, false}, {0x4801, 18, 16, false}, {0x3801, 19, 17, false}, {0x3401, 20, 18, false},
+	{0x3001, 21, 19, false}, {0x2801, 22, 19, false}, {0x2401, 23, 20, false}, {0x2201, 24, 21, false},
+	{0x1C01, 25, 22, false}, {0x1801, 26, 23, false}, {0x1601, 27, 24, false}, {0x1401, 28, 25, false},
+	{0x1201, 29, 26, false}, {0x1101, 30, 27, false}, {0x0AC1, 31, 28, false}, {0x09C1, 32, 29, false},
+	{0x08A1, 33, 30, false}, {0x0521, 34, 31, false}, {0x0441, 35, 32, false}, {0x02A1, 36, 33, false},
+	{0x0221, 37, 34, false}, {0x0141, 38, 35, false}, {0x0111, 39, 36, false}, {0x0085, 40, 37, false},
+	{0x0049, 41, 38, false}, {0x0025, 42, 39, false}, {0x0015, 43, 40, false}, {0x0009, 44, 41, false},
+	{0x0005, 45, 42, false}, {0x0001, 45, 43, false}, {0x5601, 46, 46, false},
+}
+
+// j2kMQContext is the state of a context of the MQ coder.
+type j2kMQContext struct {
+	state uint8
+	mps   uint8
+}
+
+// j2kResetContexts sets `contexts` to their initial states, as per "Table D.7"
+// of ITU-T T.800.
+func j2kResetContexts(contexts *[j2kContexts]j2kMQContext) {
+	for i := range contexts {
+		contexts[i] = j2kMQContext{}
+	}
+	contexts[0].state = 4
+	contexts[j2kContextRunLength].state = 3
+	contexts[j2kContextUniform].state = 46
+}
+
+// j2kMQDecoder is the MQ arithmetic decoder, as per "C.3" of ITU-T T.800.
+type j2kMQDecoder struct {
+	src []byte
+	bp  int
+	a   uint32
+	c   uint32
+	ct  uint
+}
+
+// byteAt returns the byte at `i`, or 0xFF beyond the end of the segment.
+func (mq *j2kMQDecoder) byteAt(i int) uint32 {
+	if i < len(mq.src) {
+		return uint32(mq.src[i])
+	}
+	return 0xFF
+}
+
+// init begins decoding of the codeword segment `src`.
+func (mq *j2kMQDecoder) init(src []byte) {
+	mq.src = src
+	mq.bp = 0
+	mq.c = mq.byteAt(0) << 16
+	mq.byteIn()
+	mq.c <<= 7
+	mq.ct -= 7
+	mq.a = 0x8000
+}
+
+func (mq *j2kMQDecoder) byteIn() {
+	if mq.byteAt(mq.bp) == 0xFF {
+		if mq.byteAt(mq.bp+1) > 0x8F {
+			mq.c += 0xFF00
+			mq.ct = 8
+		} else {
+			mq.bp++
+			mq.c += mq.byteAt(mq.bp) << 9
+			mq.ct = 7
+		}
+		return
+	}
+	mq.bp++
+	mq.c += mq.byteAt(mq.bp) << 8
+	mq.ct = 8
+}
+
+// decode decodes a decision in context `cx`.
+func (mq *j2kMQDecoder) decode(cx *j2kMQContext) int {
+	state := &j2kMQStates[cx.state]
+	mq.a -= state.qe
+	var d uint8
+	if mq.c>>16 < state.qe {
+		// LPS exchange
+		if mq.a < state.qe {
+			d = cx.mps
+			cx.state = state.nmps
+		} else {
+			d = 1 - cx.mps
+			if state.switchMPS {
+				cx.mps = 1 - cx.mps
+			}
+			cx.state = state.nlps
+		}
+		mq.a = state.qe
+	} else {
+		mq.c -= state.qe << 16
+		if mq.a&0x8000 != 0 {
+			return int(cx.mps)
+		}
+		// MPS exchange
+		if mq.a < state.qe {
+			d = 1 - cx.mps
+			if state.switchMPS {
+				cx.mps = 1 - cx.mps
+			}
+			cx.state = state.nlps
+		} else {
+			d = cx.mps
+			cx.state = state.nmps
+		}
+	}
+	// renormalisation
+	for {
+		if mq.ct == 0 {
+			mq.byteIn()
+		}
+		mq.a <<= 1
+		mq.c <<= 1
+		mq.ct--
+		if mq.a&0x8000 != 0 {
+			break
+		}
+	}
+	return int(d)
+}
+
+// j2kBitReader reads bits which are stuffed with a zero bit following each
+// 0xFF byte, as are packet headers and raw codeword segments.
+type j2kBitReader struct {
+	src []byte
+	pos int
+	b   byte
+	n   uint
+	// afterFF indicates that the last byte read was 0xFF
+	afterFF bool
+	// overrun indicates that bits were read beyond the end of src
+	overrun bool
+}
+
+func (br *j2kBitReader) bit() int {
+	if br.n == 0 {
+		br.b = 0xFF
+		if br.pos < len(br.src) {
+			br.b = br.src[br.pos]
+		} else {
+			br.overrun = true
+		}
+		br.pos++
+		br.n = 8
+		if br.afterFF {
+			br.n = 7
+		}
+		br.afterFF = br.b == 0xFF
+	}
+	br.n--
+	return int(br.b>>br.n) & 1
+}
+
+func (br *j2kBitReader) bits(n int) int {
+	v := 0
+	for i := 0; i < n; i++ {
+		v = v<<1 | br.bit()
+	}
+	return v
+}
+
+// align discards the remaining bits of the current byte, and the byte
+// following it if the current byte is 0xFF.
+func (br *j2kBitReader) align() {
+	br.n = 0
+	if br.afterFF {
+		br.pos++
+		br.afterFF = false
+	}
+}
+
+// j2kTagTree is a tag tree, as per "B.10.2" of ITU-T T.800. Leaves are held
+// first, in raster order, followed by each level above them.
+type j2kTagTree struct {
+	nodes []j2kTagNode
+	stack []int
+}
+
+type j2kTagNode struct {
+	parent     int
+	value, low int
+}
+
+// newJ2KTagTree returns a tag tree with `width` x `height` leaves, the values
+// of which are unknown.
+func newJ2KTagTree(width, height int) *j2kTagTree {
+	t := j2kTagTree{}
+	start := 0
+	for {
+		for i := 0; i < width*height; i++ {
+			t.nodes = append(t.nodes, j2kTagNode{parent: -1, value: math.MaxInt32})
+		}
+		if width*height <= 1 {
+			break
+		}
+		parentWidth, parentHeight := (width+1)/2, (height+1)/2
+		parentStart := start + width*height
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				t.nodes[start+y*width+x].parent = parentStart + (y/2)*parentWidth + x/2
+			}
+		}
+		start, width, height = parentStart, parentWidth, parentHeight
+	}
+	return &t
+}
+
+// path returns the nodes from the root of the tree to `leaf`.
+func (t *j2kTagTree) path(leaf int) []int {
+	t.stack = t.stack[:0]
+	for n := leaf; n >= 0; n = t.nodes[n].parent {
+		t.stack = append(t.stack, n)
+	}
+	for i, j := 0, len(t.stack)-1; i < j; i, j = i+1, j-1 {
+		t.stack[i], t.stack[j] = t.stack[j], t.stack[i]
+	}
+	return t.stack
+}
+
+// decode decodes the value of `leaf` as far as `threshold`, and returns whether
+// it is less than `threshold`.
+func (t *j2kTagTree) decode(br *j2kBitReader, leaf, threshold int) bool {
+	low := 0
+	for _, n := range t.path(leaf) {
+		node := &t.nodes[n]
+		if low > node.low {
+			node.low = low
+		} else {
+			low = node.low
+		}
+		for low < threshold && low < node.value {
+			if br.bit() == 1 {
+				node.value = low
+			} else {
+				low++
+			}
+		}
+		node.low = low
+	}
+	return t.nodes[leaf].value < threshold
+}
+
+// j2kCodingStyle holds the coding style of a tile-component, from a COD or
+// COC segment.
+type j2kCodingStyle struct {
+	levels int
+	// cbWidth and cbHeight are the exponents of the nominal code-block size
+	cbWidth, cbHeight int
+	cbStyle           byte
+	reversible        bool
+	// precincts holds the exponents of the precinct size of each resolution,
+	// PPx in the low four bits and PPy in the high four bits
+	precincts []byte
+}
+
+// j2kGlobalStyle holds the parts of a COD segment which apply to all components.
+type j2kGlobalStyle struct {
+	progression int
+	layers      int
+	mct         bool
+	sop, eph    bool
+}
+
+// j2kQuantization holds the quantization of a tile-component, from a QCD or
+// QCC segment.
+type j2kQuantization struct {
+	style byte
+	guard int
+	// exponents and mantissas of each subband, or only of LL for derived
+	// quantization
+	exponents, mantissas []int
+}
+
+// j2kHeader holds the coding parameters of the main header, or of a tile.
+type j2kHeader struct {
+	global *j2kGlobalStyle
+	cod    *j2kCodingStyle
+	coc    map[int]*j2kCodingStyle
+	qcd    *j2kQuantization
+	qcc    map[int]*j2kQuantization
+	rgn    map[int]int
+}
+
+func newJ2KHeader() j2kHeader {
+	return j2kHeader{coc: map[int]*j2kCodingStyle{}, qcc: map[int]*j2kQuantization{}, rgn: map[int]int{}}
+}
+
+// j2kComponent holds the properties of a component, from the SIZ segment.
+type j2kComponent struct {
+	precision int
+	signed    bool
+}
+
+// j2kTile holds a tile's header, and its tile-parts' data.
+type j2kTile struct {
+	header j2kHeader
+	data   []byte
+	parts  int
+}
+
+// j2kDecoder decodes JPEG 2000 codestreams.
+type j2kDecoder struct {
+	src []byte
+	pos int
+	// image and tile geometry on the reference grid
+	x0, y0, x1, y1         int
+	tileWidth, tileHeight  int
+	tileX0, tileY0         int
+	tilesX, tilesY         int
+	components             []j2kComponent
+	main                   j2kHeader
+	tiles                  []j2kTile
+	planes                 [][]int32
+	multipleComponentXform bool
+	// params, if not nil, describe the Image Pixel module which the image
+	// must match. See: `checkFrameSize`
+	params *ImageParameters
+}
+
+// j2kCodestream returns the codestream of a JPEG 2000 frame, which is either a
+// codestream or a JP2 file.
+func j2kCodestream(frame []byte) ([]byte, error) {
+	if bytes.HasPrefix(frame, j2kSOC) {
+		return frame, nil
+	}
+	if !bytes.HasPrefix(frame, j2kJP2Signature) {
+		return nil, errors.New("frame is neither a JPEG 2000 codestream nor a JP2 file")
+	}
+	for pos := 0; pos+8 <= len(frame); {
+		length := uint64(frame[pos])<<24 | uint64(frame[pos+1])<<16 | uint64(frame[pos+2])<<8 | uint64(frame[pos+3])
+		boxType := string(frame[pos+4 : pos+8])
+		header := uint64(8)
+		switch length {
+		case 0:
+			length = uint64(len(frame) - pos)
+		case 1:
+			if pos+16 > len(frame) {
+				return nil, errors.New("invalid JP2 box")
+			}
+			length = 0
+			for _, b := range frame[pos+8 : pos+16] {
+				length = length<<8 | uint64(b)
+			}
+			header = 16
+		}
+		if length < header || length > uint64(len(frame)-pos) {
+			return nil, errors.New("invalid JP2 box")
+		}
+		if boxType == "jp2c" {
+			return frame[pos+int(header) : pos+int(length)], nil
+		}
+		pos += int(length)
+	}
+	return nil, errors.New("JP2 file holds no codestream")
+}
+
+// readMarker returns the marker at the current position, and the content of
+// its segment, if it has one.
+func (d *j2kDecoder) readMarker() (byte, []byte, error) {
+	if d.pos+2 > len(d.src) || d.src[d.pos] != 0xFF {
+		return 0, nil, errors.New("expected JPEG 2000 marker")
+	}
+	marker := d.src[d.pos+1]
+	d.pos += 2
+	if marker == j2kMarkerSOC || marker == j2kMarkerSOD || marker == j2kMarkerEOC || (marker >= 0x30 && marker <= 0x3F) {
+		return marker, nil, nil
+	}
+	if d.pos+2 > len(d.src) {
+		return 0, nil, errors.New("unexpected end of JPEG 2000 codestream")
+	}
+	length := int(d.src[d.pos])<<8 | int(d.src[d.pos+1])
+	if length < 2 || d.pos+length > len(d.src) {
+		return 0, nil, errors.New("invalid JPEG 2000 segment length")
+	}
+	segment := d.src[d.pos+2 : d.pos+length]
+	d.pos += length
+	return marker, segment, nil
+}
+
+// decode decodes the codestream into the planes of each component.
+func (d *j2kDecoder) decode() error {
+	if !bytes.HasPrefix(d.src, j2kSOC) {
+		return errors.New("JPEG 2000 codestream does not begin with SOC marker")
+	}
+	d.pos = 2
+	d.main = newJ2KHeader()
+	// main header
+	for {
+		start := d.pos
+		marker, segment, err := d.readMarker()
+		if err != nil {
+			return err
+		}
+		if marker == j2kMarkerSOT {
+			if d.components == nil || d.main.cod == nil || d.main.qcd == nil {
+				return errors.New("JPEG 2000 main header lacks SIZ, COD or QCD segment")
+			}
+			d.pos = start
+			break
+		}
+		if marker == j2kMarkerSIZ {
+			err = d.parseSIZ(segment)
+		} else if d.components == nil {
+			err = errors.New("JPEG 2000 main header does not begin with SIZ segment")
+		} else {
+			err = d.parseHeaderSegment(&d.main, marker, segment)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	// tile-parts
+	for d.pos < len(d.src) {
+		start := d.pos
+		marker, segment, err := d.readMarker()
+		if err != nil {
+			return err
+		}
+		if marker == j2kMarkerEOC {
+			break
+		}
+		if marker != j2kMarkerSOT {
+			return fmt.Errorf("expected SOT marker, found 0x%02X", marker)
+		}
+		if err = d.parseTilePart(start, segment); err != nil {
+			return err
+		}
+	}
+
+	d.planes = make([][]int32, len(d.components))
+	for i := range d.planes {
+		d.planes[i] = make([]int32, (d.x1-d.x0)*(d.y1-d.y0))
+	}
+	for i := range d.tiles {
+		if err := d.decodeTile(i); err != nil {
+			return fmt.Errorf("tile %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// parseSIZ parses an image and tile size segment.
+func (d *j2kDecoder) parseSIZ(segment []byte) error {
+	if d.components != nil || len(segment) < 36 {
+		return errors.New("invalid SIZ segment")
+	}
+	u32 := func(i int) int {
+		return int(uint32(segment[i])<<24 | uint32(segment[i+1])<<16 | uint32(segment[i+2])<<8 | uint32(segment[i+3]))
+	}
+	d.x1, d.y1, d.x0, d.y0 = u32(2), u32(6), u32(10), u32(14)
+	d.tileWidth, d.tileHeight, d.tileX0, d.tileY0 = u32(18), u32(22), u32(26), u32(30)
+	numComponents := int(segment[34])<<8 | int(segment[35])
+	if d.x1 <= d.x0 || d.y1 <= d.y0 || d.tileWidth == 0 || d.tileHeight == 0 ||
+		d.tileX0 > d.x0 || d.tileY0 > d.y0 || d.tileX0+d.tileWidth <= d.x0 || d.tileY0+d.tileHeight <= d.y0 ||
+		numComponents == 0 || len(segment) != 36+3*numComponents {
+		return errors.New("invalid SIZ segment")
+	}
+	if d.params != nil {
+		if err := checkFrameSize(d.x1-d.x0, d.y1-d.y0, numComponents, d.params); err != nil {
+			return err
+		}
+	}
+	d.tilesX = ceilDiv(d.x1-d.tileX0, d.tileWidth)
+	d.tilesY = ceilDiv(d.y1-d.tileY0, d.tileHeight)
+	if d.tilesX*d.tilesY > 65535 {
+		return errors.New("invalid SIZ segment")
+	}
+	d.tiles = make([]j2kTile, d.tilesX*d.tilesY)
+	d.components = make([]j2kComponent, numComponents)
+	for i := range d.components {
+		ssiz := segment[36+i*3]
+		d.components[i] = j2kComponent{precision: int(ssiz&0x7F) + 1, signed: ssiz&0x80 != 0}
+		if d.components[i].precision > 16 {
+			return fmt.Errorf("JPEG 2000 precision of %d bits is not supported", d.components[i].precision)
+		}
+		if segment[37+i*3] != 1 || segment[38+i*3] != 1 {
+			return errors.New("JPEG 2000 component subsampling is not supported")
+		}
+	}
+	return nil
+}
+
+// componentIndex reads the index of a component from a COC, QCC or RGN segment,
+// returning the remainder of the segment.
+func (d *j2kDecoder) componentIndex(segment []byte) (int, []byte, error) {
+	c, n := 0, 1
+	if len(d.components) >= 257 {
+		n = 2
+	}
+	if len(segment) < n {
+		return 0, nil, errors.New("invalid JPEG 2000 segment")
+	}
+	for _, b := range segment[:n] {
+		c = c<<8 | int(b)
+	}
+	if c >= len(d.components) {
+		return 0, nil, fmt.Errorf("JPEG 2000 segment references unknown component %d", c)
+	}
+	return c, segment[n:], nil
+}
+
+// parseHeaderSegment parses a segment of the main header or a tile-part header
+// into `header`.
+func (d *j2kDecoder) parseHeaderSegment(header *j2kHeader, marker byte, segment []byte) error {
+	switch marker {
+	case j2kMarkerCOD:
+		if len(segment) < 5 {
+			return errors.New("invalid COD segment")
+		}
+		header.global = &j2kGlobalStyle{
+			progression: int(segment[1]),
+			layers:      int(segment[2])<<8 | int(segment[3]),
+			mct:         segment[4] != 0,
+			sop:         segment[0]&0x02 != 0,
+			eph:         segment[0]&0x04 != 0,
+		}
+		if header.global.progression > j2kCPRL || header.global.layers == 0 {
+			return errors.New("invalid COD segment")
+		}
+		style, err := parseJ2KCodingStyle(segment[0], segment[5:])
+		if err != nil {
+			return err
+		}
+		header.cod = style
+	case j2kMarkerCOC:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		if len(rest) < 1 {
+			return errors.New("invalid COC segment")
+		}
+		if header.coc[c], err = parseJ2KCodingStyle(rest[0], rest[1:]); err != nil {
+			return err
+		}
+	case j2kMarkerQCD:
+		q, err := parseJ2KQuantization(segment)
+		if err != nil {
+			return err
+		}
+		header.qcd = q
+	case j2kMarkerQCC:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		if header.qcc[c], err = parseJ2KQuantization(rest); err != nil {
+			return err
+		}
+	case j2kMarkerRGN:
+		c, rest, err := d.componentIndex(segment)
+		if err != nil {
+			return err
+		}
+		if len(rest) != 2 || rest[0] != 0 {
+			return errors.New("invalid RGN segment")
+		}
+		header.rgn[c] = int(rest[1])
+	case j2kMarkerPOC:
+		return errors.New("JPEG 2000 progression order changes are not supported")
+	case j2kMarkerPPM, j2kMarkerPPT:
+		return errors.New("JPEG 2000 packed packet headers are not supported")
+	}
+	// TLM, PLM, PLT, CRG, COM, and others which do not affect decoding
+	return nil
+}
+
+// parseJ2KCodingStyle parses the coding style parameters (SPcod or SPcoc) of a
+// COD or COC segment, given its coding style (Scod or Scoc).
+func parseJ2KCodingStyle(scod byte, segment []byte) (*j2kCodingStyle, error) {
+	if len(segment) < 5 {
+		return nil, errors.New("invalid coding style")
+	}
+	style := j2kCodingStyle{
+		levels:     int(segment[0]),
+		cbWidth:    int(segment[1]&0x0F) + 2,
+		cbHeight:   int(segment[2]&0x0F) + 2,
+		cbStyle:    segment[3],
+		reversible: segment[4] == 1,
+	}
+	if style.levels > 32 || style.cbWidth > 10 || style.cbHeight > 10 || style.cbWidth+style.cbHeight > 12 || segment[4] > 1 {
+		return nil, errors.New("invalid coding style")
+	}
+	if style.cbStyle&j2kStyleHT != 0 {
+		return nil, errors.New("JPEG 2000 high throughput code-blocks are not supported")
+	}
+	if scod&0x01 != 0 {
+		if len(segment) != 5+style.levels+1 {
+			return nil, errors.New("invalid coding style")
+		}
+		style.precincts = segment[5:]
+	}
+	return &style, nil
+}
+
+// parseJ2KQuantization parses the content of a QCD segment, or of a QCC
+// segment following its component index.
+func parseJ2KQuantization(segment []byte) (*j2kQuantization, error) {
+	if len(segment) < 2 {
+		return nil, errors.New("invalid quantization")
+	}
+	q := j2kQuantization{style: segment[0] & 0x1F, guard: int(segment[0] >> 5)}
+	steps := segment[1:]
+	switch q.style {
+	case 0:
+		for _, b := range steps {
+			q.exponents = append(q.exponents, int(b>>3))
+			q.mantissas = append(q.mantissas, 0)
+		}
+	case 1, 2:
+		if len(steps)%2 != 0 || (q.style == 1 && len(steps) != 2) {
+			return nil, errors.New("invalid quantization")
+		}
+		for i := 0; i < len(steps); i += 2 {
+			v := int(steps[i])<<8 | int(steps[i+1])
+			q.exponents = append(q.exponents, v>>11)
+			q.mantissas = append(q.mantissas, v&0x7FF)
+		}
+	default:
+		return nil, errors.New("invalid quantization")
+	}
+	return &q, nil
+}
+
+// parseTilePart parses a tile-part beginning at `start`, given the content of
+// its SOT segment.
+func (d *j2kDecoder) parseTilePart(start int, sot []byte) error {
+	if len(sot) != 8 {
+		return errors.New("invalid SOT segment")
+	}
+	index := int(sot[0])<<8 | int(sot[1])
+	length := int(uint32(sot[2])<<24 | uint32(sot[3])<<16 | uint32(sot[4])<<8 | uint32(sot[5]))
+	if index >= len(d.tiles) {
+		return fmt.Errorf("SOT segment references unknown tile %d", index)
+	}
+	end := start + length
+	if length == 0 {
+		// the tile-part extends to the EOC marker
+		end = len(d.src)
+		if bytes.HasSuffix(d.src, []byte{0xFF, j2kMarkerEOC}) {
+			end -= 2
+		}
+	}
+	if end > len(d.src) || end < d.pos {
+		return errors.New("invalid SOT segment")
+	}
+	tile := &d.tiles[index]
+	if tile.parts == 0 {
+		tile.header = newJ2KHeader()
+	}
+	for {
+		marker, segment, err := d.readMarker()
+		if err != nil {
+			return err
+		}
+		if marker == j2kMarkerSOD {
+			break
+		}
+		if tile.parts > 0 && marker != j2kMarkerPOC && marker != j2kMarkerPPT {
+			// only the first tile-part may change the coding parameters
+			continue
+		}
+		if err = d.parseHeaderSegment(&tile.header, marker, segment); err != nil {
+			return err
+		}
+	}
+	if d.pos > end {
+		return errors.New("invalid SOT segment")
+	}
+	tile.data = append(tile.data, d.src[d.pos:end]...)
+	tile.parts++
+	d.pos = end
+	return nil
+}
+
+// codingStyle returns the coding style of component `c` of `tile`, following
+// the precedence of "A.6" of ITU-T T.800.
+func (d *j2kDecoder) codingStyle(tile *j2kTile, c int) (*j2kGlobalStyle, *j2kCodingStyle) {
+	global := d.main.global
+	if tile.header.global != nil {
+		global = tile.header.global
+	}
+	if style := tile.header.coc[c]; style != nil {
+		return global, style
+	}
+	if tile.header.cod != nil {
+		return global, tile.header.cod
+	}
+	if style := d.main.coc[c]; style != nil {
+		return global, style
+	}
+	return global, d.main.cod
+}
+
+// quantization returns the quantization of component `c` of `tile`.
+func (d *j2kDecoder) quantization(tile *j2kTile, c int) *j2kQuantization {
+	if q := tile.header.qcc[c]; q != nil {
+		return q
+	}
+	if tile.header.qcd != nil {
+		return tile.header.qcd
+	}
+	if q := d.main.qcc[c]; q != nil {
+		return q
+	}
+	return d.main.qcd
+}
+
+// roiShift returns the region of interest shift of component `c` of `tile`.
+func (d *j2kDecoder) roiShift(tile *j2kTile, c int) int {
+	if shift, ok := tile.header.rgn[c]; ok {
+		return shift
+	}
+	return d.main.rgn[c]
+}
+
+// j2kTileComponent holds the resolutions of a component of a tile.
+type j2kTileComponent struct {
+	x0, y0, x1, y1 int
+	style          *j2kCodingStyle
+	resolutions    []*j2kResolution
+}
+
+// j2kResolution holds the subbands and precinct partition of a resolution level.
+type j2kResolution struct {
+	x0, y0, x1, y1 int
+	// ppx and ppy are the exponents of the precinct size
+	ppx, ppy               int
+	precinctsX             int
+	precinctsY             int
+	bands                  []*j2kBand
+	precinctX0, precinctY0 int
+}
+
+// j2kBand holds the code-blocks and coefficients of a subband.
+type j2kBand struct {
+	orientation    int
+	x0, y0, x1, y1 int
+	// magnitudeBits is the number of magnitude bit-planes, Mb, including any
+	// region of interest shift
+	magnitudeBits int
+	step          float64
+	precincts     []*j2kPrecinct
+	coefficients  []float64
+}
+
+// j2kPrecinct holds the code-blocks of a subband within a precinct.
+type j2kPrecinct struct {
+	blocksX, blocksY int
+	blocks           []*j2kCodeBlock
+	inclusion        *j2kTagTree
+	zeroBitPlanes    *j2kTagTree
+}
+
+// j2kCodeBlock holds the codeword segments of a code-block.
+type j2kCodeBlock struct {
+	x0, y0, x1, y1 int
+	included       bool
+	zeroBitPlanes  int
+	passes         int
+	lblock         int
+	segments       []*j2kSegment
+}
+
+// j2kSegment is a codeword segment of a code-block.
+type j2kSegment struct {
+	data              []byte
+	passes, maxPasses int
+}
+
+// ceilDiv returns the ceiling of `a` / `b`, for positive `b`.
+func ceilDiv(a, b int) int {
+	if a < 0 {
+		return -(-a / b)
+	}
+	return (a + b - 1) / b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// newTileComponent returns the resolutions, subbands, precincts and code-blocks
+// of component `c` of tile `t`, being `tx0`, `ty0`, `tx1`, `ty1` on the
+// reference grid.
+func (d *j2kDecoder) newTileComponent(t *j2kTile, c int, tx0, ty0, tx1, ty1 int) (*j2kTileComponent, error) {
+	_, style := d.codingStyle(t, c)
+	q := d.quantization(t, c)
+	roiShift := d.roiShift(t, c)
+	tc := j2kTileComponent{x0: tx0, y0: ty0, x1: tx1, y1: ty1, style: style}
+	levels := style.levels
+	for r := 0; r <= levels; r++ {
+		scale := 1 << uint(levels-r)
+		res := j2kResolution{
+			x0: ceilDiv(tx0, scale), y0: ceilDiv(ty0, scale),
+			x1: ceilDiv(tx1, scale), y1: ceilDiv(ty1, scale),
+			ppx: 15, ppy: 15,
+		}
+		if style.precincts != nil {
+			res.ppx, res.ppy = int(style.precincts[r]&0x0F), int(style.precincts[r]>>4)
+			if r > 0 && (res.ppx == 0 || res.ppy == 0) {
+				return nil, errors.New("invalid precinct size")
+			}
+		}
+		if res.x1 > res.x0 {
+			res.precinctX0 = res.x0 >> uint(res.ppx)
+			res.precinctsX = ceilDiv(res.x1, 1<<uint(res.ppx)) - res.precinctX0
+		}
+		if res.y1 > res.y0 {
+			res.precinctY0 = res.y0 >> uint(res.ppy)
+			res.precinctsY = ceilDiv(res.y1, 1<<uint(res.ppy)) - res.precinctY0
+		}
+		orientations := []int{j2kHL, j2kLH, j2kHH}
+		if r == 0 {
+			orientations = []int{j2kLL}
+		}
+		for _, orientation := range orientations {
+			band := j2kBand{orientation: orientation, x0: res.x0, y0: res.y0, x1: res.x1, y1: res.y1}
+			// precinct and code-block sizes, in the coordinates of the subband
+			ppx, ppy := res.ppx, res.ppy
+			if r > 0 {
+				ppx, ppy = ppx-1, ppy-1
+				// subbands of a resolution level above zero hold the low or
+				// high pass coefficients of the resolution level
+				band.x0, band.x1 = ceilDiv(res.x0, 2), ceilDiv(res.x1, 2)
+				band.y0, band.y1 = ceilDiv(res.y0, 2), ceilDiv(res.y1, 2)
+				if orientation == j2kHL || orientation == j2kHH {
+					band.x0, band.x1 = res.x0/2, res.x1/2
+				}
+				if orientation == j2kLH || orientation == j2kHH {
+					band.y0, band.y1 = res.y0/2, res.y1/2
+				}
+			}
+			if err := d.setBandQuantization(&band, q, r, levels, c, style.reversible, roiShift); err != nil {
+				return nil, err
+			}
+			cbw, cbh := style.cbWidth, style.cbHeight
+			if cbw > ppx {
+				cbw = ppx
+			}
+			if cbh > ppy {
+				cbh = ppy
+			}
+			band.coefficients = make([]float64, (band.x1-band.x0)*(band.y1-band.y0))
+			for py := 0; py < res.precinctsY; py++ {
+				for px := 0; px < res.precinctsX; px++ {
+					// the precinct within the subband
+					x0 := maxInt(band.x0, (res.precinctX0+px)<<uint(ppx))
+					y0 := maxInt(band.y0, (res.precinctY0+py)<<uint(ppy))
+					x1 := minInt(band.x1, (res.precinctX0+px+1)<<uint(ppx))
+					y1 := minInt(band.y1, (res.precinctY0+py+1)<<uint(ppy))
+					band.precincts = append(band.precincts, newJ2KPrecinct(x0, y0, x1, y1, cbw, cbh))
+				}
+			}
+			res.bands = append(res.bands, &band)
+		}
+		tc.resolutions = append(tc.resolutions, &res)
+	}
+	return &tc, nil
+}
+
+// newJ2KPrecinct returns the precinct of a subband `x0`, `y0`, `x1`, `y1`, with
+// code-blocks of 2^`cbw` x 2^`cbh`.
+func newJ2KPrecinct(x0, y0, x1, y1, cbw, cbh int) *j2kPrecinct {
+	p := j2kPrecinct{}
+	if x1 <= x0 || y1 <= y0 {
+		return &p
+	}
+	bx0, by0 := x0>>uint(cbw), y0>>uint(cbh)
+	p.blocksX = ceilDiv(x1, 1<<uint(cbw)) - bx0
+	p.blocksY = ceilDiv(y1, 1<<uint(cbh)) - by0
+	for by := 0; by < p.blocksY; by++ {
+		for bx := 0; bx < p.blocksX; bx++ {
+			p.blocks = append(p.blocks, &j2kCodeBlock{
+				x0: maxInt(x0, (bx0+bx)<<uint(cbw)), y0: maxInt(y0, (by0+by)<<uint(cbh)),
+				x1: minInt(x1, (bx0+bx+1)<<uint(cbw)), y1: minInt(y1, (by0+by+1)<<uint(cbh)),
+				lblock: 3,
+			})
+		}
+	}
+	p.inclusion = newJ2KTagTree(p.blocksX, p.blocksY)
+	p.zeroBitPlanes = newJ2KTagTree(p.blocksX, p.blocksY)
+	return &p
+}
+
+// setBandQuantization sets the number of magnitude bit-planes and quantization
+// step size of `band`, of resolution level `r`, as per "E.1" of ITU-T T.800.
+func (d *j2kDecoder) setBandQuantization(band *j2kBand, q *j2kQuantization, r, levels, c int, reversible bool, roiShift int) error {
+	// the index of the subband, in the order in which they are signalled
+	index := 0
+	if r > 0 {
+		index = 3*(r-1) + band.orientation
+	}
+	var exponent, mantissa int
+	switch {
+	case q.style == 1:
+		// derived from the LL subband, as per "E-5"
+		decomposition := levels
+		if r > 0 {
+			decomposition = levels - r + 1
+		}
+		exponent, mantissa = q.exponents[0]-levels+decomposition, q.mantissas[0]
+	case index < len(q.exponents):
+		exponent, mantissa = q.exponents[index], q.mantissas[index]
+	default:
+		return errors.New("quantization does not describe every subband")
+	}
+	band.magnitudeBits = q.guard + exponent - 1 + roiShift
+	band.step = 1
+	if !reversible {
+		// the nominal dynamic range of the subband, being the precision of the
+		// component and the log2 of the subband's gain
+		gain := [4]int{0, 1, 1, 2}[band.orientation]
+		rb := d.components[c].precision + gain
+		band.step = math.Ldexp(1+float64(mantissa)/2048, rb-exponent)
+	}
+	return nil
+}
+
+// tileBounds returns the bounds of tile `index` on the reference grid.
+func (d *j2kDecoder) tileBounds(index int) (int, int, int, int) {
+	p, q := index%d.tilesX, index/d.tilesX
+	return maxInt(d.tileX0+p*d.tileWidth, d.x0), maxInt(d.tileY0+q*d.tileHeight, d.y0),
+		minInt(d.tileX0+(p+1)*d.tileWidth, d.x1), minInt(d.tileY0+(q+1)*d.tileHeight, d.y1)
+}
+
+// decodeTile decodes tile `index` into the planes of each component.
+func (d *j2kDecoder) decodeTile(index int) error {
+	t := &d.tiles[index]
+	tx0, ty0, tx1, ty1 := d.tileBounds(index)
+
+	components := make([]*j2kTileComponent, len(d.components))
+	for c := range components {
+		tc, err := d.newTileComponent(t, c, tx0, ty0, tx1, ty1)
+		if err != nil {
+			return err
+		}
+		components[c] = tc
+	}
+	global, _ := d.codingStyle(t, 0)
+	if err := d.decodePackets(t, global, components); err != nil {
+		return err
+	}
+
+	samples := make([][]float64, len(components))
+	for c, tc := range components {
+		for _, res := range tc.resolutions {
+			for _, band := range res.bands {
+				if err := decodeBandCodeBlocks(band, tc.style, d.roiShift(t, c)); err != nil {
+					return err
+				}
+			}
+		}
+		samples[c] = inverseDWT(tc)
+	}
+
+	if global.mct && len(components) >= 3 {
+		if components[0].style.reversible != components[1].style.reversible || components[0].style.reversible != components[2].style.reversible {
+			return errors.New("multiple component transform of mixed wavelet transforms")
+		}
+		inverseMCT(samples[0], samples[1], samples[2], components[0].style.reversible)
+		d.multipleComponentXform = true
+	}
+
+	// DC level shifting, and placement into the planes
+	width := d.x1 - d.x0
+	for c := range components {
+		comp := d.components[c]
+		minValue, maxValue := 0.0, float64(int32(1)<<uint(comp.precision)-1)
+		shift := float64(int32(1) << uint(comp.precision-1))
+		if comp.signed {
+			minValue, maxValue, shift = -shift, shift-1, 0
+		}
+		w := tx1 - tx0
+		for y := ty0; y < ty1; y++ {
+			for x := tx0; x < tx1; x++ {
+				v := math.Floor(samples[c][(y-ty0)*w+(x-tx0)] + shift + 0.5)
+				d.planes[c][(y-d.y0)*width+(x-d.x0)] = int32(math.Max(minValue, math.Min(maxValue, v)))
+			}
+		}
+	}
+	return nil
+}
+
+// j2kPacket identifies a packet of a tile.
+type j2kPacket struct {
+	layer, resolution, component, precinct int
+}
+
+// packetOrder returns the packets of a tile in the order of its progression,
+// as per "B.12" of ITU-T T.800.
+func (d *j2kDecoder) packetOrder(global *j2kGlobalStyle, components []*j2kTileComponent) []j2kPacket {
+	maxResolutions := 0
+	for _, tc := range components {
+		maxResolutions = maxInt(maxResolutions, len(tc.resolutions))
+	}
+	packets := []j2kPacket{}
+	precincts := func(c, r int) int {
+		if r >= len(components[c].resolutions) {
+			return 0
+		}
+		res := components[c].resolutions[r]
+		return res.precinctsX * res.precinctsY
+	}
+	switch global.progression {
+	case j2kLRCP:
+		for l := 0; l < global.layers; l++ {
+			for r := 0; r < maxResolutions; r++ {
+				for c := range components {
+					for p := 0; p < precincts(c, r); p++ {
+						packets = append(packets, j2kPacket{l, r, c, p})
+					}
+				}
+			}
+		}
+		return packets
+	case j2kRLCP:
+		for r := 0; r < maxResolutions; r++ {
+			for l := 0; l < global.layers; l++ {
+				for c := range components {
+					for p := 0; p < precincts(c, r); p++ {
+						packets = append(packets, j2kPacket{l, r, c, p})
+					}
+				}
+			}
+		}
+		return packets
+	}
+
+	// position-driven progressions visit each precinct at the position of its
+	// upper left corner on the reference grid
+	type entry struct {
+		r, c, p, x, y int
+	}
+	entries := []entry{}
+	for c, tc := range components {
+		for r, res := range tc.resolutions {
+			scale := uint(len(tc.resolutions) - 1 - r)
+			for p := 0; p < res.precinctsX*res.precinctsY; p++ {
+				px, py := p%res.precinctsX, p/res.precinctsX
+				x := (res.precinctX0 + px) << uint(res.ppx) << scale
+				if px == 0 && res.x0%(1<<uint(res.ppx)) != 0 {
+					x = tc.x0
+				}
+				y := (res.precinctY0 + py) << uint(res.ppy) << scale
+				if py == 0 && res.y0%(1<<uint(res.ppy)) != 0 {
+					y = tc.y0
+				}
+				entries = append(entries, entry{r, c, p, x, y})
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		var ka, kb [4]int
+		switch global.progression {
+		case j2kRPCL:
+			ka, kb = [4]int{a.r, a.y, a.x, a.c}, [4]int{b.r, b.y, b.x, b.c}
+		case j2kPCRL:
+			ka, kb = [4]int{a.y, a.x, a.c, a.r}, [4]int{b.y, b.x, b.c, b.r}
+		default:
+			ka, kb = [4]int{a.c, a.y, a.x, a.r}, [4]int{b.c, b.y, b.x, b.r}
+		}
+		for k := range ka {
+			if ka[k] != kb[k] {
+				return ka[k] < kb[k]
+			}
+		}
+		return false
+	})
+	for _, e := range entries {
+		for l := 0; l < global.layers; l++ {
+			packets = append(packets, j2kPacket{l, e.r, e.c, e.p})
+		}
+	}
+	return packets
+}
+
+// j2kContribution is the contribution of a packet to a codeword segment.
+type j2kContribution struct {
+	segment *j2kSegment
+	length  int
+}
+
+// decodePackets decodes the packets of tile `t`, assigning their data to the
+// codeword segments of each code-block, as per "B.9" and "B.10" of ITU-T T.800.
+func (d *j2kDecoder) decodePackets(t *j2kTile, global *j2kGlobalStyle, components []*j2kTileComponent) error {
+	data := t.data
+	pos := 0
+	for _, packet := range d.packetOrder(global, components) {
+		if pos >= len(data) {
+			// the codestream is truncated
+			return nil
+		}
+		if global.sop && pos+6 <= len(data) && data[pos] == 0xFF && data[pos+1] == j2kMarkerSOP {
+			pos += 6
+		}
+		tc := components[packet.component]
+		res := tc.resolutions[packet.resolution]
+		br := j2kBitReader{src: data, pos: pos}
+		contributions := []j2kContribution{}
+		if br.bit() == 1 {
+			for _, band := range res.bands {
+				precinct := band.precincts[packet.precinct]
+				for i, cb := range precinct.blocks {
+					c, err := decodeCodeBlockHeader(&br, precinct, i, cb, packet.layer, tc.style.cbStyle)
+					if err != nil {
+						return err
+					}
+					contributions = append(contributions, c...)
+				}
+			}
+		}
+		br.align()
+		if br.overrun {
+			return errors.New("packet header exceeds tile data")
+		}
+		pos = br.pos
+		if global.eph && pos+2 <= len(data) && data[pos] == 0xFF && data[pos+1] == j2kMarkerEPH {
+			pos += 2
+		}
+		for _, c := range contributions {
+			if pos+c.length > len(data) {
+				return errors.New("packet body exceeds tile data")
+			}
+			c.segment.data = append(c.segment.data, data[pos:pos+c.length]...)
+			pos += c.length
+		}
+	}
+	return nil
+}
+
+// decodeCodeBlockHeader decodes the part of a packet header describing the
+// contribution of code-block `i` of `precinct` to layer `layer`.
+func decodeCodeBlockHeader(br *j2kBitReader, precinct *j2kPrecinct, i int, cb *j2kCodeBlock, layer int, cbStyle byte) ([]j2kContribution, error) {
+	firstInclusion := !cb.included
+	if firstInclusion {
+		if !precinct.inclusion.decode(br, i, layer+1) {
+			return nil, nil
+		}
+	} else if br.bit() == 0 {
+		return nil, nil
+	}
+	if firstInclusion {
+		zeroBitPlanes := 1
+		for !precinct.zeroBitPlanes.decode(br, i, zeroBitPlanes) {
+			zeroBitPlanes++
+			if zeroBitPlanes > 64 || br.overrun {
+				return nil, errors.New("invalid zero bit-planes")
+			}
+		}
+		cb.zeroBitPlanes = zeroBitPlanes - 1
+		cb.included = true
+	}
+
+	// number of coding passes, as per "Table B.4"
+	passes := 1
+	if br.bit() == 1 {
+		passes = 2
+		if br.bit() == 1 {
+			if v := br.bits(2); v < 3 {
+				passes = 3 + v
+			} else if v = br.bits(5); v < 31 {
+				passes = 6 + v
+			} else {
+				passes = 37 + br.bits(7)
+			}
+		}
+	}
+	cb.passes += passes
+	if cb.passes > 164 {
+		return nil, errors.New("too many coding passes")
+	}
+	for br.bit() == 1 {
+		cb.lblock++
+		if br.overrun {
+			return nil, errors.New("packet header exceeds tile data")
+		}
+	}
+
+	// lengths of the contribution to each codeword segment
+	contributions := []j2kContribution{}
+	for passes > 0 {
+		if len(cb.segments) == 0 || cb.segments[len(cb.segments)-1].passes == cb.segments[len(cb.segments)-1].maxPasses {
+			cb.segments = append(cb.segments, &j2kSegment{maxPasses: cb.segmentMaxPasses(cbStyle)})
+		}
+		segment := cb.segments[len(cb.segments)-1]
+		n := minInt(segment.maxPasses-segment.passes, passes)
+		bits := cb.lblock
+		for v := n; v > 1; v >>= 1 {
+			bits++
+		}
+		contributions = append(contributions, j2kContribution{segment, br.bits(bits)})
+		segment.passes += n
+		passes -= n
+	}
+	return contributions, nil
+}
+
+// segmentMaxPasses returns the maximum number of coding passes of the next
+// codeword segment of a code-block, as per "D.4.1" of ITU-T T.800.
+func (cb *j2kCodeBlock) segmentMaxPasses(cbStyle byte) int {
+	switch {
+	case cbStyle&j2kStyleTermAll != 0:
+		return 1
+	case cbStyle&j2kStyleBypass != 0:
+		if len(cb.segments) == 0 {
+			// arithmetic coding of the first four bit-planes
+			return 10
+		}
+		// alternating raw significance propagation and magnitude refinement
+		// passes, and arithmetic coded cleanup passes
+		if previous := cb.segments[len(cb.segments)-1].maxPasses; previous == 1 || previous == 10 {
+			return 2
+		}
+		return 1
+	}
+	return 164
+}
+
+// decodeBandCodeBlocks decodes the code-blocks of `band` into its coefficients.
+func decodeBandCodeBlocks(band *j2kBand, style *j2kCodingStyle, roiShift int) error {
+	t1 := j2kT1{}
+	width := band.x1 - band.x0
+	for _, precinct := range band.precincts {
+		for _, cb := range precinct.blocks {
+			if cb.passes == 0 {
+				continue
+			}
+			lowestBitPlane, err := t1.decode(cb, band, style.cbStyle)
+			if err != nil {
+				return err
+			}
+			// reconstruction, as per "E.1.1"
+			half := 0.0
+			if lowestBitPlane > 0 {
+				half = math.Ldexp(1, lowestBitPlane-1)
+			} else if !style.reversible {
+				half = 0.5
+			}
+			w := cb.x1 - cb.x0
+			for y := cb.y0; y < cb.y1; y++ {
+				for x := cb.x0; x < cb.x1; x++ {
+					i := (y-cb.y0)*w + (x - cb.x0)
+					magnitude := t1.magnitudes[i]
+					if magnitude == 0 {
+						continue
+					}
+					v := float64(magnitude) + half
+					if roiShift > 0 && magnitude >= 1<<uint(roiShift) {
+						v = math.Ldexp(v, -roiShift)
+					}
+					v *= band.step
+					if t1.states[t1.index(x-cb.x0, y-cb.y0)]&j2kNegative != 0 {
+						v = -v
+					}
+					band.coefficients[(y-band.y0)*width+(x-band.x0)] = v
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// j2kT1 decodes code-blocks, as per "Annex D" of ITU-T T.800.
+type j2kT1 struct {
+	width, height int
+	orientation   int
+	causal        bool
+	// states are held with a border of one coefficient on each side
+	states     []uint8
+	magnitudes []uint32
+	contexts   [j2kContexts]j2kMQContext
+	mq         j2kMQDecoder
+	raw        j2kBitReader
+	bypass     bool
+}
+
+// index returns the index of the state of the coefficient at (`x`, `y`).
+func (t1 *j2kT1) index(x, y int) int {
+	return (y+1)*(t1.width+2) + x + 1
+}
+
+// decode decodes the coding passes of `cb`, returning the lowest bit-plane of
+// which every pass was decoded.
+func (t1 *j2kT1) decode(cb *j2kCodeBlock, band *j2kBand, cbStyle byte) (int, error) {
+	t1.width, t1.height = cb.x1-cb.x0, cb.y1-cb.y0
+	t1.orientation = band.orientation
+	t1.causal = cbStyle&j2kStyleCausal != 0
+	n := t1.width * t1.height
+	t1.magnitudes = append(t1.magnitudes[:0], make([]uint32, n)...)
+	t1.states = append(t1.states[:0], make([]uint8, (t1.width+2)*(t1.height+2))...)
+	j2kResetContexts(&t1.contexts)
+
+	bitPlanes := band.magnitudeBits - cb.zeroBitPlanes
+	if bitPlanes <= 0 || bitPlanes > 31 {
+		return 0, fmt.Errorf("code-block of %d bit-planes is not supported", bitPlanes)
+	}
+	// passes cycle through cleanup (0), significance propagation (1) and
+	// magnitude refinement (2), beginning with cleanup of the most significant
+	// bit-plane
+	bitPlane, passType, pass := bitPlanes-1, 0, 0
+	lowest := bitPlanes
+	for _, segment := range cb.segments {
+		for i := 0; i < segment.passes; i++ {
+			if bitPlane < 0 {
+				return 0, errors.New("code-block holds too many coding passes")
+			}
+			raw := cbStyle&j2kStyleBypass != 0 && pass >= 10 && passType != 0
+			if i == 0 {
+				if raw {
+					t1.raw = j2kBitReader{src: segment.data}
+				} else {
+					t1.mq.init(segment.data)
+				}
+			}
+			t1.bypass = raw
+			switch passType {
+			case 0:
+				t1.cleanup(bitPlane)
+				if cbStyle&j2kStyleSegMark != 0 {
+					for k := 0; k < 4; k++ {
+						t1.mq.decode(&t1.contexts[j2kContextUniform])
+					}
+				}
+				lowest = bitPlane
+			case 1:
+				t1.significancePropagation(bitPlane)
+			case 2:
+				t1.magnitudeRefinement(bitPlane)
+			}
+			if cbStyle&j2kStyleReset != 0 {
+				j2kResetContexts(&t1.contexts)
+			}
+			pass++
+			passType = (passType + 1) % 3
+			if passType == 1 {
+				bitPlane--
+			}
+		}
+	}
+	return lowest, nil
+}
+
+// decodeBit decodes a decision in context `cx`, or a raw bit in bypass mode.
+func (t1 *j2kT1) decodeBit(cx int) int {
+	if t1.bypass {
+		return t1.raw.bit()
+	}
+	return t1.mq.decode(&t1.contexts[cx])
+}
+
+// neighbours returns the number of significant horizontal, vertical and
+// diagonal neighbours of the coefficient at (`x`, `y`).
+func (t1 *j2kT1) neighbours(x, y int) (int, int, int) {
+	i, stride := t1.index(x, y), t1.width+2
+	sig := func(j int) int {
+		return int(t1.states[j] & j2kSignificant)
+	}
+	h := sig(i-1) + sig(i+1)
+	v := sig(i - stride)
+	d := sig(i-stride-1) + sig(i-stride+1)
+	// in vertically causal mode, the stripe below is not considered
+	if !t1.causal || y%4 != 3 {
+		v += sig(i + stride)
+		d += sig(i+stride-1) + sig(i+stride+1)
+	}
+	return h, v, d
+}
+
+// zeroCodingContext returns the context of zero coding, as per "Table D.1"
+// of ITU-T T.800.
+func (t1 *j2kT1) zeroCodingContext(x, y int) int {
+	h, v, d := t1.neighbours(x, y)
+	switch t1.orientation {
+	case j2kHH:
+		hv := h + v
+		switch {
+		case d >= 3:
+			return 8
+		case d == 2 && hv >= 1:
+			return 7
+		case d == 2:
+			return 6
+		case d == 1 && hv >= 2:
+			return 5
+		case d == 1 && hv == 1:
+			return 4
+		case d == 1:
+			return 3
+		case hv >= 2:
+			return 2
+		}
+		return hv
+	case j2kHL:
+		h, v = v, h
+	}
+	switch {
+	case h == 2:
+		return 8
+	case h == 1 && v >= 1:
+		return 7
+	case h == 1 && d >= 1:
+		return 6
+	case h == 1:
+		return 5
+	case v == 2:
+		return 4
+	case v == 1:
+		return 3
+	case d >= 2:
+		return 2
+	}
+	return d
+}
+
+// signContext returns the context of sign coding of the coefficient at
+// (`x`, `y`), and the bit with which the decision is XORed, as per "Table D.3"
+// of ITU-T T.800.
+func (t1 *j2kT1) signContext(x, y int) (int, int) {
+	i, stride := t1.index(x, y), t1.width+2
+	contribution := func(j int) int {
+		switch t1.states[j] & (j2kSignificant | j2kNegative) {
+		case j2kSignificant:
+			return 1
+		case j2kSignificant | j2kNegative:
+			return -1
+		}
+		return 0
+	}
+	clamp := func(v int) int {
+		return maxInt(-1, minInt(1, v))
+	}
+	h := clamp(contribution(i-1) + contribution(i+1))
+	below := 0
+	if !t1.causal || y%4 != 3 {
+		below = contribution(i + stride)
+	}
+	v := clamp(contribution(i-stride) + below)
+	if h < 0 || (h == 0 && v < 0) {
+		return 9 - h*3 - v, 1
+	}
+	return 9 + h*3 + v, 0
+}
+
+// refinementContext returns the context of magnitude refinement of the
+// coefficient at (`x`, `y`), as per "Table D.4" of ITU-T T.800.
+func (t1 *j2kT1) refinementContext(x, y int) int {
+	if t1.states[t1.index(x, y)]&j2kRefined != 0 {
+		return 16
+	}
+	if h, v, d := t1.neighbours(x, y); h+v+d > 0 {
+		return 15
+	}
+	return 14
+}
+
+// decodeSign decodes the sign of the coefficient at (`x`, `y`), and marks the
+// coefficient significant.
+func (t1 *j2kT1) decodeSign(x, y int) {
+	var sign int
+	if t1.bypass {
+		sign = t1.raw.bit()
+	} else {
+		cx, xor := t1.signContext(x, y)
+		sign = t1.mq.decode(&t1.contexts[cx]) ^ xor
+	}
+	i := t1.index(x, y)
+	t1.states[i] |= j2kSignificant
+	if sign == 1 {
+		t1.states[i] |= j2kNegative
+	}
+}
+
+// significancePropagation decodes a significance propagation pass of `bitPlane`.
+func (t1 *j2kT1) significancePropagation(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			for y := y0; y < y0+4 && y < t1.height; y++ {
+				i := t1.index(x, y)
+				if t1.states[i]&j2kSignificant != 0 {
+					continue
+				}
+				cx := t1.zeroCodingContext(x, y)
+				if cx == 0 {
+					continue
+				}
+				t1.states[i] |= j2kVisited
+				if t1.decodeBit(cx) == 1 {
+					t1.decodeSign(x, y)
+					t1.magnitudes[y*t1.width+x] |= 1 << uint(bitPlane)
+				}
+			}
+		}
+	}
+}
+
+// magnitudeRefinement decodes a magnitude refinement pass of `bitPlane`.
+func (t1 *j2kT1) magnitudeRefinement(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			for y := y0; y < y0+4 && y < t1.height; y++ {
+				i := t1.index(x, y)
+				if t1.states[i]&(j2kSignificant|j2kVisited) != j2kSignificant {
+					continue
+				}
+				if t1.decodeBit(t1.refinementContext(x, y)) == 1 {
+					t1.magnitudes[y*t1.width+x] |= 1 << uint(bitPlane)
+				}
+				t1.states[i] |= j2kRefined
+			}
+		}
+	}
+}
+
+// cleanup decodes a cleanup pass of `bitPlane`.
+func (t1 *j2kT1) cleanup(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			y := y0
+			// run-length coding of a full stripe column in which every
+			// coefficient is insignificant, and has insignificant neighbours
+			if y0+4 <= t1.height {
+				run := true
+				for k := y0; k < y0+4 && run; k++ {
+					run = t1.states[t1.index(x, k)]&(j2kSignificant|j2kVisited) == 0 && t1.zeroCodingContext(x, k) == 0
+				}
+				if run {
+					if t1.mq.decode(&t1.contexts[j2kContextRunLength]) == 0 {
+						continue
+					}
+					y += t1.mq.decode(&t1.contexts[j2kContextUniform])<<1 | t1.mq.decode(&t1.contexts[j2kContextUniform])
+					t1.decodeSign(x, y)
+					t1.magnitudes[y*t1.width+x] |= 1 << uint(bitPlane)
+					y++
+				}
+			}
+			for ; y < y0+4 && y < t1.height; y++ {
+				i := t1.index(x, y)
+				if t1.states[i]&(j2kSignificant|j2kVisited) != 0 {
+					continue
+				}
+				if t1.mq.decode(&t1.contexts[t1.zeroCodingContext(x, y)]) == 1 {
+					t1.decodeSign(x, y)
+					t1.magnitudes[y*t1.width+x] |= 1 << uint(bitPlane)
+				}
+			}
+		}
+	}
+	for i := range t1.states {
+		t1.states[i] &^= j2kVisited
+	}
+}
+
+// lifting coefficients of the irreversible 9-7 wavelet transform, as per
+// "Table F.4" of ITU-T T.800
+const (
+	j2kAlpha = -1.586134342059924
+	j2kBeta  = -0.052980118572961
+	j2kGamma = 0.882911075530934
+	j2kDelta = 0.443506852043971
+	j2kK     = 1.230174104914001
+)
+
+// inverseDWT returns the samples of a tile-component, reconstructed from its
+// subbands, as per "F.3" of ITU-T T.800.
+func inverseDWT(tc *j2kTileComponent) []float64 {
+	reversible := tc.style.reversible
+	samples := tc.resolutions[0].bands[0].coefficients
+	for r := 1; r < len(tc.resolutions); r++ {
+		res := tc.resolutions[r]
+		width, height := res.x1-res.x0, res.y1-res.y0
+		if width == 0 || height == 0 {
+			samples = nil
+			continue
+		}
+		// interleave the coefficients of the subbands, as per "F.3.3"
+		out := make([]float64, width*height)
+		subbands := [4][]float64{samples, res.bands[0].coefficients, res.bands[1].coefficients, res.bands[2].coefficients}
+		lowWidth := ceilDiv(res.x1, 2) - ceilDiv(res.x0, 2)
+		highWidth := res.x1/2 - res.x0/2
+		for y := 0; y < height; y++ {
+			v := res.y0 + y
+			by, vHigh := (v+1)/2-ceilDiv(res.y0, 2), v%2 == 1
+			if vHigh {
+				by = v/2 - res.y0/2
+			}
+			for x := 0; x < width; x++ {
+				u := res.x0 + x
+				bx, uHigh := (u+1)/2-ceilDiv(res.x0, 2), u%2 == 1
+				if uHigh {
+					bx = u/2 - res.x0/2
+				}
+				switch {
+				case !uHigh && !vHigh:
+					out[y*width+x] = subbands[0][by*lowWidth+bx]
+				case uHigh && !vHigh:
+					out[y*width+x] = subbands[1][by*highWidth+bx]
+				case !uHigh && vHigh:
+					out[y*width+x] = subbands[2][by*lowWidth+bx]
+				default:
+					out[y*width+x] = subbands[3][by*highWidth+bx]
+				}
+			}
+		}
+		// horizontal, then vertical, one-dimensional reconstruction
+		ext := make([]float64, maxInt(width, height)+8)
+		for y := 0; y < height; y++ {
+			inverseDWT1D(out[y*width:(y+1)*width], res.x0, reversible, ext)
+		}
+		column := make([]float64, height)
+		for x := 0; x < width; x++ {
+			for y := range column {
+				column[y] = out[y*width+x]
+			}
+			inverseDWT1D(column, res.y0, reversible, ext)
+			for y := range column {
+				out[y*width+x] = column[y]
+			}
+		}
+		samples = out
+	}
+	return samples
+}
+
+// inverseDWT1D reconstructs the signal `x`, which begins at coordinate `i0`, in
+// place, as per "F.3.6" of ITU-T T.800. `ext` is a buffer of at least
+// len(x)+8 values.
+func inverseDWT1D(x []float64, i0 int, reversible bool, ext []float64) {
+	n := len(x)
+	if n == 1 {
+		if i0%2 == 1 {
+			x[0] /= 2
+		}
+		return
+	}
+	// periodic symmetric extension by four samples at each end, as per "F.3.7"
+	const pad = 4
+	period := 2 * (n - 1)
+	for i := -pad; i < n+pad; i++ {
+		j := i % period
+		if j < 0 {
+			j += period
+		}
+		if j >= n {
+			j = period - j
+		}
+		ext[i+pad] = x[j]
+	}
+	e := ext[:n+2*pad]
+	even := func(i int) bool {
+		return (i0+i)&1 == 0
+	}
+	if reversible {
+		// "F-5"
+		for i := 1 - pad; i < n+pad-1; i++ {
+			if even(i) {
+				e[i+pad] -= math.Floor((e[i+pad-1] + e[i+pad+1] + 2) / 4)
+			}
+		}
+		for i := 0; i < n; i++ {
+			if !even(i) {
+				e[i+pad] += math.Floor((e[i+pad-1] + e[i+pad+1]) / 2)
+			}
+		}
+	} else {
+		// "F-7"
+		for i := -pad; i < n+pad; i++ {
+			if even(i) {
+				e[i+pad] *= j2kK
+			} else {
+				e[i+pad] /= j2kK
+			}
+		}
+		steps := []struct {
+			even       bool
+			start, end int
+			c          float64
+		}{
+			{true, -3, n + 3, j2kDelta},
+			{false, -2, n + 2, j2kGamma},
+			{true, -1, n + 1, j2kBeta},
+			{false, 0, n, j2kAlpha},
+		}
+		for _, step := range steps {
+			for i := step.start; i < step.end; i++ {
+				if even(i) == step.even {
+					e[i+pad] -= step.c * (e[i+pad-1] + e[i+pad+1])
+				}
+			}
+		}
+	}
+	copy(x, e[pad:pad+n])
+}
+
+// inverseMCT applies the inverse multiple component transformation to the first
+// three components of a tile, as per "Annex G" of ITU-T T.800.
+func inverseMCT(y0, y1, y2 []float64, reversible bool) {
+	for i := range y0 {
+		if reversible {
+			g := y0[i] - math.Floor((y1[i]+y2[i])/4)
+			y0[i], y1[i], y2[i] = y2[i]+g, g, y1[i]+g
+		} else {
+			y, cb, cr := y0[i], y1[i], y2[i]
+			y0[i] = y + 1.402*cr
+			y1[i] = y - 0.344136*cb - 0.714136*cr
+			y2[i] = y + 1.772*cb
+		}
+	}
+}
+
+// DecodeJPEG2000 decompresses a JPEG 2000 frame into native pixel data, updating
+// `params` to describe the decompressed frame.
+//
+// Components which underwent a multiple component transformation are converted
+// to RGB, in which case PhotometricInterpretation becomes "RGB". Decompressed
+// samples are little endian, and interleaved (PlanarConfiguration 0).
+//
+// An error is returned, before the frame is decoded, if its dimensions or
+// components do not match `params`.
+func DecodeJPEG2000(frame []byte, params *ImageParameters) ([]byte, error) {
+	codestream, err := j2kCodestream(frame)
+	if err != nil {
+		return nil, err
+	}
+	d := j2kDecoder{src: codestream, params: params}
+	if err = d.decode(); err != nil {
+		return nil, err
+	}
+	precision := 0
+	signed := false
+	for _, c := range d.components {
+		precision = maxInt(precision, c.precision)
+		signed = signed || c.signed
+	}
+	dst, err := nativeFromPlanes(d.planes, d.x1-d.x0, d.y1-d.y0, precision, params)
+	if err != nil {
+		return nil, err
+	}
+	params.PixelRepresentation = 0
+	if signed {
+		params.PixelRepresentation = 1
+	}
+	switch params.PhotometricInterpretation {
+	case "YBR_RCT", "YBR_ICT":
+		params.PhotometricInterpretation = "RGB"
+	default:
+		if d.multipleComponentXform {
+			params.PhotometricInterpretation = "RGB"
+		}
+	}
+	return dst, nil
+}
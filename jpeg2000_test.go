@@ -0,0 +1,1120 @@
+package opendcm
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"image/color"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// j2kMQEncoder is the MQ arithmetic encoder, as per "C.2" of ITU-T T.800.
+type j2kMQEncoder struct {
+	a  uint32
+	c  uint64
+	ct uint
+	// out holds the bytes written, following a placeholder for the byte
+	// preceding the first
+	out []byte
+}
+
+func (mq *j2kMQEncoder) init() {
+	mq.a, mq.c, mq.ct = 0x8000, 0, 12
+	mq.out = []byte{0}
+}
+
+// encode encodes decision `d` in context `cx`.
+func (mq *j2kMQEncoder) encode(cx *j2kMQContext, d int) {
+	state := &j2kMQStates[cx.state]
+	mq.a -= state.qe
+	if uint8(d) == cx.mps {
+		if mq.a&0x8000 != 0 {
+			mq.c += uint64(state.qe)
+			return
+		}
+		if mq.a < state.qe {
+			mq.a = state.qe
+		} else {
+			mq.c += uint64(state.qe)
+		}
+		cx.state = state.nmps
+	} else {
+		if mq.a < state.qe {
+			mq.c += uint64(state.qe)
+		} else {
+			mq.a = state.qe
+		}
+		if state.switchMPS {
+			cx.mps = 1 - cx.mps
+		}
+		cx.state = state.nlps
+	}
+	for {
+		mq.a <<= 1
+		mq.c <<= 1
+		mq.ct--
+		if mq.ct == 0 {
+			mq.byteOut()
+		}
+		if mq.a&0x8000 != 0 {
+			return
+		}
+	}
+}
+
+func (mq *j2kMQEncoder) byteOut() {
+	emit := func(shift uint) {
+		mq.out = append(mq.out, byte(mq.c>>shift))
+		mq.c &= 1<<shift - 1
+		mq.ct = 27 - shift
+	}
+	last := &mq.out[len(mq.out)-1]
+	switch {
+	case *last == 0xFF:
+		emit(20)
+	case mq.c < 0x8000000:
+		emit(19)
+	default:
+		// carry into the preceding byte
+		*last++
+		if *last == 0xFF {
+			mq.c &= 0x7FFFFFF
+			emit(20)
+		} else {
+			emit(19)
+		}
+	}
+}
+
+// flush terminates the codeword segment, and returns it.
+func (mq *j2kMQEncoder) flush() []byte {
+	temp := mq.c + uint64(mq.a)
+	mq.c |= 0xFFFF
+	if mq.c >= temp {
+		mq.c -= 0x8000
+	}
+	mq.c <<= mq.ct
+	mq.byteOut()
+	mq.c <<= mq.ct
+	mq.byteOut()
+	out := mq.out[1:]
+	if out[len(out)-1] == 0xFF {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// j2kT1Encoder encodes code-blocks, sharing the coefficient bit modeling of
+// `j2kT1`.
+type j2kT1Encoder struct {
+	j2kT1
+	negative []bool
+	mqe      j2kMQEncoder
+	rawe     stuffedBitWriter
+}
+
+func (t1 *j2kT1Encoder) encodeBit(cx, bit int) {
+	if t1.bypass {
+		t1.rawe.write(uint32(bit), 1)
+	} else {
+		t1.mqe.encode(&t1.contexts[cx], bit)
+	}
+}
+
+func (t1 *j2kT1Encoder) encodeSign(x, y int) {
+	sign := 0
+	if t1.negative[y*t1.width+x] {
+		sign = 1
+	}
+	if t1.bypass {
+		t1.rawe.write(uint32(sign), 1)
+	} else {
+		cx, xor := t1.signContext(x, y)
+		t1.mqe.encode(&t1.contexts[cx], sign^xor)
+	}
+	i := t1.index(x, y)
+	t1.states[i] |= j2kSignificant
+	if sign == 1 {
+		t1.states[i] |= j2kNegative
+	}
+}
+
+func (t1 *j2kT1Encoder) bit(x, y, bitPlane int) int {
+	return int(t1.magnitudes[y*t1.width+x]>>uint(bitPlane)) & 1
+}
+
+func (t1 *j2kT1Encoder) significancePropagation(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			for y := y0; y < y0+4 && y < t1.height; y++ {
+				i := t1.index(x, y)
+				if t1.states[i]&j2kSignificant != 0 {
+					continue
+				}
+				cx := t1.zeroCodingContext(x, y)
+				if cx == 0 {
+					continue
+				}
+				t1.states[i] |= j2kVisited
+				bit := t1.bit(x, y, bitPlane)
+				t1.encodeBit(cx, bit)
+				if bit == 1 {
+					t1.encodeSign(x, y)
+				}
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1Encoder) magnitudeRefinement(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			for y := y0; y < y0+4 && y < t1.height; y++ {
+				i := t1.index(x, y)
+				if t1.states[i]&(j2kSignificant|j2kVisited) != j2kSignificant {
+					continue
+				}
+				t1.encodeBit(t1.refinementContext(x, y), t1.bit(x, y, bitPlane))
+				t1.states[i] |= j2kRefined
+			}
+		}
+	}
+}
+
+func (t1 *j2kT1Encoder) cleanup(bitPlane int) {
+	for y0 := 0; y0 < t1.height; y0 += 4 {
+		for x := 0; x < t1.width; x++ {
+			y := y0
+			if y0+4 <= t1.height {
+				run := true
+				for k := y0; k < y0+4 && run; k++ {
+					run = t1.states[t1.index(x, k)]&(j2kSignificant|j2kVisited) == 0 && t1.zeroCodingContext(x, k) == 0
+				}
+				if run {
+					for y < y0+4 && t1.bit(x, y, bitPlane) == 0 {
+						y++
+					}
+					if y == y0+4 {
+						t1.mqe.encode(&t1.contexts[j2kContextRunLength], 0)
+						continue
+					}
+					t1.mqe.encode(&t1.contexts[j2kContextRunLength], 1)
+					t1.mqe.encode(&t1.contexts[j2kContextUniform], (y-y0)>>1)
+					t1.mqe.encode(&t1.contexts[j2kContextUniform], (y-y0)&1)
+					t1.encodeSign(x, y)
+					y++
+				}
+			}
+			for ; y < y0+4 && y < t1.height; y++ {
+				if t1.states[t1.index(x, y)]&(j2kSignificant|j2kVisited) != 0 {
+					continue
+				}
+				bit := t1.bit(x, y, bitPlane)
+				t1.mqe.encode(&t1.contexts[t1.zeroCodingContext(x, y)], bit)
+				if bit == 1 {
+					t1.encodeSign(x, y)
+				}
+			}
+		}
+	}
+	for i := range t1.states {
+		t1.states[i] &^= j2kVisited
+	}
+}
+
+// encode encodes every coding pass of `cb`, from its quantized `magnitudes`
+// and signs, into its codeword segments.
+func (t1 *j2kT1Encoder) encode(cb *j2kCodeBlock, band *j2kBand, cbStyle byte, magnitudes []uint32, negative []bool) {
+	t1.width, t1.height = cb.x1-cb.x0, cb.y1-cb.y0
+	t1.orientation = band.orientation
+	t1.causal = cbStyle&j2kStyleCausal != 0
+	t1.magnitudes, t1.negative = magnitudes, negative
+	t1.states = make([]uint8, (t1.width+2)*(t1.height+2))
+	j2kResetContexts(&t1.contexts)
+
+	bitPlanes := 0
+	for _, m := range magnitudes {
+		for m>>uint(bitPlanes) != 0 {
+			bitPlanes++
+		}
+	}
+	if bitPlanes == 0 {
+		return
+	}
+	if bitPlanes > band.magnitudeBits {
+		panic("coefficient exceeds the magnitude bit-planes of its subband")
+	}
+	cb.zeroBitPlanes = band.magnitudeBits - bitPlanes
+	cb.passes = 3*bitPlanes - 2
+
+	terminate := func() {
+		segment := cb.segments[len(cb.segments)-1]
+		if t1.bypass {
+			t1.rawe.flush()
+			segment.data = t1.rawe.dst
+		} else {
+			segment.data = t1.mqe.flush()
+		}
+	}
+	bitPlane, passType := bitPlanes-1, 0
+	for pass := 0; pass < cb.passes; pass++ {
+		if len(cb.segments) == 0 || cb.segments[len(cb.segments)-1].passes == cb.segments[len(cb.segments)-1].maxPasses {
+			if len(cb.segments) > 0 {
+				terminate()
+			}
+			cb.segments = append(cb.segments, &j2kSegment{maxPasses: cb.segmentMaxPasses(cbStyle)})
+			t1.bypass = cbStyle&j2kStyleBypass != 0 && pass >= 10 && passType != 0
+			if t1.bypass {
+				t1.rawe = stuffedBitWriter{}
+			} else {
+				t1.mqe.init()
+			}
+		}
+		switch passType {
+		case 0:
+			t1.cleanup(bitPlane)
+			if cbStyle&j2kStyleSegMark != 0 {
+				for _, bit := range []int{1, 0, 1, 0} {
+					t1.mqe.encode(&t1.contexts[j2kContextUniform], bit)
+				}
+			}
+		case 1:
+			t1.significancePropagation(bitPlane)
+		case 2:
+			t1.magnitudeRefinement(bitPlane)
+		}
+		if cbStyle&j2kStyleReset != 0 {
+			j2kResetContexts(&t1.contexts)
+		}
+		cb.segments[len(cb.segments)-1].passes++
+		passType = (passType + 1) % 3
+		if passType == 1 {
+			bitPlane--
+		}
+	}
+	terminate()
+}
+
+// j2kTagTreeEncoder encodes the values of a tag tree.
+type j2kTagTreeEncoder struct {
+	*j2kTagTree
+	known []bool
+}
+
+// newJ2KTagTreeEncoder returns an encoder of the tag tree with leaves `values`.
+func newJ2KTagTreeEncoder(tree *j2kTagTree, values []int) *j2kTagTreeEncoder {
+	for i, v := range values {
+		tree.nodes[i].value = v
+	}
+	for i, node := range tree.nodes {
+		if node.parent >= 0 && tree.nodes[i].value < tree.nodes[node.parent].value {
+			tree.nodes[node.parent].value = tree.nodes[i].value
+		}
+	}
+	return &j2kTagTreeEncoder{tree, make([]bool, len(tree.nodes))}
+}
+
+// encode encodes the value of `leaf` as far as `threshold`.
+func (t *j2kTagTreeEncoder) encode(bw *stuffedBitWriter, leaf, threshold int) {
+	low := 0
+	for _, n := range t.path(leaf) {
+		node := &t.nodes[n]
+		if low > node.low {
+			node.low = low
+		} else {
+			low = node.low
+		}
+		for low < threshold {
+			if low >= node.value {
+				if !t.known[n] {
+					bw.write(1, 1)
+					t.known[n] = true
+				}
+				break
+			}
+			bw.write(0, 1)
+			low++
+		}
+		node.low = low
+	}
+}
+
+// forwardDWT1D decomposes the signal `x`, which begins at coordinate `i0`, in
+// place, as per "F.4.8" of ITU-T T.800.
+func forwardDWT1D(x []float64, i0 int, reversible bool, ext []float64) {
+	n := len(x)
+	if n == 1 {
+		if i0%2 == 1 {
+			x[0] *= 2
+		}
+		return
+	}
+	const pad = 4
+	period := 2 * (n - 1)
+	for i := -pad; i < n+pad; i++ {
+		j := i % period
+		if j < 0 {
+			j += period
+		}
+		if j >= n {
+			j = period - j
+		}
+		ext[i+pad] = x[j]
+	}
+	e := ext[:n+2*pad]
+	even := func(i int) bool {
+		return (i0+i)&1 == 0
+	}
+	if reversible {
+		for i := -1; i < n+1; i++ {
+			if !even(i) {
+				e[i+pad] -= math.Floor((e[i+pad-1] + e[i+pad+1]) / 2)
+			}
+		}
+		for i := 0; i < n; i++ {
+			if even(i) {
+				e[i+pad] += math.Floor((e[i+pad-1] + e[i+pad+1] + 2) / 4)
+			}
+		}
+	} else {
+		steps := []struct {
+			even       bool
+			start, end int
+			c          float64
+		}{
+			{false, -3, n + 3, j2kAlpha},
+			{true, -2, n + 2, j2kBeta},
+			{false, -1, n + 1, j2kGamma},
+			{true, 0, n, j2kDelta},
+		}
+		for _, step := range steps {
+			for i := step.start; i < step.end; i++ {
+				if even(i) == step.even {
+					e[i+pad] += step.c * (e[i+pad-1] + e[i+pad+1])
+				}
+			}
+		}
+		for i := 0; i < n; i++ {
+			if even(i) {
+				e[i+pad] /= j2kK
+			} else {
+				e[i+pad] *= j2kK
+			}
+		}
+	}
+	copy(x, e[pad:pad+n])
+}
+
+// forwardDWT decomposes the samples of a tile-component into its subbands.
+func forwardDWT(tc *j2kTileComponent, samples []float64) {
+	reversible := tc.style.reversible
+	for r := len(tc.resolutions) - 1; r >= 1; r-- {
+		res := tc.resolutions[r]
+		width, height := res.x1-res.x0, res.y1-res.y0
+		lowWidth := ceilDiv(res.x1, 2) - ceilDiv(res.x0, 2)
+		highWidth := res.x1/2 - res.x0/2
+		lowHeight := ceilDiv(res.y1, 2) - ceilDiv(res.y0, 2)
+		low := make([]float64, lowWidth*lowHeight)
+		if width == 0 || height == 0 {
+			samples = low
+			continue
+		}
+		ext := make([]float64, maxInt(width, height)+8)
+		column := make([]float64, height)
+		for x := 0; x < width; x++ {
+			for y := range column {
+				column[y] = samples[y*width+x]
+			}
+			forwardDWT1D(column, res.y0, reversible, ext)
+			for y := range column {
+				samples[y*width+x] = column[y]
+			}
+		}
+		for y := 0; y < height; y++ {
+			forwardDWT1D(samples[y*width:(y+1)*width], res.x0, reversible, ext)
+		}
+		for y := 0; y < height; y++ {
+			v := res.y0 + y
+			by, vHigh := (v+1)/2-ceilDiv(res.y0, 2), v%2 == 1
+			if vHigh {
+				by = v/2 - res.y0/2
+			}
+			for x := 0; x < width; x++ {
+				u := res.x0 + x
+				bx, uHigh := (u+1)/2-ceilDiv(res.x0, 2), u%2 == 1
+				if uHigh {
+					bx = u/2 - res.x0/2
+				}
+				s := samples[y*width+x]
+				switch {
+				case !uHigh && !vHigh:
+					low[by*lowWidth+bx] = s
+				case uHigh && !vHigh:
+					res.bands[0].coefficients[by*highWidth+bx] = s
+				case !uHigh && vHigh:
+					res.bands[1].coefficients[by*lowWidth+bx] = s
+				default:
+					res.bands[2].coefficients[by*highWidth+bx] = s
+				}
+			}
+		}
+		samples = low
+	}
+	copy(tc.resolutions[0].bands[0].coefficients, samples)
+}
+
+// j2kEncodeOptions describes how `encodeJPEG2000` encodes a frame.
+type j2kEncodeOptions struct {
+	precision             int
+	signed                bool
+	levels                int
+	cbWidth, cbHeight     int
+	cbStyle               byte
+	irreversible, derived bool
+	mct                   bool
+	layers                int
+	progression           int
+	tileWidth, tileHeight int
+	// tileParts splits each tile into two tile-parts
+	tileParts bool
+	precincts []byte
+	sop, eph  bool
+	roiShift  int
+}
+
+// encodeJPEG2000 returns a JPEG 2000 codestream of `planes`, being `width` x
+// `height` samples.
+func encodeJPEG2000(planes [][]int32, width, height int, o j2kEncodeOptions) []byte {
+	if o.layers == 0 {
+		o.layers = 1
+	}
+	if o.cbWidth == 0 {
+		o.cbWidth, o.cbHeight = 6, 6
+	}
+	if o.tileWidth == 0 {
+		o.tileWidth, o.tileHeight = width, height
+	}
+	u16 := func(v int) []byte {
+		return []byte{byte(v >> 8), byte(v)}
+	}
+	u32 := func(v int) []byte {
+		return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+	}
+	segment := func(marker byte, content ...byte) []byte {
+		return append(append([]byte{0xFF, marker}, u16(len(content)+2)...), content...)
+	}
+
+	siz := append(u16(0), u32(width)...)
+	siz = append(siz, u32(height)...)
+	siz = append(siz, u32(0)...)
+	siz = append(siz, u32(0)...)
+	siz = append(siz, u32(o.tileWidth)...)
+	siz = append(siz, u32(o.tileHeight)...)
+	siz = append(siz, u32(0)...)
+	siz = append(siz, u32(0)...)
+	siz = append(siz, u16(len(planes))...)
+	for range planes {
+		ssiz := byte(o.precision - 1)
+		if o.signed {
+			ssiz |= 0x80
+		}
+		siz = append(siz, ssiz, 1, 1)
+	}
+
+	scod := byte(0)
+	if o.precincts != nil {
+		scod |= 0x01
+	}
+	if o.sop {
+		scod |= 0x02
+	}
+	if o.eph {
+		scod |= 0x04
+	}
+	mct, transform := byte(0), byte(1)
+	if o.mct {
+		mct = 1
+	}
+	if o.irreversible {
+		transform = 0
+	}
+	cod := append([]byte{scod, byte(o.progression)}, u16(o.layers)...)
+	cod = append(cod, mct, byte(o.levels), byte(o.cbWidth-2), byte(o.cbHeight-2), o.cbStyle, transform)
+	cod = append(cod, o.precincts...)
+
+	// the exponent of each subband allows for the gain of the subband, the
+	// extra bit of the multiple component transformation and the expansion of
+	// the wavelet transform
+	exponent := func(r, orientation int) int {
+		return o.precision + [4]int{0, 1, 1, 2}[orientation] + 2
+	}
+	var qcd []byte
+	switch {
+	case !o.irreversible:
+		qcd = []byte{2 << 5}
+		qcd = append(qcd, byte(exponent(0, j2kLL)<<3))
+		for r := 1; r <= o.levels; r++ {
+			for _, orientation := range []int{j2kHL, j2kLH, j2kHH} {
+				qcd = append(qcd, byte(exponent(r, orientation)<<3))
+			}
+		}
+	case o.derived:
+		qcd = append([]byte{3<<5 | 1}, u16((o.precision+2+o.levels)<<11|0x100)...)
+	default:
+		qcd = append([]byte{3<<5 | 2}, u16(exponent(0, j2kLL)<<11|0x200)...)
+		for r := 1; r <= o.levels; r++ {
+			for _, orientation := range []int{j2kHL, j2kLH, j2kHH} {
+				qcd = append(qcd, u16(exponent(r, orientation)<<11|0x200)...)
+			}
+		}
+	}
+
+	header := append([]byte{0xFF, j2kMarkerSOC}, segment(j2kMarkerSIZ, siz...)...)
+	header = append(header, segment(j2kMarkerCOD, cod...)...)
+	header = append(header, segment(j2kMarkerQCD, qcd...)...)
+	if o.roiShift > 0 {
+		for c := range planes {
+			header = append(header, segment(j2kMarkerRGN, byte(c), 0, byte(o.roiShift))...)
+		}
+	}
+
+	// the coding parameters are parsed as they are by the decoder
+	d := j2kDecoder{main: newJ2KHeader()}
+	if err := d.parseSIZ(siz); err != nil {
+		panic(err)
+	}
+	for _, s := range []struct {
+		marker  byte
+		content []byte
+	}{{j2kMarkerCOD, cod}, {j2kMarkerQCD, qcd}} {
+		if err := d.parseHeaderSegment(&d.main, s.marker, s.content); err != nil {
+			panic(err)
+		}
+	}
+	for c := range planes {
+		d.main.rgn[c] = o.roiShift
+	}
+
+	stream := header
+	for index := range d.tiles {
+		tile := &d.tiles[index]
+		tx0, ty0, tx1, ty1 := d.tileBounds(index)
+		w, h := tx1-tx0, ty1-ty0
+		samples := make([][]float64, len(planes))
+		for c, plane := range planes {
+			samples[c] = make([]float64, w*h)
+			for y := ty0; y < ty1; y++ {
+				for x := tx0; x < tx1; x++ {
+					v := float64(plane[y*width+x])
+					if !o.signed {
+						v -= float64(int32(1) << uint(o.precision-1))
+					}
+					samples[c][(y-ty0)*w+(x-tx0)] = v
+				}
+			}
+		}
+		if o.mct {
+			r, g, b := samples[0], samples[1], samples[2]
+			for i := range r {
+				if o.irreversible {
+					r[i], g[i], b[i] = 0.299*r[i]+0.587*g[i]+0.114*b[i],
+						-0.16875*r[i]-0.33126*g[i]+0.5*b[i],
+						0.5*r[i]-0.41869*g[i]-0.08131*b[i]
+				} else {
+					r[i], g[i], b[i] = math.Floor((r[i]+2*g[i]+b[i])/4), b[i]-g[i], r[i]-g[i]
+				}
+			}
+		}
+
+		components := make([]*j2kTileComponent, len(planes))
+		e := j2kPacketEncoder{layers: map[*j2kSegment]int{}, tagTrees: map[*j2kPrecinct][2]*j2kTagTreeEncoder{}, included: map[*j2kCodeBlock]bool{}}
+		t1 := j2kT1Encoder{}
+		for c := range planes {
+			tc, err := d.newTileComponent(tile, c, tx0, ty0, tx1, ty1)
+			if err != nil {
+				panic(err)
+			}
+			components[c] = tc
+			forwardDWT(tc, samples[c])
+			for _, res := range tc.resolutions {
+				for _, band := range res.bands {
+					for _, precinct := range band.precincts {
+						e.encodePrecinct(&t1, precinct, band, tc.style.cbStyle, o)
+					}
+				}
+			}
+		}
+
+		global, _ := d.codingStyle(tile, 0)
+		data := []byte{}
+		for n, packet := range d.packetOrder(global, components) {
+			if o.sop {
+				data = append(data, 0xFF, j2kMarkerSOP, 0, 4, byte(n>>8), byte(n))
+			}
+			data = append(data, e.encodePacket(packet, components[packet.component], o.eph)...)
+		}
+
+		parts := [][]byte{data}
+		if o.tileParts {
+			parts = [][]byte{data[:len(data)/2], data[len(data)/2:]}
+		}
+		for k, part := range parts {
+			sot := append(u16(index), u32(12+2+len(part))...)
+			sot = append(sot, byte(k), byte(len(parts)))
+			stream = append(stream, segment(j2kMarkerSOT, sot...)...)
+			stream = append(stream, 0xFF, j2kMarkerSOD)
+			stream = append(stream, part...)
+		}
+	}
+	return append(stream, 0xFF, j2kMarkerEOC)
+}
+
+// j2kPacketEncoder encodes the packets of a tile.
+type j2kPacketEncoder struct {
+	// layers holds the layer of each codeword segment
+	layers map[*j2kSegment]int
+	// tagTrees holds the inclusion and zero bit-plane tag trees of each precinct
+	tagTrees map[*j2kPrecinct][2]*j2kTagTreeEncoder
+	included map[*j2kCodeBlock]bool
+}
+
+// encodePrecinct encodes the code-blocks of `precinct`, assigning their
+// codeword segments to layers.
+func (e *j2kPacketEncoder) encodePrecinct(t1 *j2kT1Encoder, precinct *j2kPrecinct, band *j2kBand, cbStyle byte, o j2kEncodeOptions) {
+	bandWidth := band.x1 - band.x0
+	inclusion := make([]int, len(precinct.blocks))
+	zeroBitPlanes := make([]int, len(precinct.blocks))
+	for i, cb := range precinct.blocks {
+		w := cb.x1 - cb.x0
+		magnitudes := make([]uint32, w*(cb.y1-cb.y0))
+		negative := make([]bool, len(magnitudes))
+		for y := cb.y0; y < cb.y1; y++ {
+			for x := cb.x0; x < cb.x1; x++ {
+				v := band.coefficients[(y-band.y0)*bandWidth+(x-band.x0)]
+				m := uint32(math.Floor(math.Abs(v) / band.step))
+				if m != 0 {
+					m <<= uint(o.roiShift)
+				}
+				magnitudes[(y-cb.y0)*w+(x-cb.x0)] = m
+				negative[(y-cb.y0)*w+(x-cb.x0)] = v < 0
+			}
+		}
+		t1.encode(cb, band, cbStyle, magnitudes, negative)
+		// code-blocks are first included in differing layers, following which
+		// their segments are spread across the remaining layers
+		inclusion[i] = math.MaxInt32
+		if len(cb.segments) > 0 {
+			inclusion[i] = i % o.layers
+		}
+		for k, segment := range cb.segments {
+			e.layers[segment] = inclusion[i] + k*(o.layers-inclusion[i])/len(cb.segments)
+		}
+		zeroBitPlanes[i] = cb.zeroBitPlanes
+	}
+	if len(precinct.blocks) > 0 {
+		e.tagTrees[precinct] = [2]*j2kTagTreeEncoder{
+			newJ2KTagTreeEncoder(precinct.inclusion, inclusion),
+			newJ2KTagTreeEncoder(precinct.zeroBitPlanes, zeroBitPlanes),
+		}
+	}
+}
+
+// encodePacket returns the header and body of `packet`.
+func (e *j2kPacketEncoder) encodePacket(packet j2kPacket, tc *j2kTileComponent, eph bool) []byte {
+	res := tc.resolutions[packet.resolution]
+	// the segments of each code-block in the layer
+	contributions := map[*j2kCodeBlock][]*j2kSegment{}
+	for _, band := range res.bands {
+		for _, cb := range band.precincts[packet.precinct].blocks {
+			for _, segment := range cb.segments {
+				if e.layers[segment] == packet.layer {
+					contributions[cb] = append(contributions[cb], segment)
+				}
+			}
+		}
+	}
+	bw := stuffedBitWriter{}
+	body := []byte{}
+	if len(contributions) == 0 {
+		bw.write(0, 1)
+	} else {
+		bw.write(1, 1)
+		for _, band := range res.bands {
+			precinct := band.precincts[packet.precinct]
+			for i, cb := range precinct.blocks {
+				segments := contributions[cb]
+				if !e.included[cb] {
+					trees := e.tagTrees[precinct]
+					trees[0].encode(&bw, i, packet.layer+1)
+					if len(segments) == 0 {
+						continue
+					}
+					for threshold := 1; ; threshold++ {
+						trees[1].encode(&bw, i, threshold)
+						if cb.zeroBitPlanes < threshold {
+							break
+						}
+					}
+					e.included[cb] = true
+				} else if len(segments) == 0 {
+					bw.write(0, 1)
+					continue
+				} else {
+					bw.write(1, 1)
+				}
+
+				passes := 0
+				for _, segment := range segments {
+					passes += segment.passes
+				}
+				switch {
+				case passes == 1:
+					bw.write(0, 1)
+				case passes == 2:
+					bw.write(2, 2)
+				case passes <= 5:
+					bw.write(0xC|uint32(passes-3), 4)
+				case passes <= 36:
+					bw.write(0x1E0|uint32(passes-6), 9)
+				default:
+					bw.write(0xFF80|uint32(passes-37), 16)
+				}
+
+				// lengths, increasing Lblock as necessary to signal them
+				bits := func(v int) int {
+					n := 0
+					for ; v > 0; v >>= 1 {
+						n++
+					}
+					return n
+				}
+				increment := 0
+				for _, segment := range segments {
+					increment = maxInt(increment, bits(len(segment.data))-cb.lblock-(bits(segment.passes)-1))
+				}
+				bw.write(1<<uint(increment+1)-2, uint(increment+1))
+				cb.lblock += increment
+				for _, segment := range segments {
+					bw.write(uint32(len(segment.data)), uint(cb.lblock+bits(segment.passes)-1))
+					body = append(body, segment.data...)
+				}
+			}
+		}
+	}
+	bw.flush()
+	if eph {
+		bw.dst = append(bw.dst, 0xFF, j2kMarkerEPH)
+	}
+	return append(bw.dst, body...)
+}
+
+// wrapJP2 returns a JP2 file holding `codestream`.
+func wrapJP2(codestream []byte) []byte {
+	box := func(boxType string, content []byte) []byte {
+		n := len(content) + 8
+		return append(append([]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}, boxType...), content...)
+	}
+	jp2 := append([]byte{}, j2kJP2Signature...)
+	jp2 = append(jp2, box("ftyp", []byte("jp2 \x00\x00\x00\x00jp2 "))...)
+	jp2 = append(jp2, box("jp2h", nil)...)
+	return append(jp2, box("jp2c", codestream)...)
+}
+
+func TestJ2KMQDecoder(t *testing.T) {
+	// ensures that the MQ decoder decodes the test sequence of "H.2" of
+	// ITU-T T.88, and that decisions encoded by the MQ encoder are decoded.
+	t.Parallel()
+	decisions := []byte{
+		0x00, 0x02, 0x00, 0x51, 0x00, 0x00, 0x00, 0xC0, 0x03, 0x52, 0x87, 0x2A, 0xAA, 0xAA, 0xAA, 0xAA,
+		0x82, 0xC0, 0x20, 0x00, 0xFC, 0xD7, 0x9E, 0xF6, 0xBF, 0x7F, 0xED, 0x90, 0x4F, 0x46, 0xA3, 0xBF,
+	}
+	encoded := []byte{
+		0x84, 0xC7, 0x3B, 0xFC, 0xE1, 0xA1, 0x43, 0x04, 0x02, 0x20, 0x00, 0x00, 0x41, 0x0D, 0xBB, 0x86,
+		0xF4, 0x31, 0x7F, 0xFF, 0x88, 0xFF, 0x37, 0x47, 0x1A, 0xDB, 0x6A, 0xDF, 0xFF, 0xAC,
+	}
+	mq := j2kMQDecoder{}
+	mq.init(encoded)
+	cx := j2kMQContext{}
+	for i := 0; i < len(decisions)*8; i++ {
+		assert.Equal(t, int(decisions[i/8]>>uint(7-i%8))&1, mq.decode(&cx), "decision %d", i)
+	}
+
+	random := rand.New(rand.NewSource(1))
+	values := make([]int, 5000)
+	for i := range values {
+		// skewed decisions in a few contexts
+		if random.Intn(10) < 8 {
+			values[i] = i % 2
+		} else {
+			values[i] = random.Intn(2)
+		}
+	}
+	mqe := j2kMQEncoder{}
+	mqe.init()
+	contexts := [j2kContexts]j2kMQContext{}
+	j2kResetContexts(&contexts)
+	for i, v := range values {
+		mqe.encode(&contexts[i%j2kContexts], v)
+	}
+	mq.init(mqe.flush())
+	j2kResetContexts(&contexts)
+	for i, v := range values {
+		assert.Equal(t, v, mq.decode(&contexts[i%j2kContexts]), "decision %d", i)
+	}
+}
+
+func TestJ2KTagTree(t *testing.T) {
+	// ensures that tag tree values are decoded as they are encoded.
+	t.Parallel()
+	values := []int{1, 3, 2, 3, 2, 0, 2, 1, 4, 2, 2, 5, 1, 3, 3, 6, 2, 0, 4, 1, 2}
+	encoder := newJ2KTagTreeEncoder(newJ2KTagTree(7, 3), values)
+	bw := stuffedBitWriter{}
+	for threshold := 1; threshold <= 7; threshold++ {
+		for leaf := range values {
+			encoder.encode(&bw, leaf, threshold)
+		}
+	}
+	bw.flush()
+	decoder := newJ2KTagTree(7, 3)
+	br := j2kBitReader{src: bw.dst}
+	for threshold := 1; threshold <= 7; threshold++ {
+		for leaf, v := range values {
+			assert.Equal(t, v < threshold, decoder.decode(&br, leaf, threshold))
+		}
+	}
+	assert.False(t, br.overrun)
+}
+
+func TestJ2KWavelet(t *testing.T) {
+	// ensures that the inverse wavelet transforms reconstruct the signals of
+	// the forward transforms, for each length and parity of the first sample.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	for n := 1; n < 12; n++ {
+		for i0 := 0; i0 < 2; i0++ {
+			signal := make([]float64, n)
+			for i := range signal {
+				signal[i] = float64(random.Intn(512) - 256)
+			}
+			ext := make([]float64, n+8)
+			x := append([]float64{}, signal...)
+			forwardDWT1D(x, i0, true, ext)
+			inverseDWT1D(x, i0, true, ext)
+			assert.Equal(t, signal, x)
+
+			x = append([]float64{}, signal...)
+			forwardDWT1D(x, i0, false, ext)
+			inverseDWT1D(x, i0, false, ext)
+			assert.InDeltaSlice(t, signal, x, 1e-6)
+		}
+	}
+
+	// constant signals become low pass coefficients of the same value
+	x := []float64{7, 7, 7, 7, 7, 7}
+	forwardDWT1D(x, 0, false, make([]float64, 14))
+	assert.InDeltaSlice(t, []float64{7, 0, 7, 0, 7, 0}, x, 1e-6)
+}
+
+func TestJPEG2000Decoder(t *testing.T) {
+	// ensures that reversible frames are decoded exactly, and irreversible frames
+	// closely, for each coding option.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	for _, testCase := range []struct {
+		width, height, components int
+		options                   j2kEncodeOptions
+	}{
+		{37, 23, 1, j2kEncodeOptions{precision: 8}},
+		{37, 23, 1, j2kEncodeOptions{precision: 8, levels: 3}},
+		{64, 64, 1, j2kEncodeOptions{precision: 16, levels: 5}},
+		{33, 19, 1, j2kEncodeOptions{precision: 12, signed: true, levels: 2}},
+		{1, 1, 1, j2kEncodeOptions{precision: 8, levels: 2}},
+		{1, 9, 1, j2kEncodeOptions{precision: 8, levels: 3}},
+		{45, 31, 1, j2kEncodeOptions{precision: 10, levels: 2, cbWidth: 2, cbHeight: 3}},
+		{45, 31, 1, j2kEncodeOptions{precision: 10, levels: 2, cbWidth: 3, cbHeight: 2, layers: 3, cbStyle: j2kStyleTermAll}},
+		{45, 31, 1, j2kEncodeOptions{precision: 12, levels: 2, cbWidth: 4, cbHeight: 4, cbStyle: j2kStyleBypass}},
+		{45, 31, 1, j2kEncodeOptions{precision: 12, levels: 2, cbWidth: 4, cbHeight: 4, cbStyle: j2kStyleBypass | j2kStyleTermAll, layers: 4}},
+		{45, 31, 1, j2kEncodeOptions{precision: 12, levels: 2, cbWidth: 4, cbHeight: 3, cbStyle: j2kStyleReset | j2kStyleCausal | j2kStyleSegMark}},
+		{45, 31, 3, j2kEncodeOptions{precision: 8, levels: 2, mct: true}},
+		{45, 31, 3, j2kEncodeOptions{precision: 8, levels: 2}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, tileWidth: 16, tileHeight: 24, tileParts: true, mct: true}},
+		{50, 40, 1, j2kEncodeOptions{precision: 8, levels: 3, tileWidth: 13, tileHeight: 11}},
+		{50, 40, 1, j2kEncodeOptions{precision: 8, levels: 2, sop: true, eph: true, layers: 2, cbStyle: j2kStyleTermAll}},
+		{50, 40, 1, j2kEncodeOptions{precision: 8, levels: 2, roiShift: 13}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, cbWidth: 2, cbHeight: 2, precincts: []byte{0x22, 0x33, 0x33}, layers: 2, cbStyle: j2kStyleTermAll, progression: j2kLRCP}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, cbWidth: 2, cbHeight: 2, precincts: []byte{0x22, 0x33, 0x33}, layers: 2, cbStyle: j2kStyleTermAll, progression: j2kRLCP}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, cbWidth: 2, cbHeight: 2, precincts: []byte{0x22, 0x33, 0x33}, layers: 2, cbStyle: j2kStyleTermAll, progression: j2kRPCL}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, cbWidth: 2, cbHeight: 2, precincts: []byte{0x22, 0x33, 0x33}, layers: 2, cbStyle: j2kStyleTermAll, progression: j2kPCRL}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, cbWidth: 2, cbHeight: 2, precincts: []byte{0x22, 0x33, 0x33}, layers: 2, cbStyle: j2kStyleTermAll, progression: j2kCPRL}},
+		{50, 40, 3, j2kEncodeOptions{precision: 8, levels: 2, precincts: []byte{0x33, 0x44, 0x43}, tileWidth: 21, tileHeight: 17, progression: j2kPCRL}},
+		{37, 23, 1, j2kEncodeOptions{precision: 8, levels: 3, irreversible: true}},
+		{37, 23, 1, j2kEncodeOptions{precision: 12, levels: 3, irreversible: true, derived: true}},
+		{45, 31, 3, j2kEncodeOptions{precision: 8, levels: 2, irreversible: true, mct: true, cbStyle: j2kStyleBypass}},
+	} {
+		planes := make([][]int32, testCase.components)
+		for c := range planes {
+			planes[c] = newJPEGLSPlane(random, testCase.width, testCase.height, testCase.options.precision)
+			if testCase.options.signed {
+				for i := range planes[c] {
+					planes[c][i] -= 1 << uint(testCase.options.precision-1)
+				}
+			}
+		}
+		codestream := encodeJPEG2000(planes, testCase.width, testCase.height, testCase.options)
+		d := j2kDecoder{src: codestream}
+		if !assert.NoError(t, d.decode(), "%+v", testCase) {
+			continue
+		}
+		assert.Equal(t, testCase.options.mct, d.multipleComponentXform)
+		if !testCase.options.irreversible {
+			assert.Equal(t, planes, d.planes, "%+v", testCase)
+			continue
+		}
+		for c := range planes {
+			for i := range planes[c] {
+				assert.InDelta(t, planes[c][i], d.planes[c][i], 2, "%+v", testCase)
+			}
+		}
+	}
+}
+
+func TestJPEG2000DecoderError(t *testing.T) {
+	// ensures that invalid or unsupported codestreams are rejected.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	planes := [][]int32{newJPEGLSPlane(random, 16, 16, 8)}
+	valid := encodeJPEG2000(planes, 16, 16, j2kEncodeOptions{precision: 8, levels: 2})
+	// the codestream's SIZ segment follows SOC, its content beginning at offset
+	// 6, and is followed by COD and QCD
+	siz := valid[2:]
+	sizLength := int(siz[2])<<8 | int(siz[3])
+	cod := siz[2+sizLength:]
+	codLength := int(cod[2])<<8 | int(cod[3])
+	// the offset of the segment following COD, which ends with the code-block
+	// width, height, style and wavelet transform
+	afterCOD := 2 + sizLength + 2 + codLength + 2
+	qcdLength := int(valid[afterCOD+2])<<8 | int(valid[afterCOD+3])
+	replace := func(offset int, b ...byte) []byte {
+		out := append([]byte{}, valid...)
+		copy(out[offset:], b)
+		return out
+	}
+	insert := func(offset int, b ...byte) []byte {
+		return append(append(append([]byte{}, valid[:offset]...), b...), valid[offset:]...)
+	}
+	for i, codestream := range [][]byte{
+		nil,
+		{0xFF, 0xD8, 0xFF, 0xD9},
+		valid[:2],
+		valid[:20],
+		// precision of 17 bits
+		replace(6+36, 16),
+		// component subsampling
+		replace(6+37, 2),
+		// no tiles
+		replace(6+18, 0, 0, 0, 0),
+		// high throughput code-blocks
+		replace(afterCOD-2, j2kStyleHT),
+		// code-blocks of 2^11 samples
+		replace(afterCOD-4, 9),
+		// progression order changes
+		insert(afterCOD, 0xFF, j2kMarkerPOC, 0, 2),
+		// packed packet headers
+		insert(afterCOD, 0xFF, j2kMarkerPPM, 0, 2),
+		// missing QCD segment
+		append(append([]byte{}, valid[:afterCOD]...), valid[afterCOD+2+qcdLength:]...),
+		// truncated tile-part
+		valid[:len(valid)-10],
+		// not a JP2 codestream
+		wrapJP2(nil)[:len(j2kJP2Signature)+20],
+	} {
+		_, err := DecodeJPEG2000(codestream, &ImageParameters{Rows: 16, Columns: 16, SamplesPerPixel: 1})
+		assert.Error(t, err, "%d: % X", i, codestream)
+	}
+
+	// an image of 2^28 columns within a single tile, which does not match the
+	// Image Pixel module, is rejected before allocating for the image
+	codestream := replace(6+2, 0x10, 0, 0, 0)
+	copy(codestream[6+18:], []byte{0x10, 0, 0, 0})
+	d := j2kDecoder{src: codestream, params: &ImageParameters{Rows: 16, Columns: 16, SamplesPerPixel: 1}}
+	assert.Error(t, d.decode())
+	assert.Nil(t, d.tiles)
+	// as are components which do not match
+	_, err := DecodeJPEG2000(valid, &ImageParameters{Rows: 16, Columns: 16, SamplesPerPixel: 3})
+	assert.Error(t, err)
+}
+
+func TestDecodeJPEG2000(t *testing.T) {
+	// ensures that frames are decompressed into native pixel data.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	planes := [][]int32{newJPEGLSPlane(random, 16, 8, 12)}
+	params := ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 16, BitsStored: 12, HighBit: 11, LittleEndian: true,
+	}
+	dcm := newCompressedDicom(t, JPEG2000Lossless, params, encodeJPEG2000(planes, 16, 8, j2kEncodeOptions{precision: 12, levels: 2}))
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 16; x++ {
+			assert.Equal(t, color.Gray16{Y: uint16(planes[0][y*16+x])}, img.At(x, y))
+		}
+	}
+
+	// RGB by way of the reversible colour transform, in a JP2 file
+	planes = [][]int32{newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8)}
+	params = ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_RCT",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	frame := wrapJP2(encodeJPEG2000(planes, 16, 8, j2kEncodeOptions{precision: 8, levels: 1, mct: true}))
+	dcm = newCompressedDicom(t, JPEG2000, params, frame)
+	img, err = dcm.GetImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: uint8(planes[0][17]), G: uint8(planes[1][17]), B: uint8(planes[2][17]), A: 0xFF}, img.At(1, 1))
+	_, err = DecodeJPEG2000(frame, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, "RGB", params.PhotometricInterpretation)
+
+	// dimensions do not match the Image Pixel module
+	params.Rows = 16
+	_, err = DecodeJPEG2000(frame, &params)
+	assert.Error(t, err)
+}
+
+func TestDecodeJPEG2000Kakadu(t *testing.T) {
+	// ensures that a JP2 file of another encoder (Kakadu v3.2: 5 levels of the
+	// reversible transform, 12 layers, LRCP, reversible colour transform) is decoded.
+	t.Parallel()
+	frame, err := ioutil.ReadFile(filepath.Join("testdata", "mimetype", "jp2.jp2"))
+	assert.NoError(t, err)
+	params := ImageParameters{
+		Rows: 300, Columns: 400, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_RCT",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	pixels, err := DecodeJPEG2000(frame, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, "RGB", params.PhotometricInterpretation)
+	assert.Len(t, pixels, 400*300*3)
+	for _, testCase := range []struct {
+		x, y     int
+		expected []byte
+	}{
+		{0, 0, []byte{56, 62, 52}},
+		{399, 0, []byte{14, 4, 0}},
+		{0, 299, []byte{54, 64, 64}},
+		{399, 299, []byte{17, 58, 27}},
+		{200, 150, []byte{148, 172, 192}},
+		{123, 45, []byte{21, 28, 30}},
+	} {
+		i := (testCase.y*400 + testCase.x) * 3
+		assert.Equal(t, testCase.expected, pixels[i:i+3], "(%d, %d)", testCase.x, testCase.y)
+	}
+	assert.Equal(t, "14e5e2a0bfc482eb7fffa418e01c3a0d13fc91a98229ffb9b06289c8bcaee2e0", fmt.Sprintf("%x", sha256.Sum256(pixels)))
+}
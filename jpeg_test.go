@@ -0,0 +1,441 @@
+package opendcm
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    JPEG
+===============================================================================
+*/
+
+// jpegBitWriter writes the bits of an entropy-coded segment, stuffing a zero
+// byte after each 0xFF byte.
+type jpegBitWriter struct {
+	dst  []byte
+	acc  uint32
+	nAcc uint
+}
+
+func (bw *jpegBitWriter) write(bits uint32, n uint) {
+	for i := int(n) - 1; i >= 0; i-- {
+		bw.acc = bw.acc<<1 | (bits>>uint(i))&1
+		bw.nAcc++
+		if bw.nAcc == 8 {
+			bw.dst = append(bw.dst, byte(bw.acc))
+			if byte(bw.acc) == 0xFF {
+				bw.dst = append(bw.dst, 0x00)
+			}
+			bw.acc, bw.nAcc = 0, 0
+		}
+	}
+}
+
+// flush pads the final byte with one bits.
+func (bw *jpegBitWriter) flush() {
+	for bw.nAcc != 0 {
+		bw.write(1, 1)
+	}
+}
+
+// magnitude returns the magnitude category of `v`, and its additional bits.
+func magnitude(v int32) (uint, uint32) {
+	s := uint(0)
+	for a := v; a != 0; a /= 2 {
+		s++
+	}
+	if v < 0 {
+		v += 1<<s - 1
+	}
+	return s, uint32(v)
+}
+
+// jpegSegment returns a marker segment holding `content`.
+func jpegSegment(marker byte, content ...byte) []byte {
+	length := len(content) + 2
+	return append([]byte{0xFF, marker, byte(length >> 8), byte(length)}, content...)
+}
+
+// encodeFlatJPEG returns a single component JPEG Extended stream of `precision`
+// bits, in which every sample of the `i`th block has value `blocks[i]`. Blocks
+// are encoded using only their DC coefficient, with a quantization value of one.
+// Restart markers are inserted every `restartInterval` blocks, if non-zero.
+func encodeFlatJPEG(precision int, width, height int, blocks []int32, restartInterval int) []byte {
+	stream := []byte{0xFF, jpegMarkerSOI}
+	// quantization table of 16 bit values, all one
+	dqt := []byte{0x10}
+	for k := 0; k < 64; k++ {
+		dqt = append(dqt, 0x00, 0x01)
+	}
+	stream = append(stream, jpegSegment(jpegMarkerDQT, dqt...)...)
+	stream = append(stream, jpegSegment(jpegMarkerSOF1, byte(precision), byte(height>>8), byte(height), byte(width>>8), byte(width), 1, 1, 0x11, 0)...)
+	// DC table: categories 0-15 with five bit codes; AC table: EOB with a one bit code
+	dht := []byte{0x00, 0, 0, 0, 0, 16, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	for s := byte(0); s < 16; s++ {
+		dht = append(dht, s)
+	}
+	dht = append(dht, 0x10, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x00)
+	stream = append(stream, jpegSegment(jpegMarkerDHT, dht...)...)
+	if restartInterval > 0 {
+		stream = append(stream, jpegSegment(jpegMarkerDRI, byte(restartInterval>>8), byte(restartInterval))...)
+	}
+	stream = append(stream, jpegSegment(jpegMarkerSOS, 1, 1, 0x00, 0, 63, 0)...)
+
+	bw := jpegBitWriter{}
+	pred := int32(0)
+	for i, v := range blocks {
+		if restartInterval > 0 && i > 0 && i%restartInterval == 0 {
+			bw.flush()
+			bw.dst = append(bw.dst, 0xFF, byte(jpegMarkerRST0+(i/restartInterval-1)%8))
+			pred = 0
+		}
+		// the DC coefficient of a flat block is eight times its level-shifted value
+		dc := (v - 1<<uint(precision-1)) * 8
+		s, bits := magnitude(dc - pred)
+		bw.write(uint32(s), 5)
+		bw.write(bits, s)
+		bw.write(0, 1)
+		pred = dc
+	}
+	bw.flush()
+	stream = append(stream, bw.dst...)
+	return append(stream, 0xFF, jpegMarkerEOI)
+}
+
+// encodeLosslessJPEG returns a JPEG Lossless stream of `precision` bits, with one
+// component for each of `planes`, using predictor `predictor` and point transform
+// `pointTransform`. Restart markers are inserted every `restartInterval` MCUs, if non-zero.
+func encodeLosslessJPEG(planes [][]int32, width, height, precision, predictor, pointTransform, restartInterval int) []byte {
+	stream := []byte{0xFF, jpegMarkerSOI}
+	sof := []byte{byte(precision), byte(height >> 8), byte(height), byte(width >> 8), byte(width), byte(len(planes))}
+	sos := []byte{byte(len(planes))}
+	for i := range planes {
+		sof = append(sof, byte(i+1), 0x11, 0)
+		sos = append(sos, byte(i+1), 0x00)
+	}
+	stream = append(stream, jpegSegment(jpegMarkerSOF3, sof...)...)
+	// DC table: categories 0-16 with five bit codes
+	dht := []byte{0x00, 0, 0, 0, 0, 17, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	for s := byte(0); s <= 16; s++ {
+		dht = append(dht, s)
+	}
+	stream = append(stream, jpegSegment(jpegMarkerDHT, dht...)...)
+	if restartInterval > 0 {
+		stream = append(stream, jpegSegment(jpegMarkerDRI, byte(restartInterval>>8), byte(restartInterval))...)
+	}
+	stream = append(stream, jpegSegment(jpegMarkerSOS, append(sos, byte(predictor), 0, byte(pointTransform))...)...)
+
+	bw := jpegBitWriter{}
+	firstRow := 0
+	for i := 0; i < width*height; i++ {
+		x, y := i%width, i/width
+		restart := i == 0
+		if restartInterval > 0 && i > 0 && i%restartInterval == 0 {
+			bw.flush()
+			bw.dst = append(bw.dst, 0xFF, byte(jpegMarkerRST0+(i/restartInterval-1)%8))
+			restart = true
+		}
+		if restart {
+			firstRow = y
+		}
+		for _, plane := range planes {
+			sample := func(x, y int) int32 {
+				return plane[y*width+x] >> uint(pointTransform)
+			}
+			var prediction int32
+			switch {
+			case restart:
+				prediction = 1 << uint(precision-pointTransform-1)
+			case y == firstRow:
+				prediction = sample(x-1, y)
+			case x == 0:
+				prediction = sample(x, y-1)
+			default:
+				ra, rb, rc := sample(x-1, y), sample(x, y-1), sample(x-1, y-1)
+				prediction = [8]int32{0, ra, rb, rc, ra + rb - rc, ra + (rb-rc)>>1, rb + (ra-rc)>>1, (ra + rb) >> 1}[predictor]
+			}
+			diff := (sample(x, y) - prediction) & 0xFFFF
+			if diff >= 32768 {
+				diff -= 65536
+			}
+			if diff == -32768 {
+				bw.write(16, 5)
+				continue
+			}
+			s, bits := magnitude(diff)
+			bw.write(uint32(s), 5)
+			bw.write(bits, s)
+		}
+	}
+	bw.flush()
+	stream = append(stream, bw.dst...)
+	return append(stream, 0xFF, jpegMarkerEOI)
+}
+
+// newTestImage returns an image with a gradient, and a region of detail.
+func newTestImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.RGBA{R: uint8(x * 255 / width), G: uint8(y * 255 / height), B: uint8((x + y) * 4), A: 0xFF}
+			if x > width/2 && y > height/2 && (x+y)%3 == 0 {
+				c.R, c.G = 0xFF, 0x00
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestJPEGDecoder(t *testing.T) {
+	// ensures that eight bit frames are decoded equivalently to `image/jpeg`,
+	// including frames with subsampled components and partial MCUs.
+	t.Parallel()
+	src := newTestImage(37, 23)
+	gray := image.NewGray(src.Bounds())
+	for i := range gray.Pix {
+		gray.Pix[i] = src.Pix[i*4]
+	}
+	for _, img := range []image.Image{src, gray} {
+		buf := bytes.Buffer{}
+		assert.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}))
+		expectedImg, err := jpeg.Decode(bytes.NewReader(buf.Bytes()))
+		assert.NoError(t, err)
+		expected, _, err := imagePlanes(expectedImg)
+		assert.NoError(t, err)
+
+		d := jpegDecoder{jpegStream: jpegStream{src: buf.Bytes()}}
+		assert.NoError(t, d.decode())
+		planes := d.planes()
+		assert.Equal(t, len(expected), len(planes))
+		for i := range planes {
+			for j := range planes[i] {
+				diff := planes[i][j] - expected[i][j]
+				if diff < -2 || diff > 2 {
+					t.Fatalf("component %d, sample %d: expected %d, got %d", i, j, expected[i][j], planes[i][j])
+				}
+			}
+		}
+	}
+}
+
+func TestJPEGDecoderTwelveBit(t *testing.T) {
+	// ensures that twelve bit frames are decoded, including frames
+	// with restart markers and partial blocks.
+	t.Parallel()
+	for _, testCase := range []struct {
+		width, height   int
+		blocks          []int32
+		restartInterval int
+	}{
+		{16, 8, []int32{100, 4000}, 0},
+		{12, 10, []int32{0, 4095, 2048, 1}, 1},
+		{24, 8, []int32{5, 3000, 7}, 2},
+	} {
+		d := jpegDecoder{jpegStream: jpegStream{src: encodeFlatJPEG(12, testCase.width, testCase.height, testCase.blocks, testCase.restartInterval)}}
+		assert.NoError(t, d.decode())
+		assert.Equal(t, 12, d.precision)
+		planes := d.planes()
+		assert.Len(t, planes, 1)
+		blocksPerLine := (testCase.width + 7) / 8
+		for y := 0; y < testCase.height; y++ {
+			for x := 0; x < testCase.width; x++ {
+				assert.Equal(t, testCase.blocks[(y/8)*blocksPerLine+x/8], planes[0][y*testCase.width+x])
+			}
+		}
+	}
+}
+
+func TestJPEGDecoderLossless(t *testing.T) {
+	// ensures that lossless frames are decoded with each predictor and
+	// precision, including frames with restart markers, several components
+	// and a point transform.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	width, height := 13, 7
+	newPlane := func(precision int) []int32 {
+		plane := make([]int32, width*height)
+		for i := range plane {
+			// a gradient with noise, such that each predictor is exercised
+			plane[i] = int32((i*37 + random.Intn(16)) % (1 << uint(precision)))
+		}
+		return plane
+	}
+	for _, testCase := range []struct {
+		precision, components, pointTransform, restartInterval int
+	}{
+		{2, 1, 0, 0},
+		{8, 1, 0, 0},
+		{12, 1, 0, 0},
+		{16, 1, 0, 0},
+		{16, 1, 0, 13},
+		{8, 3, 0, 26},
+		{12, 1, 2, 0},
+	} {
+		for predictor := 1; predictor <= 7; predictor++ {
+			planes := make([][]int32, testCase.components)
+			for i := range planes {
+				planes[i] = newPlane(testCase.precision)
+			}
+			stream := encodeLosslessJPEG(planes, width, height, testCase.precision, predictor, testCase.pointTransform, testCase.restartInterval)
+			d := jpegDecoder{jpegStream: jpegStream{src: stream}}
+			assert.NoError(t, d.decode())
+			decoded := d.planes()
+			assert.Len(t, decoded, testCase.components)
+			for i := range planes {
+				for j := range planes[i] {
+					planes[i][j] = planes[i][j] >> uint(testCase.pointTransform) << uint(testCase.pointTransform)
+				}
+				assert.Equal(t, planes[i], decoded[i], "precision %d, predictor %d", testCase.precision, predictor)
+			}
+		}
+	}
+
+	// unsupported predictor
+	stream := encodeLosslessJPEG([][]int32{newPlane(8)}, width, height, 8, 1, 0, 0)
+	stream[bytes.LastIndex(stream, []byte{0xFF, jpegMarkerSOS})+7] = 0
+	d := jpegDecoder{jpegStream: jpegStream{src: stream}}
+	assert.Error(t, d.decode())
+}
+
+func TestJPEGDecoderError(t *testing.T) {
+	// ensures that invalid or unsupported streams result in an error.
+	t.Parallel()
+	valid := encodeFlatJPEG(12, 8, 8, []int32{100}, 0)
+	for _, stream := range [][]byte{
+		// missing SOI
+		valid[2:],
+		// no scans
+		valid[:2],
+		// truncated segment
+		valid[:10],
+		// progressive
+		append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpegMarkerSOF2, 8, 0, 8, 0, 8, 1, 1, 0x11, 0)...),
+		// scan without frame
+		append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpegMarkerSOS, 1, 1, 0x00, 0, 63, 0)...),
+	} {
+		d := jpegDecoder{jpegStream: jpegStream{src: stream}}
+		assert.Error(t, d.decode())
+	}
+	_, _, err := jpegFrameHeader(valid[:2])
+	assert.Error(t, err)
+
+	// a frame of 65535x65535 samples of 255 components, which does not match
+	// the Image Pixel module, is rejected before allocating for the frame
+	sof := []byte{12, 0xFF, 0xFF, 0xFF, 0xFF, 255}
+	for i := 0; i < 255; i++ {
+		sof = append(sof, byte(i), 0x11, 0)
+	}
+	stream := append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpegMarkerSOF1, sof...)...)
+	d := jpegDecoder{jpegStream: jpegStream{src: stream}, params: &ImageParameters{Rows: 8, Columns: 8, SamplesPerPixel: 1}}
+	assert.Error(t, d.decode())
+	assert.Nil(t, d.components)
+}
+
+func TestDecodeJPEG(t *testing.T) {
+	// ensures that frames are decompressed into native pixel data, and that
+	// YBR frames are converted to RGB.
+	t.Parallel()
+	src := newTestImage(16, 16)
+	buf := bytes.Buffer{}
+	assert.NoError(t, jpeg.Encode(&buf, src, nil))
+	expected, err := jpeg.Decode(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+
+	params := ImageParameters{
+		Rows: 16, Columns: 16, SamplesPerPixel: 3, PhotometricInterpretation: "YBR_FULL_422",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	dcm := newCompressedDicom(t, JPEGBaseline, params, buf.Bytes())
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 16; x++ {
+			r, g, b, _ := expected.At(x, y).RGBA()
+			assert.Equal(t, color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 0xFF}, img.At(x, y))
+		}
+	}
+
+	// an RGB photometric interpretation indicates that the components are not YCbCr
+	params.PhotometricInterpretation = "RGB"
+	frame, err := DecodeJPEG(buf.Bytes(), &params)
+	assert.NoError(t, err)
+	ycbcr := expected.(*image.YCbCr).YCbCrAt(0, 0)
+	assert.Equal(t, []byte{ycbcr.Y, ycbcr.Cb, ycbcr.Cr}, frame[:3])
+
+	// twelve bit
+	params = ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 16, BitsStored: 12, HighBit: 11, LittleEndian: true,
+	}
+	dcm = newCompressedDicom(t, JPEGExtended, params, encodeFlatJPEG(12, 16, 8, []int32{100, 4000}, 0))
+	img, err = dcm.GetImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, color.Gray16{Y: 100}, img.At(0, 0))
+	assert.Equal(t, color.Gray16{Y: 4000}, img.At(15, 7))
+
+	// dimensions do not match the Image Pixel module
+	params.Rows = 16
+	_, err = DecodeJPEG(encodeFlatJPEG(12, 16, 8, []int32{100, 4000}, 0), &params)
+	assert.Error(t, err)
+
+	// components do not match the Image Pixel module
+	params = ImageParameters{
+		Rows: 16, Columns: 16, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	_, err = DecodeJPEG(buf.Bytes(), &params)
+	assert.Error(t, err)
+}
+
+// losslessPhantom returns the value at (`x`, `y`) of the synthetic phantom held
+// by the JPEG Lossless fixture.
+func losslessPhantom(x, y int) int16 {
+	dx, dy := x-84, y-84
+	switch r := dx*dx + dy*dy; {
+	case r > 80*80:
+		return -1000 // air
+	case r > 74*74:
+		return 1200 // bone
+	default:
+		return int16((x*7+y*3)%61 - 30) // soft tissue
+	}
+}
+
+func TestGetImageJPEGLossless(t *testing.T) {
+	// ensures that frames of the JPEG Lossless fixture are decoded.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "JPEGLossless.dcm"))
+	assert.NoError(t, err)
+	assert.Equal(t, JPEGLosslessSV1, dcm.GetTransferSyntax().UID)
+	assert.Equal(t, 1, dcm.GetPixelData().NumFrames())
+
+	params, err := dcm.GetImageParameters()
+	assert.NoError(t, err)
+	frame, err := DecodeJPEG(dcm.GetPixelData().GetFrame(0), &params)
+	assert.NoError(t, err)
+	assert.Len(t, frame, 168*168*2)
+	for y := 0; y < 168; y++ {
+		for x := 0; x < 168; x++ {
+			i := (y*168 + x) * 2
+			if v := int16(uint16(frame[i]) | uint16(frame[i+1])<<8); v != losslessPhantom(x, y) {
+				t.Fatalf("(%d, %d): expected %d, got %d", x, y, losslessPhantom(x, y), v)
+			}
+		}
+	}
+
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	// signed values are offset by 2^15
+	assert.Equal(t, color.Gray16{Y: 32768 - 1000}, img.At(0, 0))
+	assert.Equal(t, color.Gray16{Y: uint16(32768 + int(losslessPhantom(84, 84)))}, img.At(84, 84))
+}
@@ -0,0 +1,770 @@
+package opendcm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+/*
+===============================================================================
+	JPEG-LS
+	---
+	Provides decoding of frames compressed with JPEG-LS Lossless and JPEG-LS
+	Lossy (Near-Lossless), as per ITU-T T.87.
+===============================================================================
+*/
+
+// JPEG-LS markers, as per "Table C.1" of ITU-T T.87
+const (
+	jpeglsMarkerSOF55 = 0xF7
+	jpeglsMarkerLSE   = 0xF8
+)
+
+const (
+	// jpeglsContexts is the number of regular mode contexts
+	jpeglsContexts = 365
+	// jpeglsMinC and jpeglsMaxC bound the bias correction values
+	jpeglsMinC = -128
+	jpeglsMaxC = 127
+)
+
+// jpeglsJ holds the order of run lengths of each run index, as per "A.7.1.1"
+// of ITU-T T.87.
+var jpeglsJ = [32]int{0, 0, 0, 0, 1, 1, 1, 1, 2, 2, 2, 2, 3, 3, 3, 3, 4, 4, 5, 5, 6, 6, 7, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// jpeglsContext holds the variables of a regular mode context.
+type jpeglsContext struct {
+	a, b, c, n int32
+}
+
+// jpeglsRunContext holds the variables of a run interruption context.
+type jpeglsRunContext struct {
+	a, n, nn int32
+	riType   int32
+}
+
+// jpeglsBitReader reads the bits of a JPEG-LS scan, in which a zero bit is
+// stuffed following each 0xFF byte. Once a marker is reached, zero bits are
+// returned.
+type jpeglsBitReader struct {
+	src []byte
+	pos int
+	acc uint64
+	n   uint
+	// afterFF indicates that the last byte read was 0xFF
+	afterFF bool
+}
+
+// fill ensures that at least 32 bits are available.
+func (br *jpeglsBitReader) fill() {
+	for br.n <= 32 {
+		// 0xFF followed by a byte with its high bit set is a marker, ending the scan
+		if br.pos >= len(br.src) || (br.src[br.pos] == 0xFF && (br.pos+1 >= len(br.src) || br.src[br.pos+1] >= 0x80)) {
+			br.acc <<= 8
+			br.n += 8
+			continue
+		}
+		b := br.src[br.pos]
+		br.pos++
+		if br.afterFF {
+			br.acc = br.acc<<7 | uint64(b)
+			br.n += 7
+		} else {
+			br.acc = br.acc<<8 | uint64(b)
+			br.n += 8
+		}
+		br.afterFF = b == 0xFF
+	}
+}
+
+// readBits returns the next `n` bits, where `n` is at most 32.
+func (br *jpeglsBitReader) readBits(n uint) int32 {
+	if n == 0 {
+		return 0
+	}
+	br.fill()
+	br.n -= n
+	return int32(br.acc>>br.n) & (1<<n - 1)
+}
+
+// readHighBits returns the number of zero bits preceding the next one bit,
+// up to `limit`.
+func (br *jpeglsBitReader) readHighBits(limit int32) (int32, error) {
+	for count := int32(0); count <= limit; count++ {
+		if br.readBits(1) == 1 {
+			return count, nil
+		}
+	}
+	return 0, errors.New("invalid JPEG-LS code")
+}
+
+// jpeglsDecoder decodes JPEG-LS frames.
+type jpeglsDecoder struct {
+	jpegStream
+	precision     int
+	width, height int
+	componentIDs  []byte
+	planes        [][]int32
+	// maxVal, t1, t2, t3 and reset are the preset coding parameters, which
+	// are zero when the defaults are used
+	maxVal, t1, t2, t3, reset int32
+	// parameters of the current scan
+	near, rng, qbpp, limit int32
+	scanMaxVal, scanReset  int32
+	scanT1, scanT2, scanT3 int32
+	contexts               [jpeglsContexts]jpeglsContext
+	runContexts            [2]jpeglsRunContext
+	runIndex               int
+	br                     jpeglsBitReader
+	// params, if not nil, describe the Image Pixel module which the frame
+	// must match. See: `checkFrameSize`
+	params *ImageParameters
+}
+
+// decode decodes the frame into the planes of each component.
+func (d *jpeglsDecoder) decode() error {
+	if !bytes.HasPrefix(d.src, jpegSOI) {
+		return errors.New("JPEG-LS stream does not begin with SOI marker")
+	}
+	d.pos = 2
+	scans := 0
+	for {
+		marker, found := d.nextMarker()
+		if !found || marker == jpegMarkerEOI {
+			if scans == 0 {
+				return errors.New("JPEG-LS stream holds no scans")
+			}
+			return nil
+		}
+		segment, err := d.readSegment()
+		if err != nil {
+			return err
+		}
+		switch marker {
+		case jpeglsMarkerSOF55:
+			err = d.parseSOF(segment)
+		case jpeglsMarkerLSE:
+			err = d.parseLSE(segment)
+		case jpegMarkerDRI:
+			if len(segment) >= 2 && (segment[0] != 0 || segment[1] != 0) {
+				err = errors.New("JPEG-LS restart intervals are not supported")
+			}
+		case jpegMarkerSOS:
+			err = d.parseSOS(segment)
+			scans++
+		default:
+			if isJPEGSOF(marker) {
+				return fmt.Errorf("JPEG process of marker 0x%02X is not JPEG-LS", marker)
+			}
+			// APPn, COM, and others which do not affect decoding
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// parseSOF parses a start of frame segment.
+func (d *jpeglsDecoder) parseSOF(segment []byte) error {
+	if d.planes != nil {
+		return errors.New("JPEG-LS stream holds multiple frames")
+	}
+	if len(segment) < 6 || len(segment) < 6+3*int(segment[5]) || segment[5] == 0 {
+		return errors.New("invalid SOF segment")
+	}
+	d.precision = int(segment[0])
+	d.height = int(segment[1])<<8 | int(segment[2])
+	d.width = int(segment[3])<<8 | int(segment[4])
+	if d.precision < 2 || d.precision > 16 || d.width == 0 || d.height == 0 {
+		return errors.New("invalid SOF segment")
+	}
+	if d.params != nil {
+		if err := checkFrameSize(d.width, d.height, int(segment[5]), d.params); err != nil {
+			return err
+		}
+	}
+	d.componentIDs = make([]byte, segment[5])
+	d.planes = make([][]int32, segment[5])
+	for i := range d.componentIDs {
+		d.componentIDs[i] = segment[6+i*3]
+		if segment[7+i*3] != 0x11 {
+			return errors.New("JPEG-LS component subsampling is not supported")
+		}
+		d.planes[i] = make([]int32, d.width*d.height)
+	}
+	return nil
+}
+
+// parseLSE parses a JPEG-LS preset parameters segment. Only coding parameters
+// are supported.
+func (d *jpeglsDecoder) parseLSE(segment []byte) error {
+	if len(segment) < 1 {
+		return errors.New("invalid LSE segment")
+	}
+	if segment[0] != 1 {
+		return fmt.Errorf("JPEG-LS preset parameters of type %d are not supported", segment[0])
+	}
+	if len(segment) < 11 {
+		return errors.New("invalid LSE segment")
+	}
+	values := make([]int32, 5)
+	for i := range values {
+		values[i] = int32(segment[1+i*2])<<8 | int32(segment[2+i*2])
+	}
+	d.maxVal, d.t1, d.t2, d.t3, d.reset = values[0], values[1], values[2], values[3], values[4]
+	return nil
+}
+
+// parseSOS parses a start of scan segment, and decodes the scan which follows.
+func (d *jpeglsDecoder) parseSOS(segment []byte) error {
+	if d.planes == nil {
+		return errors.New("SOS segment precedes SOF segment")
+	}
+	if len(segment) < 1 || len(segment) != 4+2*int(segment[0]) || segment[0] == 0 {
+		return errors.New("invalid SOS segment")
+	}
+	components := make([]int, segment[0])
+	for i := range components {
+		components[i] = -1
+		for j, id := range d.componentIDs {
+			if id == segment[1+i*2] {
+				components[i] = j
+			}
+		}
+		if components[i] == -1 {
+			return fmt.Errorf("scan references unknown component %d", segment[1+i*2])
+		}
+		if segment[2+i*2] != 0 {
+			return errors.New("JPEG-LS mapping tables are not supported")
+		}
+	}
+	params := segment[1+2*len(components):]
+	near, interleave, pointTransform := int32(params[0]), params[1], params[2]&0x0F
+	if pointTransform != 0 {
+		return errors.New("JPEG-LS point transform is not supported")
+	}
+	if err := d.initScan(near); err != nil {
+		return err
+	}
+	d.br = jpeglsBitReader{src: d.src, pos: d.pos}
+	var err error
+	switch {
+	case interleave == 0 && len(components) == 1, interleave == 1:
+		err = d.decodeLines(components)
+	case interleave == 2 && len(components) == 3:
+		err = d.decodeSampleInterleaved(components)
+	default:
+		err = fmt.Errorf("JPEG-LS interleave mode %d of %d components is not supported", interleave, len(components))
+	}
+	d.pos = d.br.pos
+	return err
+}
+
+// initScan derives the coding parameters of a scan with the given NEAR, and
+// initialises its contexts, as per "A.2" and "C.2.4.1.1" of ITU-T T.87.
+func (d *jpeglsDecoder) initScan(near int32) error {
+	d.near = near
+	d.scanMaxVal = d.maxVal
+	if d.scanMaxVal == 0 {
+		d.scanMaxVal = 1<<uint(d.precision) - 1
+	}
+	maxNear := d.scanMaxVal / 2
+	if maxNear > 255 {
+		maxNear = 255
+	}
+	if near > maxNear {
+		return fmt.Errorf("invalid JPEG-LS NEAR of %d", near)
+	}
+	d.scanReset = d.reset
+	if d.scanReset == 0 {
+		d.scanReset = 64
+	}
+	d.scanT1, d.scanT2, d.scanT3 = d.defaultThresholds()
+	if d.t1 != 0 {
+		d.scanT1 = d.t1
+	}
+	if d.t2 != 0 {
+		d.scanT2 = d.t2
+	}
+	if d.t3 != 0 {
+		d.scanT3 = d.t3
+	}
+	d.rng = (d.scanMaxVal+2*near)/(2*near+1) + 1
+	d.qbpp = bitLength(d.rng - 1)
+	bpp := bitLength(d.scanMaxVal)
+	if bpp < 2 {
+		bpp = 2
+	}
+	if bpp < 8 {
+		d.limit = 2 * (bpp + 8)
+	} else {
+		d.limit = 4 * bpp
+	}
+
+	a := (d.rng + 32) / 64
+	if a < 2 {
+		a = 2
+	}
+	for i := range d.contexts {
+		d.contexts[i] = jpeglsContext{a: a, n: 1}
+	}
+	d.runContexts[0] = jpeglsRunContext{a: a, n: 1, riType: 0}
+	d.runContexts[1] = jpeglsRunContext{a: a, n: 1, riType: 1}
+	d.runIndex = 0
+	return nil
+}
+
+// bitLength returns the number of bits required to represent `v`, being
+// ceil(log2(v+1)).
+func bitLength(v int32) int32 {
+	n := int32(0)
+	for ; v > 0; v >>= 1 {
+		n++
+	}
+	return n
+}
+
+// defaultThresholds returns the default gradient thresholds of the current
+// scan, as per "C.2.4.1.1" of ITU-T T.87.
+func (d *jpeglsDecoder) defaultThresholds() (int32, int32, int32) {
+	const basicT1, basicT2, basicT3 = 3, 7, 21
+	maxVal, near := d.scanMaxVal, d.near
+	clamp := func(i, j int32) int32 {
+		if i > maxVal || i < j {
+			return j
+		}
+		return i
+	}
+	max := func(a, b int32) int32 {
+		if a > b {
+			return a
+		}
+		return b
+	}
+	if maxVal >= 128 {
+		factor := maxVal
+		if factor > 4095 {
+			factor = 4095
+		}
+		factor = (factor + 128) / 256
+		t1 := clamp(factor*(basicT1-2)+2+3*near, near+1)
+		t2 := clamp(factor*(basicT2-3)+3+5*near, t1)
+		return t1, t2, clamp(factor*(basicT3-4)+4+7*near, t2)
+	}
+	factor := 256 / (maxVal + 1)
+	t1 := clamp(max(2, basicT1/factor+3*near), near+1)
+	t2 := clamp(max(3, basicT2/factor+5*near), t1)
+	return t1, t2, clamp(max(4, basicT3/factor+7*near), t2)
+}
+
+// decodeLines decodes a scan of one component, or of line interleaved
+// components.
+func (d *jpeglsDecoder) decodeLines(components []int) error {
+	// lines hold the previous and current line of each component, with an
+	// additional sample at each end
+	prev := make([][]int32, len(components))
+	cur := make([][]int32, len(components))
+	runIndices := make([]int, len(components))
+	for i := range components {
+		prev[i] = make([]int32, d.width+2)
+		cur[i] = make([]int32, d.width+2)
+	}
+	for y := 0; y < d.height; y++ {
+		for i, c := range components {
+			d.runIndex = runIndices[i]
+			if err := d.decodeLine(prev[i], cur[i]); err != nil {
+				return err
+			}
+			runIndices[i] = d.runIndex
+			copy(d.planes[c][y*d.width:], cur[i][1:d.width+1])
+			prev[i], cur[i] = cur[i], prev[i]
+		}
+	}
+	return nil
+}
+
+// decodeLine decodes a line of samples into `cur`, given the previous line
+// `prev`. Sample x is held at index x+1 of each.
+func (d *jpeglsDecoder) decodeLine(prev, cur []int32) error {
+	width := d.width
+	// edge samples, as per "A.2.1" of ITU-T T.87
+	cur[0] = prev[1]
+	prev[width+1] = prev[width]
+	for x := 0; x < width; {
+		ra, rb, rc, rd := cur[x], prev[x+1], prev[x], prev[x+2]
+		q1, q2, q3 := d.quantize(rd-rb), d.quantize(rb-rc), d.quantize(rc-ra)
+		if q1 == 0 && q2 == 0 && q3 == 0 {
+			n, err := d.decodeRun(prev, cur, x)
+			if err != nil {
+				return err
+			}
+			x += n
+			continue
+		}
+		v, err := d.decodeRegular(q1, q2, q3, ra, rb, rc)
+		if err != nil {
+			return err
+		}
+		cur[x+1] = v
+		x++
+	}
+	return nil
+}
+
+// quantize returns the region of a local gradient, as per "A.3.3" of ITU-T T.87.
+func (d *jpeglsDecoder) quantize(di int32) int32 {
+	switch {
+	case di <= -d.scanT3:
+		return -4
+	case di <= -d.scanT2:
+		return -3
+	case di <= -d.scanT1:
+		return -2
+	case di < -d.near:
+		return -1
+	case di <= d.near:
+		return 0
+	case di < d.scanT1:
+		return 1
+	case di < d.scanT2:
+		return 2
+	case di < d.scanT3:
+		return 3
+	}
+	return 4
+}
+
+// regularContext returns the context of a sample in regular mode given the
+// regions of its local gradients, and the sign by which its error is inverted,
+// as per "A.3.4" of ITU-T T.87.
+func (d *jpeglsDecoder) regularContext(q1, q2, q3 int32) (*jpeglsContext, int32) {
+	if q1 < 0 || (q1 == 0 && (q2 < 0 || (q2 == 0 && q3 < 0))) {
+		return &d.contexts[-(q1*81 + q2*9 + q3)], -1
+	}
+	return &d.contexts[q1*81+q2*9+q3], 1
+}
+
+// predict returns the prediction of a sample from its neighbours by the
+// median edge detector, with the bias correction of `ctx`, as per "A.4".
+func (d *jpeglsDecoder) predict(ctx *jpeglsContext, sign, ra, rb, rc int32) int32 {
+	px := ra + rb - rc
+	switch {
+	case rc >= ra && rc >= rb:
+		px = ra
+		if rb < ra {
+			px = rb
+		}
+	case rc <= ra && rc <= rb:
+		px = ra
+		if rb > ra {
+			px = rb
+		}
+	}
+	return d.clamp(px + sign*ctx.c)
+}
+
+// golombK returns the Golomb coding parameter of a regular mode context.
+func (ctx *jpeglsContext) golombK() uint {
+	k := uint(0)
+	for ctx.n<<k < ctx.a {
+		k++
+	}
+	return k
+}
+
+// decodeRegular decodes a sample in regular mode, given the regions of its
+// local gradients and its neighbours, as per "A.4" to "A.6" of ITU-T T.87.
+func (d *jpeglsDecoder) decodeRegular(q1, q2, q3, ra, rb, rc int32) (int32, error) {
+	ctx, sign := d.regularContext(q1, q2, q3)
+	px := d.predict(ctx, sign, ra, rb, rc)
+	k := ctx.golombK()
+	mErrval, err := d.decodeValue(k, d.limit)
+	if err != nil {
+		return 0, err
+	}
+	errval := mErrval >> 1
+	if mErrval&1 == 1 {
+		errval = -(mErrval + 1) >> 1
+	}
+	if d.near == 0 && k == 0 && 2*ctx.b <= -ctx.n {
+		errval = -errval - 1
+	}
+	d.updateContext(ctx, errval)
+	return d.reconstruct(px + sign*errval*(2*d.near+1)), nil
+}
+
+// updateContext updates the variables of a regular mode context with the
+// error of a sample, as per "A.6" of ITU-T T.87.
+func (d *jpeglsDecoder) updateContext(ctx *jpeglsContext, errval int32) {
+	ctx.b += errval * (2*d.near + 1)
+	ctx.a += abs32(errval)
+	if ctx.n == d.scanReset {
+		ctx.a >>= 1
+		if ctx.b >= 0 {
+			ctx.b >>= 1
+		} else {
+			ctx.b = -((1 - ctx.b) >> 1)
+		}
+		ctx.n >>= 1
+	}
+	ctx.n++
+	if ctx.b <= -ctx.n {
+		ctx.b += ctx.n
+		if ctx.c > jpeglsMinC {
+			ctx.c--
+		}
+		if ctx.b <= -ctx.n {
+			ctx.b = -ctx.n + 1
+		}
+	} else if ctx.b > 0 {
+		ctx.b -= ctx.n
+		if ctx.c < jpeglsMaxC {
+			ctx.c++
+		}
+		if ctx.b > 0 {
+			ctx.b = 0
+		}
+	}
+}
+
+// decodeValue decodes a limited length Golomb code with parameter `k`, as per
+// "A.5.3" of ITU-T T.87.
+func (d *jpeglsDecoder) decodeValue(k uint, limit int32) (int32, error) {
+	high, err := d.br.readHighBits(limit)
+	if err != nil {
+		return 0, err
+	}
+	if high >= limit-(d.qbpp+1) {
+		return d.br.readBits(uint(d.qbpp)) + 1, nil
+	}
+	return high<<k | d.br.readBits(k), nil
+}
+
+// clamp limits `v` to the range of samples.
+func (d *jpeglsDecoder) clamp(v int32) int32 {
+	if v < 0 {
+		return 0
+	}
+	if v > d.scanMaxVal {
+		return d.scanMaxVal
+	}
+	return v
+}
+
+// reconstruct returns the sample reconstructed from the sum of its prediction
+// and dequantized error, reduced modulo the range.
+func (d *jpeglsDecoder) reconstruct(v int32) int32 {
+	if v < -d.near {
+		v += d.rng * (2*d.near + 1)
+	} else if v > d.scanMaxVal+d.near {
+		v -= d.rng * (2*d.near + 1)
+	}
+	return d.clamp(v)
+}
+
+// decodeRunLength decodes the length of a run of samples beginning at `x`,
+// as per "A.7.1" of ITU-T T.87. Returns false if the run is interrupted
+// before the end of the line.
+func (d *jpeglsDecoder) decodeRunLength(x int) (int, bool, error) {
+	remaining := d.width - x
+	n := 0
+	for d.br.readBits(1) == 1 {
+		count := 1 << uint(jpeglsJ[d.runIndex])
+		if count > remaining-n {
+			count = remaining - n
+		}
+		n += count
+		if count == 1<<uint(jpeglsJ[d.runIndex]) && d.runIndex < 31 {
+			d.runIndex++
+		}
+		if n == remaining {
+			return n, false, nil
+		}
+	}
+	n += int(d.br.readBits(uint(jpeglsJ[d.runIndex])))
+	if n >= remaining {
+		return 0, false, errors.New("JPEG-LS run exceeds line")
+	}
+	return n, true, nil
+}
+
+// decodeRun decodes a run of samples beginning at `x`, followed by the run
+// interruption sample, if any. Returns the number of samples decoded.
+func (d *jpeglsDecoder) decodeRun(prev, cur []int32, x int) (int, error) {
+	ra := cur[x]
+	n, interrupted, err := d.decodeRunLength(x)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < n; i++ {
+		cur[x+1+i] = ra
+	}
+	if !interrupted {
+		return n, nil
+	}
+	rb := prev[x+n+1]
+	var v int32
+	if abs32(ra-rb) <= d.near {
+		errval, err := d.decodeRunInterruption(&d.runContexts[1])
+		if err != nil {
+			return 0, err
+		}
+		v = d.reconstruct(ra + errval*(2*d.near+1))
+	} else {
+		errval, err := d.decodeRunInterruption(&d.runContexts[0])
+		if err != nil {
+			return 0, err
+		}
+		if rb < ra {
+			errval = -errval
+		}
+		v = d.reconstruct(rb + errval*(2*d.near+1))
+	}
+	cur[x+n+1] = v
+	if d.runIndex > 0 {
+		d.runIndex--
+	}
+	return n + 1, nil
+}
+
+// golombK returns the Golomb coding parameter of a run interruption context.
+func (ctx *jpeglsRunContext) golombK() uint {
+	temp := ctx.a + (ctx.n>>1)*ctx.riType
+	k := uint(0)
+	for ctx.n<<k < temp {
+		k++
+	}
+	return k
+}
+
+// update updates the variables of a run interruption context with the error
+// of a sample, and its mapped value.
+func (ctx *jpeglsRunContext) update(errval, emErrval, reset int32) {
+	if errval < 0 {
+		ctx.nn++
+	}
+	ctx.a += (emErrval + 1 - ctx.riType) >> 1
+	if ctx.n == reset {
+		ctx.a >>= 1
+		ctx.n >>= 1
+		ctx.nn >>= 1
+	}
+	ctx.n++
+}
+
+// decodeRunInterruption decodes the error of a run interruption sample, as
+// per "A.7.2" of ITU-T T.87.
+func (d *jpeglsDecoder) decodeRunInterruption(ctx *jpeglsRunContext) (int32, error) {
+	k := ctx.golombK()
+	emErrval, err := d.decodeValue(k, d.limit-int32(jpeglsJ[d.runIndex])-1)
+	if err != nil {
+		return 0, err
+	}
+	temp := emErrval + ctx.riType
+	mapped := temp&1 == 1
+	errval := (temp + temp&1) / 2
+	if (k != 0 || 2*ctx.nn >= ctx.n) == mapped {
+		errval = -errval
+	}
+	ctx.update(errval, emErrval, d.scanReset)
+	return errval, nil
+}
+
+// decodeSampleInterleaved decodes a scan of three sample interleaved
+// components, in which runs are of whole pixels.
+func (d *jpeglsDecoder) decodeSampleInterleaved(components []int) error {
+	width := d.width
+	prev := make([][]int32, 3)
+	cur := make([][]int32, 3)
+	for i := range prev {
+		prev[i] = make([]int32, width+2)
+		cur[i] = make([]int32, width+2)
+	}
+	var q [3][3]int32
+	var ra, rb, rc [3]int32
+	for y := 0; y < d.height; y++ {
+		for i := range prev {
+			cur[i][0] = prev[i][1]
+			prev[i][width+1] = prev[i][width]
+		}
+		for x := 0; x < width; {
+			run := true
+			for i := range prev {
+				ra[i], rb[i], rc[i] = cur[i][x], prev[i][x+1], prev[i][x]
+				q[i] = [3]int32{d.quantize(prev[i][x+2] - rb[i]), d.quantize(rb[i] - rc[i]), d.quantize(rc[i] - ra[i])}
+				run = run && q[i] == [3]int32{}
+			}
+			if !run {
+				for i := range prev {
+					v, err := d.decodeRegular(q[i][0], q[i][1], q[i][2], ra[i], rb[i], rc[i])
+					if err != nil {
+						return err
+					}
+					cur[i][x+1] = v
+				}
+				x++
+				continue
+			}
+			n, interrupted, err := d.decodeRunLength(x)
+			if err != nil {
+				return err
+			}
+			for i := range prev {
+				for j := 0; j < n; j++ {
+					cur[i][x+1+j] = ra[i]
+				}
+			}
+			x += n
+			if !interrupted {
+				continue
+			}
+			// each sample of the interrupting pixel is coded with the context
+			// of run interruption type 0
+			for i := range prev {
+				errval, err := d.decodeRunInterruption(&d.runContexts[0])
+				if err != nil {
+					return err
+				}
+				rb := prev[i][x+1]
+				if rb < ra[i] {
+					errval = -errval
+				}
+				cur[i][x+1] = d.reconstruct(rb + errval*(2*d.near+1))
+			}
+			if d.runIndex > 0 {
+				d.runIndex--
+			}
+			x++
+		}
+		for i, c := range components {
+			copy(d.planes[c][y*width:], cur[i][1:width+1])
+			prev[i], cur[i] = cur[i], prev[i]
+		}
+	}
+	return nil
+}
+
+// abs32 returns the absolute value of `v`.
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// DecodeJPEGLS decompresses a JPEG-LS Lossless or Near-Lossless frame into
+// native pixel data, updating `params` to describe the decompressed frame.
+//
+// Decompressed samples are little endian, and interleaved (PlanarConfiguration 0).
+// PhotometricInterpretation is unchanged.
+//
+// An error is returned, before the frame is decoded, if its dimensions or
+// components do not match `params`.
+func DecodeJPEGLS(frame []byte, params *ImageParameters) ([]byte, error) {
+	d := jpeglsDecoder{jpegStream: jpegStream{src: frame}, params: params}
+	if err := d.decode(); err != nil {
+		return nil, err
+	}
+	return nativeFromPlanes(d.planes, d.width, d.height, d.precision, params)
+}
@@ -0,0 +1,490 @@
+package opendcm
+
+import (
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    JPEG-LS
+===============================================================================
+*/
+
+// stuffedBitWriter writes bits, stuffing a zero bit after each 0xFF byte, as in
+// JPEG-LS scans and JPEG 2000 packet headers.
+type stuffedBitWriter struct {
+	dst []byte
+	acc byte
+	// nAcc is the number of bits held by acc, and capacity the number of bits
+	// the current byte may hold
+	nAcc, capacity uint
+}
+
+func (bw *stuffedBitWriter) write(bits uint32, n uint) {
+	if bw.capacity == 0 {
+		bw.capacity = 8
+	}
+	for i := int(n) - 1; i >= 0; i-- {
+		bw.acc = bw.acc<<1 | byte(bits>>uint(i))&1
+		bw.nAcc++
+		if bw.nAcc == bw.capacity {
+			bw.dst = append(bw.dst, bw.acc)
+			bw.capacity = 8
+			if bw.acc == 0xFF {
+				bw.capacity = 7
+			}
+			bw.acc, bw.nAcc = 0, 0
+		}
+	}
+}
+
+// flush pads the final byte with zero bits, and appends a further byte if the
+// final byte is 0xFF.
+func (bw *stuffedBitWriter) flush() {
+	for bw.nAcc != 0 {
+		bw.write(0, 1)
+	}
+	if len(bw.dst) > 0 && bw.dst[len(bw.dst)-1] == 0xFF {
+		bw.dst = append(bw.dst, 0x00)
+	}
+}
+
+// jpeglsEncoder encodes JPEG-LS scans, sharing the context modelling of
+// `jpeglsDecoder`.
+type jpeglsEncoder struct {
+	jpeglsDecoder
+	bw stuffedBitWriter
+}
+
+// encodeValue writes `v` as a limited length Golomb code with parameter `k`.
+func (e *jpeglsEncoder) encodeValue(v int32, k uint, limit int32) {
+	if high := v >> k; high < limit-e.qbpp-1 {
+		e.bw.write(0, uint(high))
+		e.bw.write(1, 1)
+		e.bw.write(uint32(v), k)
+		return
+	}
+	e.bw.write(0, uint(limit-e.qbpp-1))
+	e.bw.write(1, 1)
+	e.bw.write(uint32(v-1), uint(e.qbpp))
+}
+
+// quantizeError returns the quantized prediction error of `ix`, and the
+// reconstructed sample.
+func (e *jpeglsEncoder) quantizeError(ix, px, sign int32) (int32, int32) {
+	errval := (ix - px) * sign
+	if e.near > 0 {
+		if errval > 0 {
+			errval = (errval + e.near) / (2*e.near + 1)
+		} else {
+			errval = -(e.near - errval) / (2*e.near + 1)
+		}
+	}
+	rx := e.clamp(px + sign*errval*(2*e.near+1))
+	// modulo reduction
+	if errval < 0 {
+		errval += e.rng
+	}
+	if errval >= (e.rng+1)/2 {
+		errval -= e.rng
+	}
+	return errval, rx
+}
+
+// encodeRegular encodes `ix` in regular mode, returning the reconstructed sample.
+func (e *jpeglsEncoder) encodeRegular(q1, q2, q3, ra, rb, rc, ix int32) int32 {
+	ctx, sign := e.regularContext(q1, q2, q3)
+	px := e.predict(ctx, sign, ra, rb, rc)
+	errval, rx := e.quantizeError(ix, px, sign)
+	k := ctx.golombK()
+	var mErrval int32
+	switch {
+	case e.near == 0 && k == 0 && 2*ctx.b <= -ctx.n && errval >= 0:
+		mErrval = 2*errval + 1
+	case e.near == 0 && k == 0 && 2*ctx.b <= -ctx.n:
+		mErrval = -2 * (errval + 1)
+	case errval >= 0:
+		mErrval = 2 * errval
+	default:
+		mErrval = -2*errval - 1
+	}
+	e.encodeValue(mErrval, k, e.limit)
+	e.updateContext(ctx, errval)
+	return rx
+}
+
+// encodeRunLength encodes a run of `n` samples beginning at `x`.
+func (e *jpeglsEncoder) encodeRunLength(n, x int) {
+	endOfLine := x+n == e.width
+	for n >= 1<<uint(jpeglsJ[e.runIndex]) {
+		e.bw.write(1, 1)
+		n -= 1 << uint(jpeglsJ[e.runIndex])
+		if e.runIndex < 31 {
+			e.runIndex++
+		}
+	}
+	if endOfLine {
+		if n > 0 {
+			e.bw.write(1, 1)
+		}
+		return
+	}
+	e.bw.write(0, 1)
+	e.bw.write(uint32(n), uint(jpeglsJ[e.runIndex]))
+}
+
+// encodeRunInterruption encodes the run interruption sample `ix`, returning
+// the reconstructed sample.
+func (e *jpeglsEncoder) encodeRunInterruption(ctx *jpeglsRunContext, px, sign, ix int32) int32 {
+	errval, rx := e.quantizeError(ix, px, sign)
+	k := ctx.golombK()
+	mapped := int32(0)
+	if (k == 0 && errval > 0 && 2*ctx.nn < ctx.n) || (errval < 0 && (2*ctx.nn >= ctx.n || k != 0)) {
+		mapped = 1
+	}
+	emErrval := 2*abs32(errval) - ctx.riType - mapped
+	e.encodeValue(emErrval, k, e.limit-int32(jpeglsJ[e.runIndex])-1)
+	ctx.update(errval, emErrval, e.scanReset)
+	return rx
+}
+
+// encodeLine encodes the line `src`, holding the reconstructed line in `cur`.
+func (e *jpeglsEncoder) encodeLine(src, prev, cur []int32) {
+	width := e.width
+	cur[0] = prev[1]
+	prev[width+1] = prev[width]
+	for x := 0; x < width; {
+		ra, rb, rc, rd := cur[x], prev[x+1], prev[x], prev[x+2]
+		q1, q2, q3 := e.quantize(rd-rb), e.quantize(rb-rc), e.quantize(rc-ra)
+		if q1 != 0 || q2 != 0 || q3 != 0 {
+			cur[x+1] = e.encodeRegular(q1, q2, q3, ra, rb, rc, src[x])
+			x++
+			continue
+		}
+		n := 0
+		for x+n < width && abs32(src[x+n]-ra) <= e.near {
+			cur[x+n+1] = ra
+			n++
+		}
+		e.encodeRunLength(n, x)
+		x += n
+		if x == width {
+			continue
+		}
+		rb = prev[x+1]
+		if abs32(ra-rb) <= e.near {
+			cur[x+1] = e.encodeRunInterruption(&e.runContexts[1], ra, 1, src[x])
+		} else if rb < ra {
+			cur[x+1] = e.encodeRunInterruption(&e.runContexts[0], rb, -1, src[x])
+		} else {
+			cur[x+1] = e.encodeRunInterruption(&e.runContexts[0], rb, 1, src[x])
+		}
+		if e.runIndex > 0 {
+			e.runIndex--
+		}
+		x++
+	}
+}
+
+// encodeSampleInterleaved encodes three components of which runs are of whole pixels.
+func (e *jpeglsEncoder) encodeSampleInterleaved(planes [][]int32) {
+	width := e.width
+	prev := [3][]int32{make([]int32, width+2), make([]int32, width+2), make([]int32, width+2)}
+	cur := [3][]int32{make([]int32, width+2), make([]int32, width+2), make([]int32, width+2)}
+	var q [3][3]int32
+	var ra, rb, rc [3]int32
+	for y := 0; y < e.height; y++ {
+		for i := range prev {
+			cur[i][0] = prev[i][1]
+			prev[i][width+1] = prev[i][width]
+		}
+		for x := 0; x < width; {
+			run := true
+			for i := range prev {
+				ra[i], rb[i], rc[i] = cur[i][x], prev[i][x+1], prev[i][x]
+				q[i] = [3]int32{e.quantize(prev[i][x+2] - rb[i]), e.quantize(rb[i] - rc[i]), e.quantize(rc[i] - ra[i])}
+				run = run && q[i] == [3]int32{}
+			}
+			if !run {
+				for i := range prev {
+					cur[i][x+1] = e.encodeRegular(q[i][0], q[i][1], q[i][2], ra[i], rb[i], rc[i], planes[i][y*width+x])
+				}
+				x++
+				continue
+			}
+			n := 0
+			for ; x+n < width; n++ {
+				matches := true
+				for i := range prev {
+					matches = matches && abs32(planes[i][y*width+x+n]-ra[i]) <= e.near
+				}
+				if !matches {
+					break
+				}
+				for i := range prev {
+					cur[i][x+n+1] = ra[i]
+				}
+			}
+			e.encodeRunLength(n, x)
+			x += n
+			if x == width {
+				continue
+			}
+			for i := range prev {
+				sign := int32(1)
+				if prev[i][x+1] < ra[i] {
+					sign = -1
+				}
+				cur[i][x+1] = e.encodeRunInterruption(&e.runContexts[0], prev[i][x+1], sign, planes[i][y*width+x])
+			}
+			if e.runIndex > 0 {
+				e.runIndex--
+			}
+			x++
+		}
+		for i := range prev {
+			prev[i], cur[i] = cur[i], prev[i]
+		}
+	}
+}
+
+// encodeJPEGLS returns a JPEG-LS stream of `precision` bits, with one component
+// for each of `planes`, using the given NEAR and interleave mode. The preset
+// coding parameters maxVal, t1, t2, t3 and reset are written if any is non-zero.
+func encodeJPEGLS(planes [][]int32, width, height, precision int, near int32, interleave int, preset [5]int32) []byte {
+	stream := []byte{0xFF, jpegMarkerSOI}
+	sof := []byte{byte(precision), byte(height >> 8), byte(height), byte(width >> 8), byte(width), byte(len(planes))}
+	for i := range planes {
+		sof = append(sof, byte(i+1), 0x11, 0)
+	}
+	stream = append(stream, jpegSegment(jpeglsMarkerSOF55, sof...)...)
+	e := jpeglsEncoder{jpeglsDecoder: jpeglsDecoder{precision: precision, width: width, height: height}}
+	if preset != [5]int32{} {
+		lse := []byte{1}
+		for _, v := range preset {
+			lse = append(lse, byte(v>>8), byte(v))
+		}
+		stream = append(stream, jpegSegment(jpeglsMarkerLSE, lse...)...)
+		e.maxVal, e.t1, e.t2, e.t3, e.reset = preset[0], preset[1], preset[2], preset[3], preset[4]
+	}
+
+	// the components of each scan
+	scans := [][]int{}
+	if interleave == 0 {
+		for i := range planes {
+			scans = append(scans, []int{i})
+		}
+	} else {
+		scans = append(scans, []int{0, 1, 2}[:len(planes)])
+	}
+	for _, scan := range scans {
+		sos := []byte{byte(len(scan))}
+		for _, c := range scan {
+			sos = append(sos, byte(c+1), 0)
+		}
+		sos = append(sos, byte(near), byte(interleave), 0)
+		stream = append(stream, jpegSegment(jpegMarkerSOS, sos...)...)
+		if err := e.initScan(near); err != nil {
+			panic(err)
+		}
+		e.bw = stuffedBitWriter{}
+		if interleave == 2 {
+			e.encodeSampleInterleaved(planes)
+		} else {
+			prev := make([][]int32, len(scan))
+			cur := make([][]int32, len(scan))
+			runIndices := make([]int, len(scan))
+			for i := range scan {
+				prev[i], cur[i] = make([]int32, width+2), make([]int32, width+2)
+			}
+			for y := 0; y < height; y++ {
+				for i, c := range scan {
+					e.runIndex = runIndices[i]
+					e.encodeLine(planes[c][y*width:(y+1)*width], prev[i], cur[i])
+					runIndices[i] = e.runIndex
+					prev[i], cur[i] = cur[i], prev[i]
+				}
+			}
+		}
+		e.bw.flush()
+		stream = append(stream, e.bw.dst...)
+	}
+	return append(stream, 0xFF, jpegMarkerEOI)
+}
+
+// newJPEGLSPlane returns a plane of samples of `precision` bits, holding a
+// gradient with noise and flat regions, such that both regular and run modes
+// are exercised.
+func newJPEGLSPlane(random *rand.Rand, width, height, precision int) []int32 {
+	plane := make([]int32, width*height)
+	for i := range plane {
+		x, y := i%width, i/width
+		switch {
+		case x < width/3:
+			plane[i] = int32(y % 2)
+		case y%5 == 0:
+			plane[i] = int32(random.Intn(1 << uint(precision)))
+		default:
+			plane[i] = int32((x*37 + y*11 + random.Intn(8)) % (1 << uint(precision)))
+		}
+	}
+	return plane
+}
+
+func TestJPEGLSDecoder(t *testing.T) {
+	// ensures that lossless and near-lossless frames are decoded for each
+	// precision and interleave mode, including frames with preset parameters.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	width, height := 41, 23
+	for _, testCase := range []struct {
+		precision, components, interleave int
+		near                              int32
+		preset                            [5]int32
+	}{
+		{2, 1, 0, 0, [5]int32{}},
+		{8, 1, 0, 0, [5]int32{}},
+		{12, 1, 0, 0, [5]int32{}},
+		{16, 1, 0, 0, [5]int32{}},
+		{8, 3, 0, 0, [5]int32{}},
+		{8, 3, 1, 0, [5]int32{}},
+		{8, 3, 2, 0, [5]int32{}},
+		{12, 3, 2, 0, [5]int32{}},
+		{5, 1, 0, 0, [5]int32{}},
+		{8, 1, 0, 3, [5]int32{}},
+		{12, 1, 0, 2, [5]int32{}},
+		{8, 3, 1, 1, [5]int32{}},
+		{8, 3, 2, 2, [5]int32{}},
+		{12, 1, 0, 0, [5]int32{4095, 5, 20, 70, 32}},
+		{16, 1, 0, 0, [5]int32{0, 18, 67, 276, 0}},
+	} {
+		planes := make([][]int32, testCase.components)
+		for i := range planes {
+			planes[i] = newJPEGLSPlane(random, width, height, testCase.precision)
+		}
+		stream := encodeJPEGLS(planes, width, height, testCase.precision, testCase.near, testCase.interleave, testCase.preset)
+		d := jpeglsDecoder{jpegStream: jpegStream{src: stream}}
+		assert.NoError(t, d.decode(), "%+v", testCase)
+		assert.Len(t, d.planes, testCase.components)
+		for i := range planes {
+			if testCase.near == 0 {
+				assert.Equal(t, planes[i], d.planes[i], "%+v", testCase)
+				continue
+			}
+			for j := range planes[i] {
+				if abs32(planes[i][j]-d.planes[i][j]) > testCase.near {
+					t.Errorf("%+v: sample %d of component %d is %d, expected %d", testCase, j, i, d.planes[i][j], planes[i][j])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestJPEGLSDecoderExample(t *testing.T) {
+	// ensures that the example of "H.3" of ITU-T T.87 is decoded, and encoded
+	// into the same stream.
+	t.Parallel()
+	stream := []byte{
+		0xFF, 0xD8, 0xFF, 0xF7, 0x00, 0x0B, 0x08, 0x00, 0x04, 0x00, 0x04, 0x01, 0x01, 0x11, 0x00,
+		0xFF, 0xDA, 0x00, 0x08, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00,
+		0xC0, 0x00, 0x00, 0x6C, 0x80, 0x20, 0x8E, 0x01, 0xC0, 0x00, 0x00, 0x57, 0x40, 0x00, 0x00,
+		0x6E, 0xE6, 0x00, 0x00, 0x01, 0xBC, 0x18, 0x00, 0x00, 0x05, 0xD8, 0x00, 0x00, 0x91, 0x60,
+		0xFF, 0xD9,
+	}
+	expected := []int32{0, 0, 90, 74, 68, 50, 43, 205, 64, 145, 145, 145, 100, 145, 145, 145}
+	d := jpeglsDecoder{jpegStream: jpegStream{src: stream}}
+	assert.NoError(t, d.decode())
+	assert.Equal(t, [][]int32{expected}, d.planes)
+	assert.Equal(t, stream, encodeJPEGLS([][]int32{expected}, 4, 4, 8, 0, 0, [5]int32{}))
+}
+
+func TestJPEGLSDecoderError(t *testing.T) {
+	// ensures that invalid or unsupported streams result in an error.
+	t.Parallel()
+	valid := encodeJPEGLS([][]int32{make([]int32, 16)}, 4, 4, 8, 0, 0, [5]int32{})
+	d := jpeglsDecoder{jpegStream: jpegStream{src: valid}}
+	assert.NoError(t, d.decode())
+	sof := jpegSegment(jpeglsMarkerSOF55, 8, 0, 4, 0, 4, 1, 1, 0x11, 0)
+	for _, stream := range [][]byte{
+		// missing SOI
+		valid[2:],
+		// no scans
+		valid[:2],
+		// truncated segment
+		valid[:10],
+		// JPEG process
+		append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpegMarkerSOF1, 8, 0, 8, 0, 8, 1, 1, 0x11, 0)...),
+		// scan without frame
+		append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpegMarkerSOS, 1, 1, 0, 0, 0, 0)...),
+		// unknown component
+		append(append([]byte{0xFF, jpegMarkerSOI}, sof...), jpegSegment(jpegMarkerSOS, 1, 2, 0, 0, 0, 0)...),
+		// NEAR beyond MAXVAL / 2
+		append(append([]byte{0xFF, jpegMarkerSOI}, sof...), jpegSegment(jpegMarkerSOS, 1, 1, 0, 200, 0, 0)...),
+		// point transform
+		append(append([]byte{0xFF, jpegMarkerSOI}, sof...), jpegSegment(jpegMarkerSOS, 1, 1, 0, 0, 0, 1)...),
+		// mapping table
+		append(append([]byte{0xFF, jpegMarkerSOI}, sof...), jpegSegment(jpegMarkerSOS, 1, 1, 5, 0, 0, 0)...),
+		// preset parameters other than coding parameters
+		append(append([]byte{0xFF, jpegMarkerSOI}, sof...), jpegSegment(jpeglsMarkerLSE, 2, 1, 1, 0)...),
+	} {
+		d := jpeglsDecoder{jpegStream: jpegStream{src: stream}}
+		assert.Error(t, d.decode())
+	}
+
+	// a frame of 65535x65535 samples of 255 components, which does not match
+	// the Image Pixel module, is rejected before allocating for the frame
+	content := []byte{8, 0xFF, 0xFF, 0xFF, 0xFF, 255}
+	for i := 0; i < 255; i++ {
+		content = append(content, byte(i), 0x11, 0)
+	}
+	stream := append([]byte{0xFF, jpegMarkerSOI}, jpegSegment(jpeglsMarkerSOF55, content...)...)
+	d = jpeglsDecoder{jpegStream: jpegStream{src: stream}, params: &ImageParameters{Rows: 4, Columns: 4, SamplesPerPixel: 1}}
+	assert.Error(t, d.decode())
+	assert.Nil(t, d.planes)
+}
+
+func TestDecodeJPEGLS(t *testing.T) {
+	// ensures that frames are decompressed into native pixel data.
+	t.Parallel()
+	random := rand.New(rand.NewSource(1))
+	planes := [][]int32{newJPEGLSPlane(random, 16, 8, 12)}
+	params := ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 1, PhotometricInterpretation: "MONOCHROME2",
+		BitsAllocated: 16, BitsStored: 12, HighBit: 11, LittleEndian: true,
+	}
+	dcm := newCompressedDicom(t, JPEGLSLossless, params, encodeJPEGLS(planes, 16, 8, 12, 0, 0, [5]int32{}))
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 16; x++ {
+			assert.Equal(t, color.Gray16{Y: uint16(planes[0][y*16+x])}, img.At(x, y))
+		}
+	}
+
+	// RGB, near-lossless
+	planes = [][]int32{newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8), newJPEGLSPlane(random, 16, 8, 8)}
+	params = ImageParameters{
+		Rows: 8, Columns: 16, SamplesPerPixel: 3, PhotometricInterpretation: "RGB",
+		BitsAllocated: 8, BitsStored: 8, HighBit: 7, LittleEndian: true,
+	}
+	dcm = newCompressedDicom(t, JPEGLSNearLossless, params, encodeJPEGLS(planes, 16, 8, 8, 0, 2, [5]int32{}))
+	img, err = dcm.GetImage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, color.RGBA{R: uint8(planes[0][17]), G: uint8(planes[1][17]), B: uint8(planes[2][17]), A: 0xFF}, img.At(1, 1))
+
+	// dimensions do not match the Image Pixel module
+	params.Rows = 16
+	_, err = DecodeJPEGLS(encodeJPEGLS(planes, 16, 8, 8, 0, 2, [5]int32{}), &params)
+	assert.Error(t, err)
+
+	// components do not match the Image Pixel module
+	params.Rows = 8
+	params.SamplesPerPixel = 1
+	_, err = DecodeJPEGLS(encodeJPEGLS(planes, 16, 8, 8, 0, 2, [5]int32{}), &params)
+	assert.Error(t, err)
+}
@@ -0,0 +1,360 @@
+package opendcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+/*
+===============================================================================
+	Element (Mutation)
+	---
+	Provides encoding of Go values into the value of an element, and the
+	insertion of items into sequences.
+===============================================================================
+*/
+
+// maxLengthOfVR is the maximum length of each value of a VR, in characters.
+// PN values are instead limited to 64 characters per component group, and
+// the length of DA, TM and DT values is validated by parsing, as the ranges
+// of queries exceed that of a single value.
+var maxLengthOfVR = map[string]int{
+	"AE": 16, "AS": 4, "CS": 16, "DS": 16, "IS": 12,
+	"LO": 64, "LT": 10240, "SH": 16, "ST": 1024, "UI": 64,
+}
+
+// NewElementWithValue returns a fresh Element for `tag`, with its value set to `value`.
+// See: SetValue
+func NewElementWithValue(tag uint32, value interface{}) (Element, error) {
+	e := NewElementWithTag(tag)
+	return e, e.SetValue(value)
+}
+
+// NewItemWithDataSet returns an Item embedding data set `ds`.
+func NewItemWithDataSet(ds DataSet) Item {
+	return Item{dataset: ds}
+}
+
+// SetValue encodes `v` into the element's "value" component, according to its VR.
+//
+// `v` may be of any type which `GetValue` writes for the VR, or a slice of such,
+// which is joined into a value of multiplicity greater than one. A string is
+// split on "\" into its values, except for VRs of a single value (LT, ST, UT, UR).
+// Integers of type `int` are accepted by each integer VR. Sequences (SQ) accept a
+// `DataSet`, `[]DataSet`, `Item` or `[]Item`, which replace any existing items.
+// Elements of unknown VR (UN) accept only a string or `[]byte`.
+//
+// As elements are held by data sets by value, an element retrieved from a data
+// set must be put back to the data set once modified. See: DataSet.Put
+func (e *Element) SetValue(v interface{}) error {
+	supported := e.supportsType(v)
+	if e.GetVR() == "UN" {
+		switch v.(type) {
+		case string, []byte:
+		default:
+			supported = false
+		}
+	}
+	if !supported {
+		return fmt.Errorf("SetValue(%s): value of %s cannot be expressed from a %s", reflect.TypeOf(v), e.dictEntry, reflect.TypeOf(v))
+	}
+	if items, isSequence := itemsOf(v); isSequence {
+		e.items = items
+		e.data = nil
+		e.datalen = 0xFFFFFFFF
+		e.deferred = nil
+		return nil
+	}
+	data, err := e.encodeValue(v)
+	if err != nil {
+		return fmt.Errorf("SetValue(%s): value of %s: %v", reflect.TypeOf(v), e.dictEntry, err)
+	}
+	e.data = data
+	e.datalen = uint32(len(data))
+	e.deferred = nil
+	return nil
+}
+
+// itemsOf returns the items of sequence value `v`, and whether `v` is a sequence value.
+func itemsOf(v interface{}) ([]Item, bool) {
+	switch typed := v.(type) {
+	case DataSet:
+		return []Item{NewItemWithDataSet(typed)}, true
+	case []DataSet:
+		items := make([]Item, len(typed))
+		for i, ds := range typed {
+			items[i] = NewItemWithDataSet(ds)
+		}
+		return items, true
+	case Item:
+		return []Item{typed}, true
+	case []Item:
+		return append([]Item{}, typed...), true
+	}
+	return nil, false
+}
+
+// InsertItem inserts `item` into the sequence, such that it becomes the item at
+// `index`. An index equal to the number of items appends the item.
+func (e *Element) InsertItem(index int, item Item) error {
+	if e.GetVR() != "SQ" {
+		return fmt.Errorf("InsertItem: %s is not a sequence", e.dictEntry)
+	}
+	if index < 0 || index > len(e.items) {
+		return fmt.Errorf("InsertItem: index %d is out of range; sequence has %d items", index, len(e.items))
+	}
+	e.items = append(e.items, Item{})
+	copy(e.items[index+1:], e.items[index:])
+	e.items[index] = item
+	e.datalen = 0xFFFFFFFF
+	return nil
+}
+
+// AddItem appends an item embedding data set `ds` to the sequence.
+// See: InsertItem
+func (e *Element) AddItem(ds DataSet) error {
+	return e.InsertItem(len(e.items), NewItemWithDataSet(ds))
+}
+
+// encodeValue returns the encoding of `v` according to the VR of the element.
+// `v` is assumed to be supported by the VR. See: supportsType
+func (e *Element) encodeValue(v interface{}) ([]byte, error) {
+	vr := e.GetVR()
+	switch typed := v.(type) {
+	case []byte:
+		return typed, nil
+	case string:
+		switch vr {
+		case "LT", "ST", "UT", "UR", "UN":
+			return e.encodeStrings([]string{typed})
+		}
+		// "\" delimits each value
+		return e.encodeStrings(strings.Split(typed, `\`))
+	case []string:
+		return e.encodeStrings(typed)
+	case int:
+		return e.encodeValue([]int{typed})
+	case []int:
+		return e.encodeInts(typed)
+	case float64:
+		return e.encodeValue([]float64{typed})
+	case []float64:
+		if vr == "DS" {
+			values := make([]string, len(typed))
+			for i, f := range typed {
+				if math.IsNaN(f) || math.IsInf(f, 0) {
+					return nil, fmt.Errorf("%v cannot be expressed as a decimal string", f)
+				}
+				values[i] = formatDecimalString(f)
+			}
+			return e.encodeStrings(values)
+		}
+	case int64:
+		return e.encodeValue([]int64{typed})
+	case []int64:
+		if vr == "IS" {
+			values := make([]string, len(typed))
+			for i, n := range typed {
+				if n < math.MinInt32 || n > math.MaxInt32 {
+					return nil, fmt.Errorf("%d is out of range of an integer string", n)
+				}
+				values[i] = strconv.FormatInt(n, 10)
+			}
+			return e.encodeStrings(values)
+		}
+	case uint32:
+		return e.encodeValue([]uint32{typed})
+	case []uint32:
+		if vr == "AT" {
+			// attribute tags are pairs of 16 bit group and element numbers
+			pairs := make([]uint16, 0, len(typed)*2)
+			for _, tag := range typed {
+				pairs = append(pairs, uint16(tag>>16), uint16(tag))
+			}
+			return e.encodeBinary(pairs)
+		}
+	case time.Time:
+		return e.encodeValue(DateTime{Time: typed, Precision: precisionOf(typed), HasOffset: typed.Location() != time.UTC})
+	case DateTime:
+		return e.encodeValue([]DateTime{typed})
+	case []DateTime:
+		values := make([]string, len(typed))
+		for i, dt := range typed {
+			values[i] = formatDateTime(vr, dt)
+		}
+		return e.encodeStrings(values)
+	case DateTimeRange:
+		value := "-"
+		if typed.Start != nil {
+			value = formatDateTime(vr, *typed.Start) + value
+		}
+		if typed.End != nil {
+			value += formatDateTime(vr, *typed.End)
+		}
+		return e.encodeStrings([]string{value})
+	case PersonName:
+		return e.encodeValue([]PersonName{typed})
+	case []PersonName:
+		values := make([]string, len(typed))
+		for i, pn := range typed {
+			values[i] = pn.String()
+		}
+		return e.encodeStrings(values)
+	case Age:
+		return e.encodeStrings([]string{typed.String()})
+	}
+	// the remainder are binary values of fixed size
+	return e.encodeBinary(v)
+}
+
+// encodeBinary returns the encoding of the fixed size value or slice `v`,
+// in the byte order of the element.
+func (e *Element) encodeBinary(v interface{}) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := binary.Write(&buf, e.byteOrder(), v); err != nil {
+		return nil, fmt.Errorf(`writing type "%v" is not yet implemented`, reflect.TypeOf(v))
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeInts returns the encoding of `values` as the integer type of the element's VR,
+// provided that each value is within its range.
+func (e *Element) encodeInts(values []int) ([]byte, error) {
+	lower, upper := int64(0), int64(math.MaxInt64)
+	switch e.GetVR() {
+	case "SS":
+		lower, upper = math.MinInt16, math.MaxInt16
+	case "US":
+		upper = math.MaxUint16
+	case "SL", "IS":
+		lower, upper = math.MinInt32, math.MaxInt32
+	case "UL":
+		upper = math.MaxUint32
+	case "SV":
+		lower = math.MinInt64
+	}
+	converted := reflect.MakeSlice(map[string]reflect.Type{
+		"SS": reflect.TypeOf([]int16{}),
+		"US": reflect.TypeOf([]uint16{}),
+		"SL": reflect.TypeOf([]int32{}),
+		"UL": reflect.TypeOf([]uint32{}),
+		"SV": reflect.TypeOf([]int64{}),
+		"IS": reflect.TypeOf([]int64{}),
+		"UV": reflect.TypeOf([]uint64{}),
+	}[e.GetVR()], len(values), len(values))
+	for i, v := range values {
+		if int64(v) < lower || int64(v) > upper {
+			return nil, fmt.Errorf("%d is out of range of %s", v, e.GetVR())
+		}
+		converted.Index(i).Set(reflect.ValueOf(v).Convert(converted.Type().Elem()))
+	}
+	return e.encodeValue(converted.Interface())
+}
+
+// encodeStrings validates each of `values` according to the VR of the element,
+// and returns them joined by "\".
+func (e *Element) encodeStrings(values []string) ([]byte, error) {
+	vr := e.GetVR()
+	switch vr {
+	case "LT", "ST", "UT", "UR":
+		if len(values) > 1 {
+			return nil, fmt.Errorf("%s values are of a single value, but %d were given", vr, len(values))
+		}
+	}
+	for _, v := range values {
+		if err := validateString(vr, v); err != nil {
+			return nil, fmt.Errorf(`"%s" is not a valid %s value: %v`, v, vr, err)
+		}
+	}
+	return []byte(strings.Join(values, `\`)), nil
+}
+
+// validateString returns whether `v` is a valid value of VR `vr`.
+func validateString(vr string, v string) error {
+	if max, found := maxLengthOfVR[vr]; found && utf8.RuneCountInString(v) > max {
+		return fmt.Errorf("exceeds the maximum length of %d", max)
+	}
+	if v == "" {
+		return nil
+	}
+	switch vr {
+	case "LT", "ST", "UT", "UR", "UN":
+	default:
+		if strings.Contains(v, `\`) {
+			return errors.New(`"\" delimits values`)
+		}
+	}
+	var err error
+	switch vr {
+	case "CS":
+		if strings.TrimLeft(v, "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 _") != "" {
+			err = errors.New("may only contain upper case letters, digits, space and underscore")
+		}
+	case "UI":
+		if strings.TrimLeft(v, "0123456789.") != "" {
+			err = errors.New("may only contain digits and periods")
+		}
+	case "DS":
+		_, err = strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case "IS":
+		_, err = strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+	case "DA", "TM", "DT":
+		// ranges are valid values of queries
+		_, err = ParseDateTimeRange(vr, v)
+	case "AS":
+		_, err = ParseAge(v)
+	case "PN":
+		for _, group := range strings.Split(v, "=") {
+			if utf8.RuneCountInString(group) > 64 {
+				err = errors.New("component group exceeds the maximum length of 64")
+			}
+		}
+	}
+	return err
+}
+
+// formatDecimalString returns `f` in at most the 16 characters of a DS value.
+func formatDecimalString(f float64) string {
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	for precision := 15; len(s) > 16 && precision > 0; precision-- {
+		s = strconv.FormatFloat(f, 'g', precision, 64)
+	}
+	return s
+}
+
+// precisionOf returns the precision with which `t` is expressed as a value.
+func precisionOf(t time.Time) DateTimePrecision {
+	if t.Nanosecond() != 0 {
+		return PrecisionFraction
+	}
+	return PrecisionSecond
+}
+
+// formatDateTime returns `dt` as a value of VR `vr` (DA, TM or DT), to its precision.
+// DA values are always of a whole date, and TM values of at least an hour.
+func formatDateTime(vr string, dt DateTime) string {
+	date := []string{"2006", "200601", "20060102"}
+	clock := []string{"15", "1504", "150405", "150405.000000"}
+	switch vr {
+	case "DA":
+		return dt.Format(date[2])
+	case "TM":
+		return dt.Format(clock[maxInt(int(dt.Precision-PrecisionHour), 0)])
+	}
+	layout := date[minInt(int(dt.Precision), int(PrecisionDay))]
+	if dt.Precision >= PrecisionHour {
+		layout += clock[dt.Precision-PrecisionHour]
+	}
+	if dt.HasOffset {
+		layout += "-0700"
+	}
+	return dt.Format(layout)
+}
@@ -0,0 +1,195 @@
+package opendcm
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewElementWithValue(t *testing.T) {
+	// ensures that `NewElementWithValue` encodes values according to the VR of the tag.
+	t.Parallel()
+	moscow := time.FixedZone("", 3*3600)
+	for _, c := range []struct {
+		tag      uint32
+		value    interface{}
+		expected []byte
+	}{
+		{0x00100010, "Doe^Jane", []byte("Doe^Jane")},
+		{0x00100010, PersonName{Alphabetic: PersonNameGroup{Family: "Yamada", Given: "Tarou"}, Ideographic: PersonNameGroup{Family: "山田", Given: "太郎"}}, []byte("Yamada^Tarou=山田^太郎")},
+		{0x00080008, []string{"ORIGINAL", "PRIMARY"}, []byte(`ORIGINAL\PRIMARY`)},
+		{0x00080008, `ORIGINAL\PRIMARY`, []byte(`ORIGINAL\PRIMARY`)},
+		{0x00284000, `Text\With\Backslashes`, []byte(`Text\With\Backslashes`)},
+		{0x00280010, 512, []byte{0x00, 0x02}},
+		{0x00280010, uint16(512), []byte{0x00, 0x02}},
+		{0x00189219, -1234, []byte{0x2E, 0xFB}},
+		{0x00281052, -1024.5, []byte("-1024.5")},
+		{0x00281050, []float64{40, 400}, []byte(`40\400`)},
+		{0x00281050, 1.0 / 3, []byte("0.33333333333333")},
+		{0x00200013, 7, []byte("7")},
+		{0x00200013, int64(-12), []byte("-12")},
+		{0x00180050, math.Pi, []byte("3.14159265358979")},
+		{0x00720076, float32(1.5), []byte{0x00, 0x00, 0xC0, 0x3F}},
+		{0x00720060, uint32(0x00280010), []byte{0x28, 0x00, 0x10, 0x00}},
+		{0x00080020, time.Date(2018, 3, 2, 7, 15, 30, 0, time.UTC), []byte("20180302")},
+		{0x00080030, time.Date(2018, 3, 2, 7, 15, 30, 0, time.UTC), []byte("071530")},
+		{0x00080030, time.Date(2018, 3, 2, 7, 15, 30, 250000000, time.UTC), []byte("071530.250000")},
+		{0x0008002A, time.Date(2018, 3, 2, 7, 15, 30, 0, time.UTC), []byte("20180302071530")},
+		{0x0008002A, time.Date(2018, 3, 2, 7, 15, 30, 0, moscow), []byte("20180302071530+0300")},
+		{0x0008002A, DateTime{Time: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Precision: PrecisionMonth}, []byte("201803")},
+		{0x00080020, DateTimeRange{End: &DateTime{Time: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), Precision: PrecisionDay}}, []byte("-20180301")},
+		{0x00080020, "20180101-20180301", []byte("20180101-20180301")},
+		{0x00101010, Age{Value: 42, Unit: 'Y'}, []byte("042Y")},
+		{0x00020010, ExplicitVRLittleEndian, []byte(ExplicitVRLittleEndian)},
+		{0x20010010, "PRIVATE CREATOR", []byte("PRIVATE CREATOR")},
+		{0x7FE00010, []byte{0x01, 0x02}, []byte{0x01, 0x02}},
+	} {
+		e, err := NewElementWithValue(c.tag, c.value)
+		assert.NoError(t, err, "%08X %v", c.tag, c.value)
+		assert.Equal(t, c.expected, e.data, "%08X %v", c.tag, c.value)
+		assert.Equal(t, len(c.expected), e.Len())
+	}
+}
+
+func TestSetValueError(t *testing.T) {
+	// ensures that `SetValue` rejects values which can not be expressed by the VR of the element.
+	t.Parallel()
+	for _, c := range []struct {
+		tag   uint32
+		value interface{}
+	}{
+		{0x00280010, "512"},                 // US from string
+		{0x00280010, 70000},                 // US out of range
+		{0x00280010, float32(1)},            // US from float
+		{0x00100010, 42},                    // PN from int
+		{0x00080060, "ct"},                  // CS of lower case
+		{0x00080060, "COMPUTED TOMOGRAPHY"}, // CS too long
+		{0x00080060, []string{`A\B`}},       // "\" within a value
+		{0x0020000D, "1.2.3.a"},             // UI of letters
+		{0x00080020, "2018-03-02"},          // DA of hyphens
+		{0x00080030, "25"},                  // TM out of range
+		{0x00101010, "42 years"},            // AS of words
+		{0x00281050, "forty"},               // DS of words
+		{0x00281050, math.NaN()},            // DS of NaN
+		{0x00200013, "1.5"},                 // IS of a fraction
+		{0x00200013, int64(1 << 40)},        // IS out of range
+		{0x00284000, []string{"A", "B"}},    // LT of more than one value
+		{0x20010010, 42},                    // UN from int
+		{0x00720080, "item"},                // SQ from string
+		{0x00100010, struct{}{}},            // unsupported type
+	} {
+		e := NewElementWithTag(c.tag)
+		assert.Error(t, e.SetValue(c.value), "%08X %v", c.tag, c.value)
+	}
+}
+
+func TestInsertItem(t *testing.T) {
+	// ensures that items are inserted into sequences at the given index.
+	t.Parallel()
+	newDataSet := func(uid string) DataSet {
+		ds := make(DataSet, 0)
+		e, err := NewElementWithValue(0x0020000E, uid)
+		assert.NoError(t, err)
+		ds.Put(e)
+		return ds
+	}
+	sq, err := NewElementWithValue(0x00081115, []DataSet{newDataSet("1.2")})
+	assert.NoError(t, err)
+	assert.NoError(t, sq.AddItem(newDataSet("1.4")))
+	assert.NoError(t, sq.InsertItem(1, NewItemWithDataSet(newDataSet("1.3"))))
+	assert.NoError(t, sq.InsertItem(0, NewItemWithDataSet(newDataSet("1.1"))))
+	assert.Error(t, sq.InsertItem(5, NewItem()))
+	assert.Error(t, sq.InsertItem(-1, NewItem()))
+
+	ds := make(DataSet, 0)
+	ds.Put(sq)
+	uids := []string{}
+	for _, e := range queryElements(t, &ds, "ReferencedSeriesSequence[*].SeriesInstanceUID") {
+		uid := ""
+		assert.NoError(t, e.GetValue(&uid))
+		uids = append(uids, uid)
+	}
+	assert.Equal(t, []string{"1.1", "1.2", "1.3", "1.4"}, uids)
+
+	// only sequences have items
+	e := NewElementWithTag(0x0020000E)
+	assert.Error(t, e.AddItem(make(DataSet, 0)))
+}
+
+// queryElements returns the elements of `ds` addressed by `path`.
+func queryElements(t *testing.T, ds *DataSet, path string) []Element {
+	elements, err := ds.Query(path)
+	assert.NoError(t, err)
+	return elements
+}
+
+func TestSetValueRoundTrip(t *testing.T) {
+	// ensures that values set using `SetValue` are written and decoded back
+	// into equal values, in both byte orders.
+	t.Parallel()
+	for _, ts := range []string{ExplicitVRLittleEndian, ExplicitVRBigEndian, ImplicitVRLittleEndian} {
+		dcm := newDicom()
+		item := make(DataSet, 0)
+		for tag, value := range map[uint32]interface{}{
+			0x00020010: ts,
+			0x00100010: "Doe^Jane",
+			0x00100030: time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC),
+			0x00280010: 512,
+			0x00189219: int16(-1234),
+			0x00281050: []float64{40, -600},
+			0x00720074: []float64{math.Pi, math.E},
+			0x00720060: []uint32{0x00280010, 0x00280011},
+			0x00081115: []DataSet{item},
+		} {
+			e, err := NewElementWithValue(tag, value)
+			assert.NoError(t, err)
+			dcm.Put(e)
+		}
+		e, err := NewElementWithValue(0x0020000E, "1.2.3")
+		assert.NoError(t, err)
+		item.Put(e)
+
+		buf := bytes.Buffer{}
+		_, err = dcm.WriteTo(&buf)
+		assert.NoError(t, err)
+		decoded, err := FromReader(&buf)
+		assert.NoError(t, err)
+
+		name := PersonName{}
+		_, err = decoded.GetElementValue(0x00100010, &name)
+		assert.NoError(t, err)
+		assert.Equal(t, "Jane", name.Alphabetic.Given)
+		birth := time.Time{}
+		_, err = decoded.GetElementValue(0x00100030, &birth)
+		assert.NoError(t, err)
+		assert.True(t, birth.Equal(time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC)))
+		rows := uint16(0)
+		_, err = decoded.GetElementValue(0x00280010, &rows)
+		assert.NoError(t, err)
+		assert.Equal(t, uint16(512), rows)
+		angle := int16(0)
+		_, err = decoded.GetElementValue(0x00189219, &angle)
+		assert.NoError(t, err)
+		assert.Equal(t, int16(-1234), angle)
+		centers := []float64{}
+		_, err = decoded.GetElementValue(0x00281050, &centers)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{40, -600}, centers)
+		doubles := []float64{}
+		_, err = decoded.GetElementValue(0x00720074, &doubles)
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{math.Pi, math.E}, doubles)
+		tags := []uint32{}
+		_, err = decoded.GetElementValue(0x00720060, &tags)
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{0x00280010, 0x00280011}, tags)
+		uid := ""
+		found, err := decoded.QueryValue("ReferencedSeriesSequence[0].SeriesInstanceUID", &uid)
+		assert.True(t, found)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2.3", uid)
+	}
+}
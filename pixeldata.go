@@ -0,0 +1,260 @@
+package opendcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+)
+
+/*
+===============================================================================
+	PixelData
+	---
+	Provides mechanisms for splitting (7FE0,0010) PixelData into frames,
+	according to the Image Pixel module of the data set.
+===============================================================================
+*/
+
+// PixelData holds the frames of a dicom's (7FE0,0010) PixelData element.
+type PixelData struct {
+	frames [][]byte
+}
+
+func newPixelData() PixelData {
+	return PixelData{frames: make([][]byte, 0)}
+}
+
+// GetFrame returns the frame at `index`.
+//
+// Native frames hold the pixel data as stored in the source. For bit-packed
+// pixel data (BitsAllocated = 1), each frame begins at the first bit of its
+// first byte.
+func (pd *PixelData) GetFrame(index int) []byte {
+	return pd.frames[index]
+}
+
+// NumFrames returns the number of frames.
+func (pd *PixelData) NumFrames() int {
+	return len(pd.frames)
+}
+
+// onPixelData is called when a PixelData element is detected in the dicom.
+// It is called once all other elements have been added to the data set.
+func (dcm *Dicom) onPixelData(pdElement Element) {
+	if pdElement.HasItems() {
+		dcm.pixelData.frames = dcm.splitEncapsulatedFrames(pdElement.items)
+	} else {
+		dcm.pixelData.frames = dcm.splitNativeFrames(pdElement.data)
+	}
+	Debugf("PixelData holds %d frames", len(dcm.pixelData.frames))
+}
+
+// numberOfFrames returns the value of NumberOfFrames, which is only present
+// in multi-frame images. If missing or invalid, one frame is assumed.
+func (dcm *Dicom) numberOfFrames() int {
+	numFrames := 1
+	numFramesStr := ""
+	if found, _ := dcm.GetElementValue(0x00280008, &numFramesStr); found {
+		if numFrames, dcm.err = strconv.Atoi(strings.TrimSpace(numFramesStr)); dcm.err != nil || numFrames < 1 {
+			Warnf(`invalid NumberOfFrames "%s"; assuming one frame`, numFramesStr)
+			numFrames = 1
+		}
+	}
+	return numFrames
+}
+
+// splitNativeFrames splits native (uncompressed) pixel data into frames,
+// according to Rows, Columns, SamplesPerPixel, BitsAllocated and NumberOfFrames.
+//
+// If the frame size cannot be determined, `data` is returned as a single frame.
+// If `data` is too short to hold every frame, only the complete frames are returned.
+func (dcm *Dicom) splitNativeFrames(data []byte) [][]byte {
+	params, err := dcm.GetImageParameters()
+	if err != nil {
+		Debugf("could not determine frame size: %v", err)
+		return [][]byte{data}
+	}
+	frameBits := params.frameBits()
+	if frameBits == 0 {
+		Debug("could not determine frame size: missing Image Pixel module attributes")
+		return [][]byte{data}
+	}
+
+	numFrames := dcm.numberOfFrames()
+	if available := len(data) * 8 / frameBits; available < numFrames {
+		Warnf("PixelData holds %d of %d frames", available, numFrames)
+		numFrames = available
+	}
+
+	frames := make([][]byte, numFrames)
+	for i := range frames {
+		if frameBits%8 == 0 {
+			frames[i] = data[i*frameBits/8 : (i+1)*frameBits/8]
+		} else {
+			// bit-packed frames do not necessarily begin on a byte boundary
+			frames[i] = extractBits(data, i*frameBits, frameBits)
+		}
+	}
+	return frames
+}
+
+// splitEncapsulatedFrames assembles the frames of encapsulated pixel data from
+// its items: the Basic Offset Table, followed by a series of fragments.
+//
+// Frames are located using, in order of preference:
+//   - the Extended Offset Table (7FE0,0001) and its lengths (7FE0,0002)
+//   - the Basic Offset Table
+//   - one fragment per frame, if the number of fragments equals NumberOfFrames
+//   - all fragments, if there is only one frame
+//   - the JPEG/JPEG 2000 markers which begin and end each frame
+//
+// Trailing padding of JPEG and JPEG 2000 frames is removed.
+func (dcm *Dicom) splitEncapsulatedFrames(items []Item) [][]byte {
+	basicOffsetTable := items[0].fragment
+	fragments := make([][]byte, len(items)-1)
+	// offsets of each fragment are relative to the first byte of the
+	// first fragment's item tag, and so include the item headers
+	fragmentOffsets := make(map[uint64]int, len(fragments))
+	offset := uint64(0)
+	for i := range fragments {
+		fragments[i] = items[i+1].fragment
+		fragmentOffsets[offset] = i
+		offset += 8 + uint64(len(fragments[i]))
+	}
+	numFrames := dcm.numberOfFrames()
+
+	var frames [][]byte
+	if frames = dcm.framesFromExtendedOffsetTable(fragments, fragmentOffsets); frames == nil {
+		if frames = framesFromBasicOffsetTable(basicOffsetTable, fragments, fragmentOffsets); frames == nil {
+			switch {
+			case len(fragments) == numFrames:
+				frames = fragments
+			case numFrames == 1:
+				frames = [][]byte{bytes.Join(fragments, nil)}
+			default:
+				frames = framesFromMarkers(fragments)
+			}
+		}
+	}
+	if len(frames) != numFrames {
+		Warnf("PixelData holds %d frames, but NumberOfFrames is %d", len(frames), numFrames)
+	}
+	for i := range frames {
+		frames[i] = trimFramePadding(frames[i])
+	}
+	return frames
+}
+
+// framesFromExtendedOffsetTable returns frames located by the Extended Offset
+// Table, in which case each frame is contained within one fragment.
+// Returns nil if the table is missing, or invalid.
+func (dcm *Dicom) framesFromExtendedOffsetTable(fragments [][]byte, fragmentOffsets map[uint64]int) [][]byte {
+	table := []byte{}
+	if found, _ := dcm.GetElementValue(0x7FE00001, &table); !found || len(table) < 8 {
+		return nil
+	}
+	lengths := []byte{}
+	dcm.GetElementValue(0x7FE00002, &lengths)
+	frames := make([][]byte, len(table)/8)
+	for i := range frames {
+		fragmentIndex, found := fragmentOffsets[binary.LittleEndian.Uint64(table[i*8:])]
+		if !found {
+			Warn("Extended Offset Table does not match fragments; ignoring")
+			return nil
+		}
+		frames[i] = fragments[fragmentIndex]
+		if len(lengths) >= (i+1)*8 {
+			if length := binary.LittleEndian.Uint64(lengths[i*8:]); length <= uint64(len(frames[i])) {
+				frames[i] = frames[i][:length]
+			}
+		}
+	}
+	return frames
+}
+
+// framesFromBasicOffsetTable returns frames located by the Basic Offset Table,
+// concatenating the fragments that make up each frame.
+// Returns nil if the table is empty, or invalid.
+func framesFromBasicOffsetTable(table []byte, fragments [][]byte, fragmentOffsets map[uint64]int) [][]byte {
+	if len(table) < 4 {
+		return nil
+	}
+	// index of the first fragment of each frame
+	firstFragments := make([]int, len(table)/4)
+	for i := range firstFragments {
+		fragmentIndex, found := fragmentOffsets[uint64(binary.LittleEndian.Uint32(table[i*4:]))]
+		if !found || (i > 0 && fragmentIndex <= firstFragments[i-1]) {
+			Warn("Basic Offset Table does not match fragments; ignoring")
+			return nil
+		}
+		firstFragments[i] = fragmentIndex
+	}
+	frames := make([][]byte, len(firstFragments))
+	for i, first := range firstFragments {
+		last := len(fragments)
+		if i+1 < len(firstFragments) {
+			last = firstFragments[i+1]
+		}
+		frames[i] = bytes.Join(fragments[first:last], nil)
+	}
+	return frames
+}
+
+var (
+	// jpegSOI begins JPEG, JPEG-LS and JPEG Lossless frames
+	jpegSOI = []byte{0xFF, 0xD8}
+	// jpeg2000SOC begins JPEG 2000 frames
+	jpeg2000SOC = []byte{0xFF, 0x4F}
+	// jpegEOI ends JPEG frames (and JPEG 2000 frames, as EOC)
+	jpegEOI = []byte{0xFF, 0xD9}
+)
+
+// framesFromMarkers assembles frames by detecting fragments that begin a frame:
+// those which begin with a start of image marker, where the previous
+// fragment ended with an end of image marker.
+func framesFromMarkers(fragments [][]byte) [][]byte {
+	frames := make([][]byte, 0)
+	for _, fragment := range fragments {
+		if len(frames) == 0 || (isFrameStart(fragment) && bytes.HasSuffix(trimFramePadding(frames[len(frames)-1]), jpegEOI)) {
+			frames = append(frames, append([]byte{}, fragment...))
+			continue
+		}
+		frames[len(frames)-1] = append(frames[len(frames)-1], fragment...)
+	}
+	return frames
+}
+
+// isFrameStart returns whether `fragment` begins with a JPEG or JPEG 2000 start marker.
+func isFrameStart(fragment []byte) bool {
+	return bytes.HasPrefix(fragment, jpegSOI) || bytes.HasPrefix(fragment, jpeg2000SOC)
+}
+
+// trimFramePadding removes padding that follows the end of image marker of JPEG
+// and JPEG 2000 frames; fragments are padded to an even length. Other frames
+// are returned as-is.
+func trimFramePadding(frame []byte) []byte {
+	if !isFrameStart(frame) {
+		return frame
+	}
+	trimmed := bytes.TrimRight(frame, "\x00")
+	if !bytes.HasSuffix(trimmed, jpegEOI) {
+		return frame
+	}
+	return trimmed
+}
+
+// extractBits returns `nBits` bits of `src`, beginning at bit `offset`.
+//
+// Bits are numbered from the least significant bit of each byte, as is the
+// case for bit-packed pixel data. Unused bits of the last byte are zero.
+func extractBits(src []byte, offset int, nBits int) []byte {
+	dst := make([]byte, (nBits+7)/8)
+	for i := 0; i < nBits; i++ {
+		bit := offset + i
+		if src[bit/8]&(1<<uint(bit%8)) != 0 {
+			dst[i/8] |= 1 << uint(i%8)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,310 @@
+package opendcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    PixelData
+===============================================================================
+*/
+
+// newImagePixelDicom returns a dicom with the Image Pixel module described by
+// `params`, omitting attributes of zero value, as their defaults are equivalent.
+// NumberOfFrames is omitted if empty.
+func newImagePixelDicom(params ImageParameters, numFrames string) Dicom {
+	dcm := newDicom()
+	for tag, v := range map[uint32]uint16{
+		0x00280010: params.Rows,
+		0x00280011: params.Columns,
+		0x00280002: params.SamplesPerPixel,
+		0x00280006: params.PlanarConfiguration,
+		0x00280100: params.BitsAllocated,
+		0x00280101: params.BitsStored,
+		0x00280102: params.HighBit,
+		0x00280103: params.PixelRepresentation,
+	} {
+		if v == 0 {
+			continue
+		}
+		e := NewElementWithTag(tag)
+		e.data = make([]byte, 2)
+		binary.LittleEndian.PutUint16(e.data, v)
+		dcm.addElement(e)
+	}
+	for tag, v := range map[uint32]string{
+		0x00280004: params.PhotometricInterpretation,
+		0x00280008: numFrames,
+	} {
+		if v == "" {
+			continue
+		}
+		e := NewElementWithTag(tag)
+		e.data = []byte(v)
+		dcm.addElement(e)
+	}
+	return dcm
+}
+
+// newNativeDicom returns a dicom describing native pixel data with the given
+// Image Pixel module attributes. NumberOfFrames is omitted if empty.
+func newNativeDicom(rows, columns, samplesPerPixel, bitsAllocated uint16, numFrames string, data []byte) Dicom {
+	params := ImageParameters{Rows: rows, Columns: columns, SamplesPerPixel: samplesPerPixel, BitsAllocated: bitsAllocated}
+	dcm := newImagePixelDicom(params, numFrames)
+	pd := NewElementWithTag(pixelDataTag)
+	pd.setVR("OW")
+	pd.data = data
+	dcm.addElement(pd)
+	return dcm
+}
+
+// sequentialBytes returns `n` bytes with values 0, 1, 2, ...
+func sequentialBytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+func TestSplitNativeFrames(t *testing.T) {
+	// ensures that native pixel data is split into frames according
+	// to the Image Pixel module.
+	t.Parallel()
+	for _, testCase := range []struct {
+		rows, columns, samplesPerPixel, bitsAllocated uint16
+		numFrames                                     string
+		dataLength                                    int
+		expectedFrames                                int
+		expectedFrameLength                           int
+	}{
+		// single frame, NumberOfFrames absent
+		{2, 3, 1, 16, "", 12, 1, 12},
+		// multi-frame, 16 bit
+		{2, 3, 1, 16, "4", 48, 4, 12},
+		// multi-frame RGB, with trailing padding byte
+		{1, 3, 3, 8, "3 ", 28, 3, 9},
+		// SamplesPerPixel missing
+		{2, 2, 0, 8, "2", 8, 2, 4},
+		// truncated: only complete frames are returned
+		{2, 3, 1, 16, "4", 40, 3, 12},
+		// invalid NumberOfFrames is treated as one frame
+		{2, 3, 1, 16, "X", 48, 1, 12},
+		// frame size cannot be determined
+		{0, 3, 1, 16, "4", 48, 1, 48},
+	} {
+		data := sequentialBytes(testCase.dataLength)
+		dcm := newNativeDicom(testCase.rows, testCase.columns, testCase.samplesPerPixel, testCase.bitsAllocated, testCase.numFrames, data)
+		dcm.onPixelData(dcm.DataSet[pixelDataTag])
+		pd := dcm.GetPixelData()
+		assert.Equal(t, testCase.expectedFrames, pd.NumFrames())
+		for i := 0; i < pd.NumFrames(); i++ {
+			offset := i * testCase.expectedFrameLength
+			assert.Equal(t, data[offset:offset+testCase.expectedFrameLength], pd.GetFrame(i))
+		}
+	}
+}
+
+func TestSplitNativeFramesBitPacked(t *testing.T) {
+	// ensures that bit-packed frames which do not begin on a
+	// byte boundary are extracted correctly.
+	t.Parallel()
+	// three frames of 3x3 pixels: 27 bits, packed from the least significant bit.
+	// frame 0 is all ones, frame 1 is alternating, frame 2 is all zeros
+	bits := []int{
+		1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 0, 1, 0, 1, 0, 1, 0, 1,
+		0, 0, 0, 0, 0, 0, 0, 0, 0,
+	}
+	data := make([]byte, 4)
+	for i, bit := range bits {
+		data[i/8] |= byte(bit << uint(i%8))
+	}
+	dcm := newNativeDicom(3, 3, 1, 1, "3", data)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	pd := dcm.GetPixelData()
+	assert.Equal(t, 3, pd.NumFrames())
+	assert.Equal(t, []byte{0xFF, 0x01}, pd.GetFrame(0))
+	assert.Equal(t, []byte{0x55, 0x01}, pd.GetFrame(1))
+	assert.Equal(t, []byte{0x00, 0x00}, pd.GetFrame(2))
+
+	// byte-aligned bit-packed frames
+	dcm = newNativeDicom(2, 4, 1, 1, "2", []byte{0xAA, 0x0F})
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	pd = dcm.GetPixelData()
+	assert.Equal(t, 2, pd.NumFrames())
+	assert.Equal(t, []byte{0xAA}, pd.GetFrame(0))
+	assert.Equal(t, []byte{0x0F}, pd.GetFrame(1))
+}
+
+func TestFromReaderMultiFrame(t *testing.T) {
+	// ensures that frames are split when parsing, regardless of
+	// the order in which elements appear.
+	t.Parallel()
+	data := sequentialBytes(48)
+	dcm := newNativeDicom(2, 3, 1, 16, "4", data)
+	// element following PixelData
+	padding := NewElementWithTag(0xFFFCFFFC)
+	padding.setVR("OB")
+	padding.data = make([]byte, 8)
+	dcm.addElement(padding)
+	buf := bytes.Buffer{}
+	_, err := dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	pd := decoded.GetPixelData()
+	assert.Equal(t, 4, pd.NumFrames())
+	assert.Equal(t, data[36:], pd.GetFrame(3))
+}
+
+func TestExtractBits(t *testing.T) {
+	t.Parallel()
+	src := []byte{0xF0, 0x0F}
+	assert.Equal(t, []byte{0x0F}, extractBits(src, 4, 4))
+	assert.Equal(t, []byte{0xFF}, extractBits(src, 4, 8))
+	assert.Equal(t, []byte{0xFF, 0x00}, extractBits(src, 4, 9))
+	assert.Equal(t, []byte{0xF0, 0x0F}, extractBits(src, 0, 16))
+}
+
+// newEncapsulatedDicom returns a dicom holding encapsulated pixel data with the
+// given Basic Offset Table and fragments. NumberOfFrames is omitted if empty.
+func newEncapsulatedDicom(numFrames string, basicOffsetTable []byte, fragments ...[]byte) Dicom {
+	dcm := newImagePixelDicom(ImageParameters{}, numFrames)
+	dcm.addElement(newEncapsulatedPixelData(append([][]byte{basicOffsetTable}, fragments...)...))
+	return dcm
+}
+
+// newEncapsulatedPixelData returns a PixelData element holding `items`, the
+// first of which is the Basic Offset Table.
+func newEncapsulatedPixelData(items ...[]byte) Element {
+	pd := NewElementWithTag(pixelDataTag)
+	for _, fragment := range items {
+		item := NewItem()
+		item.fragment = fragment
+		pd.items = append(pd.items, item)
+	}
+	return pd
+}
+
+// offsetTable encodes `offsets` as a table of little endian integers of `size` bytes.
+func offsetTable(size int, offsets ...uint64) []byte {
+	table := make([]byte, size*len(offsets))
+	for i, offset := range offsets {
+		if size == 4 {
+			binary.LittleEndian.PutUint32(table[i*4:], uint32(offset))
+		} else {
+			binary.LittleEndian.PutUint64(table[i*8:], offset)
+		}
+	}
+	return table
+}
+
+func TestSplitEncapsulatedFrames(t *testing.T) {
+	// ensures that frames of encapsulated pixel data are assembled
+	// correctly, with or without a Basic Offset Table.
+	t.Parallel()
+	frame0 := []byte{0xFF, 0xD8, 0xAA, 0xBB, 0xFF, 0xD9}
+	frame1 := []byte{0xFF, 0xD8, 0xCC, 0xFF, 0xD9}
+	// frame 0 is split over two fragments; fragments are padded to an even length
+	fragments := [][]byte{frame0[:2], append(frame0[2:], 0x00), append(frame1, 0x00)}
+	for _, testCase := range []struct {
+		name     string
+		dcm      Dicom
+		expected [][]byte
+	}{
+		{
+			name:     "basic offset table",
+			dcm:      newEncapsulatedDicom("2", offsetTable(4, 0, 22), fragments...),
+			expected: [][]byte{frame0, frame1},
+		},
+		{
+			name:     "basic offset table, one frame",
+			dcm:      newEncapsulatedDicom("", offsetTable(4, 0), fragments[:2]...),
+			expected: [][]byte{frame0},
+		},
+		{
+			name:     "empty basic offset table, one fragment per frame",
+			dcm:      newEncapsulatedDicom("2", nil, frame0, append(frame1, 0x00)),
+			expected: [][]byte{frame0, frame1},
+		},
+		{
+			name:     "empty basic offset table, one frame",
+			dcm:      newEncapsulatedDicom("", nil, fragments[:2]...),
+			expected: [][]byte{frame0},
+		},
+		{
+			name:     "empty basic offset table, multi-fragment frames",
+			dcm:      newEncapsulatedDicom("2", nil, fragments...),
+			expected: [][]byte{frame0, frame1},
+		},
+		{
+			name:     "invalid basic offset table",
+			dcm:      newEncapsulatedDicom("2", offsetTable(4, 0, 3), frame0, frame1),
+			expected: [][]byte{frame0, frame1},
+		},
+		{
+			name:     "basic offset table out of order",
+			dcm:      newEncapsulatedDicom("2", offsetTable(4, 14, 0), frame0, frame1),
+			expected: [][]byte{frame0, frame1},
+		},
+		{
+			name:     "non-jpeg frames are not trimmed",
+			dcm:      newEncapsulatedDicom("", nil, []byte{0x01, 0x00, 0x00, 0x00}),
+			expected: [][]byte{{0x01, 0x00, 0x00, 0x00}},
+		},
+	} {
+		testCase.dcm.onPixelData(testCase.dcm.DataSet[pixelDataTag])
+		pd := testCase.dcm.GetPixelData()
+		assert.Equal(t, len(testCase.expected), pd.NumFrames(), testCase.name)
+		for i := 0; i < pd.NumFrames() && i < len(testCase.expected); i++ {
+			assert.Equal(t, testCase.expected[i], pd.GetFrame(i), testCase.name)
+		}
+	}
+}
+
+func TestSplitEncapsulatedFramesExtendedOffsetTable(t *testing.T) {
+	// ensures that the Extended Offset Table is preferred over the
+	// Basic Offset Table, and that lengths are respected.
+	t.Parallel()
+	frame0 := []byte{0xFF, 0xD8, 0xAA, 0xFF, 0xD9}
+	frame1 := []byte{0xFF, 0xD8, 0xBB, 0xCC, 0xFF, 0xD9}
+	dcm := newEncapsulatedDicom("2", nil, append(frame0, 0x00), frame1)
+	eot := NewElementWithTag(0x7FE00001)
+	eot.data = offsetTable(8, 0, 14)
+	dcm.addElement(eot)
+	lengths := NewElementWithTag(0x7FE00002)
+	lengths.data = offsetTable(8, 5, 6)
+	dcm.addElement(lengths)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	pd := dcm.GetPixelData()
+	assert.Equal(t, 2, pd.NumFrames())
+	assert.Equal(t, frame0, pd.GetFrame(0))
+	assert.Equal(t, frame1, pd.GetFrame(1))
+
+	// invalid extended offset table falls back to one fragment per frame
+	eot.data = offsetTable(8, 0, 13)
+	dcm.addElement(eot)
+	dcm.pixelData = newPixelData()
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	assert.Equal(t, 2, dcm.GetPixelData().NumFrames())
+	assert.Equal(t, frame1, dcm.GetPixelData().GetFrame(1))
+}
+
+func TestTrimFramePadding(t *testing.T) {
+	t.Parallel()
+	// jpeg
+	assert.Equal(t, []byte{0xFF, 0xD8, 0xFF, 0xD9}, trimFramePadding([]byte{0xFF, 0xD8, 0xFF, 0xD9, 0x00}))
+	// jpeg 2000
+	assert.Equal(t, []byte{0xFF, 0x4F, 0xFF, 0xD9}, trimFramePadding([]byte{0xFF, 0x4F, 0xFF, 0xD9, 0x00, 0x00}))
+	// missing end of image marker
+	assert.Equal(t, []byte{0xFF, 0xD8, 0x01, 0x00}, trimFramePadding([]byte{0xFF, 0xD8, 0x01, 0x00}))
+	// not a jpeg
+	assert.Equal(t, []byte{0xFF, 0xD9, 0x00}, trimFramePadding([]byte{0xFF, 0xD9, 0x00}))
+}
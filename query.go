@@ -0,0 +1,117 @@
+package opendcm
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+===============================================================================
+	Query
+	---
+	Provides retrieval of elements nested within sequences, addressed by a
+	path such as "ReferencedSeriesSequence[*].SeriesInstanceUID".
+===============================================================================
+*/
+
+// anyItem selects every item of a sequence.
+const anyItem = -1
+
+// queryComponent addresses an element of a data set and, unless it is the
+// last component of a path, the items of that element to descend into.
+type queryComponent struct {
+	tag  uint32
+	item int
+}
+
+// parseQuery parses `path` into its components.
+func parseQuery(path string) ([]queryComponent, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New("path is empty")
+	}
+	parts := strings.Split(path, ".")
+	components := make([]queryComponent, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		selector := ""
+		if open := strings.IndexByte(part, '['); open != -1 {
+			if !strings.HasSuffix(part, "]") {
+				return nil, fmt.Errorf(`item selector of "%s" is not closed`, part)
+			}
+			part, selector = part[:open], part[open+1:len(part)-1]
+		}
+		tag, err := ParseTag(part)
+		if err != nil {
+			return nil, err
+		}
+		components[i] = queryComponent{tag: tag, item: anyItem}
+		switch {
+		case i == len(parts)-1 && selector != "":
+			return nil, fmt.Errorf(`"%s" selects items, but is the last component of the path`, parts[i])
+		case i == len(parts)-1:
+		case selector == "":
+			return nil, fmt.Errorf(`"%s" must select items with "[n]" or "[*]"`, parts[i])
+		case selector != "*":
+			if components[i].item, err = strconv.Atoi(selector); err != nil || components[i].item < 0 {
+				return nil, fmt.Errorf(`item selector "[%s]" is invalid`, selector)
+			}
+		}
+	}
+	return components, nil
+}
+
+// Query returns the elements addressed by `path`, in the order in which their
+// items appear. `path` is a series of components separated by ".", each being
+// a tag or keyword as accepted by `ParseTag`. Each component but the last
+// addresses a sequence, and selects either one of its items by index, "[n]",
+// or every item, "[*]". For example:
+//
+//	(0040,0275)[0].(0032,1060)
+//	ReferencedSeriesSequence[*].SeriesInstanceUID
+//
+// Elements and items which do not exist are not an error; nothing is returned for them.
+func (ds *DataSet) Query(path string) ([]Element, error) {
+	components, err := parseQuery(path)
+	if err != nil {
+		return nil, fmt.Errorf("Query(%s): %v", path, err)
+	}
+	elements := []Element{}
+	datasets := []*DataSet{ds}
+	for i, component := range components {
+		next := []*DataSet{}
+		for _, dataset := range datasets {
+			e := NewElement()
+			if !dataset.GetElement(component.tag, &e) {
+				continue
+			}
+			if i == len(components)-1 {
+				elements = append(elements, e)
+				continue
+			}
+			items := e.GetItems()
+			if component.item == anyItem {
+				for j := range items {
+					next = append(next, items[j].GetDataSet())
+				}
+			} else if component.item < len(items) {
+				next = append(next, items[component.item].GetDataSet())
+			}
+		}
+		datasets = next
+	}
+	return elements, nil
+}
+
+// QueryValue writes the value of the first element addressed by `path` into `dst`.
+// its return value (bool) indicates whether any element is addressed by `path`.
+// its return value (error) indicates whether there are any other problems.
+// See: Query and Element.GetValue
+func (ds *DataSet) QueryValue(path string, dst interface{}) (bool, error) {
+	elements, err := ds.Query(path)
+	if err != nil || len(elements) == 0 {
+		return false, err
+	}
+	return true, elements[0].GetValue(dst)
+}
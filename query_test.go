@@ -0,0 +1,111 @@
+package opendcm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newQueryDataSet returns a data set with two levels of nested sequences:
+// two referenced series, each with referenced instances, and a request attributes item.
+func newQueryDataSet() DataSet {
+	newItem := func(tag uint32, value string, sequences ...Element) Item {
+		item := NewItem()
+		e := NewElementWithTag(tag)
+		e.data = []byte(value)
+		item.dataset.addElement(e)
+		for _, sq := range sequences {
+			item.dataset.addElement(sq)
+		}
+		return item
+	}
+	newSequence := func(tag uint32, items ...Item) Element {
+		sq := NewElementWithTag(tag)
+		sq.items = items
+		return sq
+	}
+	ds := make(DataSet)
+	ds.addElement(newSequence(0x00081115,
+		newItem(0x0020000E, "1.2.1", newSequence(0x0008114A,
+			newItem(0x00081155, "1.2.1.1"),
+			newItem(0x00081155, "1.2.1.2"),
+		)),
+		newItem(0x0020000E, "1.2.2", newSequence(0x0008114A,
+			newItem(0x00081155, "1.2.2.1"),
+		)),
+	))
+	ds.addElement(newSequence(0x00400275, newItem(0x00321060, "CT HEAD")))
+	patientName := NewElementWithTag(0x00100010)
+	patientName.data = []byte("Anderson^Leo")
+	ds.addElement(patientName)
+	return ds
+}
+
+// queryStrings returns the string value of each element addressed by `path`.
+func queryStrings(t *testing.T, ds DataSet, path string) []string {
+	elements, err := ds.Query(path)
+	assert.NoError(t, err, path)
+	values := []string{}
+	for _, e := range elements {
+		value := ""
+		assert.NoError(t, e.GetValue(&value))
+		values = append(values, value)
+	}
+	return values
+}
+
+func TestQuery(t *testing.T) {
+	// ensures that elements are found within nested sequences, selecting
+	// items by index or by wildcard.
+	t.Parallel()
+	ds := newQueryDataSet()
+	for path, expected := range map[string][]string{
+		"PatientName":                                      {"Anderson^Leo"},
+		"(0040,0275)[0].(0032,1060)":                       {"CT HEAD"},
+		"ReferencedSeriesSequence[*].SeriesInstanceUID":    {"1.2.1", "1.2.2"},
+		"ReferencedSeriesSequence[1].SeriesInstanceUID":    {"1.2.2"},
+		"00081115[*].0008114A[*].ReferencedSOPInstanceUID": {"1.2.1.1", "1.2.1.2", "1.2.2.1"},
+		"00081115[0].0008114A[1].ReferencedSOPInstanceUID": {"1.2.1.2"},
+		// items and elements which do not exist
+		"ReferencedSeriesSequence[2].SeriesInstanceUID": {},
+		"ReferencedSeriesSequence[*].PatientName":       {},
+		"PatientName[*].PatientName":                    {},
+		"StudyInstanceUID":                              {},
+	} {
+		assert.Equal(t, expected, queryStrings(t, ds, path), path)
+	}
+
+	// values are written by `GetValue`
+	value := ""
+	found, err := ds.QueryValue("RequestAttributesSequence[*].RequestedProcedureDescription", &value)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, "CT HEAD", value)
+	found, err = ds.QueryValue("RequestAttributesSequence[1].RequestedProcedureDescription", &value)
+	assert.False(t, found)
+	assert.NoError(t, err)
+	_, err = ds.QueryValue("PatientName", &[]uint16{})
+	assert.Error(t, err)
+}
+
+func TestQueryError(t *testing.T) {
+	// ensures that malformed paths result in an error.
+	t.Parallel()
+	ds := newQueryDataSet()
+	for _, path := range []string{
+		"",
+		".",
+		"PatientName[0]",
+		"ReferencedSeriesSequence.SeriesInstanceUID",
+		"ReferencedSeriesSequence[-1].SeriesInstanceUID",
+		"ReferencedSeriesSequence[a].SeriesInstanceUID",
+		"ReferencedSeriesSequence[0.SeriesInstanceUID",
+		"ReferencedSeriesSequence[*].NotAKeyword",
+	} {
+		_, err := ds.Query(path)
+		assert.Error(t, err, path)
+		found, err := ds.QueryValue(path, new(string))
+		assert.False(t, found)
+		assert.Error(t, err, path)
+	}
+}
@@ -0,0 +1,229 @@
+package opendcm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+/*
+===============================================================================
+	RLE
+	---
+	Provides decoding and encoding of frames compressed with RLE Lossless,
+	as per "Annex G" of PS3.5.
+===============================================================================
+*/
+
+const (
+	// rleHeaderLength is the length of the header which begins each frame
+	rleHeaderLength = 64
+	// rleMaxSegments is the maximum number of segments a frame may hold
+	rleMaxSegments = 15
+)
+
+// rleSegmentCount returns the number of segments in a frame described by `params`:
+// one for each byte of each sample.
+func rleSegmentCount(params *ImageParameters) (int, error) {
+	if params.BitsAllocated == 0 || params.BitsAllocated%8 != 0 {
+		return 0, fmt.Errorf("RLE with BitsAllocated of %d is not supported", params.BitsAllocated)
+	}
+	numSegments := int(params.SamplesPerPixel) * int(params.BitsAllocated/8)
+	if numSegments > rleMaxSegments {
+		return 0, fmt.Errorf("RLE supports at most %d segments, but %d are required", rleMaxSegments, numSegments)
+	}
+	return numSegments, nil
+}
+
+// DecodeRLE decompresses an RLE Lossless frame into native pixel data, as
+// described by `params`, updating `params` to describe the decompressed frame.
+//
+// Decompressed samples are little endian, and interleaved (PlanarConfiguration 0),
+// regardless of the values of `params`.
+func DecodeRLE(frame []byte, params *ImageParameters) ([]byte, error) {
+	numSegments, err := rleSegmentCount(params)
+	if err != nil {
+		return nil, err
+	}
+	if len(frame) < rleHeaderLength {
+		return nil, errors.New("RLE frame is shorter than its header")
+	}
+	if n := binary.LittleEndian.Uint32(frame); n != uint32(numSegments) {
+		return nil, fmt.Errorf("RLE frame holds %d segments, but %d are required", n, numSegments)
+	}
+	offsets := make([]int, numSegments+1)
+	for i := 0; i < numSegments; i++ {
+		offsets[i] = int(binary.LittleEndian.Uint32(frame[4+i*4:]))
+		if offsets[i] < rleHeaderLength || offsets[i] > len(frame) || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, fmt.Errorf("RLE segment %d has invalid offset %d", i, offsets[i])
+		}
+	}
+	offsets[numSegments] = len(frame)
+
+	nPixels := int(params.Rows) * int(params.Columns)
+	bytesPerSample := int(params.BitsAllocated / 8)
+	dst := make([]byte, nPixels*numSegments)
+	for i := 0; i < numSegments; i++ {
+		segment, err := unpackBits(frame[offsets[i]:offsets[i+1]], nPixels)
+		if err != nil {
+			return nil, fmt.Errorf("RLE segment %d: %v", i, err)
+		}
+		// segments are ordered by sample, and then from most to least significant byte
+		sample, significance := i/bytesPerSample, i%bytesPerSample
+		pos := sample*bytesPerSample + (bytesPerSample - 1 - significance)
+		for j, b := range segment {
+			dst[j*numSegments+pos] = b
+		}
+	}
+	params.PlanarConfiguration = 0
+	params.LittleEndian = true
+	return dst, nil
+}
+
+// unpackBits decodes a PackBits-encoded segment, which should decode to `n` bytes.
+// Trailing padding following the `n`th byte is ignored.
+func unpackBits(src []byte, n int) ([]byte, error) {
+	dst := make([]byte, 0, n)
+	for i := 0; i < len(src) && len(dst) < n; {
+		header := int8(src[i])
+		i++
+		switch {
+		case header >= 0:
+			// literal run of header+1 bytes
+			end := i + int(header) + 1
+			if end > len(src) {
+				return nil, errors.New("literal run exceeds segment")
+			}
+			dst = append(dst, src[i:end]...)
+			i = end
+		case header != -128:
+			// replicate run of the next byte, -header+1 times
+			if i >= len(src) {
+				return nil, errors.New("replicate run exceeds segment")
+			}
+			for j := 0; j < int(-header)+1; j++ {
+				dst = append(dst, src[i])
+			}
+			i++
+		}
+	}
+	if len(dst) < n {
+		return nil, fmt.Errorf("segment decoded to %d bytes, but %d are required", len(dst), n)
+	}
+	return dst[:n], nil
+}
+
+// EncodeRLE compresses a frame of native pixel data, as described by `params`,
+// with RLE Lossless, updating `params` to describe the frame once decompressed.
+func EncodeRLE(frame []byte, params *ImageParameters) ([]byte, error) {
+	numSegments, err := rleSegmentCount(params)
+	if err != nil {
+		return nil, err
+	}
+	if err = params.validate(frame); err != nil {
+		return nil, err
+	}
+	columns := int(params.Columns)
+	nPixels := int(params.Rows) * columns
+	bytesPerSample := int(params.BitsAllocated / 8)
+	samplesPerPixel := int(params.SamplesPerPixel)
+
+	dst := make([]byte, rleHeaderLength, rleHeaderLength+len(frame))
+	binary.LittleEndian.PutUint32(dst, uint32(numSegments))
+	plane := make([]byte, nPixels)
+	for i := 0; i < numSegments; i++ {
+		binary.LittleEndian.PutUint32(dst[4+i*4:], uint32(len(dst)))
+		// gather the byte plane of segment `i`
+		sample, significance := i/bytesPerSample, i%bytesPerSample
+		byteIndex := bytesPerSample - 1 - significance
+		if !params.LittleEndian {
+			byteIndex = significance
+		}
+		for j := range plane {
+			sampleIndex := j*samplesPerPixel + sample
+			if params.PlanarConfiguration == 1 {
+				sampleIndex = sample*nPixels + j
+			}
+			plane[j] = frame[sampleIndex*bytesPerSample+byteIndex]
+		}
+		// each row is encoded separately
+		for row := 0; row < nPixels; row += columns {
+			dst = packBits(dst, plane[row:row+columns])
+		}
+		// each segment is of even length
+		if len(dst)%2 != 0 {
+			dst = append(dst, 0x00)
+		}
+	}
+	params.PlanarConfiguration = 0
+	params.LittleEndian = true
+	return dst, nil
+}
+
+// packBits appends the PackBits encoding of `src` to `dst`.
+func packBits(dst []byte, src []byte) []byte {
+	for i := 0; i < len(src); {
+		// length of the run of bytes equal to src[i], up to 128
+		run := 1
+		for i+run < len(src) && run < 128 && src[i+run] == src[i] {
+			run++
+		}
+		if run > 1 {
+			dst = append(dst, byte(int8(1-run)), src[i])
+			i += run
+			continue
+		}
+		// literal run, ending where a replicate run of at least two bytes begins
+		end := i + 1
+		for end < len(src) && end-i < 128 && !(end+1 < len(src) && src[end] == src[end+1]) {
+			end++
+		}
+		dst = append(dst, byte(end-i-1))
+		dst = append(dst, src[i:end]...)
+		i = end
+	}
+	return dst
+}
+
+// CompressRLE compresses the frames of native pixel data with RLE Lossless,
+// and updates the transfer syntax accordingly. See: `Transcode`
+func (dcm *Dicom) CompressRLE() error {
+	if ts := dcm.GetTransferSyntax(); ts != nil && ts.Encapsulated {
+		return fmt.Errorf("CompressRLE: pixel data is already encapsulated with %s", ts.Name)
+	}
+	return Transcode(dcm, RLELossless)
+}
+
+// setEncapsulatedPixelData replaces PixelData with `frames`, each held within a
+// single fragment and located by the Basic Offset Table, and sets the transfer
+// syntax to `transferSyntaxUID`.
+func (dcm *Dicom) setEncapsulatedPixelData(transferSyntaxUID string, frames [][]byte) error {
+	if dcm.transferSyntax, dcm.err = LookupTransferSyntax(transferSyntaxUID); dcm.err != nil {
+		return dcm.err
+	}
+	ts := NewElementWithTag(0x00020010)
+	ts.data = []byte(transferSyntaxUID)
+	dcm.addElement(ts)
+
+	pd := NewElementWithTag(pixelDataTag)
+	pd.setVR("OB")
+	pd.datalen = 0xFFFFFFFF
+	basicOffsetTable := NewItem()
+	basicOffsetTable.fragment = make([]byte, len(frames)*4)
+	offset := uint32(0)
+	for i, frame := range frames {
+		binary.LittleEndian.PutUint32(basicOffsetTable.fragment[i*4:], offset)
+		// fragments are padded to an even length when written
+		offset += 8 + uint32(len(frame)+len(frame)%2)
+	}
+	pd.items = append(pd.items, basicOffsetTable)
+	for _, frame := range frames {
+		fragment := NewItem()
+		fragment.fragment = frame
+		pd.items = append(pd.items, fragment)
+	}
+	dcm.addElement(pd)
+	dcm.pixelData = newPixelData()
+	dcm.pixelData.frames = frames
+	return nil
+}
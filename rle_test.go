@@ -0,0 +1,194 @@
+package opendcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    RLE
+===============================================================================
+*/
+
+func TestUnpackBits(t *testing.T) {
+	// ensures that PackBits segments are decoded, using the example given
+	// in Apple Technical Note TN1023.
+	t.Parallel()
+	src := []byte{0xFE, 0xAA, 0x02, 0x80, 0x00, 0x2A, 0xFD, 0xAA, 0x03, 0x80, 0x00, 0x2A, 0x22, 0xF7, 0xAA}
+	expected := []byte{
+		0xAA, 0xAA, 0xAA, 0x80, 0x00, 0x2A, 0xAA, 0xAA, 0xAA, 0xAA, 0x80, 0x00,
+		0x2A, 0x22, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA,
+	}
+	dst, err := unpackBits(src, len(expected))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, dst)
+
+	// no-op headers and trailing padding are ignored
+	dst, err = unpackBits(append([]byte{0x80}, append(src, 0x00)...), len(expected))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, dst)
+
+	for _, src := range [][]byte{
+		{0x02, 0x01},
+		{0xFE},
+		{0xFF, 0x01},
+	} {
+		_, err = unpackBits(src, 3)
+		assert.Error(t, err)
+	}
+}
+
+func TestPackBits(t *testing.T) {
+	// ensures that encoded segments decode to their source.
+	t.Parallel()
+	random := make([]byte, 300)
+	rand.New(rand.NewSource(1)).Read(random)
+	for _, src := range [][]byte{
+		{0x01},
+		{0x01, 0x01},
+		{0x01, 0x02, 0x02, 0x03},
+		bytes.Repeat([]byte{0x05}, 300),
+		random,
+		append(random, bytes.Repeat([]byte{0x00}, 129)...),
+	} {
+		packed := packBits(nil, src)
+		unpacked, err := unpackBits(packed, len(src))
+		assert.NoError(t, err)
+		assert.Equal(t, src, unpacked)
+	}
+	// runs are replicated
+	assert.Equal(t, []byte{0x81, 0x05, 0x00, 0x05}, packBits(nil, bytes.Repeat([]byte{0x05}, 129)))
+}
+
+func TestRLE(t *testing.T) {
+	// ensures that frames are compressed, and decompressed into
+	// interleaved little endian samples.
+	t.Parallel()
+	for _, testCase := range []struct {
+		params   ImageParameters
+		frame    []byte
+		expected []byte
+	}{
+		// 8 bit monochrome
+		{
+			ImageParameters{Rows: 2, Columns: 3, SamplesPerPixel: 1, BitsAllocated: 8, LittleEndian: true},
+			[]byte{1, 1, 1, 2, 3, 4},
+			[]byte{1, 1, 1, 2, 3, 4},
+		},
+		// 16 bit monochrome
+		{
+			ImageParameters{Rows: 1, Columns: 3, SamplesPerPixel: 1, BitsAllocated: 16, LittleEndian: true},
+			uint16Bytes(0x0102, 0x0304, 0x0506),
+			uint16Bytes(0x0102, 0x0304, 0x0506),
+		},
+		// 16 bit monochrome, big endian
+		{
+			ImageParameters{Rows: 1, Columns: 3, SamplesPerPixel: 1, BitsAllocated: 16, LittleEndian: false},
+			[]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06},
+			uint16Bytes(0x0102, 0x0304, 0x0506),
+		},
+		// RGB, interleaved
+		{
+			ImageParameters{Rows: 1, Columns: 2, SamplesPerPixel: 3, BitsAllocated: 8, LittleEndian: true},
+			[]byte{1, 2, 3, 4, 5, 6},
+			[]byte{1, 2, 3, 4, 5, 6},
+		},
+		// RGB, planar
+		{
+			ImageParameters{Rows: 1, Columns: 2, SamplesPerPixel: 3, BitsAllocated: 8, LittleEndian: true, PlanarConfiguration: 1},
+			[]byte{1, 4, 2, 5, 3, 6},
+			[]byte{1, 2, 3, 4, 5, 6},
+		},
+	} {
+		params := testCase.params
+		params.PhotometricInterpretation = "MONOCHROME2"
+		params.BitsStored = params.BitsAllocated
+		params.HighBit = params.BitsStored - 1
+
+		encoded, err := EncodeRLE(testCase.frame, &params)
+		assert.NoError(t, err)
+		assert.Zero(t, params.PlanarConfiguration)
+		assert.True(t, params.LittleEndian)
+		assert.Equal(t, uint32(params.SamplesPerPixel*params.BitsAllocated/8), binary.LittleEndian.Uint32(encoded))
+		assert.Zero(t, len(encoded)%2)
+		decoded, err := DecodeRLE(encoded, &params)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, decoded)
+	}
+}
+
+func TestDecodeRLEError(t *testing.T) {
+	// ensures that invalid frames result in an error.
+	t.Parallel()
+	params := ImageParameters{Rows: 1, Columns: 2, SamplesPerPixel: 1, BitsAllocated: 8}
+	header := func(numSegments uint32, offsets ...uint32) []byte {
+		b := make([]byte, rleHeaderLength)
+		binary.LittleEndian.PutUint32(b, numSegments)
+		for i, offset := range offsets {
+			binary.LittleEndian.PutUint32(b[4+i*4:], offset)
+		}
+		return b
+	}
+	valid := append(header(1, 64), 0x01, 0x07, 0x08)
+	decoded, err := DecodeRLE(valid, &params)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x07, 0x08}, decoded)
+
+	for _, frame := range [][]byte{
+		// shorter than header
+		valid[:32],
+		// wrong number of segments
+		append(header(2, 64, 66), 0x01, 0x07, 0x08),
+		// offset within header
+		append(header(1, 8), 0x01, 0x07, 0x08),
+		// offset beyond frame
+		append(header(1, 100), 0x01, 0x07, 0x08),
+		// truncated segment
+		append(header(1, 64), 0x01, 0x07),
+	} {
+		_, err = DecodeRLE(frame, &params)
+		assert.Error(t, err)
+	}
+	params.BitsAllocated = 1
+	_, err = DecodeRLE(valid, &params)
+	assert.Error(t, err)
+}
+
+func TestCompressRLE(t *testing.T) {
+	// ensures that a dicom compressed with RLE Lossless is written, and
+	// decoded into the same images as its native pixel data.
+	t.Parallel()
+	dcm := newNativeDicom(2, 3, 1, 16, "2", sequentialBytes(24))
+	photometric := NewElementWithTag(0x00280004)
+	photometric.data = []byte("MONOCHROME2 ")
+	dcm.addElement(photometric)
+	dcm.onPixelData(dcm.DataSet[pixelDataTag])
+	expected := []interface{}{}
+	for i := 0; i < 2; i++ {
+		img, err := dcm.GetImage(i)
+		assert.NoError(t, err)
+		expected = append(expected, img)
+	}
+
+	assert.NoError(t, dcm.CompressRLE())
+	assert.Equal(t, RLELossless, dcm.GetTransferSyntax().UID)
+	assert.Error(t, dcm.CompressRLE())
+
+	buf := bytes.Buffer{}
+	_, err := dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, RLELossless, decoded.GetTransferSyntax().UID)
+	assert.Equal(t, 2, decoded.GetPixelData().NumFrames())
+	for i := 0; i < 2; i++ {
+		img, err := decoded.GetImage(i)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[i], img)
+	}
+}
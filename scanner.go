@@ -0,0 +1,214 @@
+package opendcm
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/encoding"
+)
+
+/*
+===============================================================================
+	Scanner
+	---
+	Provides streaming access to the elements of a dicom, in the order in which
+	they appear in the data source. Unlike `FromReader`, elements are not
+	retained, and so memory usage is bounded by the size of a single element.
+===============================================================================
+*/
+
+// ErrStopScan can be returned by a `ScanFunc` to stop scanning without error.
+var ErrStopScan = errors.New("stop scan")
+
+// ScanFunc is called by `Scan` for each element encountered in the data source.
+//
+// If the function returns `ErrStopScan`, scanning stops and `Scan` returns nil.
+// Any other error stops scanning, and is returned by `Scan`.
+type ScanFunc func(e *ScannedElement) error
+
+// PathElement identifies an item of a sequence.
+type PathElement struct {
+	Tag  uint32
+	Item int
+}
+
+// Path identifies the sequence items an element is nested within,
+// ordered from outermost to innermost.
+type Path []PathElement
+
+// String returns the path in the form "(0040,0275)[0].(0040,0008)[1]"
+func (p Path) String() string {
+	components := make([]string, len(p))
+	for i, pe := range p {
+		components[i] = fmt.Sprintf("(%04X,%04X)[%d]", uint16(pe.Tag>>16), uint16(pe.Tag), pe.Item)
+	}
+	return strings.Join(components, ".")
+}
+
+// ScannedElement is an element encountered by `Scan`, along with its
+// location within the data source.
+//
+// Sequences are passed to the `ScanFunc` without their items; the elements of
+// each item are instead passed to the `ScanFunc` afterwards, in order.
+type ScannedElement struct {
+	Element
+	// Offset is the position of the element's tag within the data source.
+	// See: `ElementReader.GetPosition`
+	Offset int64
+	// Depth is the number of sequences the element is nested within
+	Depth int
+	// Path identifies the sequence items the element is nested within
+	Path Path
+}
+
+// scanner holds the state of a call to `Scan`.
+type scanner struct {
+	dcm     *Dicom
+	elr     *ElementReader
+	decoder *encoding.Decoder
+	fn      ScanFunc
+	inMeta  bool
+}
+
+// Scan decodes a dicom from `source`, passing each element to `fn` in the
+// order in which they appear. If `source` ends within an element, including
+// within the items of a sequence, `io.ErrUnexpectedEOF` is returned.
+// This takes ownership of `source`; do not use it after passing through.
+func Scan(source io.Reader, fn ScanFunc) error {
+	return ScanWithOptions(source, ParseOptions{}, fn)
+}
+
+// ScanWithOptions decodes a dicom from `source` according to `opts`, passing
+// each element to `fn` in the order in which they appear.
+// See: Scan, FromReaderWithOptions for more information
+func ScanWithOptions(source io.Reader, opts ParseOptions, fn ScanFunc) error {
+	dcm := newDicom()
+	elr, err := dcm.newElementReader(source, opts)
+	if err != nil {
+		return err
+	}
+	scn := scanner{
+		dcm:     &dcm,
+		elr:     &elr,
+		decoder: dcm.GetCharacterSet().Encoding.NewDecoder(),
+		fn:      fn,
+		inMeta:  true,
+	}
+	for {
+		if dcm._bool, dcm.err = dcm.beforeElement(scn.elr, &scn.inMeta, opts.StopAtTag); dcm.err != nil {
+			return dcm.err
+		}
+		if !dcm._bool {
+			return nil
+		}
+		start := scn.elr.GetPosition()
+		if err = scn.scanElement(nil); err != nil {
+			if err == ErrStopScan {
+				return nil
+			}
+			if err == io.EOF {
+				// the data source may only end between the elements of the data set
+				if scn.elr.GetPosition() == start {
+					return nil
+				}
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+	}
+}
+
+// scanElement reads the next element, which is nested within `path`, passing
+// it and any elements nested within it to the `ScanFunc`.
+func (scn *scanner) scanElement(path Path) error {
+	e := ScannedElement{Element: NewElement(), Offset: scn.elr.GetPosition(), Depth: len(path), Path: path}
+	if err := scn.elr.readElementHeader(&e.Element); err != nil {
+		return err
+	}
+
+	// sequences are passed to the `ScanFunc` before their items are read
+	if e.GetVR() == "SQ" || (e.datalen == 0xFFFFFFFF && e.GetTag() != pixelDataTag) {
+		if err := scn.fn(&e); err != nil {
+			return err
+		}
+		return scn.scanItems(&e)
+	}
+
+	if err := scn.elr.readElementValue(&e.Element); err != nil {
+		return err
+	}
+	if len(path) == 0 {
+		switch e.GetTag() {
+		case 0x00020010:
+			// lookup transfer syntax, which determines encoding of the non-meta section
+			if scn.dcm.transferSyntax, scn.dcm.err = LookupTransferSyntax(string(e.data)); scn.dcm.err != nil {
+				return scn.dcm.err
+			}
+		case 0x00080005:
+			// subsequent textual elements are decoded according to the character set
+			scn.dcm.addElement(e.Element)
+			scn.decoder = scn.dcm.GetCharacterSet().Encoding.NewDecoder()
+		}
+	}
+	switch e.GetVR() {
+	case "SH", "LO", "ST", "PN", "LT", "UC", "UT":
+		e.data, _ = scn.decoder.Bytes(e.data) // this will not result in an error as replacement runes are enforced
+	}
+	return scn.fn(&e)
+}
+
+// scanItems reads the items of sequence `sq`, passing their elements to the `ScanFunc`.
+func (scn *scanner) scanItems(sq *ScannedElement) error {
+	elr := scn.elr
+	endPos := elr.GetPosition() + int64(sq.datalen)
+	for i := 0; ; i++ {
+		if sq.datalen == 0xFFFFFFFF {
+			// undefined length: items continue until the sequence delimitation item
+			if elr._bool, elr.err = elr.hasReachedTag(seqDelimTag); elr.err != nil {
+				return elr.err
+			}
+			if elr._bool {
+				return elr.br.Discard(8)
+			}
+		} else if elr.GetPosition() >= endPos {
+			return nil
+		}
+
+		// read item header
+		if elr.err = elr.readTag(&elr.ui32); elr.err != nil {
+			return elr.err
+		}
+		if elr.ui32 != itemTag {
+			return errors.New("did not find ItemStartTag")
+		}
+		if elr.err = elr.br.ReadUint32(&elr.ui32); elr.err != nil {
+			return elr.err
+		}
+		itemLength := elr.ui32
+
+		// copy the path, such that it is not shared between items
+		itemPath := append(sq.Path[:len(sq.Path):len(sq.Path)], PathElement{Tag: sq.GetTag(), Item: i})
+		itemEndPos := elr.GetPosition() + int64(itemLength)
+		for {
+			if itemLength == 0xFFFFFFFF {
+				// undefined length: elements continue until the item delimitation item
+				if elr._bool, elr.err = elr.hasReachedTag(itemDelimTag); elr.err != nil {
+					return elr.err
+				}
+				if elr._bool {
+					if elr.err = elr.br.Discard(8); elr.err != nil {
+						return elr.err
+					}
+					break
+				}
+			} else if elr.GetPosition() >= itemEndPos {
+				break
+			}
+			if err := scn.scanElement(itemPath); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package opendcm
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    Scanner
+===============================================================================
+*/
+
+// newNestedSequenceDicom returns the contents of "ISO_IR192.dcm", with the
+// addition of a sequence of two items, the second of which contains a
+// nested sequence.
+func newNestedSequenceDicom(t *testing.T) Dicom {
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "ISO_IR192.dcm"))
+	assert.NoError(t, err)
+	code := NewElementWithTag(0x00080100)
+	code.data = []byte("T-D1100")
+	nested := NewElementWithTag(0x00400008)
+	nestedItem := NewItem()
+	nestedItem.dataset.addElement(code)
+	nested.items = append(nested.items, nestedItem)
+
+	first := NewItem()
+	first.dataset.addElement(code)
+	second := NewItem()
+	second.dataset.addElement(nested)
+	sq := NewElementWithTag(0x00400275)
+	sq.items = append(sq.items, first, second)
+	dcm.addElement(sq)
+	return dcm
+}
+
+func TestScan(t *testing.T) {
+	// ensures that `Scan` visits each element of a data set in the
+	// order in which they appear.
+	t.Parallel()
+	for _, path := range []string{
+		filepath.Join("testdata", "synthetic", "ShiftJIS.dcm"),
+		filepath.Join("testdata", "TCIA", "1.3.6.1.4.1.14519.5.2.1.2744.7002.251446451370536632612663178782.dcm"),
+	} {
+		dcm, err := FromFile(path)
+		assert.NoError(t, err)
+		f, err := os.Open(path)
+		assert.NoError(t, err)
+		scanned := 0
+		lastOffset := int64(-1)
+		err = Scan(f, func(e *ScannedElement) error {
+			if e.Depth == 0 {
+				scanned++
+				expected := NewElement()
+				assert.True(t, dcm.GetElement(e.GetTag(), &expected))
+				assert.Equal(t, expected.data, e.data)
+			}
+			assert.True(t, e.Offset > lastOffset)
+			assert.True(t, e.GetOffset() > e.Offset)
+			lastOffset = e.Offset
+			return nil
+		})
+		f.Close()
+		assert.NoError(t, err)
+		assert.Equal(t, dcm.Len(), scanned)
+	}
+}
+
+func TestScanSequence(t *testing.T) {
+	// ensures that sequences are visited before their items, and that
+	// nested elements are reported with their depth and path.
+	t.Parallel()
+	dcm := newNestedSequenceDicom(t)
+	buf := bytes.Buffer{}
+	_, err := dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	type visit struct {
+		tag   uint32
+		depth int
+		path  string
+	}
+	visits := make([]visit, 0)
+	err = Scan(&buf, func(e *ScannedElement) error {
+		if e.Depth > 0 || e.GetTag() == 0x00400275 {
+			assert.False(t, e.HasItems())
+			visits = append(visits, visit{e.GetTag(), e.Depth, e.Path.String()})
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []visit{
+		{0x00400275, 0, ""},
+		{0x00080100, 1, "(0040,0275)[0]"},
+		{0x00400008, 1, "(0040,0275)[1]"},
+		{0x00080100, 2, "(0040,0275)[1].(0040,0008)[0]"},
+	}, visits)
+}
+
+func TestScanStop(t *testing.T) {
+	// ensures that scanning stops when requested by the `ScanFunc`,
+	// or upon reaching `ParseOptions.StopAtTag`.
+	t.Parallel()
+	f, err := os.Open(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	defer f.Close()
+	scanned := 0
+	err = Scan(f, func(e *ScannedElement) error {
+		scanned++
+		if scanned == 3 {
+			return ErrStopScan
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, scanned)
+
+	_, err = f.Seek(0, io.SeekStart)
+	assert.NoError(t, err)
+	err = ScanWithOptions(f, ParseOptions{StopAtTag: 0x00080000}, func(e *ScannedElement) error {
+		assert.True(t, e.GetTag() < 0x00080000)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestScanError(t *testing.T) {
+	// ensures that errors are returned by `Scan`.
+	t.Parallel()
+	dcm := newNestedSequenceDicom(t)
+	buf := bytes.Buffer{}
+	_, err := dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+	encoded := buf.Bytes()
+
+	// error returned by the `ScanFunc`, from within a sequence
+	expectedErr := errors.New("error")
+	err = Scan(bytes.NewReader(encoded), func(e *ScannedElement) error {
+		if e.Depth == 2 {
+			return expectedErr
+		}
+		return nil
+	})
+	assert.Equal(t, expectedErr, err)
+
+	// truncated file: elements before the point of truncation are visited, and
+	// the truncation is returned unless it falls between elements of the data set
+	offsets := map[uint32]int{}
+	assert.NoError(t, Scan(bytes.NewReader(encoded), func(e *ScannedElement) error {
+		if e.Depth == 0 {
+			offsets[e.GetTag()] = int(e.Offset)
+		}
+		return nil
+	}))
+	for _, c := range []struct {
+		length int
+		err    error
+	}{
+		{offsets[0x00400275], nil},
+		{offsets[0x00400275] + 2, io.ErrUnexpectedEOF},  // within a tag
+		{offsets[0x00400275] + 6, io.ErrUnexpectedEOF},  // within a header
+		{offsets[0x00100010] + 10, io.ErrUnexpectedEOF}, // within a value
+		{len(encoded) - 12, io.ErrUnexpectedEOF},        // within an item
+	} {
+		scanned := 0
+		err = Scan(bytes.NewReader(encoded[:c.length]), func(e *ScannedElement) error {
+			scanned++
+			return nil
+		})
+		assert.Equal(t, c.err, err, "truncated to %d bytes", c.length)
+		assert.NotZero(t, scanned)
+	}
+
+	// not enough bytes to peek the preamble
+	err = Scan(bytes.NewReader(make([]byte, 100)), func(e *ScannedElement) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	// unrecognised transfer syntax
+	f, err := os.Open(filepath.Join("testdata", "synthetic", "UnrecognisedTransferSyntax.dcm"))
+	assert.NoError(t, err)
+	defer f.Close()
+	err = Scan(f, func(e *ScannedElement) error {
+		return nil
+	})
+	assert.IsType(t, &UnrecognisedTransferSyntaxError{}, err)
+}
+
+func TestPathString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "", Path{}.String())
+	assert.Equal(t, "(0040,0275)[0].(0040,0008)[12]", Path{{0x00400275, 0}, {0x00400008, 12}}.String())
+}
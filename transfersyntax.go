@@ -0,0 +1,130 @@
+package opendcm
+
+import (
+	"fmt"
+)
+
+/*
+===============================================================================
+	TransferSyntax
+	---
+	Provides a registry of transfer syntaxes, describing how the data set
+	following the meta group is encoded.
+===============================================================================
+*/
+
+const (
+	// ImplicitVRLittleEndian is the default transfer syntax for DICOM
+	ImplicitVRLittleEndian = "1.2.840.10008.1.2"
+
+	// ExplicitVRLittleEndian is the transfer syntax used for the meta group
+	ExplicitVRLittleEndian = "1.2.840.10008.1.2.1"
+
+	// DeflatedExplicitVRLittleEndian compresses the data set using the deflate algorithm
+	DeflatedExplicitVRLittleEndian = "1.2.840.10008.1.2.1.99"
+
+	// ExplicitVRBigEndian is retired, but commonly found in legacy archives
+	ExplicitVRBigEndian = "1.2.840.10008.1.2.2"
+
+	// JPEGBaseline compresses PixelData using 8 bit lossy JPEG
+	JPEGBaseline = "1.2.840.10008.1.2.4.50"
+
+	// JPEGExtended compresses PixelData using 8 or 12 bit lossy JPEG
+	JPEGExtended = "1.2.840.10008.1.2.4.51"
+
+	// JPEGLossless compresses PixelData using lossless JPEG, with any predictor
+	JPEGLossless = "1.2.840.10008.1.2.4.57"
+
+	// JPEGLosslessSV1 compresses PixelData using lossless JPEG, with the first-order predictor
+	JPEGLosslessSV1 = "1.2.840.10008.1.2.4.70"
+
+	// JPEGLSLossless compresses PixelData using lossless JPEG-LS
+	JPEGLSLossless = "1.2.840.10008.1.2.4.80"
+
+	// JPEGLSNearLossless compresses PixelData using JPEG-LS, with bounded error
+	JPEGLSNearLossless = "1.2.840.10008.1.2.4.81"
+
+	// JPEG2000Lossless compresses PixelData using reversible JPEG 2000
+	JPEG2000Lossless = "1.2.840.10008.1.2.4.90"
+
+	// JPEG2000 compresses PixelData using reversible or irreversible JPEG 2000
+	JPEG2000 = "1.2.840.10008.1.2.4.91"
+
+	// RLELossless compresses PixelData using byte-wise run length encoding
+	RLELossless = "1.2.840.10008.1.2.5"
+)
+
+// TransferSyntax describes the encoding of a data set.
+// See "10 Transfer Syntax" of PS3.5 for more information
+type TransferSyntax struct {
+	UID          string
+	Name         string
+	ImplicitVR   bool
+	LittleEndian bool
+	// Encapsulated indicates that PixelData is stored as a series of fragments
+	Encapsulated bool
+	// Deflated indicates that the data set following the meta group is deflate compressed
+	Deflated bool
+	// Lossy indicates that the pixel data may have undergone lossy compression
+	Lossy bool
+}
+
+// TransferSyntaxMap provides a mapping between transfer syntax UID, and transfer syntax characteristics.
+var TransferSyntaxMap = map[string]*TransferSyntax{
+	ImplicitVRLittleEndian:         {UID: ImplicitVRLittleEndian, Name: "Implicit VR Little Endian", ImplicitVR: true, LittleEndian: true},
+	ExplicitVRLittleEndian:         {UID: ExplicitVRLittleEndian, Name: "Explicit VR Little Endian", LittleEndian: true},
+	DeflatedExplicitVRLittleEndian: {UID: DeflatedExplicitVRLittleEndian, Name: "Deflated Explicit VR Little Endian", LittleEndian: true, Deflated: true},
+	ExplicitVRBigEndian:            {UID: ExplicitVRBigEndian, Name: "Explicit VR Big Endian", LittleEndian: false},
+	JPEGBaseline:                   {UID: JPEGBaseline, Name: "JPEG Baseline (Process 1)", LittleEndian: true, Encapsulated: true, Lossy: true},
+	JPEGExtended:                   {UID: JPEGExtended, Name: "JPEG Extended (Process 2 & 4)", LittleEndian: true, Encapsulated: true, Lossy: true},
+	JPEGLossless:                   {UID: JPEGLossless, Name: "JPEG Lossless, Non-Hierarchical (Process 14)", LittleEndian: true, Encapsulated: true},
+	JPEGLosslessSV1:                {UID: JPEGLosslessSV1, Name: "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1])", LittleEndian: true, Encapsulated: true},
+	JPEGLSLossless:                 {UID: JPEGLSLossless, Name: "JPEG-LS Lossless Image Compression", LittleEndian: true, Encapsulated: true},
+	JPEGLSNearLossless:             {UID: JPEGLSNearLossless, Name: "JPEG-LS Lossy (Near-Lossless) Image Compression", LittleEndian: true, Encapsulated: true, Lossy: true},
+	JPEG2000Lossless:               {UID: JPEG2000Lossless, Name: "JPEG 2000 Image Compression (Lossless Only)", LittleEndian: true, Encapsulated: true},
+	JPEG2000:                       {UID: JPEG2000, Name: "JPEG 2000 Image Compression", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.92":       {UID: "1.2.840.10008.1.2.4.92", Name: "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)", LittleEndian: true, Encapsulated: true},
+	"1.2.840.10008.1.2.4.93":       {UID: "1.2.840.10008.1.2.4.93", Name: "JPEG 2000 Part 2 Multi-component Image Compression", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.100":      {UID: "1.2.840.10008.1.2.4.100", Name: "MPEG2 Main Profile / Main Level", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.101":      {UID: "1.2.840.10008.1.2.4.101", Name: "MPEG2 Main Profile / High Level", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.102":      {UID: "1.2.840.10008.1.2.4.102", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.1", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.103":      {UID: "1.2.840.10008.1.2.4.103", Name: "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.104":      {UID: "1.2.840.10008.1.2.4.104", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.105":      {UID: "1.2.840.10008.1.2.4.105", Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.106":      {UID: "1.2.840.10008.1.2.4.106", Name: "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.107":      {UID: "1.2.840.10008.1.2.4.107", Name: "HEVC/H.265 Main Profile / Level 5.1", LittleEndian: true, Encapsulated: true, Lossy: true},
+	"1.2.840.10008.1.2.4.108":      {UID: "1.2.840.10008.1.2.4.108", Name: "HEVC/H.265 Main 10 Profile / Level 5.1", LittleEndian: true, Encapsulated: true, Lossy: true},
+	RLELossless:                    {UID: RLELossless, Name: "RLE Lossless", LittleEndian: true, Encapsulated: true},
+}
+
+// UnrecognisedTransferSyntaxError is returned when a transfer syntax UID
+// is not present in `TransferSyntaxMap`.
+type UnrecognisedTransferSyntaxError struct {
+	UID string
+}
+
+func (err *UnrecognisedTransferSyntaxError) Error() string {
+	return fmt.Sprintf(`unrecognised transfer syntax "%s"`, err.UID)
+}
+
+// LookupTransferSyntax returns the transfer syntax identified by `uid`.
+// If the transfer syntax is not recognised, an `*UnrecognisedTransferSyntaxError`
+// is returned.
+func LookupTransferSyntax(uid string) (*TransferSyntax, error) {
+	if ts, found := TransferSyntaxMap[uid]; found {
+		return ts, nil
+	}
+	return nil, &UnrecognisedTransferSyntaxError{UID: uid}
+}
+
+// transferSyntaxForEncoding returns the native (non-encapsulated) transfer syntax
+// with the given encoding. It is used when the meta group does not specify a transfer syntax.
+func transferSyntaxForEncoding(implicit bool, littleEndian bool) *TransferSyntax {
+	switch {
+	case !littleEndian:
+		return TransferSyntaxMap[ExplicitVRBigEndian]
+	case implicit:
+		return TransferSyntaxMap[ImplicitVRLittleEndian]
+	}
+	return TransferSyntaxMap[ExplicitVRLittleEndian]
+}
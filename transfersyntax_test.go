@@ -0,0 +1,111 @@
+package opendcm
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    TransferSyntax
+===============================================================================
+*/
+
+func TestLookupTransferSyntax(t *testing.T) {
+	// ensures that `LookupTransferSyntax` returns the correct
+	// characteristics for recognised transfer syntaxes.
+	t.Parallel()
+	ts, err := LookupTransferSyntax(ImplicitVRLittleEndian)
+	assert.NoError(t, err)
+	assert.True(t, ts.ImplicitVR)
+	assert.True(t, ts.LittleEndian)
+
+	ts, err = LookupTransferSyntax(ExplicitVRBigEndian)
+	assert.NoError(t, err)
+	assert.False(t, ts.ImplicitVR)
+	assert.False(t, ts.LittleEndian)
+
+	ts, err = LookupTransferSyntax("1.2.840.10008.1.2.4.50")
+	assert.NoError(t, err)
+	assert.True(t, ts.Encapsulated)
+	assert.True(t, ts.Lossy)
+
+	// every entry should be keyed by its own UID
+	for uid, ts := range TransferSyntaxMap {
+		assert.Equal(t, uid, ts.UID)
+	}
+}
+
+func TestLookupTransferSyntaxError(t *testing.T) {
+	// ensures that `LookupTransferSyntax` returns a typed
+	// error for unrecognised transfer syntaxes.
+	t.Parallel()
+	ts, err := LookupTransferSyntax("1.2.840.10008.88.8.88")
+	assert.Nil(t, ts)
+	assert.IsType(t, &UnrecognisedTransferSyntaxError{}, err)
+	assert.Contains(t, err.Error(), "1.2.840.10008.88.8.88")
+}
+
+func TestTransferSyntaxForEncoding(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ImplicitVRLittleEndian, transferSyntaxForEncoding(true, true).UID)
+	assert.Equal(t, ExplicitVRLittleEndian, transferSyntaxForEncoding(false, true).UID)
+	assert.Equal(t, ExplicitVRBigEndian, transferSyntaxForEncoding(false, false).UID)
+}
+
+func TestFromReaderTransferSyntax(t *testing.T) {
+	// ensures that `FromReader` uses the transfer syntax from the meta
+	// group, and falls back to determining encoding when it is missing.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	assert.Equal(t, ExplicitVRLittleEndian, dcm.GetTransferSyntax().UID)
+
+	dcm, err = FromFile(filepath.Join("testdata", "synthetic", "MissingTransferSyntax.dcm"))
+	assert.NoError(t, err)
+	assert.NotNil(t, dcm.GetTransferSyntax())
+
+	_, err = FromFile(filepath.Join("testdata", "synthetic", "UnrecognisedTransferSyntax.dcm"))
+	assert.IsType(t, &UnrecognisedTransferSyntaxError{}, err)
+}
+
+func TestFromReaderBigEndian(t *testing.T) {
+	// ensures that a data set in Explicit VR Big Endian is decoded
+	// according to the transfer syntax, including elements of
+	// groups that would confuse `determineEncoding`.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	private := NewElementWithTag(0x20010010)
+	private.setVR("LO")
+	private.data = []byte("PRIVATE CREATOR")
+	dcm.addElement(private)
+	angle := NewElementWithTag(0x00189219)
+	angle.data = []byte{0x2E, 0xFB}
+	dcm.addElement(angle)
+	ts := NewElementWithTag(0x00020010)
+	ts.data = []byte(ExplicitVRBigEndian)
+	dcm.addElement(ts)
+
+	buf := bytes.Buffer{}
+	_, err = dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, ExplicitVRBigEndian, decoded.GetTransferSyntax().UID)
+	assert.Equal(t, dcm.Len(), decoded.Len())
+	e := NewElement()
+	assert.True(t, decoded.GetElement(0x20010010, &e))
+	assert.Equal(t, []byte("PRIVATE CREATOR"), e.data)
+
+	// binary values should be readable in either byte order
+	ss := int16(0)
+	found, err := decoded.GetElementValue(0x00189219, &ss)
+	assert.True(t, found)
+	assert.NoError(t, err)
+	assert.Equal(t, int16(-1234), ss)
+}
@@ -0,0 +1,444 @@
+package opendcm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+===============================================================================
+	Values
+	---
+	Provides parsing of textual values into typed values: dates and times
+	(DA, TM, DT), person names (PN), ages (AS) and numbers (DS, IS).
+	These are available through `Element.GetValue`.
+===============================================================================
+*/
+
+// DateTimePrecision is the finest component specified by a DA, TM or DT value.
+type DateTimePrecision int
+
+// The precisions of DA, TM and DT values, from coarsest to finest.
+const (
+	PrecisionYear DateTimePrecision = iota
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+	PrecisionFraction
+)
+
+// DateTime is a DA, TM or DT value. Components finer than its precision are zero,
+// as are the date components of TM values.
+type DateTime struct {
+	time.Time
+	Precision DateTimePrecision
+	// HasOffset is whether the value specified its offset from UTC. Otherwise,
+	// the time is in UTC, and the Timezone Offset From UTC (0008,0201) of the data
+	// set may apply.
+	HasOffset bool
+}
+
+// End returns the last instant covered by the value, given its precision.
+// For example, the end of "2018" is the last instant of 2018-12-31.
+func (dt DateTime) End() time.Time {
+	t := dt.Time
+	switch dt.Precision {
+	case PrecisionYear:
+		t = t.AddDate(1, 0, 0)
+	case PrecisionMonth:
+		t = t.AddDate(0, 1, 0)
+	case PrecisionDay:
+		t = t.AddDate(0, 0, 1)
+	case PrecisionHour:
+		t = t.Add(time.Hour)
+	case PrecisionMinute:
+		t = t.Add(time.Minute)
+	case PrecisionSecond:
+		t = t.Add(time.Second)
+	default:
+		return t
+	}
+	return t.Add(-time.Nanosecond)
+}
+
+// DateTimeRange is a range of DA, TM or DT values, as used by queries, such as
+// "20180101-20180301". Either end may be nil, in which case the range is open.
+type DateTimeRange struct {
+	Start *DateTime
+	End   *DateTime
+}
+
+// Contains returns whether `t` falls within the range, inclusive of the whole of
+// each end: "2018-2019" contains every instant of 2018 and 2019.
+func (r DateTimeRange) Contains(t time.Time) bool {
+	if r.Start != nil && t.Before(r.Start.Time) {
+		return false
+	}
+	if r.End != nil && t.After(r.End.End()) {
+		return false
+	}
+	return true
+}
+
+// parseDigits parses `s`, which must consist of decimal digits only.
+func parseDigits(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+	}
+	v, err := strconv.Atoi(s)
+	return v, err == nil
+}
+
+// dateTimeComponents parses components of at most `maxPrecision` from `s`: a
+// four digit year followed by two digit components, the seconds of which may be
+// followed by a fraction of up to six digits.
+func dateTimeComponents(s string, first DateTimePrecision, maxPrecision DateTimePrecision) (values [7]int, precision DateTimePrecision, err error) {
+	// values: year, month, day, hour, minute, second, nanosecond
+	values[1], values[2] = 1, 1
+	fraction := ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		s, fraction = s[:i], s[i+1:]
+		if len(fraction) == 0 || len(fraction) > 6 {
+			return values, precision, errors.New("fraction must have between one and six digits")
+		}
+	}
+	precision = first - 1
+	for len(s) > 0 {
+		precision++
+		width := 2
+		if precision == PrecisionYear {
+			width = 4
+		}
+		if precision > maxPrecision || len(s) < width {
+			return values, precision, errors.New("unexpected number of digits")
+		}
+		v, ok := parseDigits(s[:width])
+		if !ok {
+			return values, precision, errors.New("components must be digits")
+		}
+		values[precision] = v
+		s = s[width:]
+	}
+	if precision < first {
+		return values, precision, errors.New("value is empty")
+	}
+	if fraction != "" {
+		if precision != PrecisionSecond {
+			return values, precision, errors.New("fraction must follow seconds")
+		}
+		v, ok := parseDigits(fraction)
+		if !ok {
+			return values, precision, errors.New("fraction must be digits")
+		}
+		values[6] = v * int(pow10(9-len(fraction)))
+		precision = PrecisionFraction
+	}
+
+	if values[1] < 1 || values[1] > 12 {
+		return values, precision, fmt.Errorf("month %d is out of range", values[1])
+	}
+	if t := time.Date(values[0], time.Month(values[1]), values[2], 0, 0, 0, 0, time.UTC); values[2] < 1 || t.Day() != values[2] {
+		return values, precision, fmt.Errorf("day %d is out of range", values[2])
+	}
+	// a second of 60 is permitted for leap seconds
+	if values[3] > 23 || values[4] > 59 || values[5] > 60 {
+		return values, precision, errors.New("time is out of range")
+	}
+	return values, precision, nil
+}
+
+// pow10 returns 10 to the power of `n`.
+func pow10(n int) int64 {
+	v := int64(1)
+	for ; n > 0; n-- {
+		v *= 10
+	}
+	return v
+}
+
+// ParseDate parses a DA value, "YYYYMMDD". The "YYYY.MM.DD" format of ACR-NEMA is also accepted.
+func ParseDate(s string) (DateTime, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 10 && s[4] == '.' && s[7] == '.' {
+		s = s[:4] + s[5:7] + s[8:]
+	}
+	if len(s) != 8 {
+		return DateTime{}, fmt.Errorf(`date "%s" is invalid: must be of the form YYYYMMDD`, s)
+	}
+	values, precision, err := dateTimeComponents(s, PrecisionYear, PrecisionDay)
+	if err != nil {
+		return DateTime{}, fmt.Errorf(`date "%s" is invalid: %v`, s, err)
+	}
+	return DateTime{Time: time.Date(values[0], time.Month(values[1]), values[2], 0, 0, 0, 0, time.UTC), Precision: precision}, nil
+}
+
+// ParseTime parses a TM value, "HH[MM[SS[.F{1-6}]]]". The "HH:MM:SS" format of
+// ACR-NEMA is also accepted.
+func ParseTime(s string) (DateTime, error) {
+	s = strings.TrimSpace(s)
+	values, precision, err := dateTimeComponents(strings.Replace(s, ":", "", 2), PrecisionHour, PrecisionSecond)
+	if err != nil {
+		return DateTime{}, fmt.Errorf(`time "%s" is invalid: %v`, s, err)
+	}
+	return DateTime{Time: time.Date(0, 1, 1, values[3], values[4], values[5], values[6], time.UTC), Precision: precision}, nil
+}
+
+// ParseDateTime parses a DT value, "YYYY[MM[DD[HH[MM[SS[.F{1-6}]]]]]][&ZZXX]",
+// where "&ZZXX" is an offset from UTC, such as "+0100" or "-0500".
+func ParseDateTime(s string) (DateTime, error) {
+	s = strings.TrimSpace(s)
+	value, offset := s, ""
+	if i := strings.LastIndexAny(s, "+-"); i != -1 {
+		value, offset = s[:i], s[i:]
+	}
+	values, precision, err := dateTimeComponents(value, PrecisionYear, PrecisionSecond)
+	if err != nil {
+		return DateTime{}, fmt.Errorf(`date time "%s" is invalid: %v`, s, err)
+	}
+	location := time.UTC
+	if offset != "" {
+		hours, hoursOK := parseDigits(offset[1:minInt(3, len(offset))])
+		minutes, minutesOK := parseDigits(offset[minInt(3, len(offset)):])
+		if len(offset) != 5 || !hoursOK || !minutesOK || minutes > 59 {
+			return DateTime{}, fmt.Errorf(`date time "%s" is invalid: offset must be of the form &ZZXX`, s)
+		}
+		seconds := hours*3600 + minutes*60
+		if offset[0] == '-' {
+			seconds = -seconds
+		}
+		if seconds < -12*3600 || seconds > 14*3600 {
+			return DateTime{}, fmt.Errorf(`date time "%s" is invalid: offset is out of range`, s)
+		}
+		location = time.FixedZone(offset, seconds)
+	}
+	t := time.Date(values[0], time.Month(values[1]), values[2], values[3], values[4], values[5], values[6], location)
+	return DateTime{Time: t, Precision: precision, HasOffset: offset != ""}, nil
+}
+
+// ParseDateTimeRange parses a range of values of VR `vr` (DA, TM or DT), as
+// used by queries: "<start>-<end>", "<start>-" or "-<end>". A single value is
+// a range which starts and ends with that value.
+func ParseDateTimeRange(vr string, s string) (DateTimeRange, error) {
+	var parse func(string) (DateTime, error)
+	switch vr {
+	case "DA":
+		parse = ParseDate
+	case "TM":
+		parse = ParseTime
+	case "DT":
+		parse = ParseDateTime
+	default:
+		return DateTimeRange{}, fmt.Errorf("ranges of %s values are not supported", vr)
+	}
+	s = strings.TrimSpace(s)
+	parseEnd := func(s string) (*DateTime, error) {
+		if s == "" {
+			return nil, nil
+		}
+		dt, err := parse(s)
+		return &dt, err
+	}
+	// the hyphen of a range is ambiguous with the offset of DT values, so each
+	// hyphen is tried in turn, preferring a range whose ends are in order.
+	var found *DateTimeRange
+	for i := 0; i < len(s); i++ {
+		if s[i] != '-' {
+			continue
+		}
+		start, startErr := parseEnd(s[:i])
+		end, endErr := parseEnd(s[i+1:])
+		if startErr != nil || endErr != nil || (start == nil && end == nil) {
+			continue
+		}
+		r := DateTimeRange{Start: start, End: end}
+		if found == nil || (start != nil && end != nil && !end.End().Before(start.Time)) {
+			found = &r
+		}
+	}
+	if found != nil {
+		return *found, nil
+	}
+	dt, err := parse(s)
+	if err != nil {
+		return DateTimeRange{}, fmt.Errorf(`range "%s" is invalid: %v`, s, err)
+	}
+	return DateTimeRange{Start: &dt, End: &dt}, nil
+}
+
+// PersonNameGroup is one component group of a PN value.
+type PersonNameGroup struct {
+	Family string
+	Given  string
+	Middle string
+	Prefix string
+	Suffix string
+}
+
+// String returns the group as encoded within a PN value: "Family^Given^Middle^Prefix^Suffix",
+// without trailing empty components.
+func (g PersonNameGroup) String() string {
+	return strings.TrimRight(strings.Join([]string{g.Family, g.Given, g.Middle, g.Prefix, g.Suffix}, "^"), "^")
+}
+
+// PersonName is a PN value, consisting of alphabetic, ideographic and phonetic
+// representations of a name.
+type PersonName struct {
+	Alphabetic  PersonNameGroup
+	Ideographic PersonNameGroup
+	Phonetic    PersonNameGroup
+}
+
+// String returns the name as encoded within a PN value, without trailing empty groups.
+func (pn PersonName) String() string {
+	return strings.TrimRight(strings.Join([]string{pn.Alphabetic.String(), pn.Ideographic.String(), pn.Phonetic.String()}, "="), "=")
+}
+
+// ParsePersonName parses a PN value, such as "Yamada^Tarou=山田^太郎=やまだ^たろう".
+func ParsePersonName(s string) PersonName {
+	pn := PersonName{}
+	groups := []*PersonNameGroup{&pn.Alphabetic, &pn.Ideographic, &pn.Phonetic}
+	for i, group := range strings.SplitN(strings.TrimSpace(s), "=", len(groups)) {
+		components := []*string{&groups[i].Family, &groups[i].Given, &groups[i].Middle, &groups[i].Prefix, &groups[i].Suffix}
+		for j, component := range strings.SplitN(group, "^", len(components)) {
+			*components[j] = strings.TrimSpace(component)
+		}
+	}
+	return pn
+}
+
+// Age is an AS value, such as "018Y": a number of days, weeks, months or years.
+type Age struct {
+	Value int
+	// Unit is one of 'D', 'W', 'M' or 'Y'
+	Unit byte
+}
+
+// ParseAge parses an AS value, "nnnD", "nnnW", "nnnM" or "nnnY".
+func ParseAge(s string) (Age, error) {
+	s = strings.TrimSpace(s)
+	if len(s) != 4 {
+		return Age{}, fmt.Errorf(`age "%s" is invalid: must be of the form nnnU`, s)
+	}
+	value, ok := parseDigits(s[:3])
+	if !ok || !strings.ContainsRune("DWMY", rune(s[3])) {
+		return Age{}, fmt.Errorf(`age "%s" is invalid: must be of the form nnnU, where U is one of D, W, M or Y`, s)
+	}
+	return Age{Value: value, Unit: s[3]}, nil
+}
+
+// Duration returns the age as a duration, taking months and years to be of
+// their average length in the Gregorian calendar.
+func (a Age) Duration() time.Duration {
+	days := map[byte]float64{'D': 1, 'W': 7, 'M': 365.2425 / 12, 'Y': 365.2425}[a.Unit]
+	return time.Duration(float64(a.Value) * days * float64(24*time.Hour))
+}
+
+// String returns the age as encoded within an AS value.
+func (a Age) String() string {
+	return fmt.Sprintf("%03d%c", a.Value, a.Unit)
+}
+
+// textValues returns each value of the element, with padding removed. Empty values are omitted.
+func (e *Element) textValues() []string {
+	values := []string{}
+	for _, v := range splitCharacterStringVM(e.data) {
+		if s := strings.Trim(string(v), " \x00"); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// parseDateTime parses `s` according to the VR of the element.
+func (e *Element) parseDateTime(s string) (DateTime, error) {
+	switch e.GetVR() {
+	case "DA":
+		return ParseDate(s)
+	case "TM":
+		return ParseTime(s)
+	}
+	return ParseDateTime(s)
+}
+
+// getTypedValue writes the typed value of a DA, TM, DT, PN, AS, DS or IS element into `dst`.
+// Single destinations receive the first value, and slices receive every value.
+// See: GetValue
+func (e *Element) getTypedValue(dst interface{}) (err error) {
+	values := e.textValues()
+	wrap := func(err error) error {
+		if err != nil {
+			return fmt.Errorf("GetValue(%s): value of %s: %v", reflect.TypeOf(dst), e.dictEntry, err)
+		}
+		return nil
+	}
+	if len(values) == 0 {
+		switch dst.(type) {
+		case *[]DateTime, *[]PersonName, *[]float64, *[]int64:
+			return nil
+		}
+		return wrap(errors.New("value is empty"))
+	}
+
+	switch typedDst := dst.(type) {
+	case *DateTime:
+		*typedDst, err = e.parseDateTime(values[0])
+	case *[]DateTime:
+		for _, v := range values {
+			dt, err := e.parseDateTime(v)
+			if err != nil {
+				return wrap(err)
+			}
+			*typedDst = append(*typedDst, dt)
+		}
+	case *time.Time:
+		var dt DateTime
+		dt, err = e.parseDateTime(values[0])
+		*typedDst = dt.Time
+	case *DateTimeRange:
+		vr := e.GetVR()
+		if vr == "UN" {
+			vr = "DT"
+		}
+		*typedDst, err = ParseDateTimeRange(vr, values[0])
+	case *PersonName:
+		*typedDst = ParsePersonName(values[0])
+	case *[]PersonName:
+		for _, v := range values {
+			*typedDst = append(*typedDst, ParsePersonName(v))
+		}
+	case *Age:
+		*typedDst, err = ParseAge(values[0])
+	case *float64:
+		*typedDst, err = strconv.ParseFloat(values[0], 64)
+	case *[]float64:
+		for _, v := range values {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return wrap(err)
+			}
+			*typedDst = append(*typedDst, f)
+		}
+	case *int64:
+		*typedDst, err = strconv.ParseInt(values[0], 10, 64)
+	case *[]int64:
+		for _, v := range values {
+			i, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return wrap(err)
+			}
+			*typedDst = append(*typedDst, i)
+		}
+	}
+	return wrap(err)
+}
@@ -0,0 +1,225 @@
+package opendcm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDate(t *testing.T) {
+	// ensures that `ParseDate` parses DA values, including those of ACR-NEMA.
+	t.Parallel()
+	for _, s := range []string{"20180302", "2018.03.02", " 20180302 "} {
+		dt, err := ParseDate(s)
+		assert.NoError(t, err, s)
+		assert.True(t, dt.Equal(time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC)), s)
+		assert.Equal(t, PrecisionDay, dt.Precision)
+		assert.False(t, dt.HasOffset)
+	}
+	for _, s := range []string{"", "2018", "201803021", "20181302", "20180230", "2018-03-02", "2018030A"} {
+		_, err := ParseDate(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	// ensures that `ParseTime` parses TM values of every precision.
+	t.Parallel()
+	cases := []struct {
+		in        string
+		out       time.Time
+		precision DateTimePrecision
+	}{
+		{"07", time.Date(0, 1, 1, 7, 0, 0, 0, time.UTC), PrecisionHour},
+		{"0715", time.Date(0, 1, 1, 7, 15, 0, 0, time.UTC), PrecisionMinute},
+		{"071530", time.Date(0, 1, 1, 7, 15, 30, 0, time.UTC), PrecisionSecond},
+		{"071530.25", time.Date(0, 1, 1, 7, 15, 30, 250000000, time.UTC), PrecisionFraction},
+		{"071530.000001", time.Date(0, 1, 1, 7, 15, 30, 1000, time.UTC), PrecisionFraction},
+		{"07:15:30", time.Date(0, 1, 1, 7, 15, 30, 0, time.UTC), PrecisionSecond},
+	}
+	for _, c := range cases {
+		dt, err := ParseTime(c.in)
+		assert.NoError(t, err, c.in)
+		assert.True(t, dt.Equal(c.out), c.in)
+		assert.Equal(t, c.precision, dt.Precision, c.in)
+	}
+	for _, s := range []string{"", "7", "071", "2400", "0760", "0715.5", "071530.", "071530.1234567", "07153000"} {
+		_, err := ParseTime(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	// ensures that `ParseDateTime` parses DT values, with and without an offset from UTC.
+	t.Parallel()
+	dt, err := ParseDateTime("2018")
+	assert.NoError(t, err)
+	assert.True(t, dt.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, PrecisionYear, dt.Precision)
+	assert.False(t, dt.HasOffset)
+
+	dt, err = ParseDateTime("20180302071530.5-0500")
+	assert.NoError(t, err)
+	assert.True(t, dt.Equal(time.Date(2018, 3, 2, 12, 15, 30, 500000000, time.UTC)))
+	assert.Equal(t, PrecisionFraction, dt.Precision)
+	assert.True(t, dt.HasOffset)
+	_, offset := dt.Zone()
+	assert.Equal(t, -5*3600, offset)
+
+	dt, err = ParseDateTime("201803+0100")
+	assert.NoError(t, err)
+	assert.Equal(t, PrecisionMonth, dt.Precision)
+	assert.True(t, dt.Equal(time.Date(2018, 2, 28, 23, 0, 0, 0, time.UTC)))
+
+	for _, s := range []string{"", "201", "20181", "2018.5", "2018+01", "2018+1500", "2018-0160", "2018030207.5"} {
+		_, err := ParseDateTime(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestDateTimeEnd(t *testing.T) {
+	// ensures that `DateTime.End` returns the last instant covered by the precision of a value.
+	t.Parallel()
+	dt, err := ParseDateTime("2018")
+	assert.NoError(t, err)
+	assert.True(t, dt.End().Equal(time.Date(2018, 12, 31, 23, 59, 59, 999999999, time.UTC)))
+	dt, err = ParseTime("0715")
+	assert.NoError(t, err)
+	assert.True(t, dt.End().Equal(time.Date(0, 1, 1, 7, 15, 59, 999999999, time.UTC)))
+	dt, err = ParseTime("071530.5")
+	assert.NoError(t, err)
+	assert.True(t, dt.End().Equal(dt.Time))
+}
+
+func TestParseDateTimeRange(t *testing.T) {
+	// ensures that `ParseDateTimeRange` parses closed, open and single value ranges.
+	t.Parallel()
+	r, err := ParseDateTimeRange("DA", "20180101-20180301")
+	assert.NoError(t, err)
+	assert.True(t, r.Start.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, r.End.Equal(time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, r.Contains(time.Date(2018, 3, 1, 18, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Contains(time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Contains(time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC)))
+
+	r, err = ParseDateTimeRange("TM", "-1200")
+	assert.NoError(t, err)
+	assert.Nil(t, r.Start)
+	assert.True(t, r.Contains(time.Date(0, 1, 1, 12, 0, 30, 0, time.UTC)))
+
+	r, err = ParseDateTimeRange("TM", "1200-")
+	assert.NoError(t, err)
+	assert.Nil(t, r.End)
+	assert.False(t, r.Contains(time.Date(0, 1, 1, 11, 59, 0, 0, time.UTC)))
+
+	r, err = ParseDateTimeRange("DA", "20180101")
+	assert.NoError(t, err)
+	assert.Equal(t, r.Start, r.End)
+
+	// hyphens of offsets are distinguished from the hyphen of the range
+	r, err = ParseDateTimeRange("DT", "2018-0500-2019-0500")
+	assert.NoError(t, err)
+	assert.True(t, r.Start.HasOffset)
+	assert.True(t, r.End.HasOffset)
+	assert.Equal(t, 2019, r.End.Year())
+	r, err = ParseDateTimeRange("DT", "2018-2019")
+	assert.NoError(t, err)
+	assert.False(t, r.Start.HasOffset)
+	assert.Equal(t, 2019, r.End.Year())
+
+	for _, s := range []string{"", "-", "2018-2019", "20180101-2019"} {
+		_, err := ParseDateTimeRange("DA", s)
+		assert.Error(t, err, s)
+	}
+	_, err = ParseDateTimeRange("CS", "A-B")
+	assert.Error(t, err)
+}
+
+func TestParsePersonName(t *testing.T) {
+	// ensures that `ParsePersonName` parses each group and component of PN values.
+	t.Parallel()
+	pn := ParsePersonName("Adams^John Robert Quincy^^Rev.^B.A. M.Div.")
+	assert.Equal(t, PersonNameGroup{Family: "Adams", Given: "John Robert Quincy", Prefix: "Rev.", Suffix: "B.A. M.Div."}, pn.Alphabetic)
+	assert.Equal(t, PersonNameGroup{}, pn.Ideographic)
+	assert.Equal(t, "Adams^John Robert Quincy^^Rev.^B.A. M.Div.", pn.String())
+
+	pn = ParsePersonName("Yamada^Tarou=山田^太郎=やまだ^たろう")
+	assert.Equal(t, PersonNameGroup{Family: "Yamada", Given: "Tarou"}, pn.Alphabetic)
+	assert.Equal(t, PersonNameGroup{Family: "山田", Given: "太郎"}, pn.Ideographic)
+	assert.Equal(t, PersonNameGroup{Family: "やまだ", Given: "たろう"}, pn.Phonetic)
+	assert.Equal(t, "Yamada^Tarou=山田^太郎=やまだ^たろう", pn.String())
+
+	pn = ParsePersonName("=Wang^XiaoDong")
+	assert.Equal(t, PersonNameGroup{}, pn.Alphabetic)
+	assert.Equal(t, "Wang", pn.Ideographic.Family)
+	assert.Equal(t, "=Wang^XiaoDong", pn.String())
+}
+
+func TestParseAge(t *testing.T) {
+	// ensures that `ParseAge` parses AS values, and `Age.Duration` expresses them as durations.
+	t.Parallel()
+	age, err := ParseAge("018Y")
+	assert.NoError(t, err)
+	assert.Equal(t, Age{Value: 18, Unit: 'Y'}, age)
+	assert.Equal(t, "018Y", age.String())
+	assert.Equal(t, time.Duration(18*365.2425*float64(24*time.Hour)), age.Duration())
+	age, err = ParseAge("003W")
+	assert.NoError(t, err)
+	assert.Equal(t, 21*24*time.Hour, age.Duration())
+	for _, s := range []string{"", "18Y", "018y", "018X", "0018Y", "A18Y"} {
+		_, err := ParseAge(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestGetValueTyped(t *testing.T) {
+	// ensures that `GetValue` writes typed values of DA, TM, DT, PN, AS, DS and IS elements.
+	t.Parallel()
+	newElement := func(tag uint32, value string) *Element {
+		e := NewElementWithTag(tag)
+		e.data = []byte(value)
+		return &e
+	}
+
+	dt := DateTime{}
+	date := newElement(0x00080020, "20180302")
+	assert.NoError(t, date.GetValue(&dt))
+	assert.Equal(t, PrecisionDay, dt.Precision)
+	tm := time.Time{}
+	assert.NoError(t, date.GetValue(&tm))
+	assert.True(t, tm.Equal(time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC)))
+	r := DateTimeRange{}
+	assert.NoError(t, newElement(0x00080020, "20180101-20180301").GetValue(&r))
+	assert.Equal(t, time.March, r.End.Month())
+	times := []DateTime{}
+	assert.NoError(t, newElement(0x00080030, "0715\\1200 ").GetValue(&times))
+	assert.Len(t, times, 2)
+	assert.Equal(t, 12, times[1].Hour())
+	assert.NoError(t, newElement(0x0008002A, "20180302071530+0100").GetValue(&dt))
+	assert.True(t, dt.HasOffset)
+
+	pn := PersonName{}
+	assert.NoError(t, newElement(0x00100010, "Doe^Jane ").GetValue(&pn))
+	assert.Equal(t, "Jane", pn.Alphabetic.Given)
+	age := Age{}
+	assert.NoError(t, newElement(0x00101010, "042Y").GetValue(&age))
+	assert.Equal(t, 42, age.Value)
+
+	decimals := []float64{}
+	assert.NoError(t, newElement(0x00281050, " 40\\-600.5 ").GetValue(&decimals))
+	assert.Equal(t, []float64{40, -600.5}, decimals)
+	integers := []int64{}
+	assert.NoError(t, newElement(0x00200013, "12 ").GetValue(&integers))
+	assert.Equal(t, []int64{12}, integers)
+	var integer int64
+	assert.NoError(t, newElement(0x00200013, "-7").GetValue(&integer))
+	assert.Equal(t, int64(-7), integer)
+
+	// values which are invalid or of another VR
+	assert.Error(t, newElement(0x00080020, "2018").GetValue(&dt))
+	assert.Error(t, newElement(0x00080020, "").GetValue(&dt))
+	assert.Error(t, newElement(0x00200013, "1.5").GetValue(&integer))
+	assert.Error(t, newElement(0x00100010, "Doe^Jane").GetValue(&dt))
+	assert.Error(t, newElement(0x00080020, "20180302").GetValue(&pn))
+}
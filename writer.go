@@ -0,0 +1,452 @@
+package opendcm
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/text/encoding"
+)
+
+/*
+===============================================================================
+	Dicom (Encoding)
+	---
+	Provides dicom entity encoding functionality. A `Dicom` can be written
+	to either a path on disk, or an in-memory `io.Writer`.
+===============================================================================
+*/
+
+// WriteTo encodes the dicom as a Part 10 stream into `dst`, returning the
+// number of bytes written.
+//
+// The meta group (0002,xxxx) is always encoded as Explicit VR Little Endian,
+// with (0002,0000) recalculated. The remainder of the data set is encoded
+// according to the transfer syntax named by (0002,0010); if this is
+// "Deflated Explicit VR Little Endian", the remainder is also compressed.
+// Native PixelData is written as OB or OW according to BitsAllocated.
+func (dcm *Dicom) WriteTo(dst io.Writer) (int64, error) {
+	elw := NewElementWriter(dst)
+
+	// preamble and magic
+	if dcm.err = elw.writeBytes(dcm.preamble[:]); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+	if dcm.err = elw.writeBytes(dicmTestString); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+
+	// split the data set into meta and non-meta elements
+	meta := make([]Element, 0)
+	elements := make([]Element, 0)
+	for _, tag := range dcm.Tags() {
+		e := dcm.DataSet[tag]
+		switch {
+		case tag == 0x00020000:
+			// group length is recalculated below
+		case tag>>16 == 0x0002:
+			meta = append(meta, e)
+		case tag == pixelDataTag && !e.HasItems():
+			// native PixelData read with an implicit VR has that of the
+			// dictionary (OB), which is wrong for samples of more than 8 bits:
+			// the VR determines whether they are swapped, as well as the header.
+			bitsAllocated := uint16(0)
+			var found bool
+			if found, dcm.err = dcm.GetElementValue(0x00280100, &bitsAllocated); dcm.err != nil {
+				return elw.GetPosition(), dcm.err
+			}
+			if found {
+				e.setVR(nativePixelDataVR(bitsAllocated))
+			}
+			elements = append(elements, e)
+		default:
+			elements = append(elements, e)
+		}
+	}
+
+	// meta elements are always explicit vr, little endian.
+	// encode them into a buffer first in order to calculate the group length.
+	metaBuffer := bytes.Buffer{}
+	metaWriter := NewElementWriter(&metaBuffer)
+	metaWriter.SetImplicitVR(false)
+	metaWriter.SetLittleEndian(true)
+	for _, e := range meta {
+		if dcm.err = metaWriter.WriteElement(e); dcm.err != nil {
+			return elw.GetPosition(), dcm.err
+		}
+	}
+	groupLength := NewElementWithTag(0x00020000)
+	groupLength.data = make([]byte, 4)
+	binary.LittleEndian.PutUint32(groupLength.data, uint32(metaBuffer.Len()))
+	elw.SetImplicitVR(false)
+	elw.SetLittleEndian(true)
+	if dcm.err = elw.WriteElement(groupLength); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+	if dcm.err = elw.writeBytes(metaBuffer.Bytes()); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+
+	// set encoding of non-meta section according to the transfer syntax
+	tsuid := ""
+	if _, dcm.err = dcm.GetElementValue(0x00020010, &tsuid); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+	if tsuid == "" {
+		tsuid = ImplicitVRLittleEndian
+	}
+	var ts *TransferSyntax
+	if ts, dcm.err = LookupTransferSyntax(tsuid); dcm.err != nil {
+		return elw.GetPosition(), dcm.err
+	}
+	datasetWriter := &elw
+	var deflater *flate.Writer
+	if ts.Deflated {
+		// the data set following the meta group is compressed using raw deflate.
+		// flate.NewWriter only returns an error for an invalid compression level.
+		deflater, _ = flate.NewWriter(&elw, flate.DefaultCompression)
+		w := NewElementWriter(deflater)
+		datasetWriter = &w
+	}
+	datasetWriter.SetImplicitVR(ts.ImplicitVR)
+	datasetWriter.SetLittleEndian(ts.LittleEndian)
+
+	// textual elements were decoded into UTF-8 by `FromReader`, and so must
+	// be re-encoded into their native character set
+	encoder := dcm.GetCharacterSet().Encoding.NewEncoder()
+	for _, e := range elements {
+		if e, dcm.err = encodeText(e, encoder); dcm.err != nil {
+			return elw.GetPosition(), dcm.err
+		}
+		if dcm.err = datasetWriter.WriteElement(e); dcm.err != nil {
+			return elw.GetPosition(), dcm.err
+		}
+	}
+	if deflater != nil {
+		// flush any remaining compressed data
+		if dcm.err = deflater.Close(); dcm.err != nil {
+			return elw.GetPosition(), dcm.err
+		}
+	}
+	return elw.GetPosition(), nil
+}
+
+// encodeText returns a copy of `e`, with its value encoded from UTF-8 into the
+// character set of `encoder` if it is of a VR subject to the character set, along
+// with those of the elements of its items. This reverses `decodeText`.
+func encodeText(e Element, encoder *encoding.Encoder) (Element, error) {
+	var err error
+	switch e.GetVR() {
+	case "SH", "LO", "ST", "PN", "LT", "UC", "UT":
+		if e.data, err = encoder.Bytes(e.data); err != nil {
+			return e, err
+		}
+	}
+	if len(e.items) == 0 {
+		return e, nil
+	}
+	// items are copied, such that the data sets of the dicom are unchanged
+	items := make([]Item, len(e.items))
+	for i, item := range e.items {
+		items[i].fragment = item.fragment
+		if item.dataset == nil {
+			continue
+		}
+		items[i].dataset = make(DataSet, len(item.dataset))
+		for tag, nested := range item.dataset {
+			if items[i].dataset[tag], err = encodeText(nested, encoder); err != nil {
+				return e, err
+			}
+		}
+	}
+	e.items = items
+	return e, nil
+}
+
+// ToFile encodes the dicom into a file at the given path.
+// See: WriteTo for more information
+func (dcm *Dicom) ToFile(path string) error {
+	var f *os.File
+	if f, dcm.err = os.Create(path); dcm.err != nil {
+		return dcm.err
+	}
+	if _, dcm.err = dcm.WriteTo(f); dcm.err != nil {
+		f.Close()
+		return dcm.err
+	}
+	return f.Close()
+}
+
+/*
+===============================================================================
+	ElementWriter
+	---
+	Provides mechanisms for writing elements to a dicom data sink.
+===============================================================================
+*/
+
+// ElementWriter wraps an `io.Writer` to export methods to assist in
+// encoding DICOM Elements, i.e. "WriteElement".
+type ElementWriter struct {
+	w        io.Writer
+	order    binary.ByteOrder
+	implicit bool
+	pos      int64
+	tmpBuffers
+}
+
+// NewElementWriter returns a fresh ElementWriter set up to use `dst`
+// as its sink.
+//
+// For futureproofing, it is suggested to use these constructors rather than
+// manually creating an instance (i.e. `elw := ElementWriter{}`)
+func NewElementWriter(dst io.Writer) (elw ElementWriter) {
+	elw = ElementWriter{
+		w: dst,
+	}
+	// default to "Implicit VR Little Endian: Default Transfer Syntax for DICOM"
+	elw.SetImplicitVR(true)
+	elw.SetLittleEndian(true)
+	return elw
+}
+
+// GetPosition returns the number of bytes written so far.
+func (elw *ElementWriter) GetPosition() int64 {
+	return elw.pos
+}
+
+// IsLittleEndian returns whether this ElementWriter is set to encode
+// data according to Little Endian byte ordering.
+func (elw *ElementWriter) IsLittleEndian() bool {
+	return elw.order == binary.LittleEndian
+}
+
+// SetLittleEndian sets whether this ElementWriter should encode
+// data according to Little Endian byte ordering.
+func (elw *ElementWriter) SetLittleEndian(isLittleEndian bool) {
+	if isLittleEndian {
+		elw.order = binary.LittleEndian
+	} else {
+		elw.order = binary.BigEndian
+	}
+}
+
+// IsImplicitVR returns whether this ElementWriter is set to encode
+// data with the VR component being implicitly defined
+func (elw *ElementWriter) IsImplicitVR() bool {
+	return elw.implicit
+}
+
+// SetImplicitVR sets whether this ElementWriter should encode
+// data with the VR component being implicitly defined
+func (elw *ElementWriter) SetImplicitVR(isImplicitVR bool) {
+	elw.implicit = isImplicitVR
+}
+
+// writeBytes writes `src` to the underlying writer.
+func (elw *ElementWriter) writeBytes(src []byte) error {
+	elw.i, elw.err = elw.w.Write(src)
+	elw.pos += int64(elw.i)
+	return elw.err
+}
+
+// Write implements `io.Writer`, allowing the ElementWriter to be used as the
+// sink of another writer (i.e. a compressor).
+func (elw *ElementWriter) Write(p []byte) (int, error) {
+	elw.err = elw.writeBytes(p)
+	return elw.i, elw.err
+}
+
+// writeUint16 writes `v` to the underlying writer, according to the current byte order.
+func (elw *ElementWriter) writeUint16(v uint16) error {
+	elw.order.PutUint16(elw._1kb[:2], v)
+	return elw.writeBytes(elw._1kb[:2])
+}
+
+// writeUint32 writes `v` to the underlying writer, according to the current byte order.
+func (elw *ElementWriter) writeUint32(v uint32) error {
+	elw.order.PutUint32(elw._1kb[:4], v)
+	return elw.writeBytes(elw._1kb[:4])
+}
+
+// writeTag writes a dicom "Tag" to the underlying writer.
+func (elw *ElementWriter) writeTag(tag uint32) error {
+	if elw.err = elw.writeUint16(uint16(tag >> 16)); elw.err != nil {
+		return elw.err
+	}
+	return elw.writeUint16(uint16(tag))
+}
+
+// writeElementHeader writes the "Tag", "VR" and "Length" components of an element.
+func (elw *ElementWriter) writeElementHeader(tag uint32, vr string, length uint32) error {
+	if elw.err = elw.writeTag(tag); elw.err != nil {
+		return elw.err
+	}
+	if elw.IsImplicitVR() {
+		// ImplicitVR: all length definitions are 32 bits
+		return elw.writeUint32(length)
+	}
+	if !isRecognisedVR(vr) {
+		vr = "UN"
+	}
+	if elw.err = elw.writeBytes([]byte(vr)); elw.err != nil {
+		return elw.err
+	}
+	if hasLongLength(vr) {
+		// two reserved bytes, and length as 32 bits
+		if elw.err = elw.writeUint16(0); elw.err != nil {
+			return elw.err
+		}
+		return elw.writeUint32(length)
+	}
+	if length > 0xFFFF {
+		return fmt.Errorf("value length of %d would overflow the 16 bit length of VR %s", length, vr)
+	}
+	return elw.writeUint16(uint16(length))
+}
+
+// writeItem writes `item` as an item of undefined length, terminated by an
+// Item Delimitation Item.
+func (elw *ElementWriter) writeItem(item Item) error {
+	if elw.err = elw.writeTag(itemTag); elw.err != nil {
+		return elw.err
+	}
+	if elw.err = elw.writeUint32(0xFFFFFFFF); elw.err != nil {
+		return elw.err
+	}
+	for _, tag := range item.dataset.Tags() {
+		if elw.err = elw.WriteElement(item.dataset[tag]); elw.err != nil {
+			return elw.err
+		}
+	}
+	if elw.err = elw.writeTag(itemDelimTag); elw.err != nil {
+		return elw.err
+	}
+	return elw.writeUint32(0)
+}
+
+// writeFragment writes `fragment` as an item of defined length, as is the
+// case for encapsulated PixelData.
+func (elw *ElementWriter) writeFragment(fragment []byte) error {
+	if elw.err = elw.writeTag(itemTag); elw.err != nil {
+		return elw.err
+	}
+	if len(fragment)%2 != 0 {
+		fragment = append(fragment[:len(fragment):len(fragment)], 0x00)
+	}
+	if elw.err = elw.writeUint32(uint32(len(fragment))); elw.err != nil {
+		return elw.err
+	}
+	return elw.writeBytes(fragment)
+}
+
+// writeSequenceDelimiter writes a Sequence Delimitation Item.
+func (elw *ElementWriter) writeSequenceDelimiter() error {
+	if elw.err = elw.writeTag(seqDelimTag); elw.err != nil {
+		return elw.err
+	}
+	return elw.writeUint32(0)
+}
+
+// WriteElement attempts to completely write element `e`.
+//
+// Sequences are written with undefined length, and nested items are written
+// with undefined length. Encapsulated PixelData is written as a series of
+// fragments. Deferred values are read from their source before being written.
+func (elw *ElementWriter) WriteElement(e Element) error {
+	// deferred values must be read before they can be written
+	if elw.err = e.LoadValue(); elw.err != nil {
+		return elw.err
+	}
+	// encapsulated pixel data
+	if e.GetTag() == pixelDataTag && e.HasItems() {
+		if elw.err = elw.writeElementHeader(e.GetTag(), "OB", 0xFFFFFFFF); elw.err != nil {
+			return elw.err
+		}
+		for _, item := range e.items {
+			if elw.err = elw.writeFragment(item.fragment); elw.err != nil {
+				return elw.err
+			}
+		}
+		return elw.writeSequenceDelimiter()
+	}
+
+	// sequences
+	if e.GetVR() == "SQ" || e.HasItems() {
+		if elw.err = elw.writeElementHeader(e.GetTag(), "SQ", 0xFFFFFFFF); elw.err != nil {
+			return elw.err
+		}
+		for _, item := range e.items {
+			if elw.err = elw.writeItem(item); elw.err != nil {
+				return elw.err
+			}
+		}
+		return elw.writeSequenceDelimiter()
+	}
+
+	// everything else
+	data := paddedData(e)
+	if e.isLittleEndian != elw.IsLittleEndian() {
+		data = swapBytes(data, byteSizeOfVR(e.GetVR()))
+	}
+	if elw.err = elw.writeElementHeader(e.GetTag(), e.GetVR(), uint32(len(data))); elw.err != nil {
+		return elw.err
+	}
+	return elw.writeBytes(data)
+}
+
+// isRecognisedVR returns whether `vr` is contained within `RecognisedVRs`.
+func isRecognisedVR(vr string) bool {
+	for _, recognised := range RecognisedVRs {
+		if vr == recognised {
+			return true
+		}
+	}
+	return false
+}
+
+// paddedData returns the data component of `e`, padded to an even length.
+// This reverses the removal of padding that takes place in `readElementData`.
+func paddedData(e Element) []byte {
+	if len(e.data)%2 == 0 {
+		return e.data
+	}
+	padchar := byte(0x00)
+	switch e.GetVR() {
+	case "CS", "DS", "IS", "AE", "AS", "DA", "DT", "LO", "LT", "PN", "SH", "ST", "TM", "UC", "UR", "UT":
+		padchar = 0x20
+	}
+	return append(e.data[:len(e.data):len(e.data)], padchar)
+}
+
+// byteSizeOfVR returns the size of each value contained within an element of
+// the given binary VR, or 1 if the VR is not subject to byte ordering.
+func byteSizeOfVR(vr string) int {
+	switch vr {
+	case "AT", "OW", "SS", "US":
+		return 2
+	case "FL", "OF", "OL", "SL", "UL":
+		return 4
+	case "FD", "OD", "OV", "SV", "UV":
+		return 8
+	}
+	return 1
+}
+
+// swapBytes returns a copy of `src`, with the byte order of each `size` bytes reversed.
+func swapBytes(src []byte, size int) []byte {
+	if size <= 1 {
+		return src
+	}
+	dst := make([]byte, len(src))
+	copy(dst, src)
+	for pos := 0; pos+size <= len(dst); pos += size {
+		for i, j := pos, pos+size-1; i < j; i, j = i+1, j-1 {
+			dst[i], dst[j] = dst[j], dst[i]
+		}
+	}
+	return dst
+}
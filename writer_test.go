@@ -0,0 +1,353 @@
+package opendcm
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/b71729/bin"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+===============================================================================
+    Dicom (Encoding)
+===============================================================================
+*/
+
+func TestWriteTo(t *testing.T) {
+	// ensures that a dicom written using `WriteTo` decodes
+	// back into an equivalent dicom.
+	t.Parallel()
+	for _, path := range []string{
+		filepath.Join("testdata", "synthetic", "VRTest.dcm"),
+		filepath.Join("testdata", "synthetic", "ISO_IR192.dcm"),
+		filepath.Join("testdata", "synthetic", "ShiftJIS.dcm"),
+		filepath.Join("testdata", "TCIA", "1.3.6.1.4.1.14519.5.2.1.2744.7002.251446451370536632612663178782.dcm"),
+	} {
+		dcm, err := FromFile(path)
+		assert.NoError(t, err)
+		buf := bytes.Buffer{}
+		n, err := dcm.WriteTo(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(buf.Len()), n)
+
+		decoded, err := FromReader(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, dcm.GetPreamble(), decoded.GetPreamble())
+		assert.Equal(t, dcm.Len(), decoded.Len())
+		for tag, e := range dcm.DataSet {
+			d := NewElement()
+			assert.True(t, decoded.GetElement(tag, &d))
+			assert.Equal(t, e.GetVR(), d.GetVR())
+			assert.Equal(t, e.data, d.data)
+			assert.Equal(t, len(e.GetItems()), len(d.GetItems()))
+		}
+		assert.Equal(t, dcm.GetPixelData().NumFrames(), decoded.GetPixelData().NumFrames())
+	}
+}
+
+func TestWriteToGroupLength(t *testing.T) {
+	// ensures that (0002,0000) is recalculated to reflect
+	// the length of the meta group.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	// remove an element from the meta group
+	delete(dcm.DataSet, 0x00020013)
+	buf := bytes.Buffer{}
+	_, err = dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	groupLength := []byte{}
+	found, err := decoded.GetElementValue(0x00020000, &groupLength)
+	assert.True(t, found)
+	assert.NoError(t, err)
+
+	// sum the encoded length of each meta element
+	expected := 0
+	for tag, e := range decoded.DataSet {
+		if tag>>16 != 0x0002 || tag == 0x00020000 {
+			continue
+		}
+		elementBuffer := bytes.Buffer{}
+		elw := NewElementWriter(&elementBuffer)
+		elw.SetImplicitVR(false)
+		assert.NoError(t, elw.WriteElement(e))
+		expected += elementBuffer.Len()
+	}
+	assert.Equal(t, uint32(expected), binary.LittleEndian.Uint32(groupLength))
+}
+
+func TestWriteToError(t *testing.T) {
+	// ensures that errors from the underlying writer are returned.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	for _, failAfter := range []int{0, 128, 132, 200, 600} {
+		_, err = dcm.WriteTo(&failAfterN{failAfter: failAfter})
+		assert.Error(t, err)
+	}
+}
+
+func TestWriteToDeflated(t *testing.T) {
+	// ensures that the data set following the meta group is compressed
+	// when writing "Deflated Explicit VR Little Endian".
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	ts := NewElementWithTag(0x00020010)
+	ts.data = []byte(DeflatedExplicitVRLittleEndian)
+	dcm.addElement(ts)
+	deflated := bytes.Buffer{}
+	_, err = dcm.WriteTo(&deflated)
+	assert.NoError(t, err)
+
+	// meta group is not compressed: 128 byte preamble, "DICM", then the group length
+	metaLength := 132 + 12 + int(binary.LittleEndian.Uint32(deflated.Bytes()[140:144]))
+	inflated, err := ioutil.ReadAll(flate.NewReader(bytes.NewReader(deflated.Bytes()[metaLength:])))
+	assert.NoError(t, err)
+
+	// inflated data set should match the same data set encoded as explicit vr, little endian
+	ts.data = []byte(ExplicitVRLittleEndian)
+	dcm.addElement(ts)
+	uncompressed := bytes.Buffer{}
+	_, err = dcm.WriteTo(&uncompressed)
+	assert.NoError(t, err)
+	metaLength = 132 + 12 + int(binary.LittleEndian.Uint32(uncompressed.Bytes()[140:144]))
+	assert.Equal(t, uncompressed.Bytes()[metaLength:], inflated)
+}
+
+func TestWriteToByteOrder(t *testing.T) {
+	// ensures that 16 bit PixelData read from an Explicit VR Big Endian source
+	// keeps its source VR (OW), and so is swapped when written as little endian.
+	t.Parallel()
+	data := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	dcm := newNativeDicom(2, 2, 1, 16, "", data)
+	for _, uid := range []string{ExplicitVRBigEndian, ExplicitVRLittleEndian} {
+		ts := NewElementWithTag(0x00020010)
+		ts.data = []byte(uid)
+		dcm.Put(ts)
+		buf := bytes.Buffer{}
+		_, err := dcm.WriteTo(&buf)
+		assert.NoError(t, err)
+		dcm, err = FromReader(&buf)
+		assert.NoError(t, err)
+		pd := dcm.DataSet[pixelDataTag]
+		assert.Equal(t, "OW", pd.GetVR(), uid)
+		words := []uint16{}
+		assert.NoError(t, pd.GetValue(&words), uid)
+		assert.Equal(t, []uint16{0x0201, 0x0403, 0x0605, 0x0807}, words, uid)
+	}
+	assert.Equal(t, data, dcm.DataSet[pixelDataTag].data)
+}
+
+func TestWriteToImplicitVR(t *testing.T) {
+	// ensures that 16 bit PixelData read from an Implicit VR source, and so of
+	// the dictionary VR (OB), is written as OW, and swapped, as big endian.
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "TCIA", "1.3.12.2.1107.5.1.4.1001.30000013072513125762500009613.dcm"))
+	assert.NoError(t, err)
+	img, err := dcm.GetImage(0)
+	assert.NoError(t, err)
+	expected := img.At(100, 100)
+	for _, uid := range []string{ImplicitVRLittleEndian, ExplicitVRBigEndian} {
+		ts := NewElementWithTag(0x00020010)
+		ts.data = []byte(uid)
+		dcm.Put(ts)
+		buf := bytes.Buffer{}
+		_, err = dcm.WriteTo(&buf)
+		assert.NoError(t, err)
+		dcm, err = FromReader(&buf)
+		assert.NoError(t, err)
+		img, err = dcm.GetImage(0)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, img.At(100, 100), uid)
+	}
+	pd := dcm.DataSet[pixelDataTag]
+	assert.Equal(t, "OW", pd.GetVR())
+}
+
+func TestWriteToNestedCharacterSet(t *testing.T) {
+	// ensures that textual values within items are encoded into, and decoded
+	// from, the character set of the dicom, without changing the original.
+	t.Parallel()
+	dcm := newDicom()
+	item := make(DataSet, 0)
+	name, err := NewElementWithValue(0x00100010, "Müller^Hans")
+	assert.NoError(t, err)
+	item.Put(name)
+	for tag, value := range map[uint32]interface{}{
+		0x00020010: ExplicitVRLittleEndian,
+		0x00080005: "ISO_IR 100",
+		0x00081115: []DataSet{item},
+	} {
+		e, err := NewElementWithValue(tag, value)
+		assert.NoError(t, err)
+		dcm.Put(e)
+	}
+	buf := bytes.Buffer{}
+	_, err = dcm.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Contains(buf.Bytes(), []byte("M\xFCller^Hans")))
+	assert.Equal(t, []byte("Müller^Hans"), item[0x00100010].data)
+
+	decoded, err := FromReader(&buf)
+	assert.NoError(t, err)
+	value := ""
+	_, err = decoded.QueryValue("ReferencedSeriesSequence[0].PatientName", &value)
+	assert.NoError(t, err)
+	assert.Equal(t, "Müller^Hans", value)
+}
+
+func TestToFile(t *testing.T) {
+	t.Parallel()
+	dcm, err := FromFile(filepath.Join("testdata", "synthetic", "VRTest.dcm"))
+	assert.NoError(t, err)
+	dir, err := ioutil.TempDir("", "")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "out.dcm")
+	assert.NoError(t, dcm.ToFile(path))
+
+	decoded, err := FromFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, dcm.Len(), decoded.Len())
+
+	// directory does not exist
+	assert.Error(t, dcm.ToFile(filepath.Join(dir, "__", "out.dcm")))
+}
+
+/*
+===============================================================================
+    ElementWriter
+===============================================================================
+*/
+
+func TestNewElementWriter(t *testing.T) {
+	t.Parallel()
+	elw := NewElementWriter(&bytes.Buffer{})
+	assert.IsType(t, ElementWriter{}, elw)
+	assert.True(t, elw.IsImplicitVR())
+	assert.True(t, elw.IsLittleEndian())
+	elw.SetImplicitVR(false)
+	assert.False(t, elw.IsImplicitVR())
+	elw.SetLittleEndian(false)
+	assert.False(t, elw.IsLittleEndian())
+}
+
+func TestWriteElement(t *testing.T) {
+	// ensures that `WriteElement` correctly encodes
+	// elements for each encoding.
+	t.Parallel()
+	e := NewElementWithTag(0x00080005)
+	e.data = []byte("ISO_IR 192")
+
+	// implicit VR, little endian
+	buf := bytes.Buffer{}
+	elw := NewElementWriter(&buf)
+	assert.NoError(t, elw.WriteElement(e))
+	assert.Equal(t, append([]byte{0x08, 0x00, 0x05, 0x00, 0x0A, 0x00, 0x00, 0x00}, "ISO_IR 192"...), buf.Bytes())
+
+	// explicit VR, little endian
+	buf.Reset()
+	elw = NewElementWriter(&buf)
+	elw.SetImplicitVR(false)
+	assert.NoError(t, elw.WriteElement(e))
+	assert.Equal(t, append([]byte{0x08, 0x00, 0x05, 0x00, 'C', 'S', 0x0A, 0x00}, "ISO_IR 192"...), buf.Bytes())
+
+	// explicit VR, big endian
+	buf.Reset()
+	elw = NewElementWriter(&buf)
+	elw.SetImplicitVR(false)
+	elw.SetLittleEndian(false)
+	assert.NoError(t, elw.WriteElement(e))
+	assert.Equal(t, append([]byte{0x00, 0x08, 0x00, 0x05, 'C', 'S', 0x00, 0x0A}, "ISO_IR 192"...), buf.Bytes())
+
+	// explicit VR, 32 bit length, with padding
+	e = NewElementWithTag(0x00720065)
+	e.setVR("OB")
+	e.data = []byte{0x01, 0x02, 0x03}
+	buf.Reset()
+	elw = NewElementWriter(&buf)
+	elw.SetImplicitVR(false)
+	assert.NoError(t, elw.WriteElement(e))
+	assert.Equal(t, []byte{0x72, 0x00, 0x65, 0x00, 'O', 'B', 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x00}, buf.Bytes())
+
+	// binary values are swapped when written in a different byte order
+	e = NewElementWithTag(0x00280010)
+	e.data = []byte{0x00, 0x02}
+	buf.Reset()
+	elw = NewElementWriter(&buf)
+	elw.SetLittleEndian(false)
+	assert.NoError(t, elw.WriteElement(e))
+	assert.Equal(t, []byte{0x00, 0x28, 0x00, 0x10, 0x00, 0x00, 0x00, 0x02, 0x02, 0x00}, buf.Bytes())
+}
+
+func TestWriteElementSequence(t *testing.T) {
+	// ensures that sequences written by `WriteElement` are
+	// read back by `ReadElement`.
+	t.Parallel()
+	nested := NewElementWithTag(0x0072005F)
+	nested.setVR("AS")
+	nested.data = []byte("012Y")
+	item := NewItem()
+	item.dataset.addElement(nested)
+	e := NewElementWithTag(0x00089121)
+	e.items = append(e.items, item, item)
+
+	for _, implicit := range []bool{true, false} {
+		buf := bytes.Buffer{}
+		elw := NewElementWriter(&buf)
+		elw.SetImplicitVR(implicit)
+		assert.NoError(t, elw.WriteElement(e))
+
+		elr := NewElementReader(bin.NewReaderBytes(buf.Bytes(), binary.LittleEndian))
+		elr.SetImplicitVR(implicit)
+		decoded := NewElement()
+		assert.NoError(t, elr.ReadElement(&decoded))
+		assert.Len(t, decoded.GetItems(), 2)
+		for _, item := range decoded.GetItems() {
+			d := NewElement()
+			assert.True(t, item.dataset.GetElement(0x0072005F, &d))
+			assert.Equal(t, []byte("012Y"), d.data)
+		}
+	}
+}
+
+func TestWriteElementError(t *testing.T) {
+	// ensures that the error condition of `WriteElement`
+	// responds correctly.
+	t.Parallel()
+	// value too long for a 16 bit length
+	e := NewElementWithTag(0x00100010)
+	e.data = make([]byte, 0x10000)
+	elw := NewElementWriter(&bytes.Buffer{})
+	elw.SetImplicitVR(false)
+	assert.Error(t, elw.WriteElement(e))
+
+	// failure of underlying writer
+	e.data = []byte("Anderson^Leo")
+	for _, failAfter := range []int{0, 4, 6} {
+		elw = NewElementWriter(&failAfterN{failAfter: failAfter})
+		elw.SetImplicitVR(false)
+		assert.Error(t, elw.WriteElement(e))
+	}
+}
+
+func TestSwapBytes(t *testing.T) {
+	t.Parallel()
+	src := []byte{0x01, 0x02, 0x03, 0x04}
+	assert.Equal(t, []byte{0x02, 0x01, 0x04, 0x03}, swapBytes(src, 2))
+	assert.Equal(t, []byte{0x04, 0x03, 0x02, 0x01}, swapBytes(src, 4))
+	assert.Equal(t, src, swapBytes(src, 1))
+	// source is left unmodified
+	assert.Equal(t, []byte{0x01, 0x02, 0x03, 0x04}, src)
+}